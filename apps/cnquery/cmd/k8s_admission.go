@@ -0,0 +1,129 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"go.mondoo.com/cnquery/v9/explorer"
+	"go.mondoo.com/cnquery/v9/explorer/admission"
+	"go.mondoo.com/cnquery/v9/providers"
+)
+
+func init() {
+	k8sAdmissionServeCmd.Flags().String("listen", ":8443", "Address the webhook server listens on.")
+	k8sAdmissionServeCmd.Flags().String("tls-cert", "", "Path to the TLS certificate of the webhook server.")
+	k8sAdmissionServeCmd.Flags().String("tls-key", "", "Path to the TLS private key of the webhook server.")
+	k8sAdmissionServeCmd.Flags().Bool("insecure-http", false, "Serve plain HTTP instead of HTTPS. Only use this for local testing.")
+	k8sAdmissionServeCmd.Flags().StringSliceP("querypack-bundle", "f", nil, "Path to local query pack file")
+	k8sAdmissionServeCmd.Flags().StringSlice("querypack", nil, "Set the query packs to execute. You can specify multiple UIDs.")
+	k8sAdmissionServeCmd.Flags().StringSlice("check", nil, "UIDs of queries that must return true to admit a request. By default, all queries with a boolean result are checks.")
+	k8sAdmissionServeCmd.Flags().Bool("audit", false, "Admit all requests and report failed checks as warnings and audit annotations.")
+	k8sAdmissionServeCmd.Flags().StringSlice("exempt-namespace", []string{"kube-system"}, "Namespaces whose requests are admitted without evaluating them. Supports glob patterns.")
+	k8sAdmissionServeCmd.Flags().Duration("timeout", 9*time.Second, "Maximum time to evaluate a request.")
+	k8sAdmissionServeCmd.Flags().Int("max-evaluations", admission.DefaultMaxEvaluations, "Maximum number of requests that are evaluated at the same time.")
+	k8sAdmissionServeCmd.Flags().StringToString("props", nil, "Custom values for properties")
+
+	k8sAdmissionCmd.AddCommand(k8sAdmissionServeCmd)
+	rootCmd.AddCommand(k8sAdmissionCmd)
+}
+
+var k8sAdmissionCmd = &cobra.Command{
+	Use:   "k8s-admission",
+	Short: "Kubernetes admission control with query packs.",
+}
+
+var k8sAdmissionServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Run a validating admission webhook server.",
+	Long: `
+This command runs an HTTPS server for a Kubernetes ValidatingWebhookConfiguration.
+It scans the objects of incoming AdmissionReviews with the configured query packs
+and denies requests for which checks fail:
+
+		$ cnquery k8s-admission serve -f bundle.mql.yaml --tls-cert tls.crt --tls-key tls.key
+
+The webhook is served at /validate, metrics at /metrics, and a health check at /healthz.
+Both --tls-cert and --tls-key are required. To post sample reviews locally, the server
+can use plain HTTP instead:
+
+		$ cnquery k8s-admission serve -f bundle.mql.yaml --insecure-http
+		$ curl -X POST --data @admission-review.json http://localhost:8443/validate
+
+Checks that are not part of the query packs, or fail to compile, stop the server.
+Requests are denied if checks fail to run.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		paths, _ := cmd.Flags().GetStringSlice("querypack-bundle")
+		if len(paths) == 0 {
+			log.Fatal().Msg("no query packs provided, use --querypack-bundle")
+		}
+		bundle, err := explorer.BundleFromPaths(paths...)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load query packs")
+		}
+		_, err = bundle.CompileExt(context.Background(), explorer.BundleCompileConf{
+			Schema:        providers.DefaultRuntime().Schema(),
+			RemoveFailing: true,
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to compile query packs")
+		}
+
+		checks, _ := cmd.Flags().GetStringSlice("check")
+		if err := admission.ValidateChecks(bundle, checks); err != nil {
+			log.Fatal().Err(err).Msg("invalid checks")
+		}
+
+		listen, _ := cmd.Flags().GetString("listen")
+		cert, _ := cmd.Flags().GetString("tls-cert")
+		key, _ := cmd.Flags().GetString("tls-key")
+		insecure, _ := cmd.Flags().GetBool("insecure-http")
+		if insecure && (cert != "" || key != "") {
+			log.Fatal().Msg("--insecure-http cannot be combined with --tls-cert and --tls-key")
+		}
+		if !insecure && (cert == "" || key == "") {
+			log.Fatal().Msg("both --tls-cert and --tls-key are required, use --insecure-http to serve plain HTTP for local testing")
+		}
+
+		queryPacks, _ := cmd.Flags().GetStringSlice("querypack")
+		props, _ := cmd.Flags().GetStringToString("props")
+		audit, _ := cmd.Flags().GetBool("audit")
+		exempt, _ := cmd.Flags().GetStringSlice("exempt-namespace")
+		timeout, _ := cmd.Flags().GetDuration("timeout")
+		maxEvaluations, _ := cmd.Flags().GetInt("max-evaluations")
+
+		server, err := admission.NewServer(admission.Config{
+			Checks:           checks,
+			AuditOnly:        audit,
+			ExemptNamespaces: exempt,
+			Timeout:          timeout,
+			MaxEvaluations:   maxEvaluations,
+		}, admission.NewScanner(bundle, queryPacks, props))
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to configure admission server")
+		}
+
+		httpServer := &http.Server{
+			Addr:              listen,
+			Handler:           server.Handler(),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+
+		if insecure {
+			log.Warn().Str("listen", listen).Msg("serving admission webhook over plain HTTP")
+			err = httpServer.ListenAndServe()
+		} else {
+			log.Info().Str("listen", listen).Bool("audit", audit).Msg("serving admission webhook")
+			err = httpServer.ListenAndServeTLS(cert, key)
+		}
+		if err != nil {
+			log.Fatal().Err(err).Msg("admission server failed")
+		}
+	},
+}
@@ -172,7 +172,13 @@ func (c *cnqueryPlugin) RunQuery(conf *run.RunQueryConfig, runtime *providers.Ru
 				return errors.Wrap(err, "failed to unmarshal code bundle")
 			}
 			code = &b
-			results, err = sh.RunOnceBundle(code)
+			if conf.DoExplain {
+				results, err = sh.ExplainBundle(code)
+			} else {
+				results, err = sh.RunOnceBundle(code)
+			}
+		} else if conf.DoExplain {
+			code, results, err = sh.ExplainOnce(conf.Command)
 		} else {
 			code, results, err = sh.RunOnce(conf.Command)
 		}
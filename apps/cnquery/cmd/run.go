@@ -23,6 +23,7 @@ func init() {
 	RunCmd.Flags().Bool("parse", false, "Parse the query and return the logical structure.")
 	RunCmd.Flags().Bool("ast", false, "Parse the query and return the abstract syntax tree (AST).")
 	RunCmd.Flags().BoolP("json", "j", false, "Run the query and return the object in a JSON structure.")
+	RunCmd.Flags().Bool("explain", false, "Explain how the query evaluated, showing the value and timing of every step.")
 	RunCmd.Flags().String("platform-id", "", "Select a specific target asset by providing its platform ID.")
 
 	RunCmd.Flags().String("llx", "", "Generate the executable code bundle and save it to the specified file.")
@@ -51,6 +52,10 @@ var RunCmdRun = func(cmd *cobra.Command, runtime *providers.Runtime, cliRes *plu
 	if doJSON, _ := cmd.Flags().GetBool("json"); doJSON {
 		conf.Format = "json"
 	}
+	conf.DoExplain, _ = cmd.Flags().GetBool("explain")
+	if conf.DoExplain && conf.Format == "json" {
+		log.Fatal().Msg("--explain cannot be combined with --json")
+	}
 	if llx, _ := cmd.Flags().GetString("llx"); llx != "" {
 		conf.Format = "llx"
 		conf.Output = llx
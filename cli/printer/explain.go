@@ -0,0 +1,180 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package printer
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/types"
+	"go.mondoo.com/cnquery/v9/utils/stringx"
+)
+
+// maxExplainLines limits how many lines are printed for every value
+const maxExplainLines = 8
+
+// Explain prints all chunks of the code as a tree, together with the value
+// each chunk returned on the asset and the time it took. Chunks that call
+// resources or resource fields are annotated with the provider serving them,
+// if a schema is given.
+func (print *Printer) Explain(bundle *llx.CodeBundle, trace *llx.ExecutionTrace, schema llx.Schema) string {
+	if bundle == nil || bundle.CodeV2 == nil || len(bundle.CodeV2.Blocks) == 0 {
+		return ""
+	}
+
+	var res strings.Builder
+	print.explainBlock(1<<32, bundle, trace, schema, "", &res)
+	return res.String()
+}
+
+func refLabel(ref uint64) string {
+	return "<" + strconv.FormatUint(ref>>32, 10) + "," + strconv.FormatUint(ref&0xFFFFFFFF, 10) + ">"
+}
+
+func (print *Printer) explainBlock(blockRef uint64, bundle *llx.CodeBundle, trace *llx.ExecutionTrace, schema llx.Schema, indent string, w *strings.Builder) {
+	block := bundle.CodeV2.Block(blockRef)
+	if block == nil {
+		return
+	}
+
+	for i := range block.Chunks {
+		ref := blockRef | uint64(i+1)
+		chunk := block.Chunks[i]
+		print.explainChunk(ref, chunk, i < int(block.Parameters), bundle, trace, schema, indent, w)
+
+		if chunk.Function == nil {
+			continue
+		}
+		for _, arg := range chunk.Function.Args {
+			if !types.Type(arg.Type).IsFunction() {
+				continue
+			}
+			if fref, ok := arg.RefV2(); ok {
+				w.WriteString(indent + "   " + print.Secondary("-> block "+strconv.FormatUint(fref>>32, 10)) + "\n")
+				print.explainBlock(fref, bundle, trace, schema, indent+"   ", w)
+			}
+		}
+	}
+}
+
+func (print *Printer) explainChunk(ref uint64, chunk *llx.Chunk, isParameter bool, bundle *llx.CodeBundle, trace *llx.ExecutionTrace, schema llx.Schema, indent string, w *strings.Builder) {
+	w.WriteString(indent)
+	w.WriteString(print.Secondary(refLabel(ref)))
+	w.WriteString(" ")
+
+	switch {
+	case isParameter:
+		w.WriteString("<block argument>")
+	case chunk.Call == llx.Chunk_PRIMITIVE:
+		w.WriteString(print.Primitive(chunk.Primitive, bundle.CodeV2.Id, bundle, indent))
+	case chunk.Call == llx.Chunk_PROPERTY:
+		w.WriteString("props." + chunk.Id)
+	default:
+		w.WriteString(print.Primary(chunkLabel(chunk.Id)))
+		if chunk.Function != nil && chunk.Function.Binding != 0 {
+			w.WriteString(" on " + refLabel(chunk.Function.Binding))
+		}
+	}
+
+	if provider := chunkProvider(chunk, bundle.CodeV2, schema); provider != "" {
+		w.WriteString(print.Secondary(" [" + provider + "]"))
+	}
+
+	var ct *llx.ChunkTrace
+	if trace != nil {
+		ct = trace.Chunk(ref)
+	}
+	if ct == nil {
+		w.WriteString(print.Disabled(" (not executed)") + "\n")
+		return
+	}
+
+	timing := " " + ct.Duration.Round(time.Microsecond).String()
+	if ct.Calls > 1 {
+		timing += " (" + strconv.Itoa(ct.Calls) + " calls)"
+	}
+	w.WriteString(print.Secondary(timing) + "\n")
+
+	valueIndent := indent + "      "
+	for i, result := range ct.Results {
+		prefix := "= "
+		if ct.Calls > 1 {
+			prefix = "[" + strconv.Itoa(i) + "] = "
+		}
+		w.WriteString(valueIndent + prefix)
+		w.WriteString(print.explainValue(result, bundle, valueIndent+strings.Repeat(" ", len(prefix))))
+		w.WriteString("\n")
+	}
+	if ct.Calls > len(ct.Results) {
+		w.WriteString(valueIndent + print.Disabled(fmt.Sprintf("... %d more results", ct.Calls-len(ct.Results))) + "\n")
+	}
+}
+
+func (print *Printer) explainValue(data *llx.RawData, bundle *llx.CodeBundle, indent string) string {
+	if data == nil {
+		return print.Disabled("_")
+	}
+	if data.Error != nil {
+		return print.Error(data.Error.Error())
+	}
+
+	res := print.Data(data.Type, data.Value, bundle.CodeV2.Id, bundle, "")
+	res = stringx.MaxLines(maxExplainLines, strings.TrimRight(res, "\n"))
+	return strings.ReplaceAll(res, "\n", "\n"+indent)
+}
+
+// chunkLabel removes the type suffix, which operators carry in their ID
+func chunkLabel(id string) string {
+	return strings.TrimRightFunc(id, func(r rune) bool {
+		return !unicode.IsPrint(r)
+	})
+}
+
+// chunkProvider returns the name of the provider, which serves the resource
+// or resource field of this chunk
+func chunkProvider(chunk *llx.Chunk, code *llx.CodeV2, schema llx.Schema) string {
+	if schema == nil || chunk.Call != llx.Chunk_FUNCTION {
+		return ""
+	}
+
+	var provider string
+	if chunk.Function == nil || chunk.Function.Binding == 0 {
+		info := schema.Lookup(chunk.Id)
+		if info == nil {
+			return ""
+		}
+		provider = info.Provider
+	} else {
+		binding := code.Chunk(chunk.Function.Binding)
+		if binding == nil {
+			return ""
+		}
+		typ := binding.DereferencedTypeV2(code)
+		if binding.Call == llx.Chunk_FUNCTION && binding.Function == nil {
+			// global resources without arguments carry their type in the ID
+			typ = binding.Type()
+		}
+		if !typ.IsResource() {
+			return ""
+		}
+		info, field := schema.LookupField(typ.ResourceName(), chunk.Id)
+		if field == nil {
+			return ""
+		}
+		provider = field.Provider
+		if provider == "" {
+			provider = info.Provider
+		}
+	}
+
+	if provider == "" {
+		return ""
+	}
+	return path.Base(provider)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/mql"
+)
+
+func TestExplain(t *testing.T) {
+	bundle, err := x.Compile("users.where(name == 'root').list { uid }")
+	require.NoError(t, err)
+
+	trace := llx.NewExecutionTrace()
+	_, err = mql.ExecuteCodeWithTrace(x.Runtime, bundle, nil, cnquery.DefaultFeatures, trace)
+	require.NoError(t, err)
+
+	root := trace.Chunk(1<<32 | 1)
+	require.NotNil(t, root)
+	assert.Equal(t, 1, root.Calls)
+
+	out := DefaultPrinter.Explain(bundle, trace, x.Runtime.Schema())
+	assert.Contains(t, out, "<1,1> users [os]")
+	assert.Contains(t, out, "<1,2> list on <1,1> [os]")
+	assert.Contains(t, out, "-> block 2")
+	assert.Contains(t, out, "<2,2> name on <2,1> [os]")
+	assert.Contains(t, out, "<2,3> == on <2,2>")
+	assert.Contains(t, out, "= \"root\"")
+	assert.Contains(t, out, "<3,2> uid on <3,1> [os]")
+	assert.Contains(t, out, "= 0")
+}
@@ -2,6 +2,7 @@
 // SPDX-License-Identifier: BUSL-1.1
 
 // Package prof is responsible for setting up the go profiler for commands
+// and for collecting profile reports of provider calls and queries
 package prof
 
 import (
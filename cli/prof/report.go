@@ -0,0 +1,321 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package prof
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+const (
+	// CategoryProvider is used for calls across the provider plugin boundary
+	CategoryProvider = "provider"
+	// CategoryQuery is used for the execution of queries
+	CategoryQuery = "query"
+
+	// maxReportRows limits the rows of tables that can grow with the scan
+	maxReportRows = 25
+)
+
+// Event is a timed call that is recorded in the profile report
+type Event struct {
+	Category string
+	// Name of the call, e.g. GetData or the query's MQL
+	Name     string
+	Provider string
+	Resource string
+	Field    string
+	Start    time.Time
+	Duration time.Duration
+	// payload sizes in bytes
+	RequestSize  int
+	ResponseSize int
+	Failed       bool
+}
+
+// Report collects timing events of a run, which are then aggregated into
+// tables or exported as Chrome trace for flame-graph viewers
+type Report struct {
+	lock   sync.Mutex
+	start  time.Time
+	events []Event
+}
+
+func NewReport() *Report {
+	return &Report{start: time.Now()}
+}
+
+// Add an event to the report
+func (r *Report) Add(event Event) {
+	r.lock.Lock()
+	r.events = append(r.events, event)
+	r.lock.Unlock()
+}
+
+// Events returns a copy of all recorded events, sorted by their start time
+func (r *Report) Events() []Event {
+	r.lock.Lock()
+	res := make([]Event, len(r.events))
+	copy(res, r.events)
+	r.lock.Unlock()
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Start.Before(res[j].Start)
+	})
+	return res
+}
+
+type aggregate struct {
+	keys         []string
+	calls        int
+	failed       int
+	total        time.Duration
+	max          time.Duration
+	requestSize  int
+	responseSize int
+}
+
+func (a *aggregate) add(e Event) {
+	a.calls++
+	if e.Failed {
+		a.failed++
+	}
+	a.total += e.Duration
+	if e.Duration > a.max {
+		a.max = e.Duration
+	}
+	a.requestSize += e.RequestSize
+	a.responseSize += e.ResponseSize
+}
+
+func (a *aggregate) avg() time.Duration {
+	if a.calls == 0 {
+		return 0
+	}
+	return a.total / time.Duration(a.calls)
+}
+
+func aggregateEvents(events []Event, category string, keys func(e Event) []string) []*aggregate {
+	idx := map[string]*aggregate{}
+	var res []*aggregate
+	for _, e := range events {
+		if e.Category != category {
+			continue
+		}
+		k := keys(e)
+		id := strings.Join(k, "\x00")
+		cur, ok := idx[id]
+		if !ok {
+			cur = &aggregate{keys: k}
+			idx[id] = cur
+			res = append(res, cur)
+		}
+		cur.add(e)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].total > res[j].total
+	})
+	return res
+}
+
+func formatDuration(d time.Duration) string {
+	switch {
+	case d >= time.Second:
+		return d.Round(time.Millisecond).String()
+	case d >= time.Millisecond:
+		return d.Round(10 * time.Microsecond).String()
+	default:
+		return d.Round(time.Microsecond).String()
+	}
+}
+
+func formatBytes(n int) string {
+	switch {
+	case n >= 1<<20:
+		return strconv.FormatFloat(float64(n)/(1<<20), 'f', 1, 64) + " MiB"
+	case n >= 1<<10:
+		return strconv.FormatFloat(float64(n)/(1<<10), 'f', 1, 64) + " KiB"
+	default:
+		return strconv.Itoa(n) + " B"
+	}
+}
+
+func writeTable(w io.Writer, title string, header []string, rows [][]string) {
+	io.WriteString(w, title+"\n")
+	table := tablewriter.NewWriter(w)
+	table.SetHeader(header)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
+	table.SetCenterSeparator("|")
+	table.SetAutoWrapText(false)
+	table.AppendBulk(rows)
+	table.Render()
+	io.WriteString(w, "\n")
+}
+
+func truncate(s string, max int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if len(s) <= max {
+		return s
+	}
+	return s[:max-3] + "..."
+}
+
+// WriteTable writes the aggregated report as tables: per provider call,
+// per resource field and per query
+func (r *Report) WriteTable(w io.Writer) {
+	events := r.Events()
+
+	providers := aggregateEvents(events, CategoryProvider, func(e Event) []string {
+		return []string{e.Provider, e.Name}
+	})
+	rows := make([][]string, len(providers))
+	for i, a := range providers {
+		rows[i] = []string{
+			a.keys[0], a.keys[1], strconv.Itoa(a.calls), strconv.Itoa(a.failed),
+			formatDuration(a.total), formatDuration(a.avg()), formatDuration(a.max),
+			formatBytes(a.requestSize), formatBytes(a.responseSize),
+		}
+	}
+	writeTable(w, "Provider calls", []string{"Provider", "Call", "Calls", "Errors", "Total", "Avg", "Max", "Sent", "Received"}, rows)
+
+	resources := aggregateEvents(events, CategoryProvider, func(e Event) []string {
+		field := e.Field
+		if field == "" {
+			field = "(create)"
+		}
+		return []string{e.Provider, e.Resource, field}
+	})
+	rows = [][]string{}
+	for _, a := range resources {
+		if a.keys[1] == "" {
+			continue
+		}
+		if len(rows) == maxReportRows {
+			break
+		}
+		rows = append(rows, []string{
+			a.keys[0], a.keys[1], a.keys[2], strconv.Itoa(a.calls),
+			formatDuration(a.total), formatDuration(a.avg()), formatBytes(a.responseSize),
+		})
+	}
+	writeTable(w, "Resources (slowest first)", []string{"Provider", "Resource", "Field", "Calls", "Total", "Avg", "Received"}, rows)
+
+	queries := aggregateEvents(events, CategoryQuery, func(e Event) []string {
+		return []string{e.Name}
+	})
+	rows = [][]string{}
+	for _, a := range queries {
+		if len(rows) == maxReportRows {
+			break
+		}
+		rows = append(rows, []string{
+			truncate(a.keys[0], 80), strconv.Itoa(a.calls), formatDuration(a.total),
+		})
+	}
+	writeTable(w, "Queries (slowest first)", []string{"Query", "Runs", "Total"}, rows)
+}
+
+type chromeTraceEvent struct {
+	Name     string                 `json:"name"`
+	Category string                 `json:"cat,omitempty"`
+	Phase    string                 `json:"ph"`
+	Time     int64                  `json:"ts"`
+	Duration int64                  `json:"dur,omitempty"`
+	PID      int                    `json:"pid"`
+	TID      int                    `json:"tid"`
+	Args     map[string]interface{} `json:"args,omitempty"`
+}
+
+type chromeTrace struct {
+	TraceEvents     []chromeTraceEvent `json:"traceEvents"`
+	DisplayTimeUnit string             `json:"displayTimeUnit"`
+}
+
+// WriteChromeTrace exports all events in the Chrome trace event format,
+// which can be viewed in chrome://tracing, Perfetto or speedscope.
+// Every provider gets its own process, with concurrent calls spread across
+// threads so that they don't overlap.
+func (r *Report) WriteChromeTrace(w io.Writer) error {
+	events := r.Events()
+	res := chromeTrace{
+		TraceEvents:     []chromeTraceEvent{},
+		DisplayTimeUnit: "ms",
+	}
+
+	pids := map[string]int{}
+	// lanes holds the end time of the last event per thread of each process
+	lanes := map[string][]time.Time{}
+
+	for _, e := range events {
+		group := e.Provider
+		if e.Category == CategoryQuery {
+			group = "queries"
+		}
+
+		pid, ok := pids[group]
+		if !ok {
+			pid = len(pids) + 1
+			pids[group] = pid
+			res.TraceEvents = append(res.TraceEvents, chromeTraceEvent{
+				Name: "process_name", Phase: "M", PID: pid,
+				Args: map[string]interface{}{"name": group},
+			})
+		}
+
+		end := e.Start.Add(e.Duration)
+		tid := -1
+		for i, laneEnd := range lanes[group] {
+			if !laneEnd.After(e.Start) {
+				tid = i
+				break
+			}
+		}
+		if tid == -1 {
+			tid = len(lanes[group])
+			lanes[group] = append(lanes[group], end)
+		} else {
+			lanes[group][tid] = end
+		}
+
+		name := e.Name
+		args := map[string]interface{}{}
+		if e.Category == CategoryProvider {
+			if e.Resource != "" {
+				name += " " + e.Resource
+				if e.Field != "" {
+					name += "." + e.Field
+				}
+			}
+			args["request_bytes"] = e.RequestSize
+			args["response_bytes"] = e.ResponseSize
+		}
+		if e.Failed {
+			args["failed"] = true
+		}
+
+		res.TraceEvents = append(res.TraceEvents, chromeTraceEvent{
+			Name:     truncate(name, 120),
+			Category: e.Category,
+			Phase:    "X",
+			Time:     e.Start.Sub(r.start).Microseconds(),
+			Duration: e.Duration.Microseconds(),
+			PID:      pid,
+			TID:      tid + 1,
+			Args:     args,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	return enc.Encode(res)
+}
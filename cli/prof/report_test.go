@@ -0,0 +1,74 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package prof
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testReport() *Report {
+	report := NewReport()
+	start := report.start
+	report.Add(Event{
+		Category: CategoryProvider, Name: "Connect", Provider: "os",
+		Start: start, Duration: 20 * time.Millisecond, RequestSize: 100, ResponseSize: 300,
+	})
+	report.Add(Event{
+		Category: CategoryProvider, Name: "GetData", Provider: "os", Resource: "packages", Field: "list",
+		Start: start.Add(20 * time.Millisecond), Duration: 50 * time.Millisecond, RequestSize: 10, ResponseSize: 2048,
+	})
+	// overlaps with the previous call
+	report.Add(Event{
+		Category: CategoryProvider, Name: "GetData", Provider: "os", Resource: "users",
+		Start: start.Add(30 * time.Millisecond), Duration: 5 * time.Millisecond, RequestSize: 10, Failed: true,
+	})
+	report.Add(Event{
+		Category: CategoryQuery, Name: "packages.list",
+		Start: start.Add(20 * time.Millisecond), Duration: 55 * time.Millisecond,
+	})
+	return report
+}
+
+func TestReportTable(t *testing.T) {
+	var buf bytes.Buffer
+	testReport().WriteTable(&buf)
+	out := buf.String()
+
+	assert.Contains(t, out, "Provider calls")
+	assert.Regexp(t, `os +\| GetData +\| 2 +\| 1 +\| 55ms`, out)
+	assert.Regexp(t, `os +\| packages +\| list +\| 1 +\| 50ms +\| 50ms +\| 2.0 KiB`, out)
+	assert.Regexp(t, `os +\| users +\| \(create\)`, out)
+	assert.Regexp(t, `packages.list +\| 1 +\| 55ms`, out)
+}
+
+func TestReportChromeTrace(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, testReport().WriteChromeTrace(&buf))
+
+	var trace chromeTrace
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &trace))
+
+	var names []string
+	tids := map[string]int{}
+	for _, e := range trace.TraceEvents {
+		if e.Phase == "M" {
+			names = append(names, e.Args["name"].(string))
+			continue
+		}
+		tids[e.Name] = e.TID
+	}
+	assert.Equal(t, []string{"os", "queries"}, names)
+	assert.Equal(t, map[string]int{
+		"Connect":               1,
+		"GetData packages.list": 1,
+		"GetData users":         2,
+		"packages.list":         1,
+	}, tids)
+}
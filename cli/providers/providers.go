@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
@@ -14,6 +15,7 @@ import (
 	"github.com/spf13/viper"
 	"go.mondoo.com/cnquery/v9/cli/components"
 	"go.mondoo.com/cnquery/v9/cli/config"
+	"go.mondoo.com/cnquery/v9/cli/prof"
 	"go.mondoo.com/cnquery/v9/llx"
 	"go.mondoo.com/cnquery/v9/providers"
 	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
@@ -239,14 +241,51 @@ func genBuiltinFlags(discoveries ...string) []plugin.Flag {
 			Type: plugin.FlagType_String,
 			Desc: "Use a recording to inject resource data (read-only)",
 		},
+		{
+			Long:        "cache-ttl",
+			Type:        plugin.FlagType_String,
+			Desc:        "Cache resource data across runs for up to this duration (e.g. 30m), limited by each resource's TTL",
+			ConfigEntry: "cache_ttl",
+		},
+		{
+			Long:        "no-cache",
+			Type:        plugin.FlagType_Bool,
+			Desc:        "Don't use cached resource data, even if a cache TTL is configured",
+			ConfigEntry: "-",
+		},
+		{
+			Long: "profile-report",
+			Type: plugin.FlagType_String,
+			Desc: "Profile all provider calls and queries, print a summary and write a Chrome trace to this file",
+		},
 	}
 }
 
 // the following flags are not processed by providers
 var skipFlags = map[string]struct{}{
-	"ask-pass":      {},
-	"record":        {},
-	"use-recording": {},
+	"ask-pass":       {},
+	"record":         {},
+	"use-recording":  {},
+	"cache-ttl":      {},
+	"no-cache":       {},
+	"profile-report": {},
+}
+
+func writeProfileReport(report *prof.Report, path string) {
+	report.WriteTable(os.Stderr)
+
+	f, err := os.Create(path)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create profile report")
+		return
+	}
+	defer f.Close()
+
+	if err := report.WriteChromeTrace(f); err != nil {
+		log.Error().Err(err).Msg("failed to write profile report")
+		return
+	}
+	log.Info().Str("path", path).Msg("wrote profile report, open it in chrome://tracing or https://ui.perfetto.dev")
 }
 
 func attachPFlags(base *pflag.FlagSet, nu *pflag.FlagSet) {
@@ -404,6 +443,15 @@ func setConnector(provider *plugin.Provider, connector *plugin.Connector, run fu
 		if err != nil {
 			log.Warn().Msg("failed to get flag --pretty")
 		}
+		profileReport, err := cc.Flags().GetString("profile-report")
+		if err != nil {
+			log.Warn().Msg("failed to get flag --profile-report")
+		}
+		var report *prof.Report
+		if profileReport != "" {
+			report = prof.NewReport()
+			providers.Coordinator.EnableProfiling(report)
+		}
 
 		flagVals := map[string]*llx.Primitive{}
 		for i := range allFlags {
@@ -456,6 +504,23 @@ func setConnector(provider *plugin.Provider, connector *plugin.Connector, run fu
 		if err != nil {
 			log.Fatal().Msg(err.Error())
 		}
+
+		noCache, err := cc.Flags().GetBool("no-cache")
+		if err != nil {
+			log.Warn().Msg("failed to get flag --no-cache")
+		}
+		if cacheTTL := viper.GetString("cache_ttl"); cacheTTL != "" && !noCache {
+			maxTTL, err := time.ParseDuration(cacheTTL)
+			if err != nil {
+				log.Fatal().Err(err).Msg("invalid value for --cache-ttl")
+			}
+			recording, err = providers.NewResultCache(recording, providers.ResultCacheOptions{
+				MaxTTL: maxTTL,
+			})
+			if err != nil {
+				log.Fatal().Err(err).Msg("failed to set up result cache")
+			}
+		}
 		runtime.SetRecording(recording)
 
 		cliRes, err := runtime.Provider.Instance.Plugin.ParseCLI(&plugin.ParseCLIReq{
@@ -490,6 +555,10 @@ func setConnector(provider *plugin.Provider, connector *plugin.Connector, run fu
 		run(cc, runtime, cliRes)
 		runtime.Close()
 		providers.Coordinator.Shutdown()
+
+		if report != nil {
+			writeProfileReport(report, profileReport)
+		}
 	}
 
 	attachFlags(cmd.Flags(), allFlags)
@@ -43,6 +43,10 @@ func (r *cliReporter) print() error {
 		r.printQueryData()
 	}
 
+	if !r.isSummary && len(r.data.Aggregations) != 0 {
+		r.printAggregations()
+	}
+
 	r.printSummary()
 	return nil
 }
@@ -212,3 +216,36 @@ func (r *cliReporter) printAssetQueries(assetMrn string, queries []*explorer.Mqu
 		r.out.Write([]byte("\n\n"))
 	}
 }
+
+func (r *cliReporter) printAggregations() {
+	r.out.Write([]byte(r.Printer.H1("Aggregations (" + strconv.Itoa(len(r.data.Aggregations)) + ")")))
+
+	for i := range r.data.Aggregations {
+		aggregation := r.data.Aggregations[i]
+
+		title := aggregation.Query.GetTitle()
+		if title == "" {
+			title, _ = mrn.GetResource(aggregation.Query.GetMrn(), "queries")
+		}
+		if title != "" {
+			r.out.Write([]byte(title + ":\n"))
+		}
+
+		if aggregation.Error != nil {
+			r.out.Write([]byte(r.Printer.Error(aggregation.Error.Message) + "\n\n"))
+			continue
+		}
+
+		results := make(map[string]*llx.RawResult, len(aggregation.Data))
+		for k, v := range aggregation.Data {
+			results[k] = v.RawResultV2()
+		}
+
+		result := r.Reporter.Printer.Results(aggregation.Code, results)
+		if r.isCompact {
+			result = stringx.MaxLines(10, result)
+		}
+		r.out.Write([]byte(result))
+		r.out.Write([]byte("\n\n"))
+	}
+}
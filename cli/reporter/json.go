@@ -111,7 +111,35 @@ func ReportCollectionToJSON(data *explorer.ReportCollection, out shared.OutputHe
 		out.WriteString(pre + llx.PrettyPrintString(id) + ":" + llx.PrettyPrintString(errStatus.Message))
 		pre = ","
 	}
-	out.WriteString("}}")
+	out.WriteString("}")
+
+	if len(data.Aggregations) != 0 {
+		out.WriteString("," +
+			"\"aggregations\":" +
+			"{")
+		pre = ""
+		for i := range data.Aggregations {
+			aggregation := data.Aggregations[i]
+			out.WriteString(pre + llx.PrettyPrintString(aggregation.Query.GetMrn()) + ":")
+			pre = ","
+
+			if aggregation.Error != nil {
+				out.WriteString("{\"error\":" + llx.PrettyPrintString(aggregation.Error.Message) + "}")
+				continue
+			}
+
+			results := make(map[string]*llx.RawResult, len(aggregation.Data))
+			for k, v := range aggregation.Data {
+				results[k] = v.RawResultV2()
+			}
+			if err := BundleResultsToJSON(aggregation.Code, results, out); err != nil {
+				return err
+			}
+		}
+		out.WriteString("}")
+	}
+
+	out.WriteString("}")
 
 	return nil
 }
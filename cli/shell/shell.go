@@ -206,7 +206,10 @@ func (s *Shell) RunInteractive(cmd string) {
 	s.handleExit()
 }
 
-var helpResource = regexp.MustCompile(`help\s(.*)`)
+var (
+	helpResource = regexp.MustCompile(`help\s(.*)`)
+	explainQuery = regexp.MustCompile(`^:explain\s+(.*)`)
+)
 
 func (s *Shell) ExecCmd(cmd string) {
 	switch {
@@ -233,6 +236,9 @@ func (s *Shell) ExecCmd(cmd string) {
 	case helpResource.MatchString(cmd):
 		s.listFilteredResources(cmd)
 		return
+	case explainQuery.MatchString(cmd):
+		s.explainQuery(explainQuery.FindStringSubmatch(cmd)[1])
+		return
 	default:
 		s.execQuery(cmd)
 	}
@@ -276,6 +282,15 @@ func (s *Shell) execQuery(cmd string) {
 	s.query = ""
 }
 
+func (s *Shell) explainQuery(cmd string) {
+	code, res, err := s.ExplainOnce(cmd)
+	if err != nil {
+		fmt.Fprintln(s.out, s.Theme.Error("failed to explain query: "+err.Error()))
+		return
+	}
+	s.PrintResults(code, res)
+}
+
 func (s *Shell) changeLivePrefix() (string, bool) {
 	if s.isMultiline {
 		indent := strings.Repeat(" ", s.multilineIndent*2)
@@ -334,6 +349,34 @@ func (s *Shell) RunOnceBundle(code *llx.CodeBundle) (map[string]*llx.RawResult,
 	return mql.ExecuteCode(s.Runtime, code, nil, s.features)
 }
 
+// ExplainOnce executes the query, prints how every chunk of its code
+// evaluated and returns its results
+func (s *Shell) ExplainOnce(cmd string) (*llx.CodeBundle, map[string]*llx.RawResult, error) {
+	s.resetPrintCache()
+
+	code, err := mqlc.Compile(cmd, nil, mqlc.NewConfig(s.Runtime.Schema(), s.features))
+	if err != nil {
+		fmt.Fprintln(s.out, s.Theme.Error("failed to compile: "+err.Error()))
+		return nil, nil, err
+	}
+
+	res, err := s.ExplainBundle(code)
+	return code, res, err
+}
+
+// ExplainBundle executes the given code bundle, prints how every chunk
+// of its code evaluated and returns its results
+func (s *Shell) ExplainBundle(code *llx.CodeBundle) (map[string]*llx.RawResult, error) {
+	trace := llx.NewExecutionTrace()
+	res, err := mql.ExecuteCodeWithTrace(s.Runtime, code, nil, s.features, trace)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprint(s.out, s.Theme.PolicyPrinter.Explain(code, trace, s.Runtime.Schema()))
+	return res, nil
+}
+
 func (s *Shell) PrintResults(code *llx.CodeBundle, results map[string]*llx.RawResult) {
 	printedResult := s.Theme.PolicyPrinter.Results(code, results)
 
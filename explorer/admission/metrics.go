@@ -0,0 +1,97 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package admission
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	decisionAllowed = "allowed"
+	decisionDenied  = "denied"
+	decisionAudited = "audited"
+	decisionExempt  = "exempt"
+	decisionError   = "error"
+)
+
+// durationBuckets are the upper bounds of the request duration histogram
+var durationBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// metrics collects request statistics and writes them in the Prometheus
+// text format
+type metrics struct {
+	lock       sync.Mutex
+	requests   map[string]int64
+	violations map[string]int64
+	buckets    []int64
+	count      int64
+	sum        float64
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		requests:   map[string]int64{},
+		violations: map[string]int64{},
+		buckets:    make([]int64, len(durationBuckets)),
+	}
+}
+
+func (m *metrics) observe(decision string, duration time.Duration, failed []string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.requests[decision]++
+	for _, uid := range failed {
+		m.violations[uid]++
+	}
+
+	seconds := duration.Seconds()
+	m.count++
+	m.sum += seconds
+	for i, le := range durationBuckets {
+		if seconds <= le {
+			m.buckets[i]++
+		}
+	}
+}
+
+func sortedKeys(m map[string]int64) []string {
+	res := make([]string, 0, len(m))
+	for k := range m {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func (m *metrics) write(w io.Writer) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	fmt.Fprintln(w, "# HELP cnquery_admission_requests_total Admission reviews by decision.")
+	fmt.Fprintln(w, "# TYPE cnquery_admission_requests_total counter")
+	for _, decision := range []string{decisionAllowed, decisionDenied, decisionAudited, decisionExempt, decisionError} {
+		fmt.Fprintf(w, "cnquery_admission_requests_total{decision=%q} %d\n", decision, m.requests[decision])
+	}
+
+	fmt.Fprintln(w, "# HELP cnquery_admission_violations_total Failed checks by query.")
+	fmt.Fprintln(w, "# TYPE cnquery_admission_violations_total counter")
+	for _, uid := range sortedKeys(m.violations) {
+		fmt.Fprintf(w, "cnquery_admission_violations_total{query=%q} %d\n", uid, m.violations[uid])
+	}
+
+	fmt.Fprintln(w, "# HELP cnquery_admission_request_duration_seconds Time to evaluate admission reviews.")
+	fmt.Fprintln(w, "# TYPE cnquery_admission_request_duration_seconds histogram")
+	for i, le := range durationBuckets {
+		fmt.Fprintf(w, "cnquery_admission_request_duration_seconds_bucket{le=%q} %d\n", strconv.FormatFloat(le, 'f', -1, 64), m.buckets[i])
+	}
+	fmt.Fprintf(w, "cnquery_admission_request_duration_seconds_bucket{le=\"+Inf\"} %d\n", m.count)
+	fmt.Fprintf(w, "cnquery_admission_request_duration_seconds_sum %s\n", strconv.FormatFloat(m.sum, 'f', -1, 64))
+	fmt.Fprintf(w, "cnquery_admission_request_duration_seconds_count %d\n", m.count)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package admission
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	"go.mondoo.com/cnquery/v9/explorer"
+	"go.mondoo.com/cnquery/v9/explorer/scan"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"google.golang.org/protobuf/proto"
+	admissionv1 "k8s.io/api/admission/v1"
+)
+
+// admissionReviewOption is the connection option of the k8s provider that
+// holds a base64-encoded AdmissionReview
+const admissionReviewOption = "k8s-admission-review"
+
+// Scanner evaluates reviews by scanning them with the k8s provider. The
+// provider discovers the reviewed object as an asset, so query packs can
+// use the same filters as for clusters and manifests.
+type Scanner struct {
+	scanner          *scan.LocalScanner
+	bundle           *explorer.Bundle
+	queryPackFilters []string
+	props            map[string]string
+}
+
+func NewScanner(bundle *explorer.Bundle, queryPackFilters []string, props map[string]string, opts ...scan.ScannerOption) *Scanner {
+	return &Scanner{
+		scanner:          scan.NewLocalScanner(opts...),
+		bundle:           bundle,
+		queryPackFilters: queryPackFilters,
+		props:            props,
+	}
+}
+
+func (s *Scanner) Evaluate(ctx context.Context, review *admissionv1.AdmissionReview) ([]Result, error) {
+	data, err := json.Marshal(review)
+	if err != nil {
+		return nil, err
+	}
+
+	asset := &inventory.Asset{
+		Name: "K8s Admission review " + review.Request.Name,
+		Connections: []*inventory.Config{{
+			Type: "k8s",
+			Options: map[string]string{
+				admissionReviewOption: base64.StdEncoding.EncodeToString(data),
+			},
+			Discover: &inventory.Discovery{Targets: []string{"auto"}},
+		}},
+		Category: inventory.AssetCategory_CATEGORY_CICD,
+	}
+
+	// the scanner stops between assets once the context is canceled
+	reports, err := s.scanner.RunIncognito(ctx, &scan.Job{
+		Inventory: &inventory.Inventory{
+			Spec: &inventory.InventorySpec{Assets: []*inventory.Asset{asset}},
+		},
+		// the bundle is cloned, since scans filter and modify it
+		Bundle:           proto.Clone(s.bundle).(*explorer.Bundle),
+		QueryPackFilters: s.queryPackFilters,
+		Props:            s.props,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return reportResults(reports), nil
+}
+
+// reportResults converts the reports of all assets into results
+func reportResults(reports *explorer.ReportCollection) []Result {
+	res := []Result{}
+	for assetMrn, asset := range reports.Assets {
+		if errStatus, ok := reports.Errors[assetMrn]; ok {
+			res = append(res, Result{Asset: asset.Name, Error: errStatus.Message})
+			continue
+		}
+
+		values := scan.QueryResults(reports, assetMrn)
+		uids := make([]string, 0, len(values))
+		for uid := range values {
+			uids = append(uids, uid)
+		}
+		sort.Strings(uids)
+		for _, uid := range uids {
+			if msg, ok := queryError(values[uid]); ok {
+				res = append(res, Result{Asset: asset.Name, Query: uid, Error: msg})
+				continue
+			}
+			res = append(res, Result{Asset: asset.Name, Query: uid, Value: values[uid]})
+		}
+	}
+	return res
+}
+
+// queryError returns the error of a query result. Failed queries are
+// represented as {"error": "..."} in the JSON results.
+func queryError(v interface{}) (string, bool) {
+	m, ok := v.(map[string]interface{})
+	if !ok || len(m) != 1 {
+		return "", false
+	}
+	msg, ok := m["error"].(string)
+	return msg, ok
+}
+
+// ValidateChecks makes sure that all checks are queries of the bundle, so
+// that misspelled or removed checks don't admit every request
+func ValidateChecks(bundle *explorer.Bundle, checks []string) error {
+	uids := map[string]struct{}{}
+	add := func(queries []*explorer.Mquery) {
+		for _, q := range queries {
+			uids[q.Uid] = struct{}{}
+		}
+	}
+	add(bundle.Queries)
+	for _, pack := range bundle.Packs {
+		add(pack.Queries)
+		for _, group := range pack.Groups {
+			add(group.Queries)
+		}
+	}
+
+	missing := []string{}
+	for _, uid := range checks {
+		if _, ok := uids[uid]; !ok {
+			missing = append(missing, uid)
+		}
+	}
+	if len(missing) != 0 {
+		return errors.New("checks are not part of the query packs or failed to compile: " + strings.Join(missing, ", "))
+	}
+	return nil
+}
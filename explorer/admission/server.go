@@ -0,0 +1,360 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package admission implements a Kubernetes validating admission webhook. It
+// runs query packs against the objects of incoming AdmissionReviews and
+// denies requests for which configured queries fail.
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gobwas/glob"
+	"github.com/rs/zerolog/log"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// maxRequestSize limits the size of AdmissionReviews. The API server limits
+// objects to 3MiB, so reviews with an old and a new object fit into it.
+const maxRequestSize = 7 * 1024 * 1024
+
+// AuditAnnotation is the key of the audit annotation that lists failed
+// queries in audit-only mode
+const AuditAnnotation = "cnquery.mondoo.com/violations"
+
+// Result is the result of a query for one of the assets of a review
+type Result struct {
+	// Asset is the name of the asset, e.g. the name of the reviewed object
+	Asset string
+	// Query is the UID of the query. It is empty for errors of the asset.
+	Query string
+	// Value of the query, using its JSON representation
+	Value interface{}
+	// Error that occurred while scanning the asset, or while running the
+	// query if it is set
+	Error string
+}
+
+// Evaluator runs queries against the objects of an AdmissionReview
+type Evaluator interface {
+	Evaluate(ctx context.Context, review *admissionv1.AdmissionReview) ([]Result, error)
+}
+
+type Config struct {
+	// Checks are the UIDs of the queries that must return true for a request
+	// to be allowed. If empty, all queries with a boolean result are checks,
+	// including queries whose entrypoints all return booleans.
+	Checks []string
+	// AuditOnly allows all requests and reports failed checks as warnings
+	// and audit annotations instead
+	AuditOnly bool
+	// ExemptNamespaces are glob patterns of namespaces whose requests are
+	// allowed without evaluating them
+	ExemptNamespaces []string
+	// Timeout for evaluating a review. Kubernetes waits 10 seconds for
+	// webhooks by default.
+	Timeout time.Duration
+	// MaxEvaluations limits how many reviews are evaluated at the same time.
+	// Evaluations that time out keep running until the scan stops, so they
+	// count against this limit until they are done. Defaults to
+	// DefaultMaxEvaluations.
+	MaxEvaluations int
+}
+
+// DefaultMaxEvaluations is the default limit of concurrent evaluations
+const DefaultMaxEvaluations = 8
+
+type Server struct {
+	conf      Config
+	evaluator Evaluator
+	checks    map[string]struct{}
+	exempt    []glob.Glob
+	metrics   *metrics
+	// evaluations has a slot for every running evaluation
+	evaluations chan struct{}
+}
+
+func NewServer(conf Config, evaluator Evaluator) (*Server, error) {
+	if conf.MaxEvaluations <= 0 {
+		conf.MaxEvaluations = DefaultMaxEvaluations
+	}
+	s := &Server{
+		conf:        conf,
+		evaluator:   evaluator,
+		checks:      map[string]struct{}{},
+		metrics:     newMetrics(),
+		evaluations: make(chan struct{}, conf.MaxEvaluations),
+	}
+	for _, uid := range conf.Checks {
+		s.checks[uid] = struct{}{}
+	}
+	for _, ns := range conf.ExemptNamespaces {
+		g, err := glob.Compile(ns)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exempt namespace %q: %w", ns, err)
+		}
+		s.exempt = append(s.exempt, g)
+	}
+	return s, nil
+}
+
+// Handler serves the webhook at /validate, metrics at /metrics, and a health
+// check at /healthz
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/validate", s.handleValidate)
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		s.metrics.write(w)
+	})
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	return mux
+}
+
+func (s *Server) handleValidate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, "failed to read request", http.StatusBadRequest)
+		return
+	}
+
+	review := &admissionv1.AdmissionReview{}
+	if err := json.Unmarshal(data, review); err != nil || review.Request == nil {
+		http.Error(w, "request is not an AdmissionReview", http.StatusBadRequest)
+		return
+	}
+
+	start := time.Now()
+	response, err := s.Review(r.Context(), review)
+	if err != nil {
+		// errors are handled by the failure policy of the webhook
+		log.Error().Err(err).Str("uid", string(review.Request.UID)).Msg("failed to evaluate admission review")
+		s.metrics.observe(decisionError, time.Since(start), nil)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	out, err := json.Marshal(&admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionv1.SchemeGroupVersion.String(),
+			Kind:       "AdmissionReview",
+		},
+		Response: response,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(out)
+}
+
+// Review evaluates the review and returns the response for the API server
+func (s *Server) Review(ctx context.Context, review *admissionv1.AdmissionReview) (*admissionv1.AdmissionResponse, error) {
+	req := review.Request
+	if req == nil {
+		return nil, errors.New("admission review has no request")
+	}
+	res := &admissionv1.AdmissionResponse{
+		UID:     req.UID,
+		Allowed: true,
+	}
+
+	start := time.Now()
+	if s.isExempt(req.Namespace) {
+		s.metrics.observe(decisionExempt, time.Since(start), nil)
+		return res, nil
+	}
+	// there is nothing to evaluate for deletions
+	if len(req.Object.Raw) == 0 {
+		s.metrics.observe(decisionAllowed, time.Since(start), nil)
+		return res, nil
+	}
+
+	if s.conf.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.conf.Timeout)
+		defer cancel()
+	}
+	results, err := s.evaluate(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+
+	failed, violations := s.violations(results)
+	if len(violations) == 0 {
+		s.metrics.observe(decisionAllowed, time.Since(start), nil)
+		return res, nil
+	}
+
+	log.Info().
+		Str("uid", string(req.UID)).
+		Str("kind", req.Kind.Kind).
+		Str("namespace", req.Namespace).
+		Str("name", req.Name).
+		Bool("audit", s.conf.AuditOnly).
+		Strs("violations", violations).
+		Msg("admission review failed checks")
+
+	if s.conf.AuditOnly {
+		res.Warnings = violations
+		res.AuditAnnotations = map[string]string{
+			AuditAnnotation: strings.Join(violations, "; "),
+		}
+		s.metrics.observe(decisionAudited, time.Since(start), failed)
+		return res, nil
+	}
+
+	res.Allowed = false
+	res.Result = &metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusForbidden,
+		Reason:  metav1.StatusReasonForbidden,
+		Message: "denied by cnquery: " + strings.Join(violations, "; "),
+	}
+	s.metrics.observe(decisionDenied, time.Since(start), failed)
+	return res, nil
+}
+
+// evaluate runs the evaluator and stops waiting for it once the context is
+// done, so that the API server receives a response in time. The evaluation
+// is canceled once evaluate returns. Since scans only stop between assets,
+// the number of running evaluations is limited and requests fail once all
+// slots are taken.
+func (s *Server) evaluate(ctx context.Context, review *admissionv1.AdmissionReview) ([]Result, error) {
+	select {
+	case s.evaluations <- struct{}{}:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to evaluate admission review, too many running evaluations: %w", ctx.Err())
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type evaluation struct {
+		results []Result
+		err     error
+	}
+	done := make(chan evaluation, 1)
+	go func() {
+		defer func() { <-s.evaluations }()
+		results, err := s.evaluator.Evaluate(ctx, review)
+		done <- evaluation{results, err}
+	}()
+
+	select {
+	case e := <-done:
+		return e.results, e.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to evaluate admission review: %w", ctx.Err())
+	}
+}
+
+func (s *Server) isExempt(namespace string) bool {
+	for _, g := range s.exempt {
+		if g.Match(namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// violations returns the UIDs of failed checks and a description of every
+// failure. Assets that could not be scanned are failures as well, and so are
+// queries that failed to run, since they may be checks.
+func (s *Server) violations(results []Result) ([]string, []string) {
+	failed := map[string]struct{}{}
+	res := []string{}
+	for _, r := range results {
+		if r.Query == "" {
+			res = append(res, fmt.Sprintf("%s: %s", r.Asset, r.Error))
+			continue
+		}
+
+		if len(s.checks) > 0 {
+			if _, ok := s.checks[r.Query]; !ok {
+				continue
+			}
+		} else if r.Error == "" && !isCheck(r.Value) {
+			// data queries are not checks
+			continue
+		}
+
+		if r.Error != "" {
+			failed[r.Query] = struct{}{}
+			res = append(res, fmt.Sprintf("%s failed for %s: %s", r.Query, r.Asset, r.Error))
+			continue
+		}
+		// checks without a result, e.g. null, fail as well
+		if !isTrue(r.Value) {
+			failed[r.Query] = struct{}{}
+			res = append(res, fmt.Sprintf("%s failed for %s", r.Query, r.Asset))
+		}
+	}
+	sort.Strings(res)
+
+	uids := make([]string, 0, len(failed))
+	for uid := range failed {
+		uids = append(uids, uid)
+	}
+	sort.Strings(uids)
+	return uids, res
+}
+
+// isCheck returns true for boolean results. Queries with multiple
+// entrypoints are checks if all of them return booleans.
+func isCheck(v interface{}) bool {
+	switch x := v.(type) {
+	case bool:
+		return true
+	case map[string]interface{}:
+		if len(x) == 0 {
+			return false
+		}
+		for _, entry := range x {
+			if !isCheck(entry) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// isTrue checks if a query passed. Queries with multiple entrypoints pass if
+// all of them are true.
+func isTrue(v interface{}) bool {
+	switch x := v.(type) {
+	case bool:
+		return x
+	case map[string]interface{}:
+		if len(x) == 0 {
+			return false
+		}
+		for _, entry := range x {
+			if !isTrue(entry) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
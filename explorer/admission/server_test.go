@@ -0,0 +1,277 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package admission
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/explorer"
+	admissionv1 "k8s.io/api/admission/v1"
+)
+
+type fakeEvaluator struct {
+	results []Result
+	err     error
+	delay   time.Duration
+	calls   int
+	// canceled is closed if the context is canceled during the delay
+	canceled chan struct{}
+}
+
+func (f *fakeEvaluator) Evaluate(ctx context.Context, review *admissionv1.AdmissionReview) ([]Result, error) {
+	f.calls++
+	select {
+	case <-time.After(f.delay):
+	case <-ctx.Done():
+		if f.canceled != nil {
+			close(f.canceled)
+		}
+		return nil, ctx.Err()
+	}
+	return f.results, f.err
+}
+
+// blockingEvaluator ignores the context, like scans that only stop between
+// assets
+type blockingEvaluator struct {
+	block chan struct{}
+	calls atomic.Int32
+}
+
+func (b *blockingEvaluator) Evaluate(ctx context.Context, review *admissionv1.AdmissionReview) ([]Result, error) {
+	b.calls.Add(1)
+	<-b.block
+	return podResults, nil
+}
+
+func loadReview(t *testing.T) []byte {
+	data, err := os.ReadFile("./testdata/admission-review.json")
+	require.NoError(t, err)
+	return data
+}
+
+func post(t *testing.T, server *Server, data []byte) (int, *admissionv1.AdmissionReview) {
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(data)))
+	if rec.Code != http.StatusOK {
+		return rec.Code, nil
+	}
+	review := &admissionv1.AdmissionReview{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), review))
+	return rec.Code, review
+}
+
+var podResults = []Result{
+	{Asset: "default/test-dep-5f65697f8d-fxclr", Query: "no-privileged", Value: true},
+	{Asset: "default/test-dep-5f65697f8d-fxclr", Query: "no-host-network", Value: false},
+	{Asset: "default/test-dep-5f65697f8d-fxclr", Query: "images", Value: []interface{}{"nginx"}},
+}
+
+func TestServer(t *testing.T) {
+	t.Run("deny failed checks", func(t *testing.T) {
+		server, err := NewServer(Config{}, &fakeEvaluator{results: podResults})
+		require.NoError(t, err)
+
+		code, review := post(t, server, loadReview(t))
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "AdmissionReview", review.Kind)
+		assert.Equal(t, "7f187c8e-8b3f-4a26-ad92-a05dde709b1e", string(review.Response.UID))
+		assert.False(t, review.Response.Allowed)
+		assert.Equal(t, int32(http.StatusForbidden), review.Response.Result.Code)
+		assert.Contains(t, review.Response.Result.Message, "no-host-network failed for default/test-dep-5f65697f8d-fxclr")
+		assert.NotContains(t, review.Response.Result.Message, "images")
+	})
+
+	t.Run("deny failed entrypoints", func(t *testing.T) {
+		results := []Result{
+			{Asset: "default/test", Query: "containers", Value: map[string]interface{}{"a": true, "b": false}},
+			{Asset: "default/test", Query: "labels", Value: map[string]interface{}{"a": map[string]interface{}{"app": "test"}}},
+		}
+		server, err := NewServer(Config{}, &fakeEvaluator{results: results})
+		require.NoError(t, err)
+
+		_, review := post(t, server, loadReview(t))
+		assert.False(t, review.Response.Allowed)
+		assert.Equal(t, "denied by cnquery: containers failed for default/test", review.Response.Result.Message)
+	})
+
+	t.Run("configured checks", func(t *testing.T) {
+		server, err := NewServer(Config{Checks: []string{"no-privileged"}}, &fakeEvaluator{results: podResults})
+		require.NoError(t, err)
+
+		_, review := post(t, server, loadReview(t))
+		assert.True(t, review.Response.Allowed)
+
+		server, err = NewServer(Config{Checks: []string{"images"}}, &fakeEvaluator{results: podResults})
+		require.NoError(t, err)
+		_, review = post(t, server, loadReview(t))
+		assert.False(t, review.Response.Allowed)
+	})
+
+	t.Run("audit only", func(t *testing.T) {
+		server, err := NewServer(Config{AuditOnly: true}, &fakeEvaluator{results: podResults})
+		require.NoError(t, err)
+
+		_, review := post(t, server, loadReview(t))
+		assert.True(t, review.Response.Allowed)
+		assert.Equal(t, []string{"no-host-network failed for default/test-dep-5f65697f8d-fxclr"}, review.Response.Warnings)
+		assert.Equal(t, "no-host-network failed for default/test-dep-5f65697f8d-fxclr", review.Response.AuditAnnotations[AuditAnnotation])
+	})
+
+	t.Run("exempt namespaces", func(t *testing.T) {
+		evaluator := &fakeEvaluator{results: podResults}
+		server, err := NewServer(Config{ExemptNamespaces: []string{"kube-*", "def*"}}, evaluator)
+		require.NoError(t, err)
+
+		_, review := post(t, server, loadReview(t))
+		assert.True(t, review.Response.Allowed)
+		assert.Equal(t, 0, evaluator.calls)
+	})
+
+	t.Run("scan errors", func(t *testing.T) {
+		server, err := NewServer(Config{}, &fakeEvaluator{results: []Result{{Asset: "default/test", Error: "connection failed"}}})
+		require.NoError(t, err)
+		_, review := post(t, server, loadReview(t))
+		assert.False(t, review.Response.Allowed)
+		assert.Contains(t, review.Response.Result.Message, "default/test: connection failed")
+
+		// evaluation errors are left to the failure policy of the webhook
+		server, err = NewServer(Config{}, &fakeEvaluator{err: errors.New("no provider")})
+		require.NoError(t, err)
+		code, _ := post(t, server, loadReview(t))
+		assert.Equal(t, http.StatusInternalServerError, code)
+
+		// the evaluation is canceled when it times out
+		evaluator := &fakeEvaluator{delay: time.Minute, canceled: make(chan struct{})}
+		server, err = NewServer(Config{Timeout: 10 * time.Millisecond}, evaluator)
+		require.NoError(t, err)
+		code, _ = post(t, server, loadReview(t))
+		assert.Equal(t, http.StatusInternalServerError, code)
+		select {
+		case <-evaluator.canceled:
+		case <-time.After(time.Second):
+			t.Fatal("evaluation was not canceled")
+		}
+	})
+
+	t.Run("query errors", func(t *testing.T) {
+		results := []Result{
+			{Asset: "default/test", Query: "no-privileged", Error: "cannot get containers"},
+			{Asset: "default/test", Query: "images", Error: "cannot get images"},
+		}
+		server, err := NewServer(Config{}, &fakeEvaluator{results: results})
+		require.NoError(t, err)
+		_, review := post(t, server, loadReview(t))
+		assert.False(t, review.Response.Allowed)
+		assert.Contains(t, review.Response.Result.Message, "no-privileged failed for default/test: cannot get containers")
+
+		// only errors of configured checks deny requests
+		server, err = NewServer(Config{Checks: []string{"other"}}, &fakeEvaluator{results: results})
+		require.NoError(t, err)
+		_, review = post(t, server, loadReview(t))
+		assert.True(t, review.Response.Allowed)
+
+		// checks without a value fail
+		server, err = NewServer(Config{Checks: []string{"no-privileged"}}, &fakeEvaluator{results: []Result{
+			{Asset: "default/test", Query: "no-privileged", Value: nil},
+		}})
+		require.NoError(t, err)
+		_, review = post(t, server, loadReview(t))
+		assert.False(t, review.Response.Allowed)
+	})
+
+	t.Run("max evaluations", func(t *testing.T) {
+		evaluator := &blockingEvaluator{block: make(chan struct{})}
+		server, err := NewServer(Config{Timeout: 10 * time.Millisecond, MaxEvaluations: 1}, evaluator)
+		require.NoError(t, err)
+
+		code, _ := post(t, server, loadReview(t))
+		assert.Equal(t, http.StatusInternalServerError, code)
+		// the first evaluation keeps running, so no other evaluation starts
+		code, _ = post(t, server, loadReview(t))
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Equal(t, int32(1), evaluator.calls.Load())
+
+		close(evaluator.block)
+		assert.Eventually(t, func() bool {
+			code, _ := post(t, server, loadReview(t))
+			return code == http.StatusOK
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		server, err := NewServer(Config{}, &fakeEvaluator{})
+		require.NoError(t, err)
+		code, _ := post(t, server, []byte(`{"kind": "Pod"}`))
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		rec := httptest.NewRecorder()
+		server.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/validate", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+		_, err = NewServer(Config{ExemptNamespaces: []string{"["}}, &fakeEvaluator{})
+		assert.Error(t, err)
+	})
+}
+
+func TestMetrics(t *testing.T) {
+	server, err := NewServer(Config{ExemptNamespaces: []string{"kube-system"}}, &fakeEvaluator{results: podResults})
+	require.NoError(t, err)
+
+	post(t, server, loadReview(t))
+	post(t, server, bytes.ReplaceAll(loadReview(t), []byte(`"namespace": "default"`), []byte(`"namespace": "kube-system"`)))
+
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	data, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	metrics := string(data)
+
+	assert.Contains(t, metrics, `cnquery_admission_requests_total{decision="denied"} 1`)
+	assert.Contains(t, metrics, `cnquery_admission_requests_total{decision="exempt"} 1`)
+	assert.Contains(t, metrics, `cnquery_admission_requests_total{decision="allowed"} 0`)
+	assert.Contains(t, metrics, `cnquery_admission_violations_total{query="no-host-network"} 1`)
+	assert.Contains(t, metrics, `cnquery_admission_request_duration_seconds_count 2`)
+	assert.True(t, strings.HasPrefix(metrics, "# HELP"))
+}
+
+func TestValidateChecks(t *testing.T) {
+	bundle := &explorer.Bundle{
+		Queries: []*explorer.Mquery{{Uid: "shared"}},
+		Packs: []*explorer.QueryPack{{
+			Queries: []*explorer.Mquery{{Uid: "no-privileged"}},
+			Groups:  []*explorer.QueryGroup{{Queries: []*explorer.Mquery{{Uid: "no-host-network"}}}},
+		}},
+	}
+	assert.NoError(t, ValidateChecks(bundle, []string{"shared", "no-privileged", "no-host-network"}))
+
+	err := ValidateChecks(bundle, []string{"no-privileged", "no-privilegd"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no-privilegd")
+}
+
+func TestQueryError(t *testing.T) {
+	msg, ok := queryError(map[string]interface{}{"error": "failed"})
+	assert.True(t, ok)
+	assert.Equal(t, "failed", msg)
+
+	_, ok = queryError(map[string]interface{}{"error": "failed", "other": true})
+	assert.False(t, ok)
+	_, ok = queryError(true)
+	assert.False(t, ok)
+}
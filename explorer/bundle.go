@@ -129,6 +129,7 @@ func combineBundles(into *Bundle, other *Bundle) {
 
 	into.Packs = append(into.Packs, other.Packs...)
 	into.Queries = append(into.Queries, other.Queries...)
+	into.Aggregations = append(into.Aggregations, other.Aggregations...)
 }
 
 // bundleFromSingleFile loads a bundle from a single file
@@ -289,6 +290,19 @@ func (bundle *Bundle) CompileExt(ctx context.Context, conf BundleCompileConf) (*
 		}
 	}
 
+	// Aggregations are compiled against the results of a scan, not against
+	// the asset schema. We only make sure they have MRNs at this point.
+	for i := range bundle.Aggregations {
+		query := bundle.Aggregations[i]
+		if query == nil {
+			return nil, errors.New("received null aggregation")
+		}
+		query.Sanitize()
+		if err := query.RefreshMRN(ownerMrn); err != nil {
+			return nil, multierr.Wrap(err, "failed to refresh MRN for aggregation "+query.Uid)
+		}
+	}
+
 	// Removing any failing queries happens at the very end, when everything is
 	// set to go. We do this to the original bundle, because the intent is to
 	// clean it up with this option.
@@ -580,6 +594,13 @@ func (p *Bundle) EnsureUIDs() {
 			}
 		}
 	}
+
+	for i := range p.Aggregations {
+		query := p.Aggregations[i]
+		if query.Mrn == "" && query.Uid == "" {
+			query.Uid = ksuid.New().String()
+		}
+	}
 }
 
 // Filters retrieves the aggregated filters for all querypacks in this bundle.
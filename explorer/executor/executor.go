@@ -171,6 +171,11 @@ type instance struct {
 	progressReporter progress.Progress
 	collector        explorer.QueryConductor
 	assetMrn         string
+	// profiler is only set when the runtime profiles queries. It receives
+	// the time it took to collect every query.
+	profiler       llx.QueryProfiler
+	queryStarts    map[string]time.Time
+	queryChecksums map[string][]string
 }
 
 func newInstance(runtime llx.Runtime, progressReporter progress.Progress) *instance {
@@ -178,7 +183,7 @@ func newInstance(runtime llx.Runtime, progressReporter progress.Progress) *insta
 		progressReporter = progress.Noop{}
 	}
 
-	return &instance{
+	res := &instance{
 		runtime:          runtime,
 		datapointTracker: map[string][]*explorer.ExecutionQuery{},
 		queries:          map[string]*explorer.ExecutionQuery{},
@@ -189,10 +194,20 @@ func newInstance(runtime llx.Runtime, progressReporter progress.Progress) *insta
 		done:             make(chan struct{}),
 		progressReporter: progressReporter,
 		assetMrn:         runtime.AssetMRN(),
+		queryStarts:      map[string]time.Time{},
+		queryChecksums:   map[string][]string{},
 	}
+	if profiler, ok := runtime.(llx.QueryProfiler); ok && profiler.ProfilesQueries() {
+		res.profiler = profiler
+	}
+	return res
 }
 
 func (e *instance) runQuery(bundle *llx.CodeBundle, props map[string]*llx.Primitive) error {
+	if e.profiler != nil {
+		e.startProfile(bundle)
+	}
+
 	exec, err := llx.NewExecutorV2(bundle.CodeV2, e.runtime, props, e.collect)
 	if err != nil {
 		return err
@@ -207,6 +222,38 @@ func (e *instance) runQuery(bundle *llx.CodeBundle, props map[string]*llx.Primit
 	return nil
 }
 
+func (e *instance) startProfile(bundle *llx.CodeBundle) {
+	codeID := bundle.CodeV2.Id
+	e.mutex.Lock()
+	e.queryStarts[codeID] = time.Now()
+	for _, checksum := range bundle.EntrypointChecksums() {
+		e.queryChecksums[checksum] = append(e.queryChecksums[checksum], codeID)
+	}
+	e.mutex.Unlock()
+}
+
+// profileQueries adds all queries to the report, that are done once the
+// given checksum is collected. It must be called inside the mutex lock.
+func (e *instance) profileQueries(checksum string) {
+	for _, codeID := range e.queryChecksums[checksum] {
+		start, ok := e.queryStarts[codeID]
+		if !ok {
+			continue
+		}
+		query, ok := e.queries[codeID]
+		if !ok || !e.isCollected(query.Code) {
+			continue
+		}
+
+		name := query.Query
+		if name == "" {
+			name = query.Code.Source
+		}
+		e.profiler.ProfileQuery(name, start, false)
+		delete(e.queryStarts, codeID)
+	}
+}
+
 func (e *instance) WaitUntilDone(timeout time.Duration) error {
 	select {
 	case <-e.done:
@@ -301,6 +348,9 @@ func (e *instance) collect(res *llx.RawResult) {
 	e.mutex.Lock()
 
 	e.results[res.CodeID] = res
+	if e.profiler != nil {
+		e.profileQueries(res.CodeID)
+	}
 	cur := len(e.results)
 	max := len(e.datapointTracker)
 	isDone := cur == max
@@ -0,0 +1,340 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v9"
+	"go.mondoo.com/cnquery/v9/explorer"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/mql"
+	"go.mondoo.com/cnquery/v9/mrn"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/resources"
+	"go.mondoo.com/cnquery/v9/types"
+	"go.mondoo.com/cnquery/v9/utils/stringx"
+)
+
+const (
+	aggregationAssetsResource = "assets"
+	aggregationAssetResource  = "assets.asset"
+)
+
+// aggregationSchema is the schema that aggregations are compiled against.
+// It only contains the virtual `assets` resource, which exposes the results
+// of all assets in a scan.
+var aggregationSchema = &resources.Schema{
+	Resources: map[string]*resources.ResourceInfo{
+		aggregationAssetsResource: {
+			Id:       aggregationAssetsResource,
+			Name:     aggregationAssetsResource,
+			Title:    "All assets of this scan",
+			ListType: string(types.Resource(aggregationAssetResource)),
+			Fields: map[string]*resources.Field{
+				"list": {
+					Name: "list",
+					Type: string(types.Array(types.Resource(aggregationAssetResource))),
+				},
+			},
+		},
+		aggregationAssetResource: {
+			Id:    aggregationAssetResource,
+			Name:  aggregationAssetResource,
+			Title: "An asset and the results of its data queries",
+			Fields: map[string]*resources.Field{
+				"mrn": {
+					Name:        "mrn",
+					Type:        string(types.String),
+					IsMandatory: true,
+					Title:       "MRN of the asset",
+				},
+				"name": {
+					Name:        "name",
+					Type:        string(types.String),
+					IsMandatory: true,
+					Title:       "Name of the asset",
+				},
+				"data": {
+					Name:  "data",
+					Type:  string(types.Dict),
+					Title: "Results of all data queries, indexed by query UID",
+				},
+				"error": {
+					Name:  "error",
+					Type:  string(types.String),
+					Title: "Error that occurred while scanning the asset",
+				},
+			},
+		},
+	},
+}
+
+// aggregationRuntime is an in-memory runtime that serves the virtual
+// resources of the aggregation schema. It does not connect to any provider.
+type aggregationRuntime struct {
+	lock      sync.Mutex
+	resources map[string]map[string]*llx.RawData
+}
+
+func newAggregationRuntime(reports *explorer.ReportCollection) *aggregationRuntime {
+	res := &aggregationRuntime{
+		resources: map[string]map[string]*llx.RawData{},
+	}
+
+	queryMrns := bundleQueryMrns(reports.Bundle)
+	list := make([]interface{}, 0, len(reports.Assets))
+	for assetMrn, asset := range reports.Assets {
+		fields := map[string]*llx.RawData{
+			"mrn":   llx.StringData(assetMrn),
+			"name":  llx.StringData(asset.Name),
+			"data":  llx.DictData(assetQueryData(reports, assetMrn, queryMrns)),
+			"error": llx.StringData(""),
+		}
+		if errStatus, ok := reports.Errors[assetMrn]; ok {
+			fields["error"] = llx.StringData(errStatus.Message)
+		}
+
+		res.resources[aggregationAssetResource+"\x00"+assetMrn] = fields
+		list = append(list, &llx.MockResource{Name: aggregationAssetResource, ID: assetMrn})
+	}
+
+	res.resources[aggregationAssetsResource+"\x00"] = map[string]*llx.RawData{
+		"list": llx.ArrayData(list, types.Resource(aggregationAssetResource)),
+	}
+
+	return res
+}
+
+// bundleQueryMrns maps the code IDs of all queries in the bundle to their
+// MRNs. Queries with the same code share their code ID.
+func bundleQueryMrns(bundle *explorer.Bundle) map[string][]string {
+	res := map[string][]string{}
+	if bundle == nil {
+		return res
+	}
+	addQueries := func(queries []*explorer.Mquery) {
+		for i := range queries {
+			q := queries[i]
+			if !stringx.Contains(res[q.CodeId], q.Mrn) {
+				res[q.CodeId] = append(res[q.CodeId], q.Mrn)
+			}
+		}
+	}
+	addQueries(bundle.Queries)
+	for _, pack := range bundle.Packs {
+		addQueries(pack.Queries)
+		for _, group := range pack.Groups {
+			addQueries(group.Queries)
+		}
+	}
+	return res
+}
+
+// QueryResults returns the results of all queries of an asset in the
+// reports, indexed by the query's UID. Values use their JSON representation.
+func QueryResults(reports *explorer.ReportCollection, assetMrn string) map[string]interface{} {
+	return assetQueryData(reports, assetMrn, bundleQueryMrns(reports.Bundle))
+}
+
+// assetQueryData collects the results of all queries of an asset into a dict,
+// which is indexed by the query's UID. Every value is the JSON representation
+// of the query result, so that it can be accessed like any other dict.
+func assetQueryData(reports *explorer.ReportCollection, assetMrn string, queryMrns map[string][]string) map[string]interface{} {
+	res := map[string]interface{}{}
+
+	report, ok := reports.Reports[assetMrn]
+	if !ok {
+		return res
+	}
+	resolved, ok := reports.Resolved[assetMrn]
+	if !ok || resolved.ExecutionJob == nil {
+		return res
+	}
+
+	results := report.RawResults()
+	for codeID, query := range resolved.ExecutionJob.Queries {
+		keys := []string{}
+		for _, queryMrn := range queryMrns[codeID] {
+			if uid, err := mrn.GetResource(queryMrn, explorer.MRN_RESOURCE_QUERY); err == nil && uid != "" {
+				keys = append(keys, uid)
+			}
+		}
+		if len(keys) == 0 {
+			keys = append(keys, codeID)
+		}
+
+		value, err := queryResultValue(query.Code, results)
+		if err != nil {
+			log.Debug().Err(err).Str("asset", assetMrn).Strs("queries", keys).Msg("failed to convert query result for aggregations")
+			continue
+		}
+		for _, key := range keys {
+			res[key] = value
+		}
+	}
+
+	return res
+}
+
+func queryResultValue(code *llx.CodeBundle, results map[string]*llx.RawResult) (interface{}, error) {
+	if code == nil || code.CodeV2 == nil {
+		return nil, errors.New("missing compiled code for query")
+	}
+
+	eps := code.CodeV2.Entrypoints()
+	buf := bytes.Buffer{}
+	buf.WriteByte('{')
+	for i, ref := range eps {
+		checksum := code.CodeV2.Checksums[ref]
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		result, ok := results[checksum]
+		if !ok {
+			return nil, errors.New("cannot find result for this query")
+		}
+		buf.Write(result.Data.JSONfield(checksum, code))
+	}
+	buf.WriteByte('}')
+
+	var res map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &res); err != nil {
+		return nil, err
+	}
+
+	// single-value queries are unwrapped, so they can be used directly
+	if len(eps) == 1 {
+		for _, v := range res {
+			return v, nil
+		}
+	}
+	return res, nil
+}
+
+func (r *aggregationRuntime) AssetMRN() string {
+	return ""
+}
+
+func (r *aggregationRuntime) Unregister(watcherUID string) error {
+	return nil
+}
+
+func (r *aggregationRuntime) CreateResource(name string, args map[string]*llx.Primitive) (llx.Resource, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	switch name {
+	case aggregationAssetsResource:
+		return &llx.MockResource{Name: name}, nil
+	case aggregationAssetResource:
+		arg, ok := args["mrn"]
+		if !ok {
+			return nil, errors.New("cannot create '" + name + "', please provide an asset mrn")
+		}
+		id := string(arg.Value)
+		if _, ok := r.resources[name+"\x00"+id]; !ok {
+			return nil, errors.New("cannot find asset '" + id + "' in this scan")
+		}
+		return &llx.MockResource{Name: name, ID: id}, nil
+	default:
+		return nil, errors.New("resource '" + name + "' is not available in aggregations")
+	}
+}
+
+func (r *aggregationRuntime) CloneResource(src llx.Resource, id string, fields []string, args map[string]*llx.Primitive) (llx.Resource, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	name := src.MqlName()
+	existing, ok := r.resources[name+"\x00"+src.MqlID()]
+	if !ok {
+		return nil, errors.New("cannot find resource '" + name + "' to clone")
+	}
+
+	res := make(map[string]*llx.RawData, len(existing))
+	for _, field := range fields {
+		res[field] = existing[field]
+	}
+	for k, v := range args {
+		res[k] = v.RawData()
+	}
+
+	r.resources[name+"\x00"+id] = res
+	return &llx.MockResource{Name: name, ID: id}, nil
+}
+
+func (r *aggregationRuntime) WatchAndUpdate(resource llx.Resource, field string, watcherUID string, callback func(res interface{}, err error)) error {
+	r.lock.Lock()
+	fields, ok := r.resources[resource.MqlName()+"\x00"+resource.MqlID()]
+	var data *llx.RawData
+	if ok {
+		data, ok = fields[field]
+	}
+	r.lock.Unlock()
+
+	if !ok {
+		return errors.New("cannot get field '" + field + "' for resource '" + resource.MqlName() + "'")
+	}
+	callback(data.Value, data.Error)
+	return nil
+}
+
+func (r *aggregationRuntime) Schema() llx.Schema {
+	return aggregationSchema
+}
+
+func (r *aggregationRuntime) Close() {}
+
+// runAggregations executes all aggregations of a bundle across the results of
+// all assets in the report collection. Aggregations that fail to compile or run
+// report their error alongside their results.
+func runAggregations(ctx context.Context, bundle *explorer.Bundle, reports *explorer.ReportCollection) []*explorer.AggregationResult {
+	if bundle == nil || len(bundle.Aggregations) == 0 || reports == nil {
+		return nil
+	}
+
+	ownerMrn := bundle.OwnerMrn
+	if ownerMrn == "" {
+		ownerMrn = "//local.cnquery.io/run/local-execution"
+	}
+
+	features := cnquery.GetFeatures(ctx)
+	runtime := newAggregationRuntime(reports)
+	res := make([]*explorer.AggregationResult, len(bundle.Aggregations))
+	for i := range bundle.Aggregations {
+		query := bundle.Aggregations[i]
+		cur := &explorer.AggregationResult{Query: query}
+		res[i] = cur
+
+		if err := query.RefreshMRN(ownerMrn); err != nil {
+			cur.Error = explorer.NewErrorStatus(err)
+			continue
+		}
+
+		code, err := query.RefreshChecksumAndType(nil, nil, aggregationSchema)
+		if err != nil {
+			cur.Error = explorer.NewErrorStatus(err)
+			continue
+		}
+		cur.Code = code
+
+		results, err := mql.ExecuteCode(runtime, code, nil, features)
+		if err != nil {
+			cur.Error = explorer.NewErrorStatus(err)
+			continue
+		}
+
+		cur.Data = make(map[string]*llx.Result, len(results))
+		for k, v := range results {
+			cur.Data[k] = v.Result()
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,115 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package scan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9"
+	"go.mondoo.com/cnquery/v9/explorer"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/mql"
+	"go.mondoo.com/cnquery/v9/mqlc"
+)
+
+// testAssetReport runs a static query and wraps its results in the same
+// structures that a scan produces for an asset
+func testAssetReport(t *testing.T, queryMrn string, query string) (*explorer.Mquery, *explorer.Report, *explorer.ResolvedPack) {
+	runtime := newAggregationRuntime(&explorer.ReportCollection{})
+	code, err := mqlc.Compile(query, nil, mqlc.NewConfig(runtime.Schema(), cnquery.DefaultFeatures))
+	require.NoError(t, err)
+	results, err := mql.ExecuteCode(runtime, code, nil, cnquery.DefaultFeatures)
+	require.NoError(t, err)
+
+	data := map[string]*llx.Result{}
+	for k, v := range results {
+		data[k] = v.Result()
+	}
+
+	mquery := &explorer.Mquery{Mrn: queryMrn, CodeId: code.CodeV2.Id, Mql: query}
+	report := &explorer.Report{Data: data}
+	resolved := &explorer.ResolvedPack{
+		ExecutionJob: &explorer.ExecutionJob{
+			Queries: map[string]*explorer.ExecutionQuery{
+				code.CodeV2.Id: {Query: query, Code: code},
+			},
+		},
+	}
+	return mquery, report, resolved
+}
+
+func TestRunAggregations(t *testing.T) {
+	queryMrn := "//local.cnquery.io/run/local-execution/queries/host-key"
+	q1, r1, res1 := testAssetReport(t, queryMrn, "'ssh-ed25519 AAAA'")
+	_, r2, res2 := testAssetReport(t, queryMrn, "'ssh-ed25519 AAAA'")
+	_, r3, res3 := testAssetReport(t, queryMrn, "'ssh-rsa BBBB'")
+
+	reports := &explorer.ReportCollection{
+		Assets: map[string]*explorer.Asset{
+			"//assets/1": {Mrn: "//assets/1", Name: "host1"},
+			"//assets/2": {Mrn: "//assets/2", Name: "host2"},
+			"//assets/3": {Mrn: "//assets/3", Name: "host3"},
+			"//assets/4": {Mrn: "//assets/4", Name: "host4"},
+		},
+		// queries with the same code share their code ID
+		Bundle: &explorer.Bundle{Queries: []*explorer.Mquery{
+			q1,
+			{Mrn: "//local.cnquery.io/run/local-execution/queries/ssh-key", CodeId: q1.CodeId, Mql: q1.Mql},
+		}},
+		Reports: map[string]*explorer.Report{
+			"//assets/1": r1,
+			"//assets/2": r2,
+			"//assets/3": r3,
+		},
+		Resolved: map[string]*explorer.ResolvedPack{
+			"//assets/1": res1,
+			"//assets/2": res2,
+			"//assets/3": res3,
+		},
+		Errors: map[string]*explorer.ErrorStatus{
+			"//assets/4": {Message: "failed to connect"},
+		},
+	}
+
+	bundle := &explorer.Bundle{
+		Aggregations: []*explorer.Mquery{
+			{Uid: "count", Mql: "assets.length"},
+			{Uid: "shared-keys", Mql: "assets.where(data['host-key'] == 'ssh-ed25519 AAAA').map(name)"},
+			{Uid: "errors", Mql: "assets.where(error != empty).map(name)"},
+			{Uid: "same-code", Mql: "assets.where(data['ssh-key'] == data['host-key'] && data['ssh-key'] != empty).length"},
+			{Uid: "unknown", Mql: "users.list"},
+		},
+	}
+
+	res := runAggregations(context.Background(), bundle, reports)
+	require.Len(t, res, 5)
+
+	value := func(i int) interface{} {
+		agg := res[i]
+		require.Nil(t, agg.Error)
+		eps := agg.Code.CodeV2.Entrypoints()
+		require.Len(t, eps, 1)
+		data, ok := agg.Data[agg.Code.CodeV2.Checksums[eps[0]]]
+		require.True(t, ok)
+		return data.RawResultV2().Data.Value
+	}
+
+	assert.Equal(t, "//local.cnquery.io/run/local-execution/queries/count", res[0].Query.Mrn)
+	assert.Equal(t, int64(4), value(0))
+	assert.ElementsMatch(t, []interface{}{"host1", "host2"}, value(1))
+	assert.Equal(t, []interface{}{"host4"}, value(2))
+	assert.Equal(t, int64(2), value(3))
+
+	require.NotNil(t, res[4].Error)
+	assert.Contains(t, res[4].Error.Message, "users")
+}
+
+func TestRunAggregationsWithoutAggregations(t *testing.T) {
+	reports := &explorer.ReportCollection{}
+	assert.Nil(t, runAggregations(context.Background(), &explorer.Bundle{}, reports))
+	assert.Nil(t, runAggregations(context.Background(), nil, reports))
+}
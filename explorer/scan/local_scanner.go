@@ -105,6 +105,10 @@ func (s *LocalScanner) Run(ctx context.Context, job *Job) (*explorer.ReportColle
 		return nil, err
 	}
 
+	if reports != nil {
+		reports.Aggregations = runAggregations(ctx, job.Bundle, reports)
+	}
+
 	return reports, nil
 }
 
@@ -145,6 +149,10 @@ func (s *LocalScanner) RunIncognito(ctx context.Context, job *Job) (*explorer.Re
 		return nil, err
 	}
 
+	if reports != nil {
+		reports.Aggregations = runAggregations(ctx, job.Bundle, reports)
+	}
+
 	return reports, nil
 }
 
@@ -234,7 +242,8 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 		candidate := assetCandidates[i]
 
 		var runtime *providers.Runtime
-		if candidate.asset.Connections[0].Type == "k8s" {
+		isEphemeral := candidate.asset.Connections[0].Type != "k8s"
+		if !isEphemeral {
 			runtime, err = providers.Coordinator.RuntimeFor(candidate.asset, providers.DefaultRuntime())
 			if err != nil {
 				return nil, false, err
@@ -254,6 +263,11 @@ func (s *LocalScanner) distributeJob(job *Job, ctx context.Context, upstream *up
 		})
 		if err != nil {
 			log.Error().Err(err).Str("asset", candidate.asset.Name).Msg("unable to connect to asset")
+			// shut down the provider, so it cleans up what it created for the
+			// asset, e.g. debug pods on Kubernetes nodes
+			if isEphemeral {
+				runtime.Close()
+			}
 			continue
 		}
 
@@ -517,6 +517,8 @@ func init() {
 			"trim":                            {f: dictTrimV2, Label: "trim"},
 			"keys":                            {f: dictKeysV2, Label: "keys"},
 			"values":                          {f: dictValuesV2, Label: "values"},
+			"as":                              {f: dictAsV2, Label: "as"},
+			"validate":                        {f: dictValidateV2, Label: "validate"},
 			"where":                           {f: dictWhereV2, Label: "where"},
 			"$whereNot":                       {f: dictWhereNotV2},
 			"$all":                            {f: dictAllV2},
@@ -562,6 +562,59 @@ func dictValuesV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*R
 	return ArrayData(res, types.Dict), 0, nil
 }
 
+func (e *blockExecutor) resolveDictSchema(chunk *Chunk, ref uint64) (*DictSchema, uint64, error) {
+	if len(chunk.Function.Args) != 1 {
+		return nil, 0, errors.New("expected a schema argument")
+	}
+
+	arg, rref, err := e.resolveValue(chunk.Function.Args[0], ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	switch v := arg.Value.(type) {
+	case string:
+		schema, err := ParseDictSchema([]byte(v))
+		return schema, 0, err
+	case map[string]interface{}:
+		schema, err := NewDictSchema(v)
+		return schema, 0, err
+	default:
+		return nil, 0, errors.New("schema must be a JSON string or a dict")
+	}
+}
+
+func dictAsV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	schema, rref, err := e.resolveDictSchema(chunk, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	violations := schema.Validate(bind.Value)
+	if len(violations) != 0 {
+		return &RawData{
+			Type:  types.Dict,
+			Error: errors.New("value doesn't match the schema: " + strings.Join(violations, "; ")),
+		}, 0, nil
+	}
+
+	return &RawData{Type: types.Dict, Value: bind.Value}, 0, nil
+}
+
+func dictValidateV2(e *blockExecutor, bind *RawData, chunk *Chunk, ref uint64) (*RawData, uint64, error) {
+	schema, rref, err := e.resolveDictSchema(chunk, ref)
+	if err != nil || rref > 0 {
+		return nil, rref, err
+	}
+
+	violations := schema.Validate(bind.Value)
+	res := make([]interface{}, len(violations))
+	for i := range violations {
+		res[i] = violations[i]
+	}
+	return ArrayData(res, types.String), 0, nil
+}
+
 // Where blocks on strings try to see if the content is found inside the string.
 // Due to the way the compiler behaves, if we check for static values, it is
 // converted into a function:
@@ -0,0 +1,354 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package llx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DictSchema is a JSON Schema that describes dict values. It supports the
+// subset of keywords that is needed to describe configuration files:
+// type, properties, required, additionalProperties, items, enum, minimum,
+// maximum, minLength, maxLength, pattern, minItems and maxItems.
+// Other keywords are ignored.
+type DictSchema struct {
+	Types      []string
+	Properties map[string]*DictSchema
+	Required   []string
+	// Additional is the schema for properties that aren't listed. It is only
+	// set if additionalProperties is a schema.
+	Additional *DictSchema
+	// AllowAdditional is set if additionalProperties is a boolean
+	AllowAdditional *bool
+	Items           *DictSchema
+	Enum            []interface{}
+	Minimum         *float64
+	Maximum         *float64
+	MinLength       *int
+	MaxLength       *int
+	MinItems        *int
+	MaxItems        *int
+	Pattern         *regexp.Regexp
+}
+
+// ParseDictSchema parses a JSON Schema document
+func ParseDictSchema(data []byte) (*DictSchema, error) {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, errors.New("failed to parse schema: " + err.Error())
+	}
+	return NewDictSchema(raw)
+}
+
+// NewDictSchema creates a schema from its decoded JSON representation
+func NewDictSchema(raw interface{}) (*DictSchema, error) {
+	return newDictSchema(raw, "$")
+}
+
+func newDictSchema(raw interface{}, path string) (*DictSchema, error) {
+	if b, ok := raw.(bool); ok {
+		// `true` accepts everything, `false` nothing
+		if b {
+			return &DictSchema{}, nil
+		}
+		return &DictSchema{Enum: []interface{}{}}, nil
+	}
+
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid schema at " + path + ", expected an object")
+	}
+
+	res := &DictSchema{}
+	var err error
+
+	switch t := m["type"].(type) {
+	case nil:
+	case string:
+		res.Types = []string{t}
+	case []interface{}:
+		for i := range t {
+			s, ok := t[i].(string)
+			if !ok {
+				return nil, errors.New("invalid type in schema at " + path)
+			}
+			res.Types = append(res.Types, s)
+		}
+	default:
+		return nil, errors.New("invalid type in schema at " + path)
+	}
+	for _, t := range res.Types {
+		switch t {
+		case "object", "array", "string", "number", "integer", "boolean", "null":
+		default:
+			return nil, errors.New("unsupported type '" + t + "' in schema at " + path)
+		}
+	}
+
+	if props, ok := m["properties"].(map[string]interface{}); ok {
+		res.Properties = make(map[string]*DictSchema, len(props))
+		for k, v := range props {
+			if res.Properties[k], err = newDictSchema(v, path+"."+k); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if required, ok := m["required"].([]interface{}); ok {
+		for i := range required {
+			s, ok := required[i].(string)
+			if !ok {
+				return nil, errors.New("invalid required property in schema at " + path)
+			}
+			res.Required = append(res.Required, s)
+		}
+	}
+
+	switch additional := m["additionalProperties"].(type) {
+	case nil:
+	case bool:
+		res.AllowAdditional = &additional
+	default:
+		if res.Additional, err = newDictSchema(additional, path+".*"); err != nil {
+			return nil, err
+		}
+	}
+
+	if items, ok := m["items"]; ok {
+		if res.Items, err = newDictSchema(items, path+"[]"); err != nil {
+			return nil, err
+		}
+	}
+
+	if enum, ok := m["enum"].([]interface{}); ok {
+		res.Enum = enum
+	}
+	if c, ok := m["const"]; ok {
+		res.Enum = []interface{}{c}
+	}
+
+	res.Minimum = schemaFloat(m["minimum"])
+	res.Maximum = schemaFloat(m["maximum"])
+	res.MinLength = schemaInt(m["minLength"])
+	res.MaxLength = schemaInt(m["maxLength"])
+	res.MinItems = schemaInt(m["minItems"])
+	res.MaxItems = schemaInt(m["maxItems"])
+
+	if pattern, ok := m["pattern"].(string); ok {
+		if res.Pattern, err = regexp.Compile(pattern); err != nil {
+			return nil, errors.New("invalid pattern in schema at " + path + ": " + err.Error())
+		}
+	}
+
+	return res, nil
+}
+
+func schemaFloat(v interface{}) *float64 {
+	f, ok := dictNumber(v)
+	if !ok {
+		return nil
+	}
+	return &f
+}
+
+func schemaInt(v interface{}) *int {
+	f, ok := dictNumber(v)
+	if !ok {
+		return nil
+	}
+	i := int(f)
+	return &i
+}
+
+func dictNumber(v interface{}) (float64, bool) {
+	switch x := v.(type) {
+	case float64:
+		return x, true
+	case int64:
+		return float64(x), true
+	case int:
+		return float64(x), true
+	case int32:
+		return float64(x), true
+	default:
+		return 0, false
+	}
+}
+
+// dictTypeOf returns the JSON type of a dict value
+func dictTypeOf(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		if f, ok := dictNumber(x); ok {
+			if f == math.Trunc(f) {
+				return "integer"
+			}
+			return "number"
+		}
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func (s *DictSchema) allowsType(typ string) bool {
+	if len(s.Types) == 0 {
+		return true
+	}
+	for _, t := range s.Types {
+		if t == typ || (t == "number" && typ == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// Field returns the schema of the given key of an object. The second
+// return value is false if the schema doesn't know about the key.
+//
+// Unlike validation, accessing keys is strict: if a schema lists its
+// properties, only those may be accessed unless additional properties are
+// explicitly allowed. This catches typos in key paths.
+func (s *DictSchema) Field(key string) (*DictSchema, bool) {
+	if s == nil {
+		return nil, true
+	}
+	if !s.allowsType("object") {
+		return nil, false
+	}
+	if child, ok := s.Properties[key]; ok {
+		return child, true
+	}
+	if s.Additional != nil {
+		return s.Additional, true
+	}
+	if s.AllowAdditional != nil {
+		return nil, *s.AllowAdditional
+	}
+	return nil, len(s.Properties) == 0
+}
+
+// Keys returns all properties of this schema, sorted by name
+func (s *DictSchema) Keys() []string {
+	res := make([]string, 0, len(s.Properties))
+	for k := range s.Properties {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Validate a dict value against the schema. It returns all violations,
+// each prefixed with the path of the value in question.
+func (s *DictSchema) Validate(value interface{}) []string {
+	var res []string
+	s.validate(value, "$", &res)
+	return res
+}
+
+func (s *DictSchema) validate(value interface{}, path string, res *[]string) {
+	typ := dictTypeOf(value)
+	if !s.allowsType(typ) {
+		*res = append(*res, path+": expected "+strings.Join(s.Types, " or ")+", got "+typ)
+		return
+	}
+
+	if s.Enum != nil && !s.isEnum(value) {
+		*res = append(*res, path+": value is not one of the allowed values")
+	}
+
+	switch x := value.(type) {
+	case map[string]interface{}:
+		for _, key := range s.Required {
+			if _, ok := x[key]; !ok {
+				*res = append(*res, path+": missing required property '"+key+"'")
+			}
+		}
+
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if child, ok := s.Properties[k]; ok {
+				child.validate(x[k], path+"."+k, res)
+			} else if s.Additional != nil {
+				s.Additional.validate(x[k], path+"."+k, res)
+			} else if s.AllowAdditional != nil && !*s.AllowAdditional {
+				*res = append(*res, path+": property '"+k+"' is not allowed")
+			}
+		}
+
+	case []interface{}:
+		if s.MinItems != nil && len(x) < *s.MinItems {
+			*res = append(*res, path+": expected at least "+strconv.Itoa(*s.MinItems)+" items, got "+strconv.Itoa(len(x)))
+		}
+		if s.MaxItems != nil && len(x) > *s.MaxItems {
+			*res = append(*res, path+": expected at most "+strconv.Itoa(*s.MaxItems)+" items, got "+strconv.Itoa(len(x)))
+		}
+		if s.Items != nil {
+			for i := range x {
+				s.Items.validate(x[i], path+"["+strconv.Itoa(i)+"]", res)
+			}
+		}
+
+	case string:
+		length := len([]rune(x))
+		if s.MinLength != nil && length < *s.MinLength {
+			*res = append(*res, path+": expected at least "+strconv.Itoa(*s.MinLength)+" characters, got "+strconv.Itoa(length))
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			*res = append(*res, path+": expected at most "+strconv.Itoa(*s.MaxLength)+" characters, got "+strconv.Itoa(length))
+		}
+		if s.Pattern != nil && !s.Pattern.MatchString(x) {
+			*res = append(*res, path+": value doesn't match pattern "+s.Pattern.String())
+		}
+
+	default:
+		f, ok := dictNumber(x)
+		if !ok {
+			return
+		}
+		if s.Minimum != nil && f < *s.Minimum {
+			*res = append(*res, path+": value must be >= "+strconv.FormatFloat(*s.Minimum, 'f', -1, 64))
+		}
+		if s.Maximum != nil && f > *s.Maximum {
+			*res = append(*res, path+": value must be <= "+strconv.FormatFloat(*s.Maximum, 'f', -1, 64))
+		}
+	}
+}
+
+func (s *DictSchema) isEnum(value interface{}) bool {
+	for i := range s.Enum {
+		a, aok := dictNumber(s.Enum[i])
+		b, bok := dictNumber(value)
+		if aok && bok {
+			if a == b {
+				return true
+			}
+			continue
+		}
+		if reflect.DeepEqual(s.Enum[i], value) {
+			return true
+		}
+	}
+	return false
+}
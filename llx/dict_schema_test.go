@@ -0,0 +1,97 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package llx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDictSchema(t *testing.T) {
+	schema, err := ParseDictSchema([]byte(`{
+		"type": "object",
+		"required": ["name", "port"],
+		"additionalProperties": false,
+		"properties": {
+			"name": {"type": "string", "minLength": 2, "pattern": "^[a-z]+$"},
+			"port": {"type": "integer", "minimum": 1, "maximum": 65535},
+			"mode": {"enum": ["dev", "prod"]},
+			"tags": {"type": "array", "maxItems": 2, "items": {"type": "string"}},
+			"labels": {"type": "object", "additionalProperties": {"type": "string"}}
+		}
+	}`))
+	require.NoError(t, err)
+
+	t.Run("valid values", func(t *testing.T) {
+		assert.Empty(t, schema.Validate(map[string]interface{}{
+			"name":   "app",
+			"port":   float64(8080),
+			"mode":   "prod",
+			"tags":   []interface{}{"a"},
+			"labels": map[string]interface{}{"team": "x"},
+		}))
+	})
+
+	t.Run("violations", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"$: missing required property 'port'",
+			"$: property 'extra' is not allowed",
+			"$.labels.team: expected string, got integer",
+			"$.mode: value is not one of the allowed values",
+			"$.name: expected at least 2 characters, got 1",
+			"$.name: value doesn't match pattern ^[a-z]+$",
+			"$.tags: expected at most 2 items, got 3",
+			"$.tags[1]: expected string, got boolean",
+		}, schema.Validate(map[string]interface{}{
+			"name":   "A",
+			"extra":  1,
+			"mode":   "test",
+			"tags":   []interface{}{"a", true, "c"},
+			"labels": map[string]interface{}{"team": int64(1)},
+		}))
+
+		assert.Equal(t, []string{"$: expected object, got string"}, schema.Validate("app"))
+		assert.Equal(t, []string{"$.port: value must be <= 65535"}, schema.Validate(map[string]interface{}{
+			"name": "app",
+			"port": float64(70000),
+		}))
+	})
+
+	t.Run("field access", func(t *testing.T) {
+		name, ok := schema.Field("name")
+		assert.True(t, ok)
+		assert.Equal(t, []string{"string"}, name.Types)
+
+		_, ok = schema.Field("nmae")
+		assert.False(t, ok)
+
+		_, ok = name.Field("length")
+		assert.False(t, ok)
+
+		labels, _ := schema.Field("labels")
+		team, ok := labels.Field("team")
+		assert.True(t, ok)
+		assert.Equal(t, []string{"string"}, team.Types)
+
+		// schemas without properties allow any key
+		open, err := ParseDictSchema([]byte(`{"type":"object"}`))
+		require.NoError(t, err)
+		child, ok := open.Field("any")
+		assert.True(t, ok)
+		assert.Nil(t, child)
+	})
+
+	t.Run("invalid schemas", func(t *testing.T) {
+		_, err := ParseDictSchema([]byte(`{"type": "struct"}`))
+		assert.EqualError(t, err, "unsupported type 'struct' in schema at $")
+
+		_, err = ParseDictSchema([]byte(`{"properties": {"a": {"pattern": "("}}}`))
+		assert.ErrorContains(t, err, "invalid pattern in schema at $.a")
+
+		_, err = ParseDictSchema([]byte(`[]`))
+		assert.EqualError(t, err, "invalid schema at $, expected an object")
+	})
+}
@@ -76,6 +76,9 @@ func (c *Calls) Load(k uint64) ([]uint64, bool) {
 type cache struct {
 	data map[uint64]*stepCache
 	lock sync.Mutex
+	// trace receives all stored values, if tracing is enabled
+	trace   *ExecutionTrace
+	traceID string
 }
 
 func newCache() *cache {
@@ -90,6 +93,10 @@ func (c *cache) Store(k uint64, v *stepCache) {
 	c.lock.Lock()
 	c.data[k] = v
 	c.lock.Unlock()
+
+	if c.trace != nil {
+		c.trace.store(c.traceID, k, v.Result)
+	}
 }
 
 // Load a call connection
@@ -127,6 +134,7 @@ type MQLExecutorV2 struct {
 	lock           sync.Mutex
 	blockExecutors []*blockExecutor
 	unregistered   bool
+	trace          *ExecutionTrace
 }
 
 func (c *blockExecutor) watcherUID(ref uint64) string {
@@ -230,9 +238,28 @@ func (c *MQLExecutorV2) _newBlockExecutor(blockRef uint64, callback ResultCallba
 		panic("no callback points")
 	}
 
+	if c.trace != nil {
+		res.cache.trace = c.trace
+		res.cache.traceID = res.id
+	}
+
 	return res, nil
 }
 
+// WithTrace collects the results and timing of all chunks into the given
+// trace while the code is running. It must be called before Run.
+func (c *MQLExecutorV2) WithTrace(trace *ExecutionTrace) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.trace = trace
+	for i := range c.blockExecutors {
+		be := c.blockExecutors[i]
+		be.cache.trace = trace
+		be.cache.traceID = be.id
+	}
+}
+
 // NoRun returns error for all callbacks and don't run code
 func (c *MQLExecutorV2) NoRun(err error) {
 	callback := c.blockExecutors[0].callback
@@ -867,6 +894,9 @@ func (e *blockExecutor) runChain(start uint64) {
 			nextRef = 0
 			err = nil
 		} else {
+			if e.ctx.trace != nil {
+				e.ctx.trace.start(e.id, curRef)
+			}
 			res, nextRef, err = e.runRef(curRef)
 		}
 
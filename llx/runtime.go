@@ -3,7 +3,11 @@
 
 package llx
 
-import "go.mondoo.com/cnquery/v9/providers-sdk/v1/resources"
+import (
+	"time"
+
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/resources"
+)
 
 type Runtime interface {
 	AssetMRN() string
@@ -15,6 +19,13 @@ type Runtime interface {
 	Close()
 }
 
+// QueryProfiler is implemented by runtimes that can profile the execution
+// of queries. Queries are only profiled if ProfilesQueries returns true.
+type QueryProfiler interface {
+	ProfilesQueries() bool
+	ProfileQuery(name string, start time.Time, failed bool)
+}
+
 type Schema interface {
 	Lookup(resource string) *resources.ResourceInfo
 	LookupField(resource string, field string) (*resources.ResourceInfo, *resources.Field)
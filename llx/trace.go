@@ -0,0 +1,107 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package llx
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxTracedResults limits how many results are kept per chunk. Chunks inside
+// of blocks run once per element, which can be a lot for large lists.
+const maxTracedResults = 10
+
+// ChunkTrace holds the results and timing of one chunk in the code
+type ChunkTrace struct {
+	// Calls is the number of times the chunk returned a result. Chunks inside
+	// of blocks are called once for every block execution.
+	Calls int
+	// Duration is the total time spent between running the chunk
+	// and receiving its result
+	Duration time.Duration
+	// Results are the first results of this chunk
+	Results []*RawData
+}
+
+// ExecutionTrace collects the results of every chunk of executed code,
+// not just its entrypoints and datapoints. It is used to explain how a
+// query evaluated.
+type ExecutionTrace struct {
+	lock    sync.Mutex
+	chunks  map[uint64]*ChunkTrace
+	started map[string]time.Time
+	// stored tracks the index of results per chunk execution, since
+	// chunks may update their results during one execution
+	stored map[string]int
+}
+
+func NewExecutionTrace() *ExecutionTrace {
+	return &ExecutionTrace{
+		chunks:  map[uint64]*ChunkTrace{},
+		started: map[string]time.Time{},
+		stored:  map[string]int{},
+	}
+}
+
+// Chunk returns the trace of the given chunk ref. It returns nil if the
+// chunk never returned a result.
+func (t *ExecutionTrace) Chunk(ref uint64) *ChunkTrace {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	res, ok := t.chunks[ref]
+	if !ok {
+		return nil
+	}
+	cpy := *res
+	return &cpy
+}
+
+func traceKey(executorID string, ref uint64) string {
+	return executorID + "\x00" + strconv.FormatUint(ref, 10)
+}
+
+// start is called whenever a chunk begins its execution
+func (t *ExecutionTrace) start(executorID string, ref uint64) {
+	key := traceKey(executorID, ref)
+	t.lock.Lock()
+	if _, ok := t.started[key]; !ok {
+		t.started[key] = time.Now()
+	}
+	t.lock.Unlock()
+}
+
+// store is called whenever a chunk has a result
+func (t *ExecutionTrace) store(executorID string, ref uint64, data *RawData) {
+	key := traceKey(executorID, ref)
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	res, ok := t.chunks[ref]
+	if !ok {
+		res = &ChunkTrace{}
+		t.chunks[ref] = res
+	}
+
+	if started, ok := t.started[key]; ok {
+		res.Duration += time.Since(started)
+		delete(t.started, key)
+	}
+
+	if idx, ok := t.stored[key]; ok {
+		if idx >= 0 {
+			res.Results[idx] = data
+		}
+		return
+	}
+
+	res.Calls++
+	if len(res.Results) < maxTracedResults {
+		res.Results = append(res.Results, data)
+		t.stored[key] = len(res.Results) - 1
+	} else {
+		t.stored[key] = -1
+	}
+}
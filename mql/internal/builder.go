@@ -44,6 +44,8 @@ type GraphBuilder struct {
 	queryTimeout time.Duration
 
 	featureBoolAssertions bool
+	// trace collects the results of all chunks, if set
+	trace *llx.ExecutionTrace
 }
 
 func NewBuilder() *GraphBuilder {
@@ -102,6 +104,13 @@ func (b *GraphBuilder) WithFeatureBoolAssertions(featureBoolAssertions bool) {
 	b.featureBoolAssertions = featureBoolAssertions
 }
 
+// WithTrace collects the results and timing of all executed chunks into the
+// trace. Since chunks are identified by their ref, it should only be used
+// with a single query.
+func (b *GraphBuilder) WithTrace(trace *llx.ExecutionTrace) {
+	b.trace = trace
+}
+
 func (b *GraphBuilder) Build(schema llx.Schema, runtime llx.Runtime, assetMrn string) (*GraphExecutor, error) {
 	resultChan := make(chan *llx.RawResult, 128)
 
@@ -120,6 +129,7 @@ func (b *GraphBuilder) Build(schema llx.Schema, runtime llx.Runtime, assetMrn st
 		resultChan: resultChan,
 		doneChan:   make(chan struct{}),
 	}
+	ge.executionManager.trace = b.trace
 
 	ge.nodes[DatapointCollectorID] = &Node{
 		id:       DatapointCollectorID,
@@ -30,6 +30,8 @@ type executionManager struct {
 	// stopChan is a channel that is closed when a stop is requested
 	stopChan chan struct{}
 	wg       sync.WaitGroup
+	// trace collects the results of all chunks, if set
+	trace *llx.ExecutionTrace
 }
 
 type runQueueItem struct {
@@ -158,6 +160,7 @@ func (em *executionManager) executeCodeBundle(codeBundle *llx.CodeBundle, props
 
 	codeID = codeBundle.CodeV2.GetId()
 	log.Debug().Str("qrid", codeID).Msg("starting query execution")
+	start := time.Now()
 	defer func() {
 		log.Debug().Str("qrid", codeID).Msg("finished query execution")
 	}()
@@ -166,6 +169,9 @@ func (em *executionManager) executeCodeBundle(codeBundle *llx.CodeBundle, props
 	// checksum
 	x, err := llx.NewExecutorV2(codeBundle.CodeV2, em.runtime, props, sendResult)
 	if err == nil {
+		if em.trace != nil {
+			x.WithTrace(em.trace)
+		}
 		x.Run()
 	}
 	executor = x
@@ -191,6 +197,10 @@ func (em *executionManager) executeCodeBundle(codeBundle *llx.CodeBundle, props
 	case <-execDoneChan:
 	}
 
+	if profiler, ok := em.runtime.(llx.QueryProfiler); ok && profiler.ProfilesQueries() {
+		profiler.ProfileQuery(codeBundle.Source, start, errOut != nil)
+	}
+
 	unreported := wg.Decommission()
 	if len(unreported) > 0 {
 		log.Warn().Strs("missing", unreported).Str("qrid", codeID).Msg("unreported datapoints")
@@ -72,8 +72,19 @@ func Exec(query string, runtime llx.Runtime, features cnquery.Features, props ma
 }
 
 func ExecuteCode(runtime llx.Runtime, codeBundle *llx.CodeBundle, props map[string]*llx.Primitive, features cnquery.Features) (map[string]*llx.RawResult, error) {
+	return executeCode(runtime, codeBundle, props, features, nil)
+}
+
+// ExecuteCodeWithTrace runs the code like ExecuteCode and additionally
+// collects the results and timing of every chunk into the trace
+func ExecuteCodeWithTrace(runtime llx.Runtime, codeBundle *llx.CodeBundle, props map[string]*llx.Primitive, features cnquery.Features, trace *llx.ExecutionTrace) (map[string]*llx.RawResult, error) {
+	return executeCode(runtime, codeBundle, props, features, trace)
+}
+
+func executeCode(runtime llx.Runtime, codeBundle *llx.CodeBundle, props map[string]*llx.Primitive, features cnquery.Features, trace *llx.ExecutionTrace) (map[string]*llx.RawResult, error) {
 	builder := internal.NewBuilder()
 	builder.WithFeatureBoolAssertions(features.IsActive(cnquery.BoolAssertions))
+	builder.WithTrace(trace)
 
 	builder.AddQuery(codeBundle, nil, props)
 	for _, checksum := range internal.CodepointChecksums(codeBundle) {
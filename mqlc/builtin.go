@@ -81,6 +81,9 @@ func init() {
 			// map-ish
 			"keys":   {typ: stringArrayType, signature: FunctionSignature{}},
 			"values": {typ: dictArrayType, signature: FunctionSignature{}},
+			// schemas
+			"as":       {compile: compileDictAs, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Any}}},
+			"validate": {compile: compileDictValidate, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Any}}},
 		},
 		types.ArrayLike: {
 			"[]":           {typ: childType, signature: FunctionSignature{Required: 1, Args: []types.Type{types.Int}}},
@@ -153,6 +156,24 @@ func builtinFunction(typ types.Type, id string) (*compileHandler, error) {
 	return nil, errors.New("cannot find function '" + id + "' for type '" + typ.Label() + "' during compile")
 }
 
+// dictKeyFunctions are builtin functions of dicts that require arguments.
+// Without arguments they access the key of the same name, e.g. `dict.as` is
+// `dict["as"]`, so that they don't shadow keys of the dict.
+var dictKeyFunctions = map[string]struct{}{
+	"as":       {},
+	"validate": {},
+}
+
+func isDictKeyAccess(typ types.Type, id string, call *parser.Call) bool {
+	if typ != types.Dict {
+		return false
+	}
+	if _, ok := dictKeyFunctions[id]; !ok {
+		return false
+	}
+	return call == nil || len(call.Function) == 0
+}
+
 // Compile calls to builtin type handlers, that aren't mapped via builtin
 // functions above. Typically only used if we need to go deeper into the given
 // type to figure out what to do. For example: list resources are just
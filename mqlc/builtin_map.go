@@ -5,10 +5,14 @@ package mqlc
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"go.mondoo.com/cnquery/v9/llx"
 	"go.mondoo.com/cnquery/v9/mqlc/parser"
 	"go.mondoo.com/cnquery/v9/types"
+	"sigs.k8s.io/yaml"
 )
 
 func compileDictWhere(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
@@ -408,6 +412,155 @@ func compileDictFlat(c *compiler, typ types.Type, ref uint64, id string, call *p
 	return typ, nil
 }
 
+// dictSchemaFilePrefix marks schema arguments that are files. Files are only
+// read with this explicit prefix, so no schema string reads a file by accident.
+const dictSchemaFilePrefix = "file://"
+
+// loadDictSchema returns the JSON of a schema, which is either given
+// inline or as a file:// path to a JSON or YAML file
+func loadDictSchema(arg string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(arg), "{") {
+		return []byte(arg), nil
+	}
+	if !strings.HasPrefix(arg, dictSchemaFilePrefix) {
+		return nil, errors.New("schema must be a JSON object or a path to a schema file starting with " + dictSchemaFilePrefix)
+	}
+	arg = strings.TrimPrefix(arg, dictSchemaFilePrefix)
+
+	data, err := os.ReadFile(arg)
+	if err != nil {
+		return nil, errors.New("failed to load schema: " + err.Error())
+	}
+	switch strings.ToLower(filepath.Ext(arg)) {
+	case ".yaml", ".yml":
+		data, err = yaml.YAMLToJSON(data)
+		if err != nil {
+			return nil, errors.New("failed to load schema " + arg + ": " + err.Error())
+		}
+	}
+	return data, nil
+}
+
+// compileDictSchemaArg compiles the schema argument of dict functions. If the
+// schema is a literal, it is loaded during compile-time and embedded into
+// the code. This is necessary, because schema files are only available where
+// the query is compiled, not on the asset.
+func compileDictSchemaArg(c *compiler, id string, call *parser.Call) (*llx.Primitive, *llx.DictSchema, error) {
+	if call == nil || len(call.Function) != 1 {
+		return nil, nil, errors.New("function '" + id + "' needs one argument (schema)")
+	}
+	arg := call.Function[0]
+	if arg.Name != "" && arg.Name != "schema" {
+		return nil, nil, errors.New("unknown argument '" + arg.Name + "' for function '" + id + "', expected 'schema'")
+	}
+
+	val, err := c.compileExpression(arg.Value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if types.Type(val.Type) != types.String {
+		valType, err := c.dereferenceType(val)
+		if err != nil {
+			return nil, nil, err
+		}
+		if valType != types.String && valType != types.Dict {
+			return nil, nil, errors.New("schema for '" + id + "' must be a string or dict, got " + valType.Label())
+		}
+		return val, nil, nil
+	}
+
+	data, err := loadDictSchema(string(val.Value))
+	if err != nil {
+		return nil, nil, err
+	}
+	schema, err := llx.ParseDictSchema(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return llx.StringPrimitive(string(data)), schema, nil
+}
+
+func compileDictAs(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	arg, schema, err := compileDictSchemaArg(c, id, call)
+	if err != nil {
+		return types.Nil, err
+	}
+
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(types.Dict),
+			Binding: ref,
+			Args:    []*llx.Primitive{arg},
+		},
+	})
+	if schema != nil {
+		c.dictSchemas[c.tailRef()] = schema
+	}
+	return types.Dict, nil
+}
+
+func compileDictValidate(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
+	arg, _, err := compileDictSchemaArg(c, id, call)
+	if err != nil {
+		return types.Nil, err
+	}
+
+	typ = types.Array(types.String)
+	c.addChunk(&llx.Chunk{
+		Call: llx.Chunk_FUNCTION,
+		Id:   id,
+		Function: &llx.Function{
+			Type:    string(typ),
+			Binding: ref,
+			Args:    []*llx.Primitive{arg},
+		},
+	})
+	return typ, nil
+}
+
+// hasDictSchemaKey returns true if the dict at the given ref has a schema,
+// which defines the given key
+func (c *compiler) hasDictSchemaKey(dictRef uint64, key string) bool {
+	schema, ok := c.dictSchemas[dictRef]
+	if !ok {
+		return false
+	}
+	_, ok = schema.Properties[key]
+	return ok
+}
+
+// compileDictSchemaField checks that a key exists in the schema of a dict,
+// if we know its schema. The key's schema is attached to the chunk, that
+// accesses it.
+func (c *compiler) compileDictSchemaField(dictRef uint64, key string) error {
+	schema, ok := c.dictSchemas[dictRef]
+	if !ok {
+		return nil
+	}
+
+	child, ok := schema.Field(key)
+	if !ok {
+		keys := schema.Keys()
+		fields := make(map[string]llx.Documentation, len(keys))
+		for _, k := range keys {
+			fields[k] = llx.Documentation{Field: k}
+		}
+		addFieldSuggestions(fields, key, c.Result)
+		if len(keys) == 0 {
+			return errors.New("cannot access key '" + key + "', the schema doesn't describe an object")
+		}
+		return errors.New("key '" + key + "' is not defined in the schema, available keys: " + strings.Join(keys, ", "))
+	}
+
+	if child != nil {
+		c.dictSchemas[c.tailRef()] = child
+	}
+	return nil
+}
+
 func compileMapWhere(c *compiler, typ types.Type, ref uint64, id string, call *parser.Call) (types.Type, error) {
 	if call == nil {
 		return types.Nil, errors.New("missing filter argument for calling '" + id + "'")
@@ -90,6 +90,9 @@ type compiler struct {
 	blockDeps []uint64
 	props     map[string]*llx.Primitive
 	comment   string
+	// dictSchemas are the schemas of dict values, which are known at
+	// compile-time, e.g. via `dict.as(schema)`
+	dictSchemas map[uint64]*llx.DictSchema
 
 	// a standalone code is one that doesn't call any of its bindings
 	// examples:
@@ -144,6 +147,7 @@ func (c *compiler) newBlockCompiler(binding *variable) compiler {
 		block:          block,
 		blockRef:       ref,
 		props:          c.props,
+		dictSchemas:    c.dictSchemas,
 		standalone:     true,
 	}
 }
@@ -586,6 +590,9 @@ func (c *compiler) blockOnResource(expressions []*parser.Expression, typ types.T
 	}
 	blockCompiler.vars.add("_", v)
 	blockCompiler.Binding = &v
+	if schema, ok := c.dictSchemas[binding]; ok && typ == types.Dict {
+		c.dictSchemas[v.ref] = schema
+	}
 
 	err := blockCompiler.compileExpressions(expressions)
 	if err != nil {
@@ -1025,6 +1032,10 @@ func (c *compiler) compileBoundIdentifierWithMqlCtx(id string, binding *variable
 		}
 	}
 
+	if isDictKeyAccess(typ, id, call) {
+		return false, types.Nil, nil
+	}
+
 	h, _ := builtinFunction(typ, id)
 	if h != nil {
 		call = filterTrailingNullArgs(call)
@@ -1089,6 +1100,10 @@ func (c *compiler) compileBoundIdentifierWithoutMqlCtx(id string, binding *varia
 		}
 	}
 
+	if isDictKeyAccess(typ, id, call) {
+		return false, types.Nil, nil
+	}
+
 	h, _ := builtinFunction(typ, id)
 	if h != nil {
 		call = filterTrailingNullArgs(call)
@@ -1261,14 +1276,19 @@ func (c *compiler) compileIdentifier(id string, callBinding *variable, calls []*
 		return restCalls, variable.typ, nil
 	}
 
-	found, restCalls, typ, err = c.compileResource(id, calls)
-	if found {
-		return restCalls, typ, err
+	// keys that are defined in the schema of a dict take precedence over
+	// resources with the same name
+	isDict := callBinding != nil && callBinding.typ == types.Dict
+	if !isDict || !c.hasDictSchemaKey(callBinding.ref, id) {
+		found, restCalls, typ, err = c.compileResource(id, calls)
+		if found {
+			return restCalls, typ, err
+		}
 	}
 
 	// Support easy accessors for dicts and maps, e.g:
 	// json.params { A.B.C } => json.params { _["A"]["B"]["C"] }
-	if callBinding != nil && callBinding.typ == types.Dict {
+	if isDict {
 		c.addChunk(&llx.Chunk{
 			Call: llx.Chunk_FUNCTION,
 			Id:   "[]",
@@ -1279,6 +1299,9 @@ func (c *compiler) compileIdentifier(id string, callBinding *variable, calls []*
 			},
 		})
 		c.standalone = false
+		if err := c.compileDictSchemaField(callBinding.ref, id); err != nil {
+			return nil, types.Nil, err
+		}
 		return restCalls, callBinding.typ, err
 	}
 
@@ -1542,6 +1565,9 @@ func (c *compiler) compileOperand(operand *parser.Operand) (*llx.Primitive, erro
 						Args:    []*llx.Primitive{llx.StringPrimitive(id)},
 					},
 				})
+				if err := c.compileDictSchemaField(ref, id); err != nil {
+					return nil, err
+				}
 			} else {
 				typ = resType
 			}
@@ -2182,6 +2208,7 @@ func CompileAST(ast *parser.AST, props map[string]*llx.Primitive, conf compilerC
 		blockRef:       1 << 32,
 		block:          codeBundle.CodeV2.Blocks[0],
 		props:          props,
+		dictSchemas:    map[uint64]*llx.DictSchema{},
 		standalone:     true,
 	}
 
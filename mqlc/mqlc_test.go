@@ -6,6 +6,8 @@ package mqlc_test
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sort"
 	"testing"
 
@@ -538,6 +540,81 @@ func TestCompiler_Dict(t *testing.T) {
 	})
 }
 
+func TestCompiler_DictSchema(t *testing.T) {
+	schema := `{"type":"object","properties":{"server":{"type":"object","properties":{"port":{"type":"integer"}}}}}`
+	props := map[string]*llx.Primitive{
+		"d": {Type: string(types.Dict)},
+	}
+
+	compileProps(t, "props.d.as('"+schema+"').server.port", props, func(res *llx.CodeBundle) {
+		assertFunction(t, "as", &llx.Function{
+			Type:    string(types.Dict),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.StringPrimitive(schema)},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+		assert.Equal(t, []uint64{(1 << 32) | 4}, res.CodeV2.Entrypoints())
+	})
+
+	compileProps(t, "props.d.as(schema: '"+schema+"').server { port }", props, func(res *llx.CodeBundle) {
+		require.Len(t, res.CodeV2.Blocks, 2)
+	})
+
+	compileProps(t, "props.d.validate('"+schema+"')", props, func(res *llx.CodeBundle) {
+		assertFunction(t, "validate", &llx.Function{
+			Type:    string(types.Array(types.String)),
+			Binding: (1 << 32) | 1,
+			Args:    []*llx.Primitive{llx.StringPrimitive(schema)},
+		}, res.CodeV2.Blocks[0].Chunks[1])
+	})
+
+	t.Run("unknown keys are compile errors", func(t *testing.T) {
+		_, err := mqlc.Compile("props.d.as('"+schema+"').server.prot", props, conf)
+		assert.EqualError(t, err, "key 'prot' is not defined in the schema, available keys: port")
+
+		_, err = mqlc.Compile("props.d.as('"+schema+"').server { prot }", props, conf)
+		assert.EqualError(t, err, "key 'prot' is not defined in the schema, available keys: port")
+
+		_, err = mqlc.Compile("props.d.as('"+schema+"').server.port.number", props, conf)
+		assert.EqualError(t, err, "cannot access key 'number', the schema doesn't describe an object")
+	})
+
+	t.Run("keys named like schema functions", func(t *testing.T) {
+		compileProps(t, "props.d.as", props, func(res *llx.CodeBundle) {
+			assertFunction(t, "[]", &llx.Function{
+				Type:    string(types.Dict),
+				Binding: (1 << 32) | 1,
+				Args:    []*llx.Primitive{llx.StringPrimitive("as")},
+			}, res.CodeV2.Blocks[0].Chunks[1])
+		})
+
+		compileProps(t, "props.d { validate }", props, func(res *llx.CodeBundle) {
+			require.Len(t, res.CodeV2.Blocks, 2)
+			assertFunction(t, "[]", &llx.Function{
+				Type:    string(types.Dict),
+				Binding: (2 << 32) | 1,
+				Args:    []*llx.Primitive{llx.StringPrimitive("validate")},
+			}, res.CodeV2.Blocks[1].Chunks[1])
+		})
+	})
+
+	t.Run("schemas are loaded from files", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "app.schema.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("type: object\nproperties:\n  name:\n    type: string\n"), 0o600))
+
+		res, err := mqlc.Compile("props.d.as('file://"+path+"').name", props, conf)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type":"object","properties":{"name":{"type":"string"}}}`,
+			string(res.CodeV2.Blocks[0].Chunks[1].Function.Args[0].Value))
+
+		_, err = mqlc.Compile("props.d.as('file://"+path+"').nmae", props, conf)
+		assert.EqualError(t, err, "key 'nmae' is not defined in the schema, available keys: name")
+
+		// other strings are never read as files
+		_, err = mqlc.Compile("props.d.as('"+path+"').name", props, conf)
+		assert.EqualError(t, err, "schema must be a JSON object or a path to a schema file starting with file://")
+	})
+}
+
 func TestCompiler_If(t *testing.T) {
 	compileT(t, "if ( true ) { return 1 } else if ( false ) { return 2 } else { return 3 }", func(res *llx.CodeBundle) {
 		assertFunction(t, "if", &llx.Function{
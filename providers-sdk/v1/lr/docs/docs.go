@@ -28,6 +28,9 @@ type LrDocsEntry struct {
 	Snippets         []LrDocsSnippet         `json:"snippets,omitempty"`
 	IsPrivate        bool                    `json:"is_private,omitempty"`
 	MinMondooVersion string                  `json:"min_mondoo_version,omitempty"`
+	// CacheTTL is the duration for which the resource's data may be cached
+	// across runs, e.g. 10m or 1h. Resources without a TTL are never cached.
+	CacheTTL string `json:"cache_ttl,omitempty"`
 }
 
 type LrDocsPlatform struct {
@@ -85,6 +88,7 @@ func InjectMetadata(schema *resources.Schema, docs *LrDocs) {
 		}
 
 		info.MinMondooVersion = rdoc.MinMondooVersion
+		info.CacheTtl = rdoc.CacheTTL
 
 		for field, fdoc := range rdoc.Fields {
 			finfo, ok := info.Fields[field]
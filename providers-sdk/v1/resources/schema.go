@@ -41,6 +41,9 @@ func (s *Schema) Add(other *Schema) *Schema {
 			if v.Defaults != "" {
 				existing.Defaults = v.Defaults
 			}
+			if v.CacheTtl != "" {
+				existing.CacheTtl = v.CacheTtl
+			}
 
 			if existing.Fields == nil {
 				existing.Fields = v.Fields
@@ -0,0 +1,451 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/types"
+	"go.mondoo.com/cnquery/v9/utils/multierr"
+)
+
+type ResultCacheOptions struct {
+	// Path is the directory where cached results are stored.
+	// It defaults to the user's cache directory.
+	Path string
+	// MaxTTL caps the TTL that resources declare in their schema.
+	// If it is 0, the TTLs of the schema are used as-is.
+	MaxTTL time.Duration
+	// Providers are used to look up resource TTLs and provider versions.
+	// They default to the providers of the coordinator.
+	Providers Providers
+}
+
+// resultCache is a recording which persists resource data across runs.
+// It stores data per asset platform ID and provider, using the same format
+// as recordings. Only resources that declare a cache TTL in their schema are
+// cached. Cached data is discarded whenever the provider version changes.
+//
+// Fields that return resources are cached as references to the resources,
+// together with the static fields of each resource. Providers create
+// resources from their static fields, so that uncached fields of these
+// resources can still be computed in the next run.
+//
+// All calls are forwarded to the wrapped recording first.
+type resultCache struct {
+	Recording
+	path      string
+	maxTTL    time.Duration
+	providers Providers
+	now       func() time.Time
+
+	lock        sync.Mutex
+	files       map[string]*cachedAsset
+	connections map[uint32]*cachedAsset
+}
+
+type cachedAsset struct {
+	recording *recording
+	asset     *assetRecording
+	provider  *Provider
+	changed   bool
+}
+
+// NewResultCache wraps a recording with an on-disk result cache
+func NewResultCache(recording Recording, opts ResultCacheOptions) (Recording, error) {
+	if recording == nil {
+		recording = NullRecording{}
+	}
+
+	path := opts.Path
+	if path == "" {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, multierr.Wrap(err, "failed to determine cache directory")
+		}
+		path = filepath.Join(dir, "cnquery", "results")
+	}
+
+	providers := opts.Providers
+	if providers == nil {
+		providers = Coordinator.Providers
+	}
+
+	return &resultCache{
+		Recording:   recording,
+		path:        path,
+		maxTTL:      opts.MaxTTL,
+		providers:   providers,
+		now:         time.Now,
+		files:       map[string]*cachedAsset{},
+		connections: map[uint32]*cachedAsset{},
+	}, nil
+}
+
+func cacheFileName(platformID string, providerID string) string {
+	sum := sha256.Sum256([]byte(platformID + "\x00" + providerID))
+	return hex.EncodeToString(sum[:]) + ".json"
+}
+
+func (c *resultCache) EnsureAsset(asset *inventory.Asset, providerID string, connectionID uint32, conf *inventory.Config) {
+	c.Recording.EnsureAsset(asset, providerID, connectionID, conf)
+
+	if asset == nil || len(asset.PlatformIds) == 0 {
+		return
+	}
+	provider, ok := c.providers[providerID]
+	if !ok || provider.Provider == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	path := filepath.Join(c.path, cacheFileName(asset.PlatformIds[0], providerID))
+	cached, ok := c.files[path]
+	if !ok {
+		cached = c.loadAsset(path, asset, provider)
+		c.files[path] = cached
+	}
+	c.connections[connectionID] = cached
+}
+
+func (c *resultCache) loadAsset(path string, asset *inventory.Asset, provider *Provider) *cachedAsset {
+	res, err := LoadRecordingFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Debug().Err(err).Str("path", path).Msg("failed to load cached results, ignoring them")
+	}
+
+	if res != nil && !isCacheValid(res, provider) {
+		log.Debug().Str("path", path).Msg("discard cached results, provider version has changed")
+		res = nil
+	}
+
+	if res == nil {
+		res = &recording{
+			Assets: []assetRecording{{
+				Asset: assetInfo{
+					ID:          asset.PlatformIds[0],
+					PlatformIDs: asset.PlatformIds,
+				},
+				Connections: []connectionRecording{{
+					Url:        provider.ID,
+					ProviderID: provider.ID,
+					Version:    provider.Version,
+				}},
+			}},
+		}
+		res.refreshCache()
+	}
+	res.Path = path
+
+	return &cachedAsset{
+		recording: res,
+		asset:     &res.Assets[0],
+		provider:  provider,
+	}
+}
+
+func isCacheValid(res *recording, provider *Provider) bool {
+	if len(res.Assets) != 1 {
+		return false
+	}
+	for _, conn := range res.Assets[0].Connections {
+		if conn.ProviderID == provider.ID {
+			return conn.Version == provider.Version
+		}
+	}
+	return false
+}
+
+// ttl returns the cache TTL for a resource of the given provider
+func (c *resultCache) ttl(provider *Provider, resource string) time.Duration {
+	if provider.Schema == nil {
+		return 0
+	}
+	info := provider.Schema.Lookup(resource)
+	if info == nil || info.CacheTtl == "" {
+		return 0
+	}
+
+	ttl, err := time.ParseDuration(info.CacheTtl)
+	if err != nil {
+		log.Debug().Err(err).Str("resource", resource).Msg("invalid cache TTL in schema")
+		return 0
+	}
+	if c.maxTTL != 0 && ttl > c.maxTTL {
+		return c.maxTTL
+	}
+	return ttl
+}
+
+// lookup returns a cached resource if it exists and isn't stale yet
+func (c *resultCache) lookup(connectionID uint32, resource string, id string) (*cachedAsset, *resourceRecording) {
+	cached, ok := c.connections[connectionID]
+	if !ok {
+		return nil, nil
+	}
+	obj, ok := cached.asset.resources[resource+"\x00"+id]
+	if !ok || obj.Expires <= c.now().Unix() {
+		return cached, nil
+	}
+	return cached, obj
+}
+
+func (c *resultCache) AddData(connectionID uint32, resource string, id string, field string, data *llx.RawData) {
+	c.Recording.AddData(connectionID, resource, id, field, data)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cached, obj := c.lookup(connectionID, resource, id)
+	if cached == nil {
+		return
+	}
+	if obj == nil {
+		ttl := c.ttl(cached.provider, resource)
+		if ttl <= 0 {
+			return
+		}
+		obj = cached.addResource(resource, id, c.now().Add(ttl).Unix())
+	}
+
+	// we don't cache errors, they are retried on the next run
+	if field == "" || data == nil || data.Error != nil {
+		return
+	}
+	// resources can only be cached with their static fields, see AddResourceData
+	if data.Type.ContainsResource() {
+		return
+	}
+
+	obj.Fields[field] = data
+	cached.changed = true
+}
+
+// staticFieldsFunc retrieves the static fields of a resource, i.e. the fields
+// that its provider sets when it creates the resource
+type staticFieldsFunc func(resource string, id string) (map[string]*llx.RawData, error)
+
+type cachedReference struct {
+	resource string
+	id       string
+	fields   map[string]*llx.RawData
+}
+
+// AddResourceData stores a field that returns resources. The field is only
+// cached if the static fields of all its resources can be retrieved, since
+// they are required to create these resources again.
+func (c *resultCache) AddResourceData(connectionID uint32, resource string, id string, field string, data *llx.RawData, staticFields staticFieldsFunc) {
+	c.Recording.AddData(connectionID, resource, id, field, data)
+	if field == "" || data == nil || data.Error != nil {
+		return
+	}
+
+	c.lock.Lock()
+	cached, obj := c.lookup(connectionID, resource, id)
+	var expires int64
+	if obj != nil {
+		expires = obj.Expires
+	} else if cached != nil {
+		if ttl := c.ttl(cached.provider, resource); ttl > 0 {
+			expires = c.now().Add(ttl).Unix()
+		}
+	}
+	c.lock.Unlock()
+	if expires == 0 {
+		return
+	}
+
+	// static fields are retrieved from the provider, so the lock isn't held
+	refs := map[string]*cachedReference{}
+	if err := collectReferences(data.Value, data.Type, staticFields, refs); err != nil {
+		log.Debug().Err(err).Str("resource", resource).Str("field", field).Msg("cannot cache field, failed to get static fields of its resources")
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for key, ref := range refs {
+		existing, ok := cached.asset.resources[key]
+		if !ok || existing.Expires < expires {
+			existing = cached.addResource(ref.resource, ref.id, expires)
+		}
+		for k, v := range ref.fields {
+			existing.Fields[k] = v
+		}
+		existing.Complete = true
+	}
+
+	obj, ok := cached.asset.resources[resource+"\x00"+id]
+	if !ok {
+		obj = cached.addResource(resource, id, expires)
+	}
+	obj.Fields[field] = data
+	cached.changed = true
+}
+
+// collectReferences retrieves the static fields of all resources in a value,
+// including the resources that are referenced by these static fields
+func collectReferences(value interface{}, typ types.Type, staticFields staticFieldsFunc, refs map[string]*cachedReference) error {
+	switch {
+	case typ.IsArray():
+		arr, ok := value.([]interface{})
+		if !ok {
+			return nil
+		}
+		ct := typ.Child()
+		for i := range arr {
+			if err := collectReferences(arr[i], ct, staticFields, refs); err != nil {
+				return err
+			}
+		}
+
+	case typ.IsMap():
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		ct := typ.Child()
+		for _, v := range m {
+			if err := collectReferences(v, ct, staticFields, refs); err != nil {
+				return err
+			}
+		}
+
+	case typ.IsResource():
+		resource, ok := value.(llx.Resource)
+		if !ok || resource == nil {
+			return nil
+		}
+		key := resource.MqlName() + "\x00" + resource.MqlID()
+		if _, ok := refs[key]; ok {
+			return nil
+		}
+		fields, err := staticFields(resource.MqlName(), resource.MqlID())
+		if err != nil {
+			return err
+		}
+		refs[key] = &cachedReference{resource: resource.MqlName(), id: resource.MqlID(), fields: fields}
+		for _, v := range fields {
+			if v.Error != nil || !v.Type.ContainsResource() {
+				continue
+			}
+			if err := collectReferences(v.Value, v.Type, staticFields, refs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (a *cachedAsset) addResource(resource string, id string, expires int64) *resourceRecording {
+	obj := &resourceRecording{
+		Resource: resource,
+		ID:       id,
+		Fields:   map[string]*llx.RawData{},
+		Expires:  expires,
+	}
+	a.asset.resources[resource+"\x00"+id] = obj
+	a.changed = true
+	return obj
+}
+
+func (c *resultCache) GetData(connectionID uint32, resource string, id string, field string) (*llx.RawData, bool) {
+	if data, ok := c.Recording.GetData(connectionID, resource, id, field); ok {
+		return data, ok
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cached, obj := c.lookup(connectionID, resource, id)
+	if obj == nil {
+		return nil, false
+	}
+	if field == "" {
+		return &llx.RawData{Type: types.Resource(resource), Value: id}, true
+	}
+
+	data, ok := obj.Fields[field]
+	if ok {
+		log.Trace().Str("resource", resource).Str("id", id).Str("field", field).Str("provider", cached.provider.ID).Msg("use cached result")
+	}
+	return data, ok
+}
+
+// GetResource serves cached resources whose static fields are cached. Providers
+// use it to create resources they don't know about, e.g. the elements of a
+// cached list, so they can compute uncached fields.
+func (c *resultCache) GetResource(connectionID uint32, resource string, id string) (map[string]*llx.RawData, bool) {
+	if fields, ok := c.Recording.GetResource(connectionID, resource, id); ok {
+		return fields, ok
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, obj := c.lookup(connectionID, resource, id)
+	if obj == nil || !obj.Complete {
+		return nil, false
+	}
+	res := make(map[string]*llx.RawData, len(obj.Fields)+1)
+	for k, v := range obj.Fields {
+		res[k] = v
+	}
+	res["__id"] = llx.StringData(id)
+	return res, true
+}
+
+func (c *resultCache) Save() error {
+	var errs multierr.Errors
+	if err := c.Recording.Save(); err != nil {
+		errs.Add(err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for path, cached := range c.files {
+		if !cached.changed {
+			continue
+		}
+
+		// stale entries are removed, so that the cache doesn't grow forever
+		now := c.now().Unix()
+		for k, v := range cached.asset.resources {
+			if v.Expires <= now {
+				delete(cached.asset.resources, k)
+			}
+		}
+
+		if err := os.MkdirAll(c.path, 0o700); err != nil {
+			errs.Add(multierr.Wrap(err, "failed to create cache directory"))
+			break
+		}
+		// cached results may contain sensitive data. Files of earlier versions
+		// keep their permissions when they are written, so they are set again.
+		if err := cached.recording.storeFile(0o600); err != nil {
+			errs.Add(multierr.Wrap(err, "failed to store cached results in "+path))
+			continue
+		}
+		if err := os.Chmod(path, 0o600); err != nil {
+			errs.Add(multierr.Wrap(err, "failed to store cached results in "+path))
+			continue
+		}
+		cached.changed = false
+	}
+
+	return errs.Deduplicate()
+}
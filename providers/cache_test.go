@@ -0,0 +1,262 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/resources"
+	"go.mondoo.com/cnquery/v9/types"
+)
+
+func testCacheProviders(version string) Providers {
+	return Providers{
+		"os": {
+			Provider: &plugin.Provider{ID: "os", Version: version},
+			Schema: &resources.Schema{
+				Resources: map[string]*resources.ResourceInfo{
+					"packages": {Id: "packages", CacheTtl: "1h"},
+					"package":  {Id: "package", CacheTtl: "1h"},
+					"users":    {Id: "users"},
+				},
+			},
+		},
+	}
+}
+
+func testCache(t *testing.T, dir string, version string, now time.Time) *resultCache {
+	res, err := NewResultCache(nil, ResultCacheOptions{
+		Path:      dir,
+		Providers: testCacheProviders(version),
+	})
+	require.NoError(t, err)
+	cache := res.(*resultCache)
+	cache.now = func() time.Time { return now }
+
+	asset := &inventory.Asset{PlatformIds: []string{"//platformid.api.mondoo.app/machineid/1"}}
+	cache.EnsureAsset(asset, "os", 1, &inventory.Config{Type: "local"})
+	return cache
+}
+
+func TestResultCache(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	cache := testCache(t, dir, "9.0.0", now)
+	pkg := &llx.MockResource{Name: "package", ID: "git"}
+	cache.AddData(1, "packages", "", "", nil)
+	cache.AddResourceData(1, "packages", "", "list", llx.ArrayData([]interface{}{pkg}, types.Resource("package")),
+		func(resource string, id string) (map[string]*llx.RawData, error) {
+			return map[string]*llx.RawData{"name": llx.StringData(id)}, nil
+		})
+	cache.AddData(1, "package", "git", "version", llx.StringData("2.42.0"))
+	cache.AddData(1, "package", "git", "installed", &llx.RawData{Error: assert.AnError})
+	cache.AddData(1, "users", "", "list", llx.ArrayData([]interface{}{}, types.Resource("user")))
+	require.NoError(t, cache.Save())
+
+	t.Run("cached results are loaded in the next run", func(t *testing.T) {
+		cache := testCache(t, dir, "9.0.0", now.Add(time.Minute))
+
+		version, ok := cache.GetData(1, "package", "git", "version")
+		require.True(t, ok)
+		assert.Equal(t, "2.42.0", version.Value)
+
+		// errors and resources without TTL are not cached
+		_, ok = cache.GetData(1, "package", "git", "installed")
+		assert.False(t, ok)
+		_, ok = cache.GetData(1, "users", "", "list")
+		assert.False(t, ok)
+	})
+
+	t.Run("resources are cached with their static fields", func(t *testing.T) {
+		cache := testCache(t, dir, "9.0.0", now.Add(time.Minute))
+
+		list, ok := cache.GetData(1, "packages", "", "list")
+		require.True(t, ok)
+		assert.Equal(t, []interface{}{pkg}, list.Value)
+
+		fields, ok := cache.GetResource(1, "package", "git")
+		require.True(t, ok)
+		assert.Equal(t, "git", fields["name"].Value)
+		assert.Equal(t, "git", fields["__id"].Value)
+		// fields that aren't static are computed by the provider
+		_, ok = fields["installed"]
+		assert.False(t, ok)
+	})
+
+	t.Run("cached results are only readable by the user", func(t *testing.T) {
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		info, err := files[0].Info()
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	})
+
+	t.Run("stale results are ignored", func(t *testing.T) {
+		cache := testCache(t, dir, "9.0.0", now.Add(2*time.Hour))
+		_, ok := cache.GetData(1, "package", "git", "version")
+		assert.False(t, ok)
+	})
+
+	t.Run("results are discarded when the provider version changes", func(t *testing.T) {
+		cache := testCache(t, dir, "9.1.0", now.Add(time.Minute))
+		_, ok := cache.GetData(1, "package", "git", "version")
+		assert.False(t, ok)
+	})
+}
+
+func TestResultCacheMaxTTL(t *testing.T) {
+	res, err := NewResultCache(nil, ResultCacheOptions{
+		Path:      t.TempDir(),
+		MaxTTL:    10 * time.Minute,
+		Providers: testCacheProviders("9.0.0"),
+	})
+	require.NoError(t, err)
+	cache := res.(*resultCache)
+
+	provider := cache.providers["os"]
+	assert.Equal(t, 10*time.Minute, cache.ttl(provider, "packages"))
+	assert.Equal(t, time.Duration(0), cache.ttl(provider, "users"))
+
+	cache.maxTTL = 0
+	assert.Equal(t, time.Hour, cache.ttl(provider, "packages"))
+}
+
+// cachingProvider serves a list of packages and counts the requests it gets
+type cachingProvider struct {
+	callback plugin.ProviderCallback
+	created  map[string]bool
+	requests map[string]int
+}
+
+func (p *cachingProvider) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error) {
+	return nil, nil
+}
+
+func (p *cachingProvider) Connect(req *plugin.ConnectReq, callback plugin.ProviderCallback) (*plugin.ConnectRes, error) {
+	p.callback = callback
+	return &plugin.ConnectRes{Id: 1, Asset: req.Asset}, nil
+}
+
+func (p *cachingProvider) MockConnect(req *plugin.ConnectReq, callback plugin.ProviderCallback) (*plugin.ConnectRes, error) {
+	return p.Connect(req, callback)
+}
+
+func (p *cachingProvider) Shutdown(req *plugin.ShutdownReq) (*plugin.ShutdownRes, error) {
+	return &plugin.ShutdownRes{}, nil
+}
+
+func (p *cachingProvider) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
+	p.requests[req.Resource+"."+req.Field]++
+
+	var data *llx.RawData
+	switch req.Resource + "." + req.Field {
+	case "packages.list":
+		p.created["git"] = true
+		data = llx.ArrayData([]interface{}{&llx.MockResource{Name: "package", ID: "git"}}, types.Resource("package"))
+	case "package.name":
+		data = llx.StringData(req.ResourceId)
+	case "package.installed":
+		if !p.created[req.ResourceId] {
+			// like providers do, the package is created from the recording
+			res, err := p.callback.GetRecording(req)
+			if err != nil {
+				return nil, err
+			}
+			if res == nil || res.Fields["__id"] == nil || res.Fields["name"] == nil {
+				return &plugin.DataRes{Error: "package doesn't exist"}, nil
+			}
+			p.created[req.ResourceId] = true
+		}
+		data = llx.BoolTrue
+	default:
+		return &plugin.DataRes{Error: "unknown field"}, nil
+	}
+	return &plugin.DataRes{Data: data.Result().Data}, nil
+}
+
+func (p *cachingProvider) StoreData(req *plugin.StoreReq) (*plugin.StoreRes, error) {
+	return &plugin.StoreRes{}, nil
+}
+
+func testCacheRuntime(t *testing.T, dir string, provider *cachingProvider) *Runtime {
+	cache, err := NewResultCache(nil, ResultCacheOptions{
+		Path:      dir,
+		Providers: testCacheProviders("9.0.0"),
+	})
+	require.NoError(t, err)
+
+	runtime := &Runtime{
+		providers: map[string]*ConnectedProvider{},
+		schema: extensibleSchema{
+			loaded:    map[string]struct{}{},
+			allLoaded: true,
+			Schema:    resources.Schema{Resources: map[string]*resources.ResourceInfo{}},
+		},
+		Recording:       cache,
+		shutdownTimeout: defaultShutdownTimeout,
+	}
+	runtime.schema.runtime = runtime
+	runtime.Provider = &ConnectedProvider{Instance: &RunningProvider{
+		Name:   "os",
+		ID:     "os",
+		Plugin: provider,
+		Schema: &resources.Schema{
+			Resources: map[string]*resources.ResourceInfo{
+				"packages": {Id: "packages", Provider: "os", Fields: map[string]*resources.Field{
+					"list": {Name: "list", Type: string(types.Array(types.Resource("package"))), Provider: "os"},
+				}},
+				"package": {Id: "package", Provider: "os", Fields: map[string]*resources.Field{
+					"name":      {Name: "name", Type: string(types.String), IsMandatory: true, Provider: "os"},
+					"installed": {Name: "installed", Type: string(types.Bool), Provider: "os"},
+				}},
+			},
+		},
+	}}
+	runtime.AddConnectedProvider(runtime.Provider)
+
+	err = runtime.Connect(&plugin.ConnectReq{
+		Asset: &inventory.Asset{
+			PlatformIds: []string{"//platformid.api.mondoo.app/machineid/1"},
+			Connections: []*inventory.Config{{Type: "local"}},
+		},
+	})
+	require.NoError(t, err)
+	return runtime
+}
+
+func TestResultCacheRuntime(t *testing.T) {
+	dir := t.TempDir()
+
+	cold := &cachingProvider{created: map[string]bool{}, requests: map[string]int{}}
+	runtime := testCacheRuntime(t, dir, cold)
+	list, err := runtime.watchAndUpdate("packages", "", "list", "")
+	require.NoError(t, err)
+	require.Len(t, list.Value, 1)
+	require.NoError(t, runtime.Recording.(*resultCache).Save())
+	assert.Equal(t, 1, cold.requests["packages.list"])
+
+	warm := &cachingProvider{created: map[string]bool{}, requests: map[string]int{}}
+	runtime = testCacheRuntime(t, dir, warm)
+	list, err = runtime.watchAndUpdate("packages", "", "list", "")
+	require.NoError(t, err)
+	require.Len(t, list.Value, 1)
+	pkg := list.Value.([]interface{})[0].(llx.Resource)
+	assert.Equal(t, "git", pkg.MqlID())
+	assert.Equal(t, 0, warm.requests["packages.list"])
+
+	// the provider creates the package from the cache to compute uncached fields
+	installed, err := runtime.watchAndUpdate(pkg.MqlName(), pkg.MqlID(), "installed", "")
+	require.NoError(t, err)
+	assert.Equal(t, true, installed.Value)
+	assert.Equal(t, 1, warm.requests["package.installed"])
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-plugin"
 	"github.com/muesli/termenv"
 	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v9/cli/prof"
 	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
 	pp "go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/v9/providers-sdk/v1/resources"
@@ -38,6 +39,8 @@ type coordinator struct {
 	runtimes            map[string]*Runtime
 	runtimeCnt          int
 	mutex               sync.Mutex
+	// profile collects all provider calls and queries, if profiling is enabled
+	profile *prof.Report
 }
 
 type builtinProvider struct {
@@ -189,6 +192,9 @@ func (c *coordinator) Start(id string, isEphemeral bool, update UpdateProvidersC
 		Client: client,
 		Schema: provider.Schema,
 	}
+	if report := c.ProfileReport(); report != nil {
+		res.Plugin = newProfiledPlugin(res.Plugin, provider.Name, report)
+	}
 
 	c.mutex.Lock()
 	if isEphemeral {
@@ -38,6 +38,10 @@ func init() {
 			// to override args, implement: initParse(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createParse,
 		},
+		"parse.jwt": {
+			Init: initParseJwt,
+			Create: createParseJwt,
+		},
 		"uuid": {
 			Init: initUuid,
 			Create: createUuid,
@@ -209,6 +213,57 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"regex.creditCard": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlRegex).GetCreditCard()).ToDataRes(types.Regex)
 	},
+	"parse.jwt.token": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetToken()).ToDataRes(types.String)
+	},
+	"parse.jwt.keys": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetKeys()).ToDataRes(types.String)
+	},
+	"parse.jwt.header": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetHeader()).ToDataRes(types.Dict)
+	},
+	"parse.jwt.algorithm": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetAlgorithm()).ToDataRes(types.String)
+	},
+	"parse.jwt.keyId": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetKeyId()).ToDataRes(types.String)
+	},
+	"parse.jwt.type": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetType()).ToDataRes(types.String)
+	},
+	"parse.jwt.claims": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetClaims()).ToDataRes(types.Dict)
+	},
+	"parse.jwt.issuer": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetIssuer()).ToDataRes(types.String)
+	},
+	"parse.jwt.subject": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetSubject()).ToDataRes(types.String)
+	},
+	"parse.jwt.audience": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetAudience()).ToDataRes(types.Array(types.String))
+	},
+	"parse.jwt.expiresAt": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetExpiresAt()).ToDataRes(types.Time)
+	},
+	"parse.jwt.issuedAt": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetIssuedAt()).ToDataRes(types.Time)
+	},
+	"parse.jwt.notBefore": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetNotBefore()).ToDataRes(types.Time)
+	},
+	"parse.jwt.expired": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetExpired()).ToDataRes(types.Bool)
+	},
+	"parse.jwt.unsigned": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetUnsigned()).ToDataRes(types.Bool)
+	},
+	"parse.jwt.symmetric": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetSymmetric()).ToDataRes(types.Bool)
+	},
+	"parse.jwt.signatureValid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlParseJwt).GetSignatureValid()).ToDataRes(types.Bool)
+	},
 	"uuid.value": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlUuid).GetValue()).ToDataRes(types.String)
 	},
@@ -385,6 +440,78 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 			r.(*mqlParse).__id, ok = v.Value.(string)
 			return
 		},
+	"parse.jwt.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlParseJwt).__id, ok = v.Value.(string)
+			return
+		},
+	"parse.jwt.token": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).Token, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.keys": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).Keys, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.header": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).Header, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.algorithm": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).Algorithm, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.keyId": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).KeyId, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.claims": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).Claims, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.issuer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).Issuer, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.subject": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).Subject, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.audience": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).Audience, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.expiresAt": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).ExpiresAt, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.issuedAt": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).IssuedAt, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.notBefore": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).NotBefore, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.expired": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).Expired, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.unsigned": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).Unsigned, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.symmetric": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).Symmetric, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"parse.jwt.signatureValid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlParseJwt).SignatureValid, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
 	"uuid.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 			r.(*mqlUuid).__id, ok = v.Value.(string)
 			return
@@ -836,6 +963,170 @@ func (c *mqlParse) MqlID() string {
 	return c.__id
 }
 
+// mqlParseJwt for the parse.jwt resource
+type mqlParseJwt struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlParseJwtInternal
+	Token plugin.TValue[string]
+	Keys plugin.TValue[string]
+	Header plugin.TValue[interface{}]
+	Algorithm plugin.TValue[string]
+	KeyId plugin.TValue[string]
+	Type plugin.TValue[string]
+	Claims plugin.TValue[interface{}]
+	Issuer plugin.TValue[string]
+	Subject plugin.TValue[string]
+	Audience plugin.TValue[[]interface{}]
+	ExpiresAt plugin.TValue[*time.Time]
+	IssuedAt plugin.TValue[*time.Time]
+	NotBefore plugin.TValue[*time.Time]
+	Expired plugin.TValue[bool]
+	Unsigned plugin.TValue[bool]
+	Symmetric plugin.TValue[bool]
+	SignatureValid plugin.TValue[bool]
+}
+
+// createParseJwt creates a new instance of this resource
+func createParseJwt(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlParseJwt{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("parse.jwt", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlParseJwt) MqlName() string {
+	return "parse.jwt"
+}
+
+func (c *mqlParseJwt) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlParseJwt) GetToken() *plugin.TValue[string] {
+	return &c.Token
+}
+
+func (c *mqlParseJwt) GetKeys() *plugin.TValue[string] {
+	return &c.Keys
+}
+
+func (c *mqlParseJwt) GetHeader() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Header, func() (interface{}, error) {
+		return c.header()
+	})
+}
+
+func (c *mqlParseJwt) GetAlgorithm() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Algorithm, func() (string, error) {
+		return c.algorithm()
+	})
+}
+
+func (c *mqlParseJwt) GetKeyId() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.KeyId, func() (string, error) {
+		return c.keyId()
+	})
+}
+
+func (c *mqlParseJwt) GetType() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Type, func() (string, error) {
+		return c.compute_type()
+	})
+}
+
+func (c *mqlParseJwt) GetClaims() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Claims, func() (interface{}, error) {
+		return c.claims()
+	})
+}
+
+func (c *mqlParseJwt) GetIssuer() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Issuer, func() (string, error) {
+		return c.issuer()
+	})
+}
+
+func (c *mqlParseJwt) GetSubject() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Subject, func() (string, error) {
+		return c.subject()
+	})
+}
+
+func (c *mqlParseJwt) GetAudience() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Audience, func() ([]interface{}, error) {
+		return c.audience()
+	})
+}
+
+func (c *mqlParseJwt) GetExpiresAt() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.ExpiresAt, func() (*time.Time, error) {
+		return c.expiresAt()
+	})
+}
+
+func (c *mqlParseJwt) GetIssuedAt() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.IssuedAt, func() (*time.Time, error) {
+		return c.issuedAt()
+	})
+}
+
+func (c *mqlParseJwt) GetNotBefore() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.NotBefore, func() (*time.Time, error) {
+		return c.notBefore()
+	})
+}
+
+func (c *mqlParseJwt) GetExpired() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Expired, func() (bool, error) {
+		return c.expired()
+	})
+}
+
+func (c *mqlParseJwt) GetUnsigned() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Unsigned, func() (bool, error) {
+		return c.unsigned()
+	})
+}
+
+func (c *mqlParseJwt) GetSymmetric() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Symmetric, func() (bool, error) {
+		return c.symmetric()
+	})
+}
+
+func (c *mqlParseJwt) GetSignatureValid() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.SignatureValid, func() (bool, error) {
+		vargKeys := c.GetKeys()
+		if vargKeys.Error != nil {
+			return false, vargKeys.Error
+		}
+
+		return c.signatureValid(vargKeys.Data)
+	})
+}
+
 // mqlUuid for the uuid resource
 type mqlUuid struct {
 	MqlRuntime *plugin.Runtime
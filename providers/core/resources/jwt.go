@@ -0,0 +1,212 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mondoo.com/cnquery/v9/checksums"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/core/resources/jwt"
+	"gopkg.in/square/go-jose.v2"
+)
+
+const jwtAlgNone = "none"
+
+// jwtTime converts NumericDate claims, i.e. seconds since the epoch
+func jwtTime(claims map[string]interface{}, key string) plugin.TValue[*time.Time] {
+	if v, ok := claims[key].(float64); ok {
+		t := time.Unix(int64(v), 0)
+		return plugin.TValue[*time.Time]{Data: &t, State: plugin.StateIsSet}
+	}
+	return plugin.TValue[*time.Time]{State: plugin.StateIsSet | plugin.StateIsNull}
+}
+
+func jwtString(m map[string]interface{}, key string) string {
+	v, _ := m[key].(string)
+	return v
+}
+
+// jwtAudience handles audiences, which may either be a string or a list
+func jwtAudience(claims map[string]interface{}) []interface{} {
+	switch v := claims["aud"].(type) {
+	case string:
+		return []interface{}{v}
+	case []interface{}:
+		res := []interface{}{}
+		for i := range v {
+			if s, ok := v[i].(string); ok {
+				res = append(res, s)
+			}
+		}
+		return res
+	default:
+		return []interface{}{}
+	}
+}
+
+func initParseJwt(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	token, ok := args["token"]
+	if !ok || token.Value == nil {
+		return nil, nil, errors.New("missing token for parse.jwt")
+	}
+	args["token"] = llx.StringData(strings.TrimSpace(token.Value.(string)))
+
+	if keys, ok := args["keys"]; !ok || keys.Value == nil {
+		args["keys"] = llx.StringData("")
+	}
+	return args, nil, nil
+}
+
+type mqlParseJwtInternal struct {
+	lock    sync.Mutex
+	fetched bool
+}
+
+func (j *mqlParseJwt) id() (string, error) {
+	// tokens are credentials, so we don't want them to be part of the ID
+	return "parse.jwt/" + checksums.New.Add(j.Token.Data).Add(j.Keys.Data).String(), nil
+}
+
+// fetch decodes the token and sets all fields that don't need keys
+func (j *mqlParseJwt) fetch() error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if j.fetched {
+		return nil
+	}
+
+	header, claims, err := jwt.Decode(j.Token.Data)
+	if err != nil {
+		return err
+	}
+
+	alg := jwtString(header, "alg")
+	expiresAt := jwtTime(claims, "exp")
+	expired := expiresAt.Data != nil && time.Now().After(*expiresAt.Data)
+
+	j.Header = plugin.TValue[interface{}]{Data: header, State: plugin.StateIsSet}
+	j.Algorithm = plugin.TValue[string]{Data: alg, State: plugin.StateIsSet}
+	j.KeyId = plugin.TValue[string]{Data: jwtString(header, "kid"), State: plugin.StateIsSet}
+	j.Type = plugin.TValue[string]{Data: jwtString(header, "typ"), State: plugin.StateIsSet}
+	j.Claims = plugin.TValue[interface{}]{Data: claims, State: plugin.StateIsSet}
+	j.Issuer = plugin.TValue[string]{Data: jwtString(claims, "iss"), State: plugin.StateIsSet}
+	j.Subject = plugin.TValue[string]{Data: jwtString(claims, "sub"), State: plugin.StateIsSet}
+	j.Audience = plugin.TValue[[]interface{}]{Data: jwtAudience(claims), State: plugin.StateIsSet}
+	j.ExpiresAt = expiresAt
+	j.IssuedAt = jwtTime(claims, "iat")
+	j.NotBefore = jwtTime(claims, "nbf")
+	j.Expired = plugin.TValue[bool]{Data: expired, State: plugin.StateIsSet}
+	j.Unsigned = plugin.TValue[bool]{Data: strings.EqualFold(alg, jwtAlgNone), State: plugin.StateIsSet}
+	j.Symmetric = plugin.TValue[bool]{Data: strings.HasPrefix(strings.ToUpper(alg), "HS"), State: plugin.StateIsSet}
+	j.fetched = true
+	return nil
+}
+
+func (j *mqlParseJwt) header() (interface{}, error) {
+	return nil, j.fetch()
+}
+
+func (j *mqlParseJwt) algorithm() (string, error) {
+	return "", j.fetch()
+}
+
+func (j *mqlParseJwt) keyId() (string, error) {
+	return "", j.fetch()
+}
+
+func (j *mqlParseJwt) compute_type() (string, error) {
+	return "", j.fetch()
+}
+
+func (j *mqlParseJwt) claims() (interface{}, error) {
+	return nil, j.fetch()
+}
+
+func (j *mqlParseJwt) issuer() (string, error) {
+	return "", j.fetch()
+}
+
+func (j *mqlParseJwt) subject() (string, error) {
+	return "", j.fetch()
+}
+
+func (j *mqlParseJwt) audience() ([]interface{}, error) {
+	return nil, j.fetch()
+}
+
+func (j *mqlParseJwt) expiresAt() (*time.Time, error) {
+	return nil, j.fetch()
+}
+
+func (j *mqlParseJwt) issuedAt() (*time.Time, error) {
+	return nil, j.fetch()
+}
+
+func (j *mqlParseJwt) notBefore() (*time.Time, error) {
+	return nil, j.fetch()
+}
+
+func (j *mqlParseJwt) expired() (bool, error) {
+	return false, j.fetch()
+}
+
+func (j *mqlParseJwt) unsigned() (bool, error) {
+	return false, j.fetch()
+}
+
+func (j *mqlParseJwt) symmetric() (bool, error) {
+	return false, j.fetch()
+}
+
+func (j *mqlParseJwt) signatureValid(keys string) (bool, error) {
+	if keys == "" {
+		j.SignatureValid.State = plugin.StateIsSet | plugin.StateIsNull
+		return false, nil
+	}
+
+	if err := j.fetch(); err != nil {
+		return false, err
+	}
+	// unsigned tokens are never valid, even though they don't have a signature to check
+	if j.Unsigned.Data {
+		return false, nil
+	}
+
+	set, err := jwt.ParseKeys(keys)
+	if err != nil {
+		return false, err
+	}
+
+	sig, err := jose.ParseSigned(j.Token.Data)
+	if err != nil {
+		return false, err
+	}
+	kid := j.KeyId.Data
+
+	for i := range set {
+		key := set[i]
+		if kid != "" && key.KeyID != "" && kid != key.KeyID {
+			continue
+		}
+
+		// verification needs the public part of asymmetric keys
+		verificationKey := key.Key
+		if !key.IsPublic() {
+			if public := key.Public(); public.Valid() {
+				verificationKey = public.Key
+			}
+		}
+
+		if _, err := sig.Verify(verificationKey); err == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package jwt
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"gopkg.in/square/go-jose.v2"
+)
+
+// Decode decodes the header and claims of a JWS in compact serialization.
+// The signature is not verified, which allows inspecting unsigned tokens.
+func Decode(token string) (map[string]interface{}, map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	switch len(parts) {
+	case 3:
+	case 5:
+		return nil, nil, errors.New("encrypted tokens (JWE) are not supported")
+	default:
+		return nil, nil, errors.New("invalid token, expected three parts separated by '.'")
+	}
+
+	header := map[string]interface{}{}
+	if err := decodePart(parts[0], &header); err != nil {
+		return nil, nil, errors.New("failed to decode token header: " + err.Error())
+	}
+	claims := map[string]interface{}{}
+	if err := decodePart(parts[1], &claims); err != nil {
+		return nil, nil, errors.New("failed to decode token claims: " + err.Error())
+	}
+	return header, claims, nil
+}
+
+func decodePart(part string, v interface{}) error {
+	// tokens must not be padded, but some issuers do it anyway
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(part, "="))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// ParseKeys parses a JSON Web Key Set or a single JSON Web Key. Keys that
+// cannot be parsed, e.g. because of unsupported key types, are skipped.
+func ParseKeys(content string) ([]jose.JSONWebKey, error) {
+	var set struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.Unmarshal([]byte(content), &set); err != nil {
+		return nil, errors.New("failed to parse JSON Web Keys: " + err.Error())
+	}
+	if set.Keys == nil {
+		set.Keys = []json.RawMessage{json.RawMessage(content)}
+	}
+
+	res := []jose.JSONWebKey{}
+	for i := range set.Keys {
+		var key jose.JSONWebKey
+		if err := key.UnmarshalJSON(set.Keys[i]); err != nil {
+			continue
+		}
+		res = append(res, key)
+	}
+	return res, nil
+}
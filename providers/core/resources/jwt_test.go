@@ -0,0 +1,147 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"gopkg.in/square/go-jose.v2"
+)
+
+func signJwt(t *testing.T, alg jose.SignatureAlgorithm, key interface{}, kid string, claims map[string]interface{}) string {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", kid))
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	sig, err := signer.Sign(payload)
+	require.NoError(t, err)
+	token, err := sig.CompactSerialize()
+	require.NoError(t, err)
+	return token
+}
+
+func marshalJwks(t *testing.T, keys ...jose.JSONWebKey) string {
+	raw, err := json.Marshal(jose.JSONWebKeySet{Keys: keys})
+	require.NoError(t, err)
+	return string(raw)
+}
+
+func newParseJwt(t *testing.T, runtime *plugin.Runtime, token string, keys string) *mqlParseJwt {
+	res, err := NewResource(runtime, "parse.jwt", map[string]*llx.RawData{
+		"token": llx.StringData(token),
+		"keys":  llx.StringData(keys),
+	})
+	require.NoError(t, err)
+	return res.(*mqlParseJwt)
+}
+
+func TestResource_ParseJwt(t *testing.T) {
+	runtime := &plugin.Runtime{}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	claims := map[string]interface{}{
+		"iss":   "https://issuer.example.com",
+		"sub":   "user-1",
+		"aud":   []string{"api", "web"},
+		"exp":   exp.Unix(),
+		"iat":   exp.Add(-2 * time.Hour).Unix(),
+		"scope": "read",
+	}
+	token := signJwt(t, jose.RS256, rsaKey, "key-1", claims)
+	jwks := marshalJwks(t,
+		jose.JSONWebKey{Key: otherKey.Public(), KeyID: "key-0", Algorithm: "RS256", Use: "sig"},
+		jose.JSONWebKey{Key: rsaKey.Public(), KeyID: "key-1", Algorithm: "RS256", Use: "sig"},
+	)
+
+	t.Run("claims and header", func(t *testing.T) {
+		jwt := newParseJwt(t, runtime, token, "")
+		assert.Equal(t, "RS256", jwt.GetAlgorithm().Data)
+		assert.Equal(t, "key-1", jwt.GetKeyId().Data)
+		assert.Equal(t, "JWT", jwt.GetType().Data)
+		assert.Equal(t, "https://issuer.example.com", jwt.GetIssuer().Data)
+		assert.Equal(t, "user-1", jwt.GetSubject().Data)
+		assert.Equal(t, []interface{}{"api", "web"}, jwt.GetAudience().Data)
+		assert.Equal(t, "read", jwt.GetClaims().Data.(map[string]interface{})["scope"])
+		assert.Equal(t, exp, *jwt.GetExpiresAt().Data)
+		assert.Equal(t, exp.Add(-2*time.Hour), *jwt.GetIssuedAt().Data)
+		assert.Nil(t, jwt.GetNotBefore().Data)
+		assert.False(t, jwt.GetExpired().Data)
+		assert.False(t, jwt.GetUnsigned().Data)
+		assert.False(t, jwt.GetSymmetric().Data)
+		assert.NotContains(t, jwt.MqlID(), token)
+
+		valid := jwt.GetSignatureValid()
+		require.NoError(t, valid.Error)
+		assert.True(t, valid.State&plugin.StateIsNull != 0)
+	})
+
+	t.Run("valid signature", func(t *testing.T) {
+		jwt := newParseJwt(t, runtime, token, jwks)
+		valid := jwt.GetSignatureValid()
+		require.NoError(t, valid.Error)
+		assert.True(t, valid.Data)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		keys := marshalJwks(t, jose.JSONWebKey{Key: otherKey.Public(), KeyID: "key-1"})
+		jwt := newParseJwt(t, runtime, token, keys)
+		valid := jwt.GetSignatureValid()
+		require.NoError(t, valid.Error)
+		assert.False(t, valid.Data)
+	})
+
+	t.Run("single private key", func(t *testing.T) {
+		raw, err := json.Marshal(jose.JSONWebKey{Key: rsaKey})
+		require.NoError(t, err)
+		jwt := newParseJwt(t, runtime, token, string(raw))
+		assert.True(t, jwt.GetSignatureValid().Data)
+	})
+
+	t.Run("expired symmetric token", func(t *testing.T) {
+		secret := []byte("0123456789abcdef0123456789abcdef")
+		token := signJwt(t, jose.HS256, secret, "", map[string]interface{}{
+			"aud": "api",
+			"exp": time.Now().Add(-time.Minute).Unix(),
+		})
+		keys := marshalJwks(t, jose.JSONWebKey{Key: secret})
+		jwt := newParseJwt(t, runtime, token, keys)
+		assert.True(t, jwt.GetSymmetric().Data)
+		assert.True(t, jwt.GetExpired().Data)
+		assert.Equal(t, []interface{}{"api"}, jwt.GetAudience().Data)
+		assert.True(t, jwt.GetSignatureValid().Data)
+	})
+
+	t.Run("unsigned token", func(t *testing.T) {
+		header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+		payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"admin"}`))
+		jwt := newParseJwt(t, runtime, header+"."+payload+".", jwks)
+		assert.True(t, jwt.GetUnsigned().Data)
+		assert.Equal(t, "admin", jwt.GetSubject().Data)
+		assert.Nil(t, jwt.GetExpiresAt().Data)
+		assert.False(t, jwt.GetExpired().Data)
+		valid := jwt.GetSignatureValid()
+		require.NoError(t, valid.Error)
+		assert.False(t, valid.Data)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		jwt := newParseJwt(t, runtime, "not-a-token", "")
+		assert.EqualError(t, jwt.GetAlgorithm().Error, "invalid token, expected three parts separated by '.'")
+	})
+}
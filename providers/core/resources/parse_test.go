@@ -34,3 +34,21 @@ func TestParse_Date(t *testing.T) {
 		},
 	})
 }
+
+func TestParse_Jwt(t *testing.T) {
+	// {"alg":"none"}.{"sub":"admin"}
+	token := "eyJhbGciOiJub25lIn0.eyJzdWIiOiJhZG1pbiJ9."
+
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        "parse.jwt('" + token + "').subject",
+			ResultIndex: 0,
+			Expectation: "admin",
+		},
+		{
+			Code:        "parse.jwt('" + token + "').unsigned",
+			ResultIndex: 0,
+			Expectation: true,
+		},
+	})
+}
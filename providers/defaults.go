@@ -180,7 +180,7 @@ var DefaultProviders Providers = map[string]*Provider{
 			Connectors: []plugin.Connector{
 				{
 					Name:  "host",
-					Short: "a remote host",
+					Short: "a remote host or a network range in CIDR notation",
 				},
 			},
 		},
@@ -224,7 +224,7 @@ var DefaultProviders Providers = map[string]*Provider{
 	"os": {
 		Provider: &plugin.Provider{
 			Name:            "os",
-			ConnectionTypes: []string{"local", "ssh", "tar", "docker-snapshot", "vagrant", "docker-image", "docker-container", "docker-registry", "container-registry", "registry-image", "filesystem"},
+			ConnectionTypes: []string{"local", "ssh", "tar", "docker-snapshot", "vagrant", "docker-image", "docker-container", "docker-registry", "container-registry", "registry-image", "filesystem", "k8s-node"},
 			Connectors: []plugin.Connector{
 				{
 					Name:  "local",
@@ -36,6 +36,8 @@ var Config = plugin.Provider{
 				resources.DiscoveryAdmissionReviews,
 				resources.DiscoveryIngresses,
 				resources.DiscoveryNamespaces,
+				resources.DiscoveryGateways,
+				resources.DiscoveryNodesOs,
 			},
 			Flags: []plugin.Flag{
 				{
@@ -56,6 +58,24 @@ var Config = plugin.Provider{
 					Default: "",
 					Desc:    "Only include Kubernetes object in the matching namespaces.",
 				},
+				{
+					Long:    "helm-values",
+					Type:    plugin.FlagType_List,
+					Default: "",
+					Desc:    "Values files used to render Helm charts.",
+				},
+				{
+					Long:    "node-debug-image",
+					Type:    plugin.FlagType_String,
+					Default: "",
+					Desc:    "Image of the debug pods that scan the operating system of nodes.",
+				},
+				{
+					Long:    "node-debug-namespace",
+					Type:    plugin.FlagType_String,
+					Default: "",
+					Desc:    "Namespace of the privileged debug pods that scan the operating system of nodes. Required to discover nodes-os, use a dedicated namespace.",
+				},
 			},
 		},
 	},
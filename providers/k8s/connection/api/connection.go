@@ -34,6 +34,7 @@ type Connection struct {
 	namespace          string
 	clientset          *kubernetes.Clientset
 	currentClusterName string
+	kubeconfigPath     string
 }
 
 func NewConnection(id uint32, asset *inventory.Asset, discoveryCache *resources.DiscoveryCache) (shared.Connection, error) {
@@ -106,6 +107,7 @@ func NewConnection(id uint32, asset *inventory.Asset, discoveryCache *resources.
 		clientset:          clientset,
 		namespace:          asset.Connections[0].Options[shared.OPTION_NAMESPACE],
 		currentClusterName: currentClusterName,
+		kubeconfigPath:     kubeconfigPath,
 	}
 
 	return &res, nil
@@ -129,6 +131,11 @@ func (c *Connection) ID() uint32 {
 	return c.id
 }
 
+// KubeconfigPath returns the kubeconfig that the connection was loaded from
+func (c *Connection) KubeconfigPath() string {
+	return c.kubeconfigPath
+}
+
 func (c *Connection) Runtime() string {
 	return "k8s-cluster"
 }
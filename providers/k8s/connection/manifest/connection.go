@@ -8,10 +8,13 @@ import (
 	"encoding/hex"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
 	"go.mondoo.com/cnquery/v9/providers/k8s/connection/shared"
+	"go.mondoo.com/cnquery/v9/providers/k8s/connection/shared/helm"
+	"go.mondoo.com/cnquery/v9/providers/k8s/connection/shared/kustomize"
 	"go.mondoo.com/cnquery/v9/providers/k8s/connection/shared/resources"
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -32,6 +35,13 @@ func WithManifestFile(filename string) Option {
 	}
 }
 
+// WithHelmValues sets the values files for rendering Helm charts
+func WithHelmValues(files ...string) Option {
+	return func(p *Connection) {
+		p.helmValues = files
+	}
+}
+
 func WithManifestContent(data []byte) Option {
 	return func(p *Connection) {
 		p.manifestContent = data
@@ -46,6 +56,7 @@ type Connection struct {
 
 	manifestFile    string
 	manifestContent []byte
+	helmValues      []string
 }
 
 // func newManifestProvider(selectedResourceID string, objectKind string, opts ...Option) (KubernetesProvider, error) {
@@ -54,6 +65,9 @@ func NewConnection(id uint32, asset *inventory.Asset, opts ...Option) (shared.Co
 		asset:     asset,
 		namespace: asset.Connections[0].Options[shared.OPTION_NAMESPACE],
 	}
+	if values := asset.Connections[0].Options[shared.OPTION_HELM_VALUES]; values != "" {
+		c.helmValues = strings.Split(values, ",")
+	}
 
 	for _, option := range opts {
 		option(c)
@@ -65,7 +79,7 @@ func NewConnection(id uint32, asset *inventory.Asset, opts ...Option) (shared.Co
 	if len(c.manifestContent) > 0 {
 		manifest = c.manifestContent
 	} else if c.manifestFile != "" {
-		manifest, err = shared.LoadManifestFile(c.manifestFile)
+		manifest, err = loadManifest(c.manifestFile, c.namespace, c.helmValues)
 		if err != nil {
 			return nil, err
 		}
@@ -79,9 +93,39 @@ func NewConnection(id uint32, asset *inventory.Asset, opts ...Option) (shared.Co
 		return nil, err
 	}
 
+	// charts are installed into the namespace of their release
+	if c.manifestFile != "" && helm.IsChart(c.manifestFile) {
+		if err := c.ManifestParser.SetDefaultNamespace(c.namespace); err != nil {
+			return nil, err
+		}
+	}
+
 	return c, nil
 }
 
+// loadManifest renders Helm charts and builds kustomizations. All other
+// paths are read as plain manifest files.
+func loadManifest(path string, namespace string, helmValues []string) ([]byte, error) {
+	if helm.IsChart(path) {
+		manifest, err := helm.RenderFile(path, helm.Options{
+			Namespace:   namespace,
+			ValuesFiles: helmValues,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not render helm chart")
+		}
+		return manifest, nil
+	}
+	if kustomize.IsKustomization(path) {
+		manifest, err := kustomize.Build(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not build kustomization")
+		}
+		return manifest, nil
+	}
+	return shared.LoadManifestFile(path)
+}
+
 func (c *Connection) ServerVersion() *version.Info {
 	return nil
 }
@@ -0,0 +1,51 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers/k8s/connection/shared"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+func TestHelmChart(t *testing.T) {
+	asset := &inventory.Asset{Connections: []*inventory.Config{{
+		Options: map[string]string{
+			shared.OPTION_NAMESPACE:   "web",
+			shared.OPTION_HELM_VALUES: "../shared/helm/testdata/webapp-prod.yaml",
+		},
+	}}}
+	conn, err := NewConnection(0, asset, WithManifestFile("../shared/helm/testdata/webapp"))
+	require.NoError(t, err)
+
+	res, err := conn.Resources("deployments", "", "")
+	require.NoError(t, err)
+	require.Len(t, res.Resources, 1)
+	deployment := res.Resources[0].(*appsv1.Deployment)
+	assert.Equal(t, "release-name-webapp", deployment.Name)
+	assert.Equal(t, "web", deployment.Namespace)
+	assert.Equal(t, int32(3), *deployment.Spec.Replicas)
+
+	res, err = conn.Resources("ingresses", "", "")
+	require.NoError(t, err)
+	assert.Len(t, res.Resources, 1)
+}
+
+func TestKustomization(t *testing.T) {
+	asset := &inventory.Asset{Connections: []*inventory.Config{{}}}
+	conn, err := NewConnection(0, asset, WithManifestFile("../shared/kustomize/testdata/overlays/prod"))
+	require.NoError(t, err)
+
+	res, err := conn.Resources("deployments", "", "")
+	require.NoError(t, err)
+	require.Len(t, res.Resources, 1)
+	deployment := res.Resources[0].(*appsv1.Deployment)
+	assert.Equal(t, "prod-app", deployment.Name)
+	assert.Equal(t, "prod", deployment.Namespace)
+	assert.Equal(t, "nginx:1.26", deployment.Spec.Template.Spec.Containers[0].Image)
+}
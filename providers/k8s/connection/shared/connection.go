@@ -23,6 +23,12 @@ const (
 	OPTION_ADMISSION         = "k8s-admission-review"
 	OPTION_OBJECT_KIND       = "object-kind"
 	OPTION_CONTEXT           = "context"
+	// OPTION_HELM_VALUES is a comma-separated list of values files for rendering Helm charts
+	OPTION_HELM_VALUES = "helm-values"
+	// OPTION_NODE_DEBUG_IMAGE and OPTION_NODE_DEBUG_NAMESPACE configure the debug pods that
+	// are used to scan the operating system of nodes
+	OPTION_NODE_DEBUG_IMAGE     = "node-debug-image"
+	OPTION_NODE_DEBUG_NAMESPACE = "node-debug-namespace"
 )
 
 type ConnectionType string
@@ -48,6 +54,12 @@ type Connection interface {
 	InventoryConfig() *inventory.Config
 }
 
+// KubeconfigConnection is implemented by connections to clusters. The path
+// is empty if the connection uses the in-cluster config.
+type KubeconfigConnection interface {
+	KubeconfigPath() string
+}
+
 type ClusterInfo struct {
 	Name string
 }
@@ -104,6 +116,10 @@ func getPlatformInfo(objectKind string, runtime string) *inventory.Platform {
 		platformData.Name = "k8s-ingress"
 		platformData.Title = "Kubernetes Ingress"
 		return platformData
+	case "gateway":
+		platformData.Name = "k8s-gateway"
+		platformData.Title = "Kubernetes Gateway"
+		return platformData
 	case "namespace":
 		platformData.Name = "k8s-namespace"
 		platformData.Title = "Kubernetes Namespace"
@@ -0,0 +1,31 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package helm
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+)
+
+// IsChart returns true if the path is a chart directory or archive
+func IsChart(p string) bool {
+	fi, err := os.Stat(p)
+	if err != nil {
+		return false
+	}
+	if !fi.IsDir() {
+		return strings.HasSuffix(p, ".tgz") || strings.HasSuffix(p, ".tar.gz")
+	}
+	_, err = os.Stat(filepath.Join(p, "Chart.yaml"))
+	return err == nil
+}
+
+// Load loads a chart from a directory or a packaged chart archive
+func Load(p string) (*chart.Chart, error) {
+	return loader.Load(p)
+}
@@ -0,0 +1,224 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package helm
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	helmtime "helm.sh/helm/v3/pkg/time"
+	"sigs.k8s.io/yaml"
+)
+
+func renderedDocs(t *testing.T, manifest []byte) map[string]map[string]interface{} {
+	docs := map[string]map[string]interface{}{}
+	for _, doc := range strings.Split(string(manifest), "---\n") {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		obj := map[string]interface{}{}
+		require.NoError(t, yaml.Unmarshal([]byte(doc), &obj))
+		docs[obj["kind"].(string)] = obj
+	}
+	return docs
+}
+
+func TestLoad(t *testing.T) {
+	c, err := Load("./testdata/webapp")
+	require.NoError(t, err)
+	assert.Equal(t, "webapp", c.Metadata.Name)
+	assert.Equal(t, "2.0.1", c.Metadata.AppVersion)
+	assert.Len(t, c.Templates, 5)
+	require.Len(t, c.Files, 1)
+	assert.Equal(t, "files/app.conf", c.Files[0].Name)
+	require.Len(t, c.Dependencies(), 1)
+	assert.Equal(t, "cache", c.Dependencies()[0].Metadata.Name)
+}
+
+func TestRenderDefaults(t *testing.T) {
+	manifest, err := RenderFile("./testdata/webapp", Options{})
+	require.NoError(t, err)
+	assert.Contains(t, string(manifest), "# Source: webapp/templates/deployment.yaml\n")
+	assert.NotContains(t, string(manifest), "Visit")
+
+	docs := renderedDocs(t, manifest)
+	assert.Len(t, docs, 4)
+	assert.NotContains(t, docs, "Ingress")
+
+	deployment := docs["Deployment"]
+	metadata := deployment["metadata"].(map[string]interface{})
+	assert.Equal(t, "release-name-webapp", metadata["name"])
+	assert.Equal(t, "default", metadata["namespace"])
+	assert.Equal(t, map[string]interface{}{
+		"app.kubernetes.io/name":     "webapp",
+		"app.kubernetes.io/instance": "release-name",
+		"app.kubernetes.io/version":  "2.0.1",
+		"helm.sh/chart":              "webapp-1.2.3",
+		"team":                       "web",
+	}, metadata["labels"])
+
+	spec := deployment["spec"].(map[string]interface{})
+	assert.Equal(t, float64(1), spec["replicas"])
+	pod := spec["template"].(map[string]interface{})
+	annotations := pod["metadata"].(map[string]interface{})["annotations"].(map[string]interface{})
+	assert.Len(t, annotations["checksum/config"], 64)
+	container := pod["spec"].(map[string]interface{})["containers"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "nginx:2.0.1", container["image"])
+	assert.Equal(t, map[string]interface{}{"runAsNonRoot": true, "readOnlyRootFilesystem": true}, container["securityContext"])
+
+	configMap := docs["ConfigMap"]
+	assert.Equal(t, map[string]interface{}{"app.conf": "listen 8080;\n"}, configMap["data"])
+
+	statefulSet := docs["StatefulSet"]
+	assert.Equal(t, "release-name-cache", statefulSet["metadata"].(map[string]interface{})["name"])
+	assert.NotContains(t, string(manifest), "requirepass")
+}
+
+func TestRenderValues(t *testing.T) {
+	manifest, err := RenderFile("./testdata/webapp", Options{
+		ReleaseName: "shop",
+		Namespace:   "prod",
+		ValuesFiles: []string{"./testdata/webapp-prod.yaml"},
+		Values: map[string]interface{}{
+			"global": map[string]interface{}{"team": "platform"},
+		},
+	})
+	require.NoError(t, err)
+	docs := renderedDocs(t, manifest)
+	assert.Len(t, docs, 5)
+
+	deployment := docs["Deployment"]
+	metadata := deployment["metadata"].(map[string]interface{})
+	assert.Equal(t, "shop-webapp", metadata["name"])
+	assert.Equal(t, "prod", metadata["namespace"])
+	assert.Equal(t, "platform", metadata["labels"].(map[string]interface{})["team"])
+	spec := deployment["spec"].(map[string]interface{})
+	assert.Equal(t, float64(3), spec["replicas"])
+	container := spec["template"].(map[string]interface{})["spec"].(map[string]interface{})["containers"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "nginx:2.1.0", container["image"])
+	// null values remove defaults
+	assert.Equal(t, map[string]interface{}{"runAsNonRoot": true}, container["securityContext"])
+
+	assert.Equal(t, "www.example.com", docs["Ingress"]["spec"].(map[string]interface{})["rules"].([]interface{})[0].(map[string]interface{})["host"])
+
+	// values and globals are passed to the dependency
+	statefulSet := docs["StatefulSet"]
+	assert.Equal(t, "platform", statefulSet["metadata"].(map[string]interface{})["labels"].(map[string]interface{})["team"])
+	assert.Contains(t, string(manifest), `args: ["--requirepass", "s3cret"]`)
+}
+
+func TestRenderDisabledDependency(t *testing.T) {
+	manifest, err := RenderFile("./testdata/webapp", Options{
+		Values: map[string]interface{}{
+			"cache": map[string]interface{}{"enabled": false},
+		},
+	})
+	require.NoError(t, err)
+	docs := renderedDocs(t, manifest)
+	assert.NotContains(t, docs, "StatefulSet")
+}
+
+func TestRenderFuncs(t *testing.T) {
+	c := &chart.Chart{
+		Metadata: &chart.Metadata{Name: "funcs", Version: "0.1.0"},
+		Values: map[string]interface{}{
+			"name":  "My App",
+			"ports": []interface{}{float64(80), float64(443)},
+			"tags":  map[string]interface{}{"b": "2", "a": "1"},
+		},
+		Templates: []*chart.File{
+			{Name: "templates/_tpl.tpl", Data: []byte(`{{ define "greeting" }}hello {{ . }}{{ end }}`)},
+			{Name: "templates/out.txt", Data: []byte(strings.Join([]string{
+				`{{ .Values.name | lower | replace " " "-" }}`,
+				`{{ include "greeting" "world" | upper }}`,
+				`{{ tpl "{{ .Values.name }}" . }}`,
+				`{{ .Values.missing | default "fallback" }}`,
+				`{{ join "," .Values.ports }}`,
+				`{{ keys .Values.tags | sortAlpha | join "," }}`,
+				`{{ if semverCompare ">=1.25.0" .Capabilities.KubeVersion.Version }}new{{ end }}`,
+				`{{ .Capabilities.APIVersions.Has "apps/v1" }}`,
+				`{{ dict "a" 1 | toJson }}`,
+				`{{ add 1 2 3 }} {{ sub 5 2 }} {{ max 3 7 }}`,
+				`{{ list "a" "b" | has "b" }}`,
+				`{{ coalesce "" .Values.name }}`,
+				`{{ .Values.nothing }}`,
+				`{{ base "/a/b.txt" }} {{ dir "/a/b.txt" }} {{ ext "/a/b.txt" }}`,
+				`{{ trimAll "-" "--x--" }} {{ sha256sum "" | trunc 8 }}`,
+				`{{ (urlParse "https://example.com:8443/p").host }}`,
+				`{{ dict "a" 1 | toToml | trim }}`,
+				`{{ mustRegexMatch "^[a-z]+$" "abc" }} {{ list 1 2 | mustFirst }}`,
+				`{{ $ca := genCA "test-ca" 1 }}{{ hasPrefix "-----BEGIN CERTIFICATE-----" $ca.Cert }}`,
+			}, "\n"))},
+		},
+	}
+
+	rendered, err := RenderTemplates(c, nil, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"my-app",
+		"HELLO WORLD",
+		"My App",
+		"fallback",
+		"80,443",
+		"a,b",
+		"new",
+		"true",
+		`{"a":1}`,
+		"6 3 7",
+		"true",
+		"My App",
+		"",
+		"b.txt /a .txt",
+		"x e3b0c442",
+		"example.com:8443",
+		"a = 1",
+		"true 1",
+		"true",
+	}, "\n"), rendered["funcs/templates/out.txt"])
+	assert.NotContains(t, rendered, "funcs/templates/_tpl.tpl")
+}
+
+func TestRenderRequired(t *testing.T) {
+	c := &chart.Chart{
+		Metadata: &chart.Metadata{Name: "required"},
+		Templates: []*chart.File{
+			{Name: "templates/cm.yaml", Data: []byte(`{{ required "a host is required" .Values.host }}`)},
+		},
+	}
+	_, err := RenderTemplates(c, nil, Options{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "a host is required")
+}
+
+func TestRelease(t *testing.T) {
+	deployed := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	data, err := EncodeRelease(&release.Release{
+		Name:      "shop",
+		Namespace: "prod",
+		Version:   2,
+		Info:      &release.Info{Status: release.StatusDeployed, LastDeployed: helmtime.Time{Time: deployed}},
+		Chart: &chart.Chart{
+			Metadata: &chart.Metadata{Name: "webapp", Version: "1.2.3", AppVersion: "2.0.1"},
+		},
+		Config: map[string]interface{}{"replicaCount": float64(3)},
+	})
+	require.NoError(t, err)
+
+	r, err := DecodeRelease(data)
+	require.NoError(t, err)
+	assert.Equal(t, "shop", r.Name)
+	assert.Equal(t, 2, r.Version)
+	assert.Equal(t, release.StatusDeployed, r.Info.Status)
+	assert.True(t, deployed.Equal(r.Info.LastDeployed.Time))
+	assert.Equal(t, "webapp", r.Chart.Metadata.Name)
+	assert.Equal(t, map[string]interface{}{"replicaCount": float64(3)}, r.Config)
+
+	_, err = DecodeRelease([]byte("not a release"))
+	assert.Error(t, err)
+}
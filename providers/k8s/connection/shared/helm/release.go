@@ -0,0 +1,74 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package helm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+const (
+	// SecretType is the type of secrets that store Helm releases
+	SecretType = "helm.sh/release.v1"
+	// ReleaseKey is the data key of release secrets and config maps
+	ReleaseKey = "release"
+	// OwnerLabel marks release secrets and config maps as owned by Helm
+	OwnerLabel = "owner"
+	OwnerHelm  = "helm"
+
+	// maxReleaseSize limits the size of decompressed releases
+	maxReleaseSize = 50 << 20
+)
+
+// DecodeRelease decodes the release data of a secret or config map. Helm
+// stores releases as base64 encoded, gzip compressed JSON.
+func DecodeRelease(data []byte) (*release.Release, error) {
+	decoded := make([]byte, base64.StdEncoding.DecodedLen(len(data)))
+	n, err := base64.StdEncoding.Decode(decoded, bytes.TrimSpace(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode Helm release")
+	}
+	decoded = decoded[:n]
+
+	if len(decoded) > 2 && decoded[0] == 0x1f && decoded[1] == 0x8b {
+		gz, err := gzip.NewReader(bytes.NewReader(decoded))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decompress Helm release")
+		}
+		defer gz.Close()
+		decoded, err = io.ReadAll(io.LimitReader(gz, maxReleaseSize))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decompress Helm release")
+		}
+	}
+
+	res := &release.Release{}
+	if err := json.Unmarshal(decoded, res); err != nil {
+		return nil, errors.Wrap(err, "could not parse Helm release")
+	}
+	return res, nil
+}
+
+// EncodeRelease encodes a release the way Helm stores it
+func EncodeRelease(r *release.Release) ([]byte, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(data); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return []byte(base64.StdEncoding.EncodeToString(buf.Bytes())), nil
+}
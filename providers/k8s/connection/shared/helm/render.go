@@ -0,0 +1,145 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package helm
+
+import (
+	"strings"
+
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"helm.sh/helm/v3/pkg/releaseutil"
+)
+
+const (
+	// DefaultReleaseName is the release name that helm template uses
+	DefaultReleaseName = "release-name"
+	// DefaultNamespace is the namespace that charts are rendered for if nothing else is set
+	DefaultNamespace = "default"
+	// DefaultKubeVersion is reported via .Capabilities if nothing else is set
+	DefaultKubeVersion = "v1.28.0"
+)
+
+type Options struct {
+	ReleaseName string
+	Namespace   string
+	KubeVersion string
+	// ValuesFiles are merged in order, later files take precedence
+	ValuesFiles []string
+	// Values take precedence over all values files
+	Values map[string]interface{}
+}
+
+// RenderFile renders the chart at the path into a multi-document manifest
+func RenderFile(p string, opts Options) ([]byte, error) {
+	c, err := Load(p)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	for _, filename := range opts.ValuesFiles {
+		fileValues, err := chartutil.ReadValuesFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		values = chartutil.MergeTables(fileValues, values)
+	}
+	if opts.Values != nil {
+		// merging modifies the values that take precedence
+		override, err := copystructure.Copy(opts.Values)
+		if err != nil {
+			return nil, err
+		}
+		values = chartutil.MergeTables(override.(map[string]interface{}), values)
+	}
+
+	return Render(c, values, opts)
+}
+
+// Render renders the chart with the given user supplied values into a
+// multi-document manifest. Resources and hooks are printed in install order,
+// with a comment of their source, the same way helm template prints them.
+func Render(c *chart.Chart, values map[string]interface{}, opts Options) ([]byte, error) {
+	caps, err := capabilities(opts)
+	if err != nil {
+		return nil, err
+	}
+	rendered, err := renderTemplates(c, values, opts, caps)
+	if err != nil {
+		return nil, err
+	}
+
+	for name := range rendered {
+		if strings.HasSuffix(name, "NOTES.txt") {
+			delete(rendered, name)
+		}
+	}
+	hooks, manifests, err := releaseutil.SortManifests(rendered, caps.APIVersions, releaseutil.InstallOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	var out strings.Builder
+	for _, m := range manifests {
+		out.WriteString("---\n# Source: " + m.Name + "\n" + m.Content + "\n")
+	}
+	for _, h := range hooks {
+		out.WriteString("---\n# Source: " + h.Path + "\n" + h.Manifest + "\n")
+	}
+	return []byte(out.String()), nil
+}
+
+// RenderTemplates renders all templates of the chart and its enabled
+// dependencies. The result maps the template names, e.g.
+// mychart/templates/service.yaml, to the rendered content. Partials that
+// start with an underscore are not part of the result.
+func RenderTemplates(c *chart.Chart, values map[string]interface{}, opts Options) (map[string]string, error) {
+	caps, err := capabilities(opts)
+	if err != nil {
+		return nil, err
+	}
+	return renderTemplates(c, values, opts, caps)
+}
+
+func renderTemplates(c *chart.Chart, values map[string]interface{}, opts Options, caps *chartutil.Capabilities) (map[string]string, error) {
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	if opts.ReleaseName == "" {
+		opts.ReleaseName = DefaultReleaseName
+	}
+	if opts.Namespace == "" {
+		opts.Namespace = DefaultNamespace
+	}
+
+	if err := chartutil.ProcessDependenciesWithMerge(c, values); err != nil {
+		return nil, errors.Wrap(err, "could not process chart dependencies")
+	}
+	renderValues, err := chartutil.ToRenderValues(c, values, chartutil.ReleaseOptions{
+		Name:      opts.ReleaseName,
+		Namespace: opts.Namespace,
+		Revision:  1,
+		IsInstall: true,
+	}, caps)
+	if err != nil {
+		return nil, err
+	}
+	return engine.Render(c, renderValues)
+}
+
+func capabilities(opts Options) (*chartutil.Capabilities, error) {
+	if opts.KubeVersion == "" {
+		opts.KubeVersion = DefaultKubeVersion
+	}
+	kubeVersion, err := chartutil.ParseKubeVersion(opts.KubeVersion)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid kube version")
+	}
+	caps := chartutil.DefaultCapabilities.Copy()
+	caps.KubeVersion = *kubeVersion
+	return caps, nil
+}
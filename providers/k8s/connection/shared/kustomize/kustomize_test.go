@@ -0,0 +1,117 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package kustomize
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+func objectsByKind(t *testing.T, dir string) map[string]*unstructured.Unstructured {
+	data, err := Build(dir)
+	require.NoError(t, err)
+	res := map[string]*unstructured.Unstructured{}
+	for _, doc := range strings.Split(string(data), "---\n") {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		require.NoError(t, yaml.Unmarshal([]byte(doc), &obj.Object))
+		res[obj.GetKind()] = obj
+	}
+	return res
+}
+
+func nestedString(t *testing.T, obj map[string]interface{}, fields ...string) string {
+	value, _, err := unstructured.NestedString(obj, fields...)
+	require.NoError(t, err)
+	return value
+}
+
+func containers(t *testing.T, obj *unstructured.Unstructured) []map[string]interface{} {
+	list, _, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	res := make([]map[string]interface{}, len(list))
+	for i := range list {
+		res[i] = list[i].(map[string]interface{})
+	}
+	return res
+}
+
+func TestIsKustomization(t *testing.T) {
+	assert.True(t, IsKustomization("./testdata/base"))
+	assert.False(t, IsKustomization("./testdata/base/deployment.yaml"))
+	assert.False(t, IsKustomization("./testdata"))
+}
+
+func TestBuildBase(t *testing.T) {
+	objs := objectsByKind(t, "./testdata/base")
+	require.Len(t, objs, 3)
+
+	cm := objs["ConfigMap"]
+	assert.True(t, strings.HasPrefix(cm.GetName(), "app-config-"))
+	assert.Equal(t, map[string]interface{}{"LOG_LEVEL": "info"}, cm.Object["data"])
+
+	deployment := objs["Deployment"]
+	envFrom := containers(t, deployment)[0]["envFrom"].([]interface{})
+	require.Len(t, envFrom, 1)
+	assert.Equal(t, cm.GetName(), nestedString(t, envFrom[0].(map[string]interface{}), "configMapRef", "name"))
+}
+
+func TestBuildOverlay(t *testing.T) {
+	objs := objectsByKind(t, "./testdata/overlays/prod")
+	require.Len(t, objs, 3)
+
+	for _, obj := range objs {
+		assert.Equal(t, "prod", obj.GetNamespace())
+		assert.True(t, strings.HasPrefix(obj.GetName(), "prod-"), obj.GetName())
+		assert.Equal(t, "prod", obj.GetLabels()["env"])
+	}
+
+	cm := objs["ConfigMap"]
+	assert.True(t, strings.HasPrefix(cm.GetName(), "prod-app-config-"))
+	assert.Equal(t, map[string]interface{}{"LOG_LEVEL": "warn"}, cm.Object["data"])
+
+	deployment := objs["Deployment"]
+	assert.Equal(t, "prod-app", deployment.GetName())
+	replicas, _, err := unstructured.NestedFieldNoCopy(deployment.Object, "spec", "replicas")
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, replicas)
+	assert.Equal(t, "true", deployment.GetAnnotations()["prometheus.io/scrape"])
+	assert.Equal(t, "prod", nestedString(t, deployment.Object, "spec", "selector", "matchLabels", "env"))
+	assert.Equal(t, "prod", nestedString(t, deployment.Object, "spec", "template", "metadata", "labels", "env"))
+
+	cs := containers(t, deployment)
+	require.Len(t, cs, 1)
+	assert.Equal(t, "nginx:1.26", cs[0]["image"])
+	assert.Equal(t, true, cs[0]["securityContext"].(map[string]interface{})["runAsNonRoot"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "MODE", "value": "production"}}, cs[0]["env"])
+	envFrom := cs[0]["envFrom"].([]interface{})
+	require.Len(t, envFrom, 1)
+	assert.Equal(t, cm.GetName(), nestedString(t, envFrom[0].(map[string]interface{}), "configMapRef", "name"))
+
+	service := objs["Service"]
+	assert.Equal(t, "prod", nestedString(t, service.Object, "spec", "selector", "env"))
+}
+
+func TestBuild(t *testing.T) {
+	data, err := Build("./testdata/overlays/prod")
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(data), "---\n"))
+	assert.Contains(t, string(data), "name: prod-app\n")
+}
+
+func TestBuildHelmChartsDisabled(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte("helmCharts:\n- name: app\n"), 0o644))
+	_, err := Build(dir)
+	assert.Error(t, err)
+}
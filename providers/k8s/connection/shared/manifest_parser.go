@@ -66,30 +66,40 @@ func (t *ManifestParser) Namespace(name string) (*v1.Namespace, error) {
 // Namespaces iterates over all file-based manifests and extracts all namespaces used
 func (t *ManifestParser) Namespaces() ([]v1.Namespace, error) {
 	namespaceMap := map[string]struct{}{}
+	var nss []v1.Namespace
+	for i := range t.Objects {
+		res := t.Objects[i]
+		// namespaces defined in the manifests are used as they are, so their labels
+		// and annotations are available
+		if ns, ok := res.(*v1.Namespace); ok {
+			namespaceMap[ns.Name] = struct{}{}
+			nss = append(nss, *ns)
+		}
+	}
+
 	for i := range t.Objects {
 		res := t.Objects[i]
 		o, err := meta.Accessor(res)
 		if err == nil {
 			ns := o.GetNamespace()
+			if _, ok := namespaceMap[ns]; ok {
+				continue
+			}
 			// There are types of resources that do not have meta data. Instead of erroring
 			// skip them.
 			namespaceMap[ns] = struct{}{}
-		}
-	}
 
-	var nss []v1.Namespace
-
-	// NOTE: this only does the minimal required for our current implementation
-	// going forward we may need a bit more information
-	for k := range namespaceMap {
-		nss = append(nss, v1.Namespace{
-			TypeMeta: metav1.TypeMeta{
-				Kind: "Namespace",
-			},
-			ObjectMeta: metav1.ObjectMeta{
-				Name: k,
-			},
-		})
+			// NOTE: this only does the minimal required for our current implementation
+			// going forward we may need a bit more information
+			nss = append(nss, v1.Namespace{
+				TypeMeta: metav1.TypeMeta{
+					Kind: "Namespace",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name: ns,
+				},
+			})
+		}
 	}
 
 	return nss, nil
@@ -136,6 +146,31 @@ func (t *ManifestParser) resourceIndex() (*resources.ApiResourceIndex, error) {
 	return resTypes, nil
 }
 
+// SetDefaultNamespace sets the namespace of all namespaced objects that have
+// none, the same way they are created when applied to a namespace
+func (t *ManifestParser) SetDefaultNamespace(namespace string) error {
+	if namespace == "" {
+		return nil
+	}
+	resTypes, err := t.resourceIndex()
+	if err != nil {
+		return err
+	}
+	for _, o := range t.Objects {
+		obj, err := meta.Accessor(o)
+		if err != nil || obj.GetNamespace() != "" {
+			continue
+		}
+		gvk := o.GetObjectKind().GroupVersionKind()
+		resType, err := resTypes.Lookup(gvk.GroupKind().String())
+		if err != nil || !resType.Resource.Namespaced {
+			continue
+		}
+		obj.SetNamespace(namespace)
+	}
+	return nil
+}
+
 // Resources retrieves the cluster resources. If the connection has a global namespace set, then that's used
 func (t *ManifestParser) Resources(kind string, name string, namespace string) (*ResourceResult, error) {
 	// The connection namespace has precedence
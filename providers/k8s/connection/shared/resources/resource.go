@@ -4,6 +4,7 @@
 package resources
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -12,6 +13,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+// ErrKindNotFound is returned for kinds that the API server or the manifest does not know
+var ErrKindNotFound = errors.New("could not find api kind")
+
 type ApiResource struct {
 	Resource     metav1.APIResource
 	GroupVersion schema.GroupVersion
@@ -172,6 +176,35 @@ func (ri *ApiResourceIndex) Lookup(kind string) (*ApiResource, error) {
 		if len(out) != 0 {
 			return &out[0], nil
 		}
+	case "hpa", "horizontalpodautoscaler", "horizontalpodautoscalers":
+		// autoscaling/v2 is available since 1.23, prefer it over older versions
+		for _, name := range []string{"horizontalpodautoscalers.v2.autoscaling", "horizontalpodautoscalers.v2beta2.autoscaling", "horizontalpodautoscalers.v1.autoscaling"} {
+			out := ri.find(name)
+			if len(out) != 0 {
+				return &out[0], nil
+			}
+		}
+
+	case "pdb", "poddisruptionbudget", "poddisruptionbudgets":
+		// policy/v1beta1 is deprecated since 1.21
+		for _, name := range []string{"poddisruptionbudgets.v1.policy", "poddisruptionbudgets.v1beta1.policy"} {
+			out := ri.find(name)
+			if len(out) != 0 {
+				return &out[0], nil
+			}
+		}
+
+	// prevent conflicts with gateways of service meshes, e.g. gateways.v1beta1.networking.istio.io
+	case "gateway", "gateways", "httproute", "httproutes":
+		plural := strings.TrimSuffix(kind, "s") + "s"
+		for _, version := range []string{"v1", "v1beta1"} {
+			out := ri.find(plural + "." + version + ".gateway.networking.k8s.io")
+			if len(out) != 0 {
+				return &out[0], nil
+			}
+		}
+		return nil, fmt.Errorf("%w %q", ErrKindNotFound, kind)
+
 	case "admissionreview.v1.admission":
 		// AdmissionReview resources are special since they don't exist in the public
 		// k8s API. However, we do work with them since we scan them via our admission
@@ -189,7 +222,7 @@ func (ri *ApiResourceIndex) Lookup(kind string) (*ApiResource, error) {
 
 	apiResults := ri.find(kind)
 	if len(apiResults) == 0 {
-		return nil, fmt.Errorf("could not find api kind %q", kind)
+		return nil, fmt.Errorf("%w %q", ErrKindNotFound, kind)
 	} else if len(apiResults) > 1 {
 		// handle case where resource name is not specific enough
 		names := make([]string, 0, len(apiResults))
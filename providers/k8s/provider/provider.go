@@ -78,6 +78,22 @@ func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error)
 		conf.Options[shared.OPTION_NAMESPACE_EXCLUDE] = string(ns.Value)
 	}
 
+	if image, ok := req.Flags["node-debug-image"]; ok {
+		conf.Options[shared.OPTION_NODE_DEBUG_IMAGE] = string(image.Value)
+	}
+
+	if ns, ok := req.Flags["node-debug-namespace"]; ok {
+		conf.Options[shared.OPTION_NODE_DEBUG_NAMESPACE] = string(ns.Value)
+	}
+
+	if values, ok := req.Flags["helm-values"]; ok && len(values.Array) != 0 {
+		files := make([]string, 0, len(values.Array))
+		for i := range values.Array {
+			files = append(files, string(values.Array[i].Value))
+		}
+		conf.Options[shared.OPTION_HELM_VALUES] = strings.Join(files, ",")
+	}
+
 	asset := &inventory.Asset{
 		Connections: []*inventory.Config{conf},
 	}
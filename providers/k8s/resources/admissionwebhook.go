@@ -0,0 +1,268 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/v9/types"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type mqlK8sValidatingwebhookconfigurationInternal struct {
+	lock sync.Mutex
+	obj  *admissionregistrationv1.ValidatingWebhookConfiguration
+}
+
+type mqlK8sMutatingwebhookconfigurationInternal struct {
+	lock sync.Mutex
+	obj  *admissionregistrationv1.MutatingWebhookConfiguration
+}
+
+// admissionWebhook holds the fields that validating and mutating webhooks share
+type admissionWebhook struct {
+	name                    string
+	clientConfig            admissionregistrationv1.WebhookClientConfig
+	rules                   []admissionregistrationv1.RuleWithOperations
+	failurePolicy           *admissionregistrationv1.FailurePolicyType
+	matchPolicy             *admissionregistrationv1.MatchPolicyType
+	namespaceSelector       *metav1.LabelSelector
+	objectSelector          *metav1.LabelSelector
+	sideEffects             *admissionregistrationv1.SideEffectClass
+	timeoutSeconds          *int32
+	admissionReviewVersions []string
+	matchConditions         []admissionregistrationv1.MatchCondition
+	reinvocationPolicy      *admissionregistrationv1.ReinvocationPolicyType
+}
+
+func newMqlAdmissionWebhooks(runtime *plugin.Runtime, configId string, webhooks []admissionWebhook) ([]interface{}, error) {
+	res := make([]interface{}, 0, len(webhooks))
+	for _, w := range webhooks {
+		rules, err := convert.JsonToDictSlice(w.rules)
+		if err != nil {
+			return nil, err
+		}
+		namespaceSelector, err := convert.JsonToDict(w.namespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+		objectSelector, err := convert.JsonToDict(w.objectSelector)
+		if err != nil {
+			return nil, err
+		}
+		matchConditions, err := convert.JsonToDictSlice(w.matchConditions)
+		if err != nil {
+			return nil, err
+		}
+		service, err := convert.JsonToDict(w.clientConfig.Service)
+		if err != nil {
+			return nil, err
+		}
+
+		var failurePolicy, matchPolicy, sideEffects, reinvocationPolicy string
+		if w.failurePolicy != nil {
+			failurePolicy = string(*w.failurePolicy)
+		}
+		if w.matchPolicy != nil {
+			matchPolicy = string(*w.matchPolicy)
+		}
+		if w.sideEffects != nil {
+			sideEffects = string(*w.sideEffects)
+		}
+		if w.reinvocationPolicy != nil {
+			reinvocationPolicy = string(*w.reinvocationPolicy)
+		}
+
+		r, err := CreateResource(runtime, "k8s.admissionwebhook", map[string]*llx.RawData{
+			"__id":                    llx.StringData(configId + "/" + w.name),
+			"name":                    llx.StringData(w.name),
+			"failurePolicy":           llx.StringData(failurePolicy),
+			"matchPolicy":             llx.StringData(matchPolicy),
+			"sideEffects":             llx.StringData(sideEffects),
+			"timeoutSeconds":          llx.IntData(convert.ToInt64From32(w.timeoutSeconds)),
+			"admissionReviewVersions": llx.ArrayData(convert.SliceAnyToInterface(w.admissionReviewVersions), types.String),
+			"rules":                   llx.ArrayData(rules, types.Dict),
+			"namespaceSelector":       llx.DictData(namespaceSelector),
+			"objectSelector":          llx.DictData(objectSelector),
+			"matchConditions":         llx.ArrayData(matchConditions, types.Dict),
+			"reinvocationPolicy":      llx.StringData(reinvocationPolicy),
+			"service":                 llx.DictData(service),
+			"url":                     llx.StringData(convert.ToString(w.clientConfig.URL)),
+			"caBundle":                llx.StringData(string(w.clientConfig.CABundle)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+func (k *mqlK8sAdmissionwebhook) caBundleCertificates() ([]interface{}, error) {
+	if k.CaBundle.Data == "" {
+		// webhooks that are served by a URL may use the system trust roots
+		return []interface{}{}, nil
+	}
+
+	c, err := k.MqlRuntime.CreateSharedResource("certificates", map[string]*llx.RawData{
+		"pem": llx.StringData(k.CaBundle.Data),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := k.MqlRuntime.GetSharedData("certificates", c.MqlID(), "list")
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Value.([]interface{}), nil
+}
+
+func (k *mqlK8s) validatingWebhookConfigurations() ([]interface{}, error) {
+	return k8sResourceToMql(k.MqlRuntime, "validatingwebhookconfigurations", func(kind string, resource runtime.Object, obj metav1.Object, objT metav1.Type) (interface{}, error) {
+		ts := obj.GetCreationTimestamp()
+
+		manifest, err := convert.JsonToDict(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		config, ok := resource.(*admissionregistrationv1.ValidatingWebhookConfiguration)
+		if !ok {
+			return nil, errors.New("not a k8s validatingwebhookconfiguration")
+		}
+
+		objId := objIdFromK8sObj(obj, objT)
+		webhooks := make([]admissionWebhook, 0, len(config.Webhooks))
+		for _, w := range config.Webhooks {
+			webhooks = append(webhooks, admissionWebhook{
+				name:                    w.Name,
+				clientConfig:            w.ClientConfig,
+				rules:                   w.Rules,
+				failurePolicy:           w.FailurePolicy,
+				matchPolicy:             w.MatchPolicy,
+				namespaceSelector:       w.NamespaceSelector,
+				objectSelector:          w.ObjectSelector,
+				sideEffects:             w.SideEffects,
+				timeoutSeconds:          w.TimeoutSeconds,
+				admissionReviewVersions: w.AdmissionReviewVersions,
+				matchConditions:         w.MatchConditions,
+			})
+		}
+		mqlWebhooks, err := newMqlAdmissionWebhooks(k.MqlRuntime, objId, webhooks)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := CreateResource(k.MqlRuntime, "k8s.validatingwebhookconfiguration", map[string]*llx.RawData{
+			"id":              llx.StringData(objId),
+			"uid":             llx.StringData(string(obj.GetUID())),
+			"resourceVersion": llx.StringData(obj.GetResourceVersion()),
+			"name":            llx.StringData(obj.GetName()),
+			"kind":            llx.StringData(objT.GetKind()),
+			"created":         llx.TimeData(ts.Time),
+			"manifest":        llx.DictData(manifest),
+			"webhooks":        llx.ArrayData(mqlWebhooks, types.Resource("k8s.admissionwebhook")),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.(*mqlK8sValidatingwebhookconfiguration).obj = config
+		return r, nil
+	})
+}
+
+func (k *mqlK8sValidatingwebhookconfiguration) id() (string, error) {
+	return k.Id.Data, nil
+}
+
+func initK8sValidatingwebhookconfiguration(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initResource[*mqlK8sValidatingwebhookconfiguration](runtime, args, func(k *mqlK8s) *plugin.TValue[[]interface{}] { return k.GetValidatingWebhookConfigurations() })
+}
+
+func (k *mqlK8sValidatingwebhookconfiguration) annotations() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetAnnotations()), nil
+}
+
+func (k *mqlK8sValidatingwebhookconfiguration) labels() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetLabels()), nil
+}
+
+func (k *mqlK8s) mutatingWebhookConfigurations() ([]interface{}, error) {
+	return k8sResourceToMql(k.MqlRuntime, "mutatingwebhookconfigurations", func(kind string, resource runtime.Object, obj metav1.Object, objT metav1.Type) (interface{}, error) {
+		ts := obj.GetCreationTimestamp()
+
+		manifest, err := convert.JsonToDict(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		config, ok := resource.(*admissionregistrationv1.MutatingWebhookConfiguration)
+		if !ok {
+			return nil, errors.New("not a k8s mutatingwebhookconfiguration")
+		}
+
+		objId := objIdFromK8sObj(obj, objT)
+		webhooks := make([]admissionWebhook, 0, len(config.Webhooks))
+		for _, w := range config.Webhooks {
+			webhooks = append(webhooks, admissionWebhook{
+				name:                    w.Name,
+				clientConfig:            w.ClientConfig,
+				rules:                   w.Rules,
+				failurePolicy:           w.FailurePolicy,
+				matchPolicy:             w.MatchPolicy,
+				namespaceSelector:       w.NamespaceSelector,
+				objectSelector:          w.ObjectSelector,
+				sideEffects:             w.SideEffects,
+				timeoutSeconds:          w.TimeoutSeconds,
+				admissionReviewVersions: w.AdmissionReviewVersions,
+				matchConditions:         w.MatchConditions,
+				reinvocationPolicy:      w.ReinvocationPolicy,
+			})
+		}
+		mqlWebhooks, err := newMqlAdmissionWebhooks(k.MqlRuntime, objId, webhooks)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := CreateResource(k.MqlRuntime, "k8s.mutatingwebhookconfiguration", map[string]*llx.RawData{
+			"id":              llx.StringData(objId),
+			"uid":             llx.StringData(string(obj.GetUID())),
+			"resourceVersion": llx.StringData(obj.GetResourceVersion()),
+			"name":            llx.StringData(obj.GetName()),
+			"kind":            llx.StringData(objT.GetKind()),
+			"created":         llx.TimeData(ts.Time),
+			"manifest":        llx.DictData(manifest),
+			"webhooks":        llx.ArrayData(mqlWebhooks, types.Resource("k8s.admissionwebhook")),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.(*mqlK8sMutatingwebhookconfiguration).obj = config
+		return r, nil
+	})
+}
+
+func (k *mqlK8sMutatingwebhookconfiguration) id() (string, error) {
+	return k.Id.Data, nil
+}
+
+func initK8sMutatingwebhookconfiguration(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initResource[*mqlK8sMutatingwebhookconfiguration](runtime, args, func(k *mqlK8s) *plugin.TValue[[]interface{}] { return k.GetMutatingWebhookConfigurations() })
+}
+
+func (k *mqlK8sMutatingwebhookconfiguration) annotations() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetAnnotations()), nil
+}
+
+func (k *mqlK8sMutatingwebhookconfiguration) labels() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetLabels()), nil
+}
@@ -12,6 +12,7 @@ import (
 	"go.mondoo.com/cnquery/v9/llx"
 	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/v9/providers/k8s/connection/shared"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -241,3 +242,12 @@ func initResource[T K8sObject](
 	// the error ResourceNotFound is checked by cnspec
 	return nil, nil, errors.New("not found")
 }
+
+// resourceListToMap converts quantities of resources, e.g., cpu: 500m, into a map
+func resourceListToMap(list corev1.ResourceList) map[string]interface{} {
+	res := make(map[string]interface{}, len(list))
+	for name, quantity := range list {
+		res[string(name)] = quantity.String()
+	}
+	return res
+}
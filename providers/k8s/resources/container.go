@@ -53,6 +53,21 @@ func getContainers(
 	if err != nil {
 		return nil, err
 	}
+
+	podSpec, err := resources.GetPodSpec(obj)
+	if err != nil {
+		return nil, err
+	}
+	serviceAccount := podSpec.ServiceAccountName
+	if serviceAccount == "" {
+		serviceAccount = "default"
+	}
+	pullSecrets := containerPullSecrets{
+		namespace:      meta.GetNamespace(),
+		pullSecrets:    podSpec.ImagePullSecrets,
+		serviceAccount: serviceAccount,
+		nodeName:       podSpec.NodeName,
+	}
 	for i := range containers {
 
 		c := containers[i]
@@ -127,6 +142,14 @@ func getContainers(
 		if err != nil {
 			return nil, err
 		}
+		switch r := mqlContainer.(type) {
+		case *mqlK8sContainer:
+			r.containerPullSecrets = pullSecrets
+		case *mqlK8sInitContainer:
+			r.containerPullSecrets = pullSecrets
+		case *mqlK8sEphemeralContainer:
+			r.containerPullSecrets = pullSecrets
+		}
 		resp = append(resp, mqlContainer)
 	}
 	return resp, nil
@@ -0,0 +1,400 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/v9/providers/os/connection/container/image"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	annotationBaseImageName   = "org.opencontainers.image.base.name"
+	annotationBaseImageDigest = "org.opencontainers.image.base.digest"
+)
+
+// mqlK8sContainerImageInternal holds the image metadata, which is fetched once for
+// all fields. Images are shared by all containers with the same reference, pull
+// secrets, and node platform.
+type mqlK8sContainerImageInternal struct {
+	lock        sync.Mutex
+	fetched     bool
+	metadata    *image.ImageMetadata
+	fetchErr    error
+	pullSecrets []corev1.Secret
+	platform    *v1.Platform
+}
+
+// containerPullSecrets are the settings of the pod that a container belongs to
+// that determine how its image is pulled
+type containerPullSecrets struct {
+	namespace   string
+	pullSecrets []corev1.LocalObjectReference
+	// serviceAccount provides the pull secrets of pods that have none
+	serviceAccount string
+	// nodeName is the node that the pod is scheduled on, whose platform is
+	// pulled for multi-platform images
+	nodeName string
+}
+
+// key identifies the credentials of the pull secrets. Secrets with the same
+// name may hold different credentials in other namespaces.
+func (ps containerPullSecrets) key() string {
+	if len(ps.pullSecrets) == 0 {
+		return ps.namespace + "/serviceaccount:" + ps.serviceAccount + "/"
+	}
+	names := make([]string, len(ps.pullSecrets))
+	for i := range ps.pullSecrets {
+		names[i] = ps.pullSecrets[i].Name
+	}
+	return ps.namespace + "/" + strings.Join(names, ",") + "/"
+}
+
+// refs returns the pull secrets of the pod. Like the service account admission
+// controller, pods without pull secrets use the ones of their service account,
+// which covers workloads of manifests that haven't been admitted.
+func (ps containerPullSecrets) refs(runtime *plugin.Runtime) []corev1.LocalObjectReference {
+	if len(ps.pullSecrets) != 0 || ps.serviceAccount == "" {
+		return ps.pullSecrets
+	}
+	sa, err := GetServiceAccount(runtime, ps.namespace, ps.serviceAccount)
+	if err != nil {
+		log.Debug().Err(err).Str("namespace", ps.namespace).Str("serviceaccount", ps.serviceAccount).Msg("could not get image pull secrets of service account")
+		return nil
+	}
+	return sa.ImagePullSecrets
+}
+
+// nodePlatform returns the platform of the node that the pod is scheduled on.
+// It returns nil for pods that aren't scheduled or whose node is unknown.
+func (ps containerPullSecrets) nodePlatform(runtime *plugin.Runtime) *v1.Platform {
+	if ps.nodeName == "" {
+		return nil
+	}
+	n, err := NewResource(runtime, "k8s.node", map[string]*llx.RawData{
+		"name": llx.StringData(ps.nodeName),
+	})
+	if err != nil {
+		log.Debug().Err(err).Str("node", ps.nodeName).Msg("could not get platform of node")
+		return nil
+	}
+	node := n.(*mqlK8sNode).obj
+
+	// nodes of manifests only have the well-known labels, not the status
+	platform := &v1.Platform{
+		OS:           node.Status.NodeInfo.OperatingSystem,
+		Architecture: node.Status.NodeInfo.Architecture,
+	}
+	if platform.OS == "" {
+		platform.OS = node.Labels[corev1.LabelOSStable]
+	}
+	if platform.Architecture == "" {
+		platform.Architecture = node.Labels[corev1.LabelArchStable]
+	}
+	if platform.OS == "" || platform.Architecture == "" {
+		return nil
+	}
+	return platform
+}
+
+type mqlK8sContainerInternal struct {
+	containerPullSecrets
+}
+
+type mqlK8sInitContainerInternal struct {
+	containerPullSecrets
+}
+
+type mqlK8sEphemeralContainerInternal struct {
+	containerPullSecrets
+}
+
+func (k *mqlK8sContainer) imageMetadata() (*mqlK8sContainerImage, error) {
+	return newContainerImage(k.MqlRuntime, k.ImageName.Data, k.containerPullSecrets)
+}
+
+func (k *mqlK8sInitContainer) imageMetadata() (*mqlK8sContainerImage, error) {
+	return newContainerImage(k.MqlRuntime, k.ImageName.Data, k.containerPullSecrets)
+}
+
+func (k *mqlK8sEphemeralContainer) imageMetadata() (*mqlK8sContainerImage, error) {
+	return newContainerImage(k.MqlRuntime, k.ImageName.Data, k.containerPullSecrets)
+}
+
+func newContainerImage(runtime *plugin.Runtime, reference string, ps containerPullSecrets) (*mqlK8sContainerImage, error) {
+	id := ps.key() + reference
+	platform := ps.nodePlatform(runtime)
+	if platform != nil {
+		id = platform.String() + "/" + id
+	}
+
+	r, err := CreateResource(runtime, "k8s.container.image", map[string]*llx.RawData{
+		"__id":      llx.StringData(id),
+		"reference": llx.StringData(reference),
+	})
+	if err != nil {
+		return nil, err
+	}
+	img := r.(*mqlK8sContainerImage)
+
+	img.lock.Lock()
+	defer img.lock.Unlock()
+	img.platform = platform
+	if img.pullSecrets == nil {
+		img.pullSecrets = []corev1.Secret{}
+		for _, ref := range ps.refs(runtime) {
+			// secrets that cannot be read fall back to the default credentials
+			s, err := GetSecret(runtime, ps.namespace, ref.Name)
+			if err == nil {
+				img.pullSecrets = append(img.pullSecrets, *s)
+			}
+		}
+	}
+	return img, nil
+}
+
+func (k *mqlK8sContainerImage) id() (string, error) {
+	return k.Reference.Data, nil
+}
+
+func (k *mqlK8sContainerImage) fetch() (*image.ImageMetadata, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if k.fetched {
+		return k.metadata, k.fetchErr
+	}
+	k.fetched = true
+
+	ref, err := name.ParseReference(k.Reference.Data, name.WeakValidation)
+	if err != nil {
+		k.fetchErr = err
+		return nil, err
+	}
+
+	opts := []image.Option{}
+	if auth := pullSecretAuth(k.pullSecrets, ref.Context().RegistryStr()); auth != nil {
+		opts = append(opts, image.WithAuthenticator(auth))
+	}
+	if k.platform != nil {
+		opts = append(opts, image.WithPlatform(*k.platform))
+	}
+	k.metadata, k.fetchErr = image.LoadImageMetadata(ref, opts...)
+	return k.metadata, k.fetchErr
+}
+
+// pullSecretAuth returns the credentials of the first image pull secret for the registry
+func pullSecretAuth(secrets []corev1.Secret, registry string) authn.Authenticator {
+	for _, s := range secrets {
+		auths := map[string]authn.AuthConfig{}
+		switch s.Type {
+		case corev1.SecretTypeDockerConfigJson:
+			cfg := struct {
+				Auths map[string]authn.AuthConfig `json:"auths"`
+			}{}
+			if err := json.Unmarshal(secretData(s, corev1.DockerConfigJsonKey), &cfg); err != nil {
+				continue
+			}
+			auths = cfg.Auths
+		case corev1.SecretTypeDockercfg:
+			if err := json.Unmarshal(secretData(s, corev1.DockerConfigKey), &auths); err != nil {
+				continue
+			}
+		}
+
+		for host, cfg := range auths {
+			if registryHost(host) == registry {
+				return authn.FromConfig(cfg)
+			}
+		}
+	}
+	return nil
+}
+
+// secretData returns the value of a key, including values of manifests that
+// are set as string data
+func secretData(s corev1.Secret, key string) []byte {
+	if data, ok := s.Data[key]; ok {
+		return data
+	}
+	return []byte(s.StringData[key])
+}
+
+// registryHost returns the registry of a docker config key, which may be a
+// URL like https://index.docker.io/v1/
+func registryHost(key string) string {
+	key = strings.TrimPrefix(strings.TrimPrefix(key, "https://"), "http://")
+	host, _, _ := strings.Cut(key, "/")
+	switch host {
+	case name.DefaultRegistry, "registry-1.docker.io":
+		return "index.docker.io"
+	}
+	return host
+}
+
+func (k *mqlK8sContainerImage) digest() (string, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return "", err
+	}
+	return md.Digest.String(), nil
+}
+
+func (k *mqlK8sContainerImage) indexDigest() (string, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return "", err
+	}
+	if md.IndexDigest == nil {
+		return "", nil
+	}
+	return md.IndexDigest.String(), nil
+}
+
+func (k *mqlK8sContainerImage) mediaType() (string, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return "", err
+	}
+	return string(md.Manifest.MediaType), nil
+}
+
+func (k *mqlK8sContainerImage) manifest() (map[string]interface{}, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return nil, err
+	}
+	return convert.JsonToDict(md.Manifest)
+}
+
+func (k *mqlK8sContainerImage) config() (map[string]interface{}, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return nil, err
+	}
+	return convert.JsonToDict(md.Config)
+}
+
+func (k *mqlK8sContainerImage) created() (*time.Time, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return nil, err
+	}
+	if md.Config.Created.IsZero() {
+		return nil, nil
+	}
+	return &md.Config.Created.Time, nil
+}
+
+func (k *mqlK8sContainerImage) labels() (map[string]interface{}, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return nil, err
+	}
+	return convert.MapToInterfaceMap(md.Config.Config.Labels), nil
+}
+
+func (k *mqlK8sContainerImage) annotations() (map[string]interface{}, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return nil, err
+	}
+	return convert.MapToInterfaceMap(md.Manifest.Annotations), nil
+}
+
+func (k *mqlK8sContainerImage) baseImageName() (string, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return "", err
+	}
+	return md.Manifest.Annotations[annotationBaseImageName], nil
+}
+
+func (k *mqlK8sContainerImage) baseImageDigest() (string, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return "", err
+	}
+	return md.Manifest.Annotations[annotationBaseImageDigest], nil
+}
+
+func (k *mqlK8sContainerImage) user() (string, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return "", err
+	}
+	return md.Config.Config.User, nil
+}
+
+func (k *mqlK8sContainerImage) exposedPorts() ([]interface{}, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return nil, err
+	}
+	ports := make([]string, 0, len(md.Config.Config.ExposedPorts))
+	for port := range md.Config.Config.ExposedPorts {
+		ports = append(ports, port)
+	}
+	sort.Strings(ports)
+	return convert.SliceAnyToInterface(ports), nil
+}
+
+func (k *mqlK8sContainerImage) entrypoint() ([]interface{}, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return nil, err
+	}
+	return convert.SliceAnyToInterface(md.Config.Config.Entrypoint), nil
+}
+
+func (k *mqlK8sContainerImage) cmd() ([]interface{}, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return nil, err
+	}
+	return convert.SliceAnyToInterface(md.Config.Config.Cmd), nil
+}
+
+func (k *mqlK8sContainerImage) env() ([]interface{}, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return nil, err
+	}
+	return convert.SliceAnyToInterface(md.Config.Config.Env), nil
+}
+
+func (k *mqlK8sContainerImage) os() (string, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return "", err
+	}
+	return md.Config.OS, nil
+}
+
+func (k *mqlK8sContainerImage) architecture() (string, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return "", err
+	}
+	return md.Config.Architecture, nil
+}
+
+func (k *mqlK8sContainerImage) referrers() ([]interface{}, error) {
+	md, err := k.fetch()
+	if err != nil {
+		return nil, err
+	}
+	return convert.JsonToDictSlice(md.Referrers)
+}
@@ -0,0 +1,244 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/partial"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const containerImageManifest = `apiVersion: v1
+kind: Secret
+metadata:
+  name: registry
+  namespace: app
+type: kubernetes.io/dockerconfigjson
+stringData:
+  .dockerconfigjson: '{"auths":{"REGISTRY":{"auth":"AUTH"}}}'
+---
+apiVersion: v1
+kind: Node
+metadata:
+  name: arm
+  labels:
+    kubernetes.io/os: linux
+    kubernetes.io/arch: arm64
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: app
+  namespace: app
+spec:
+  nodeName: arm
+  imagePullSecrets:
+    - name: registry
+  containers:
+    - name: app
+      image: REGISTRY/app:1.0
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: registry
+  namespace: builds
+type: kubernetes.io/dockerconfigjson
+stringData:
+  .dockerconfigjson: '{"auths":{"REGISTRY":{"auth":"AUTH"}}}'
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: builder
+  namespace: builds
+imagePullSecrets:
+  - name: registry
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: app
+  namespace: builds
+spec:
+  serviceAccountName: builder
+  containers:
+    - name: app
+      image: REGISTRY/app:1.0
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: app
+  namespace: other
+spec:
+  containers:
+    - name: app
+      image: REGISTRY/app:1.0
+`
+
+func TestContainerImage(t *testing.T) {
+	// a private registry that only accepts the credentials of the pull secret
+	handler := registry.New(registry.WithReferrersSupport(true))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "mondoo" || pass != "secret" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+	auth := &authn.Basic{Username: "mondoo", Password: "secret"}
+
+	// a multi-platform image, of which only the arm64 image has a referrer
+	amd64, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	cfg, err := img.ConfigFile()
+	require.NoError(t, err)
+	cfg = cfg.DeepCopy()
+	cfg.Config.User = "nobody"
+	cfg.Config.ExposedPorts = map[string]struct{}{"8443/tcp": {}, "8080/tcp": {}}
+	cfg.Config.Entrypoint = []string{"/app"}
+	img, err = mutate.ConfigFile(img, cfg)
+	require.NoError(t, err)
+	img = mutate.Annotations(img, map[string]string{
+		annotationBaseImageName:   "docker.io/library/alpine:3.18",
+		annotationBaseImageDigest: "sha256:48d9183eb12a05c99bcc0bf44a003607b8e941e1d4f41f9ad12bdcc4b5672f86",
+	}).(v1.Image)
+	index := mutate.AppendManifests(empty.Index,
+		mutate.IndexAddendum{Add: amd64, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "amd64"}}},
+		mutate.IndexAddendum{Add: img, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "arm64"}}},
+	)
+	ref, err := name.ParseReference(host + "/app:1.0")
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(ref, index, remote.WithAuth(auth)))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	amd64Digest, err := amd64.Digest()
+	require.NoError(t, err)
+
+	desc, err := partial.Descriptor(img)
+	require.NoError(t, err)
+	sbom, err := random.Image(64, 1)
+	require.NoError(t, err)
+	sbom = mutate.ConfigMediaType(sbom, "application/spdx+json")
+	sbom = mutate.Subject(sbom, *desc).(v1.Image)
+	sbomDigest, err := sbom.Digest()
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref.Context().Digest(sbomDigest.String()), sbom, remote.WithAuth(auth)))
+
+	manifest := strings.NewReplacer(
+		"REGISTRY", host,
+		"AUTH", base64.StdEncoding.EncodeToString([]byte("mondoo:secret")),
+	).Replace(containerImageManifest)
+	file := filepath.Join(t.TempDir(), "pod.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(manifest), 0o644))
+
+	k := &mqlK8s{MqlRuntime: kindsRuntime(t, file)}
+	pods, err := k.pods()
+	require.NoError(t, err)
+	require.Len(t, pods, 3)
+	images := map[string]*mqlK8sContainerImage{}
+	for _, pod := range pods {
+		containers, err := pod.(*mqlK8sPod).containers()
+		require.NoError(t, err)
+		require.Len(t, containers, 1)
+		image, err := containers[0].(*mqlK8sContainer).imageMetadata()
+		require.NoError(t, err)
+		images[pod.(*mqlK8sPod).Namespace.Data] = image
+	}
+
+	image := images["app"]
+	assert.Equal(t, host+"/app:1.0", image.Reference.Data)
+	d, err := image.digest()
+	require.NoError(t, err)
+	assert.Equal(t, digest.String(), d)
+	user, err := image.user()
+	require.NoError(t, err)
+	assert.Equal(t, "nobody", user)
+	ports, err := image.exposedPorts()
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"8080/tcp", "8443/tcp"}, ports)
+	entrypoint, err := image.entrypoint()
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"/app"}, entrypoint)
+	baseImage, err := image.baseImageName()
+	require.NoError(t, err)
+	assert.Equal(t, "docker.io/library/alpine:3.18", baseImage)
+	referrers, err := image.referrers()
+	require.NoError(t, err)
+	require.Len(t, referrers, 1)
+	assert.Equal(t, sbomDigest.String(), referrers[0].(map[string]interface{})["digest"])
+	assert.Equal(t, "application/spdx+json", referrers[0].(map[string]interface{})["artifactType"])
+
+	// pods without pull secrets use the ones of their service account, and
+	// unscheduled pods get the default platform
+	image = images["builds"]
+	d, err = image.digest()
+	require.NoError(t, err)
+	assert.Equal(t, amd64Digest.String(), d)
+	referrers, err = image.referrers()
+	require.NoError(t, err)
+	assert.Empty(t, referrers)
+
+	// the pull secret is not used for pods of other namespaces
+	image = images["other"]
+	assert.Equal(t, host+"/app:1.0", image.Reference.Data)
+	_, err = image.digest()
+	assert.Error(t, err)
+}
+
+func TestPullSecretAuth(t *testing.T) {
+	secrets := []corev1.Secret{
+		{
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{"https://index.docker.io/v1/":{"username":"hub","password":"hub-pass"}}}`),
+			},
+		},
+		{
+			Type: corev1.SecretTypeDockercfg,
+			Data: map[string][]byte{
+				corev1.DockerConfigKey: []byte(`{"ghcr.io":{"auth":"` + base64.StdEncoding.EncodeToString([]byte("gh:gh-pass")) + `"}}`),
+			},
+		},
+	}
+
+	auth := pullSecretAuth(secrets, "index.docker.io")
+	require.NotNil(t, auth)
+	cfg, err := auth.Authorization()
+	require.NoError(t, err)
+	assert.Equal(t, "hub", cfg.Username)
+
+	auth = pullSecretAuth(secrets, "ghcr.io")
+	require.NotNil(t, auth)
+	cfg, err = auth.Authorization()
+	require.NoError(t, err)
+	assert.Equal(t, "gh", cfg.Username)
+	assert.Equal(t, "gh-pass", cfg.Password)
+
+	assert.Nil(t, pullSecretAuth(secrets, "quay.io"))
+}
@@ -96,3 +96,15 @@ func GetSecret(runtime *plugin.Runtime, namespace, name string) (*v1.Secret, err
 
 	return s.(*mqlK8sSecret).obj, nil
 }
+
+func GetServiceAccount(runtime *plugin.Runtime, namespace, name string) (*v1.ServiceAccount, error) {
+	sa, err := NewResource(runtime, "k8s.serviceaccount", map[string]*llx.RawData{
+		"namespace": llx.StringData(namespace),
+		"name":      llx.StringData(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sa.(*mqlK8sServiceaccount).obj, nil
+}
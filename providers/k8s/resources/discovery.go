@@ -18,6 +18,7 @@ import (
 	"go.mondoo.com/cnquery/v9/types"
 	"go.mondoo.com/cnquery/v9/utils/stringx"
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -38,6 +39,8 @@ const (
 	DiscoveryAdmissionReviews = "admissionreviews"
 	DiscoveryIngresses        = "ingresses"
 	DiscoveryNamespaces       = "namespaces"
+	DiscoveryGateways         = "gateways"
+	DiscoveryNodesOs          = "nodes-os"
 )
 
 type NamespaceFilterOpts struct {
@@ -242,6 +245,13 @@ func discoverAssets(
 			}
 			assets = append(assets, list...)
 		}
+		if target == DiscoveryGateways || target == DiscoveryAuto {
+			list, err = discoverGateways(conn, invConfig, clusterId, k8s, od, nsFilter, resFilters)
+			if err != nil {
+				return nil, err
+			}
+			assets = append(assets, list...)
+		}
 		if target == DiscoveryAdmissionReviews {
 			list, err = discoverAdmissionReviews(conn, invConfig, clusterId, k8s, od, nsFilter)
 			if err != nil {
@@ -256,6 +266,13 @@ func discoverAssets(
 			}
 			assets = append(assets, list...)
 		}
+		if target == DiscoveryNodesOs {
+			list, err = discoverNodesOs(conn, invConfig, clusterId, k8s)
+			if err != nil {
+				return nil, err
+			}
+			assets = append(assets, list...)
+		}
 		if target == DiscoveryContainerImages {
 			list, err = discoverContainerImages(conn, runtime, invConfig, clusterId, k8s, nsFilter)
 			if err != nil {
@@ -737,6 +754,61 @@ func discoverIngresses(
 	return assetList, nil
 }
 
+func discoverGateways(
+	conn shared.Connection,
+	invConfig *inventory.Config,
+	clusterId string,
+	k8s *mqlK8s,
+	od *PlatformIdOwnershipIndex,
+	nsFilter NamespaceFilterOpts,
+	resFilter *ResourceFilters,
+) ([]*inventory.Asset, error) {
+	gs := k8s.GetGateways()
+	if gs.Error != nil {
+		return nil, gs.Error
+	}
+
+	// If there is a resources filter we should only retrieve the workloads that are in the filter.
+	if !resFilter.IsEmpty() && resFilter.IsEmptyForType("gateway") {
+		return []*inventory.Asset{}, nil
+	}
+
+	assetList := make([]*inventory.Asset, 0, len(gs.Data))
+	for _, g := range gs.Data {
+		gateway := g.(*mqlK8sGateway)
+
+		if skip := nsFilter.skipNamespace(gateway.Namespace.Data); skip {
+			continue
+		}
+
+		if !resFilter.IsEmpty() && !resFilter.Match("gateway", gateway.Name.Data, gateway.Namespace.Data) {
+			continue
+		}
+
+		labels := map[string]string{}
+		for k, v := range gateway.GetLabels().Data {
+			labels[k] = v.(string)
+		}
+		addMondooAssetLabels(labels, gateway.obj, clusterId)
+		platform, err := createPlatformData(gateway.Kind.Data, conn.Runtime())
+		if err != nil {
+			return nil, err
+		}
+		assetList = append(assetList, &inventory.Asset{
+			PlatformIds: []string{
+				shared.NewWorkloadPlatformId(clusterId, "gateway", gateway.Namespace.Data, gateway.Name.Data, gateway.Uid.Data),
+			},
+			Name:        gateway.Namespace.Data + "/" + gateway.Name.Data,
+			Platform:    platform,
+			Labels:      labels,
+			Connections: []*inventory.Config{invConfig.Clone(inventory.WithoutDiscovery())}, // pass-in the parent connection config
+			Category:    conn.Asset().Category,
+		})
+		od.Add(gateway.obj)
+	}
+	return assetList, nil
+}
+
 func discoverNamespaces(
 	conn shared.Connection,
 	invConfig *inventory.Config,
@@ -826,6 +898,72 @@ func discoverContainerImages(conn shared.Connection, runtime *plugin.Runtime, in
 	return assetList, nil
 }
 
+// discoverNodesOs returns an asset for the operating system of every node. The os provider
+// connects to them through privileged debug pods, which is why they are only discovered
+// when explicitly requested and require a namespace for the debug pods.
+func discoverNodesOs(conn shared.Connection, invConfig *inventory.Config, clusterId string, k8s *mqlK8s) ([]*inventory.Asset, error) {
+	// nodes of manifests and admission reviews cannot be reached
+	if conn.Runtime() != "k8s-cluster" {
+		log.Debug().Str("runtime", conn.Runtime()).Msg("skip node OS discovery, no cluster connection")
+		return []*inventory.Asset{}, nil
+	}
+	if invConfig.Options[shared.OPTION_NODE_DEBUG_NAMESPACE] == "" {
+		return nil, errors.New("discovering " + DiscoveryNodesOs + " requires --node-debug-namespace, use a dedicated namespace that allows privileged pods")
+	}
+
+	kubeconfig := ""
+	if c, ok := conn.(shared.KubeconfigConnection); ok {
+		kubeconfig = c.KubeconfigPath()
+	}
+
+	nodes := k8s.GetNodes()
+	if nodes.Error != nil {
+		return nil, nodes.Error
+	}
+
+	assetList := make([]*inventory.Asset, 0, len(nodes.Data))
+	for _, n := range nodes.Data {
+		node := n.(*mqlK8sNode)
+		assetList = append(assetList, nodeOsAsset(node.obj, invConfig, kubeconfig, clusterId, conn.Asset().Category))
+	}
+	return assetList, nil
+}
+
+func nodeOsAsset(node *corev1.Node, invConfig *inventory.Config, kubeconfig string, clusterId string, category inventory.AssetCategory) *inventory.Asset {
+	labels := map[string]string{}
+	for k, v := range node.Labels {
+		labels[k] = v
+	}
+	addMondooAssetLabels(labels, node, clusterId)
+
+	options := map[string]string{}
+	// the os provider connects to the same cluster as this provider
+	if kubeconfig != "" {
+		options["kubeconfig"] = kubeconfig
+	}
+	if context := invConfig.Options[shared.OPTION_CONTEXT]; context != "" {
+		options["context"] = context
+	}
+	if image := invConfig.Options[shared.OPTION_NODE_DEBUG_IMAGE]; image != "" {
+		options["image"] = image
+	}
+	if namespace := invConfig.Options[shared.OPTION_NODE_DEBUG_NAMESPACE]; namespace != "" {
+		options["namespace"] = namespace
+	}
+
+	// the platform and its IDs are detected by the os provider
+	return &inventory.Asset{
+		Name:   node.Name,
+		Labels: labels,
+		Connections: []*inventory.Config{{
+			Type:    "k8s-node",
+			Host:    node.Name,
+			Options: options,
+		}},
+		Category: category,
+	}
+}
+
 func addMondooAssetLabels(assetLabels map[string]string, objMeta metav1.Object, clusterIdentifier string) {
 	ns := objMeta.GetNamespace()
 	if ns != "" {
@@ -960,6 +1098,10 @@ func createPlatformData(objectKind, runtime string) (*inventory.Platform, error)
 		platformData.Family = append(platformData.Family, "k8s-ingress")
 		platformData.Name = "k8s-ingress"
 		platformData.Title = "Kubernetes Ingress"
+	case "Gateway":
+		platformData.Family = append(platformData.Family, "k8s-gateway")
+		platformData.Name = "k8s-gateway"
+		platformData.Title = "Kubernetes Gateway"
 	case "Namespace":
 		platformData.Family = append(platformData.Family, "k8s-namespace")
 		platformData.Name = "k8s-namespace"
@@ -0,0 +1,54 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers/k8s/connection/shared"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNodeOsAssets(t *testing.T) {
+	node := &corev1.Node{
+		TypeMeta: metav1.TypeMeta{Kind: "Node", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "worker-1",
+			UID:    "0b5d5a9e-4a6f-4c3c-9f5e-0c4b8d6f3b1a",
+			Labels: map[string]string{"kubernetes.io/os": "linux"},
+		},
+	}
+	invConfig := &inventory.Config{
+		Type: "k8s",
+		Options: map[string]string{
+			shared.OPTION_CONTEXT:              "kind-kind",
+			shared.OPTION_NODE_DEBUG_NAMESPACE: "mondoo",
+		},
+	}
+
+	asset := nodeOsAsset(node, invConfig, "/home/user/.kube/config", "cluster", inventory.AssetCategory_CATEGORY_INVENTORY)
+	assert.Equal(t, "worker-1", asset.Name)
+	assert.Nil(t, asset.Platform)
+	assert.Equal(t, "linux", asset.Labels["kubernetes.io/os"])
+	assert.Equal(t, "Node", asset.Labels["k8s.mondoo.com/kind"])
+	assert.Equal(t, "cluster", asset.Labels["k8s.mondoo.com/cluster-id"])
+	require.Len(t, asset.Connections, 1)
+	assert.Equal(t, "k8s-node", asset.Connections[0].Type)
+	assert.Equal(t, "worker-1", asset.Connections[0].Host)
+	assert.Equal(t, map[string]string{
+		"kubeconfig": "/home/user/.kube/config",
+		"context":    "kind-kind",
+		"namespace":  "mondoo",
+	}, asset.Connections[0].Options)
+
+	// manifests have no nodes that could be scanned
+	runtime := kindsRuntime(t, "./testdata/kinds.yaml")
+	assets, err := discoverNodesOs(runtime.Connection.(shared.Connection), invConfig, "cluster", &mqlK8s{MqlRuntime: runtime})
+	require.NoError(t, err)
+	assert.Empty(t, assets)
+}
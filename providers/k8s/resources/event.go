@@ -0,0 +1,109 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/util/convert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type mqlK8sEventInternal struct {
+	lock sync.Mutex
+	obj  *corev1.Event
+}
+
+func (k *mqlK8s) events() ([]interface{}, error) {
+	return k8sResourceToMql(k.MqlRuntime, "events.v1.", func(kind string, resource runtime.Object, obj metav1.Object, objT metav1.Type) (interface{}, error) {
+		ts := obj.GetCreationTimestamp()
+
+		manifest, err := convert.JsonToDict(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		e, ok := resource.(*corev1.Event)
+		if !ok {
+			return nil, errors.New("not a k8s event")
+		}
+
+		involvedObject, err := convert.JsonToDict(e.InvolvedObject)
+		if err != nil {
+			return nil, err
+		}
+
+		// events that are created via the events.k8s.io API only set the event time and series
+		count := int64(e.Count)
+		firstTimestamp := e.FirstTimestamp.Time
+		if firstTimestamp.IsZero() {
+			firstTimestamp = e.EventTime.Time
+		}
+		lastTimestamp := e.LastTimestamp.Time
+		if e.Series != nil {
+			count = int64(e.Series.Count)
+			lastTimestamp = e.Series.LastObservedTime.Time
+		}
+		if lastTimestamp.IsZero() {
+			lastTimestamp = firstTimestamp
+		}
+		if count == 0 {
+			count = 1
+		}
+
+		source := e.Source.Component
+		if source == "" {
+			source = e.ReportingController
+		}
+
+		r, err := CreateResource(k.MqlRuntime, "k8s.event", map[string]*llx.RawData{
+			"id":              llx.StringData(objIdFromK8sObj(obj, objT)),
+			"uid":             llx.StringData(string(obj.GetUID())),
+			"resourceVersion": llx.StringData(obj.GetResourceVersion()),
+			"name":            llx.StringData(obj.GetName()),
+			"namespace":       llx.StringData(obj.GetNamespace()),
+			"kind":            llx.StringData(objT.GetKind()),
+			"created":         llx.TimeData(ts.Time),
+			"manifest":        llx.DictData(manifest),
+			"type":            llx.StringData(e.Type),
+			"reason":          llx.StringData(e.Reason),
+			"message":         llx.StringData(e.Message),
+			"involvedObject":  llx.DictData(involvedObject),
+			"source":          llx.StringData(source),
+			"count":           llx.IntData(count),
+			"firstTimestamp":  llx.TimeDataPtr(timePtr(firstTimestamp)),
+			"lastTimestamp":   llx.TimeDataPtr(timePtr(lastTimestamp)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.(*mqlK8sEvent).obj = e
+		return r, nil
+	})
+}
+
+func (k *mqlK8sEvent) id() (string, error) {
+	return k.Id.Data, nil
+}
+
+func (k *mqlK8sEvent) annotations() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetAnnotations()), nil
+}
+
+func (k *mqlK8sEvent) labels() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetLabels()), nil
+}
+
+// timePtr returns nil for zero times, so unset timestamps are null
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
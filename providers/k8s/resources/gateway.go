@@ -0,0 +1,166 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/v9/providers/k8s/connection/shared/resources"
+	"go.mondoo.com/cnquery/v9/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type mqlK8sGatewayInternal struct {
+	lock sync.Mutex
+	obj  *unstructured.Unstructured
+}
+
+type mqlK8sHttprouteInternal struct {
+	lock sync.Mutex
+	obj  *unstructured.Unstructured
+}
+
+// gatewayApiResourceToMql converts Gateway API objects. The Gateway API is
+// installed as custom resources, so clusters without it have no objects.
+func gatewayApiResourceToMql(r *plugin.Runtime, kind string, fn resourceConvertFn) ([]interface{}, error) {
+	res, err := k8sResourceToMql(r, kind, fn)
+	if errors.Is(err, resources.ErrKindNotFound) {
+		return []interface{}{}, nil
+	}
+	return res, err
+}
+
+func (k *mqlK8s) gateways() ([]interface{}, error) {
+	return gatewayApiResourceToMql(k.MqlRuntime, "gateways", func(kind string, resource runtime.Object, obj metav1.Object, objT metav1.Type) (interface{}, error) {
+		ts := obj.GetCreationTimestamp()
+
+		gateway, ok := resource.(*unstructured.Unstructured)
+		if !ok {
+			return nil, errors.New("not a k8s gateway")
+		}
+
+		spec, _, err := unstructured.NestedMap(gateway.Object, "spec")
+		if err != nil {
+			return nil, err
+		}
+		gatewayClassName, _, err := unstructured.NestedString(gateway.Object, "spec", "gatewayClassName")
+		if err != nil {
+			return nil, err
+		}
+		listeners, _, err := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+		if err != nil {
+			return nil, err
+		}
+		addresses, _, err := unstructured.NestedSlice(gateway.Object, "spec", "addresses")
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := CreateResource(k.MqlRuntime, "k8s.gateway", map[string]*llx.RawData{
+			"id":               llx.StringData(objIdFromK8sObj(obj, objT)),
+			"uid":              llx.StringData(string(obj.GetUID())),
+			"resourceVersion":  llx.StringData(obj.GetResourceVersion()),
+			"name":             llx.StringData(obj.GetName()),
+			"namespace":        llx.StringData(obj.GetNamespace()),
+			"kind":             llx.StringData(objT.GetKind()),
+			"created":          llx.TimeData(ts.Time),
+			"manifest":         llx.DictData(gateway.Object),
+			"gatewayClassName": llx.StringData(gatewayClassName),
+			"listeners":        llx.ArrayData(listeners, types.Dict),
+			"addresses":        llx.ArrayData(addresses, types.Dict),
+			"spec":             llx.DictData(spec),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.(*mqlK8sGateway).obj = gateway
+		return r, nil
+	})
+}
+
+func (k *mqlK8sGateway) id() (string, error) {
+	return k.Id.Data, nil
+}
+
+func initK8sGateway(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initNamespacedResource[*mqlK8sGateway](runtime, args, func(k *mqlK8s) *plugin.TValue[[]interface{}] { return k.GetGateways() })
+}
+
+func (k *mqlK8sGateway) annotations() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetAnnotations()), nil
+}
+
+func (k *mqlK8sGateway) labels() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetLabels()), nil
+}
+
+func (k *mqlK8s) httpRoutes() ([]interface{}, error) {
+	return gatewayApiResourceToMql(k.MqlRuntime, "httproutes", func(kind string, resource runtime.Object, obj metav1.Object, objT metav1.Type) (interface{}, error) {
+		ts := obj.GetCreationTimestamp()
+
+		route, ok := resource.(*unstructured.Unstructured)
+		if !ok {
+			return nil, errors.New("not a k8s httproute")
+		}
+
+		spec, _, err := unstructured.NestedMap(route.Object, "spec")
+		if err != nil {
+			return nil, err
+		}
+		parentRefs, _, err := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+		if err != nil {
+			return nil, err
+		}
+		hostnames, _, err := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+		if err != nil {
+			return nil, err
+		}
+		rules, _, err := unstructured.NestedSlice(route.Object, "spec", "rules")
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := CreateResource(k.MqlRuntime, "k8s.httproute", map[string]*llx.RawData{
+			"id":              llx.StringData(objIdFromK8sObj(obj, objT)),
+			"uid":             llx.StringData(string(obj.GetUID())),
+			"resourceVersion": llx.StringData(obj.GetResourceVersion()),
+			"name":            llx.StringData(obj.GetName()),
+			"namespace":       llx.StringData(obj.GetNamespace()),
+			"kind":            llx.StringData(objT.GetKind()),
+			"created":         llx.TimeData(ts.Time),
+			"manifest":        llx.DictData(route.Object),
+			"parentRefs":      llx.ArrayData(parentRefs, types.Dict),
+			"hostnames":       llx.ArrayData(convert.SliceAnyToInterface(hostnames), types.String),
+			"rules":           llx.ArrayData(rules, types.Dict),
+			"spec":            llx.DictData(spec),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.(*mqlK8sHttproute).obj = route
+		return r, nil
+	})
+}
+
+func (k *mqlK8sHttproute) id() (string, error) {
+	return k.Id.Data, nil
+}
+
+func initK8sHttproute(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initNamespacedResource[*mqlK8sHttproute](runtime, args, func(k *mqlK8s) *plugin.TValue[[]interface{}] { return k.GetHttpRoutes() })
+}
+
+func (k *mqlK8sHttproute) annotations() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetAnnotations()), nil
+}
+
+func (k *mqlK8sHttproute) labels() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetLabels()), nil
+}
@@ -0,0 +1,194 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/k8s/connection/shared/helm"
+	helmrelease "helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// redactedHelmValue replaces the values of sensitive keys in Helm values
+const redactedHelmValue = "<redacted>"
+
+// sensitiveHelmKey matches the keys of Helm values that commonly hold
+// credentials, e.g. auth.password, postgresql.existingSecret, or apiToken
+var sensitiveHelmKey = regexp.MustCompile(`(?i)(passw(or)?d|pwd|secret|token|credential|private[_-]?key|api[_-]?key|access[_-]?key|connection[_-]?string)`)
+
+type mqlK8sHelmReleaseInternal struct {
+	revisions []interface{}
+}
+
+// helmReleaseRevisions decodes all stored revisions of Helm releases. Helm
+// stores them in secrets by default and in config maps with the configmap
+// storage driver.
+func helmReleaseRevisions(runtime *plugin.Runtime) ([]*helmrelease.Release, error) {
+	kt, err := k8sProvider(runtime.Connection)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*helmrelease.Release{}
+	decode := func(name string, data []byte) {
+		release, err := helm.DecodeRelease(data)
+		if err != nil {
+			log.Debug().Err(err).Str("name", name).Msg("could not decode Helm release")
+			return
+		}
+		res = append(res, release)
+	}
+
+	secrets, err := kt.Resources("secrets.v1.", "", "")
+	if err != nil {
+		return nil, err
+	}
+	for _, resource := range secrets.Resources {
+		s, ok := resource.(*corev1.Secret)
+		if !ok || s.Type != helm.SecretType {
+			continue
+		}
+		if data, ok := s.Data[helm.ReleaseKey]; ok {
+			decode(s.Name, data)
+		}
+	}
+
+	configMaps, err := kt.Resources("configmaps", "", "")
+	if err != nil {
+		return nil, err
+	}
+	for _, resource := range configMaps.Resources {
+		cm, ok := resource.(*corev1.ConfigMap)
+		if !ok || cm.Labels[helm.OwnerLabel] != helm.OwnerHelm {
+			continue
+		}
+		if data, ok := cm.Data[helm.ReleaseKey]; ok {
+			decode(cm.Name, []byte(data))
+		}
+	}
+	return res, nil
+}
+
+func newMqlHelmRelease(runtime *plugin.Runtime, release *helmrelease.Release) (*mqlK8sHelmRelease, error) {
+	var chart, chartVersion, appVersion string
+	// values often include credentials, which are stored in the release secret
+	chartValues := map[string]interface{}{}
+	if release.Chart != nil {
+		if release.Chart.Metadata != nil {
+			chart = release.Chart.Metadata.Name
+			chartVersion = release.Chart.Metadata.Version
+			appVersion = release.Chart.Metadata.AppVersion
+		}
+		if release.Chart.Values != nil {
+			chartValues = redactHelmValues(release.Chart.Values, false).(map[string]interface{})
+		}
+	}
+	info := release.Info
+	if info == nil {
+		info = &helmrelease.Info{}
+	}
+	values := map[string]interface{}{}
+	if release.Config != nil {
+		values = redactHelmValues(release.Config, false).(map[string]interface{})
+	}
+
+	r, err := CreateResource(runtime, "k8s.helmRelease", map[string]*llx.RawData{
+		"__id":          llx.StringData(release.Namespace + "/" + release.Name + "/" + strconv.Itoa(release.Version)),
+		"name":          llx.StringData(release.Name),
+		"namespace":     llx.StringData(release.Namespace),
+		"chart":         llx.StringData(chart),
+		"chartVersion":  llx.StringData(chartVersion),
+		"appVersion":    llx.StringData(appVersion),
+		"status":        llx.StringData(info.Status.String()),
+		"revision":      llx.IntData(int64(release.Version)),
+		"description":   llx.StringData(info.Description),
+		"values":        llx.DictData(values),
+		"chartValues":   llx.DictData(chartValues),
+		"firstDeployed": llx.TimeData(info.FirstDeployed.Time),
+		"lastDeployed":  llx.TimeData(info.LastDeployed.Time),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.(*mqlK8sHelmRelease), nil
+}
+
+// redactHelmValues replaces the values of sensitive keys, including all values
+// nested below them. All other values and all keys are kept.
+func redactHelmValues(v interface{}, sensitive bool) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(x))
+		for key, value := range x {
+			res[key] = redactHelmValues(value, sensitive || sensitiveHelmKey.MatchString(key))
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(x))
+		for i := range x {
+			res[i] = redactHelmValues(x[i], sensitive)
+		}
+		return res
+	case nil:
+		return nil
+	default:
+		if sensitive {
+			return redactedHelmValue
+		}
+		return v
+	}
+}
+
+func (k *mqlK8s) helmReleases() ([]interface{}, error) {
+	revisions, err := helmReleaseRevisions(k.MqlRuntime)
+	if err != nil {
+		return nil, err
+	}
+
+	// latest revision first
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].Version > revisions[j].Version
+	})
+
+	releases := map[string][]*helmrelease.Release{}
+	keys := []string{}
+	for _, release := range revisions {
+		key := release.Namespace + "/" + release.Name
+		if _, ok := releases[key]; !ok {
+			keys = append(keys, key)
+		}
+		releases[key] = append(releases[key], release)
+	}
+	sort.Strings(keys)
+
+	res := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		history := []interface{}{}
+		for _, previous := range releases[key][1:] {
+			r, err := newMqlHelmRelease(k.MqlRuntime, previous)
+			if err != nil {
+				return nil, err
+			}
+			history = append(history, r)
+		}
+
+		r, err := newMqlHelmRelease(k.MqlRuntime, releases[key][0])
+		if err != nil {
+			return nil, err
+		}
+		r.revisions = history
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+func (k *mqlK8sHelmRelease) history() ([]interface{}, error) {
+	return k.revisions, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/k8s/connection/manifest"
+	"go.mondoo.com/cnquery/v9/providers/k8s/connection/shared/helm"
+	helmchart "helm.sh/helm/v3/pkg/chart"
+	helmrelease "helm.sh/helm/v3/pkg/release"
+	helmtime "helm.sh/helm/v3/pkg/time"
+)
+
+// releaseSecret returns a manifest of a secret the way Helm stores a release
+func releaseSecret(t *testing.T, release *helmrelease.Release) string {
+	data, err := helm.EncodeRelease(release)
+	require.NoError(t, err)
+	return fmt.Sprintf(`---
+apiVersion: v1
+kind: Secret
+type: helm.sh/release.v1
+metadata:
+  name: sh.helm.release.v1.%s.v%d
+  namespace: %s
+  labels:
+    owner: helm
+data:
+  release: %s
+`, release.Name, release.Version, release.Namespace, base64.StdEncoding.EncodeToString(data))
+}
+
+func TestHelmReleases(t *testing.T) {
+	deployed := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	chart := &helmchart.Chart{
+		Metadata: &helmchart.Metadata{Name: "webapp", Version: "1.2.3", AppVersion: "2.0.1"},
+		Values: map[string]interface{}{
+			"replicaCount": float64(1),
+			"credentials":  map[string]interface{}{"username": "admin", "apiToken": "t0ken"},
+		},
+	}
+	var manifestContent strings.Builder
+	for i, status := range []helmrelease.Status{helmrelease.StatusSuperseded, helmrelease.StatusSuperseded, helmrelease.StatusDeployed} {
+		manifestContent.WriteString(releaseSecret(t, &helmrelease.Release{
+			Name:      "web",
+			Namespace: "apps",
+			Version:   i + 1,
+			Info: &helmrelease.Info{
+				FirstDeployed: helmtime.Time{Time: deployed},
+				LastDeployed:  helmtime.Time{Time: deployed.Add(time.Duration(i) * time.Hour)},
+				Status:        status,
+				Description:   "Upgrade complete",
+			},
+			Chart: chart,
+			Config: map[string]interface{}{
+				"replicaCount": float64(i + 1),
+				"auth":         map[string]interface{}{"password": "secret", "users": []interface{}{"admin"}},
+			},
+		}))
+	}
+
+	conn, err := manifest.NewConnection(0, &inventory.Asset{
+		Connections: []*inventory.Config{{}},
+	}, manifest.WithManifestContent([]byte(manifestContent.String())))
+	require.NoError(t, err)
+	runtime := &plugin.Runtime{Connection: conn}
+
+	k := &mqlK8s{MqlRuntime: runtime}
+	releases, err := k.helmReleases()
+	require.NoError(t, err)
+	require.Len(t, releases, 1)
+
+	release := releases[0].(*mqlK8sHelmRelease)
+	assert.Equal(t, "web", release.Name.Data)
+	assert.Equal(t, "apps", release.Namespace.Data)
+	assert.Equal(t, "webapp", release.Chart.Data)
+	assert.Equal(t, "1.2.3", release.ChartVersion.Data)
+	assert.Equal(t, "2.0.1", release.AppVersion.Data)
+	assert.Equal(t, "deployed", release.Status.Data)
+	assert.Equal(t, int64(3), release.Revision.Data)
+	assert.Equal(t, map[string]interface{}{
+		"replicaCount": float64(3),
+		"auth":         map[string]interface{}{"password": "<redacted>", "users": []interface{}{"admin"}},
+	}, release.Values.Data)
+	assert.Equal(t, map[string]interface{}{
+		"replicaCount": float64(1),
+		"credentials":  map[string]interface{}{"username": "<redacted>", "apiToken": "<redacted>"},
+	}, release.ChartValues.Data)
+	assert.Equal(t, deployed, *release.FirstDeployed.Data)
+	assert.Equal(t, deployed.Add(2*time.Hour), *release.LastDeployed.Data)
+
+	history := release.GetHistory()
+	require.NoError(t, history.Error)
+	require.Len(t, history.Data, 2)
+	previous := history.Data[0].(*mqlK8sHelmRelease)
+	assert.Equal(t, int64(2), previous.Revision.Data)
+	assert.Equal(t, "superseded", previous.Status.Data)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/v9/types"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type mqlK8sHorizontalpodautoscalerInternal struct {
+	lock sync.Mutex
+	obj  metav1.Object
+}
+
+// hpaSpecV2 returns the spec of autoscaling/v1 autoscalers in the format of
+// autoscaling/v2, so that metrics can be queried the same way for both
+func hpaSpecV2(resource runtime.Object) (*autoscalingv2.HorizontalPodAutoscalerSpec, error) {
+	switch hpa := resource.(type) {
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		return &hpa.Spec, nil
+	case *autoscalingv1.HorizontalPodAutoscaler:
+		spec := &autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				Kind:       hpa.Spec.ScaleTargetRef.Kind,
+				Name:       hpa.Spec.ScaleTargetRef.Name,
+				APIVersion: hpa.Spec.ScaleTargetRef.APIVersion,
+			},
+			MinReplicas: hpa.Spec.MinReplicas,
+			MaxReplicas: hpa.Spec.MaxReplicas,
+		}
+		if hpa.Spec.TargetCPUUtilizationPercentage != nil {
+			spec.Metrics = []autoscalingv2.MetricSpec{{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name: corev1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{
+						Type:               autoscalingv2.UtilizationMetricType,
+						AverageUtilization: hpa.Spec.TargetCPUUtilizationPercentage,
+					},
+				},
+			}}
+		}
+		return spec, nil
+	}
+	return nil, errors.New("not a k8s horizontalpodautoscaler")
+}
+
+func (k *mqlK8s) horizontalPodAutoscalers() ([]interface{}, error) {
+	return k8sResourceToMql(k.MqlRuntime, "horizontalpodautoscalers", func(kind string, resource runtime.Object, obj metav1.Object, objT metav1.Type) (interface{}, error) {
+		ts := obj.GetCreationTimestamp()
+
+		manifest, err := convert.JsonToDict(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		hpaSpec, err := hpaSpecV2(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		// the spec of the version that the object was read with
+		spec, _ := manifest["spec"].(map[string]interface{})
+
+		scaleTargetRef, err := convert.JsonToDict(hpaSpec.ScaleTargetRef)
+		if err != nil {
+			return nil, err
+		}
+
+		metrics, err := convert.JsonToDictSlice(hpaSpec.Metrics)
+		if err != nil {
+			return nil, err
+		}
+
+		behavior, err := convert.JsonToDict(hpaSpec.Behavior)
+		if err != nil {
+			return nil, err
+		}
+
+		minReplicas := int64(1)
+		if hpaSpec.MinReplicas != nil {
+			minReplicas = int64(*hpaSpec.MinReplicas)
+		}
+
+		r, err := CreateResource(k.MqlRuntime, "k8s.horizontalpodautoscaler", map[string]*llx.RawData{
+			"id":              llx.StringData(objIdFromK8sObj(obj, objT)),
+			"uid":             llx.StringData(string(obj.GetUID())),
+			"resourceVersion": llx.StringData(obj.GetResourceVersion()),
+			"name":            llx.StringData(obj.GetName()),
+			"namespace":       llx.StringData(obj.GetNamespace()),
+			"kind":            llx.StringData(objT.GetKind()),
+			"created":         llx.TimeData(ts.Time),
+			"manifest":        llx.DictData(manifest),
+			"scaleTargetRef":  llx.DictData(scaleTargetRef),
+			"minReplicas":     llx.IntData(minReplicas),
+			"maxReplicas":     llx.IntData(int64(hpaSpec.MaxReplicas)),
+			"metrics":         llx.ArrayData(metrics, types.Dict),
+			"behavior":        llx.DictData(behavior),
+			"spec":            llx.DictData(spec),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.(*mqlK8sHorizontalpodautoscaler).obj = obj
+		return r, nil
+	})
+}
+
+func (k *mqlK8sHorizontalpodautoscaler) id() (string, error) {
+	return k.Id.Data, nil
+}
+
+func initK8sHorizontalpodautoscaler(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initNamespacedResource[*mqlK8sHorizontalpodautoscaler](runtime, args, func(k *mqlK8s) *plugin.TValue[[]interface{}] { return k.GetHorizontalPodAutoscalers() })
+}
+
+func (k *mqlK8sHorizontalpodautoscaler) annotations() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetAnnotations()), nil
+}
+
+func (k *mqlK8sHorizontalpodautoscaler) labels() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetLabels()), nil
+}
@@ -7,11 +7,17 @@ import (
 	"sync"
 
 	"go.mondoo.com/cnquery/v9/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/v9/providers/k8s/resources/netpol"
 )
 
 type mqlK8sInternal struct {
 	lock        sync.Mutex
 	nodesByName map[string]*mqlK8sNode
+	// network policy reachability, see reachability.go
+	netpolAnalyzer *netpol.Analyzer
+	podsByKey      map[string]*mqlK8sPod
+	// secrets that are referenced in the cluster, see secret_hygiene.go
+	secretUsage *secretUsageIndex
 }
 
 func (k *mqlK8s) serverVersion() (interface{}, error) {
@@ -38,6 +38,10 @@ func init() {
 			Init: initK8sPod,
 			Create: createK8sPod,
 		},
+		"k8s.pod.canReach": {
+			Init: initK8sPodCanReach,
+			Create: createK8sPodCanReach,
+		},
 		"k8s.deployment": {
 			Init: initK8sDeployment,
 			Create: createK8sDeployment,
@@ -66,6 +70,10 @@ func init() {
 			// to override args, implement: initK8sContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createK8sContainer,
 		},
+		"k8s.container.image": {
+			// to override args, implement: initK8sContainerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createK8sContainerImage,
+		},
 		"k8s.initContainer": {
 			// to override args, implement: initK8sInitContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createK8sInitContainer,
@@ -78,6 +86,10 @@ func init() {
 			Init: initK8sSecret,
 			Create: createK8sSecret,
 		},
+		"k8s.secretfinding": {
+			// to override args, implement: initK8sSecretfinding(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createK8sSecretfinding,
+		},
 		"k8s.configmap": {
 			Init: initK8sConfigmap,
 			Create: createK8sConfigmap,
@@ -134,6 +146,26 @@ func init() {
 			Init: initK8sRbacRolebinding,
 			Create: createK8sRbacRolebinding,
 		},
+		"k8s.rbac": {
+			// to override args, implement: initK8sRbac(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createK8sRbac,
+		},
+		"k8s.rbac.can": {
+			Init: initK8sRbacCan,
+			Create: createK8sRbacCan,
+		},
+		"k8s.rbac.subject": {
+			// to override args, implement: initK8sRbacSubject(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createK8sRbacSubject,
+		},
+		"k8s.rbac.permission": {
+			// to override args, implement: initK8sRbacPermission(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createK8sRbacPermission,
+		},
+		"k8s.helmRelease": {
+			// to override args, implement: initK8sHelmRelease(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createK8sHelmRelease,
+		},
 		"k8s.podsecuritypolicy": {
 			// to override args, implement: initK8sPodsecuritypolicy(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createK8sPodsecuritypolicy,
@@ -142,6 +174,66 @@ func init() {
 			Init: initK8sNetworkpolicy,
 			Create: createK8sNetworkpolicy,
 		},
+		"k8s.networkpolicy.peer": {
+			// to override args, implement: initK8sNetworkpolicyPeer(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createK8sNetworkpolicyPeer,
+		},
+		"k8s.persistentvolume": {
+			Init: initK8sPersistentvolume,
+			Create: createK8sPersistentvolume,
+		},
+		"k8s.persistentvolumeclaim": {
+			Init: initK8sPersistentvolumeclaim,
+			Create: createK8sPersistentvolumeclaim,
+		},
+		"k8s.storageclass": {
+			Init: initK8sStorageclass,
+			Create: createK8sStorageclass,
+		},
+		"k8s.horizontalpodautoscaler": {
+			Init: initK8sHorizontalpodautoscaler,
+			Create: createK8sHorizontalpodautoscaler,
+		},
+		"k8s.poddisruptionbudget": {
+			Init: initK8sPoddisruptionbudget,
+			Create: createK8sPoddisruptionbudget,
+		},
+		"k8s.resourcequota": {
+			Init: initK8sResourcequota,
+			Create: createK8sResourcequota,
+		},
+		"k8s.limitrange": {
+			Init: initK8sLimitrange,
+			Create: createK8sLimitrange,
+		},
+		"k8s.priorityclass": {
+			Init: initK8sPriorityclass,
+			Create: createK8sPriorityclass,
+		},
+		"k8s.validatingwebhookconfiguration": {
+			Init: initK8sValidatingwebhookconfiguration,
+			Create: createK8sValidatingwebhookconfiguration,
+		},
+		"k8s.mutatingwebhookconfiguration": {
+			Init: initK8sMutatingwebhookconfiguration,
+			Create: createK8sMutatingwebhookconfiguration,
+		},
+		"k8s.admissionwebhook": {
+			// to override args, implement: initK8sAdmissionwebhook(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createK8sAdmissionwebhook,
+		},
+		"k8s.gateway": {
+			Init: initK8sGateway,
+			Create: createK8sGateway,
+		},
+		"k8s.httproute": {
+			Init: initK8sHttproute,
+			Create: createK8sHttproute,
+		},
+		"k8s.event": {
+			// to override args, implement: initK8sEvent(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createK8sEvent,
+		},
 		"k8s.customresource": {
 			// to override args, implement: initK8sCustomresource(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createK8sCustomresource,
@@ -295,6 +387,48 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.customresources": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8s).GetCustomresources()).ToDataRes(types.Array(types.Resource("k8s.customresource")))
 	},
+	"k8s.helmReleases": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8s).GetHelmReleases()).ToDataRes(types.Array(types.Resource("k8s.helmRelease")))
+	},
+	"k8s.persistentVolumes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8s).GetPersistentVolumes()).ToDataRes(types.Array(types.Resource("k8s.persistentvolume")))
+	},
+	"k8s.persistentVolumeClaims": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8s).GetPersistentVolumeClaims()).ToDataRes(types.Array(types.Resource("k8s.persistentvolumeclaim")))
+	},
+	"k8s.storageClasses": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8s).GetStorageClasses()).ToDataRes(types.Array(types.Resource("k8s.storageclass")))
+	},
+	"k8s.horizontalPodAutoscalers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8s).GetHorizontalPodAutoscalers()).ToDataRes(types.Array(types.Resource("k8s.horizontalpodautoscaler")))
+	},
+	"k8s.podDisruptionBudgets": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8s).GetPodDisruptionBudgets()).ToDataRes(types.Array(types.Resource("k8s.poddisruptionbudget")))
+	},
+	"k8s.resourceQuotas": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8s).GetResourceQuotas()).ToDataRes(types.Array(types.Resource("k8s.resourcequota")))
+	},
+	"k8s.limitRanges": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8s).GetLimitRanges()).ToDataRes(types.Array(types.Resource("k8s.limitrange")))
+	},
+	"k8s.priorityClasses": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8s).GetPriorityClasses()).ToDataRes(types.Array(types.Resource("k8s.priorityclass")))
+	},
+	"k8s.validatingWebhookConfigurations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8s).GetValidatingWebhookConfigurations()).ToDataRes(types.Array(types.Resource("k8s.validatingwebhookconfiguration")))
+	},
+	"k8s.mutatingWebhookConfigurations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8s).GetMutatingWebhookConfigurations()).ToDataRes(types.Array(types.Resource("k8s.mutatingwebhookconfiguration")))
+	},
+	"k8s.gateways": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8s).GetGateways()).ToDataRes(types.Array(types.Resource("k8s.gateway")))
+	},
+	"k8s.httpRoutes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8s).GetHttpRoutes()).ToDataRes(types.Array(types.Resource("k8s.httproute")))
+	},
+	"k8s.events": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8s).GetEvents()).ToDataRes(types.Array(types.Resource("k8s.event")))
+	},
 	"k8s.apiresource.name": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sApiresource).GetName()).ToDataRes(types.String)
 	},
@@ -343,6 +477,24 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.namespace.annotations": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sNamespace).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
 	},
+	"k8s.namespace.podSecurityEnforce": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sNamespace).GetPodSecurityEnforce()).ToDataRes(types.String)
+	},
+	"k8s.namespace.podSecurityEnforceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sNamespace).GetPodSecurityEnforceVersion()).ToDataRes(types.String)
+	},
+	"k8s.namespace.podSecurityAudit": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sNamespace).GetPodSecurityAudit()).ToDataRes(types.String)
+	},
+	"k8s.namespace.podSecurityAuditVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sNamespace).GetPodSecurityAuditVersion()).ToDataRes(types.String)
+	},
+	"k8s.namespace.podSecurityWarn": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sNamespace).GetPodSecurityWarn()).ToDataRes(types.String)
+	},
+	"k8s.namespace.podSecurityWarnVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sNamespace).GetPodSecurityWarnVersion()).ToDataRes(types.String)
+	},
 	"k8s.node.id": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sNode).GetId()).ToDataRes(types.String)
 	},
@@ -412,6 +564,36 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.pod.node": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sPod).GetNode()).ToDataRes(types.Resource("k8s.node"))
 	},
+	"k8s.pod.defaultDenyIngress": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPod).GetDefaultDenyIngress()).ToDataRes(types.Bool)
+	},
+	"k8s.pod.defaultDenyEgress": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPod).GetDefaultDenyEgress()).ToDataRes(types.Bool)
+	},
+	"k8s.pod.reachableFrom": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPod).GetReachableFrom()).ToDataRes(types.Array(types.Resource("k8s.networkpolicy.peer")))
+	},
+	"k8s.pod.podSecurityLevel": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPod).GetPodSecurityLevel()).ToDataRes(types.String)
+	},
+	"k8s.pod.podSecurityViolations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPod).GetPodSecurityViolations()).ToDataRes(types.Array(types.Dict))
+	},
+	"k8s.pod.canReach.from": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPodCanReach).GetFrom()).ToDataRes(types.String)
+	},
+	"k8s.pod.canReach.to": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPodCanReach).GetTo()).ToDataRes(types.String)
+	},
+	"k8s.pod.canReach.port": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPodCanReach).GetPort()).ToDataRes(types.Int)
+	},
+	"k8s.pod.canReach.protocol": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPodCanReach).GetProtocol()).ToDataRes(types.String)
+	},
+	"k8s.pod.canReach.allowed": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPodCanReach).GetAllowed()).ToDataRes(types.Bool)
+	},
 	"k8s.deployment.id": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sDeployment).GetId()).ToDataRes(types.String)
 	},
@@ -451,6 +633,12 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.deployment.containers": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sDeployment).GetContainers()).ToDataRes(types.Array(types.Resource("k8s.container")))
 	},
+	"k8s.deployment.podSecurityLevel": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sDeployment).GetPodSecurityLevel()).ToDataRes(types.String)
+	},
+	"k8s.deployment.podSecurityViolations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sDeployment).GetPodSecurityViolations()).ToDataRes(types.Array(types.Dict))
+	},
 	"k8s.daemonset.id": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sDaemonset).GetId()).ToDataRes(types.String)
 	},
@@ -490,6 +678,12 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.daemonset.containers": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sDaemonset).GetContainers()).ToDataRes(types.Array(types.Resource("k8s.container")))
 	},
+	"k8s.daemonset.podSecurityLevel": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sDaemonset).GetPodSecurityLevel()).ToDataRes(types.String)
+	},
+	"k8s.daemonset.podSecurityViolations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sDaemonset).GetPodSecurityViolations()).ToDataRes(types.Array(types.Dict))
+	},
 	"k8s.statefulset.id": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sStatefulset).GetId()).ToDataRes(types.String)
 	},
@@ -529,6 +723,12 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.statefulset.containers": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sStatefulset).GetContainers()).ToDataRes(types.Array(types.Resource("k8s.container")))
 	},
+	"k8s.statefulset.podSecurityLevel": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStatefulset).GetPodSecurityLevel()).ToDataRes(types.String)
+	},
+	"k8s.statefulset.podSecurityViolations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStatefulset).GetPodSecurityViolations()).ToDataRes(types.Array(types.Dict))
+	},
 	"k8s.replicaset.id": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sReplicaset).GetId()).ToDataRes(types.String)
 	},
@@ -607,6 +807,12 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.job.containers": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sJob).GetContainers()).ToDataRes(types.Array(types.Resource("k8s.container")))
 	},
+	"k8s.job.podSecurityLevel": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sJob).GetPodSecurityLevel()).ToDataRes(types.String)
+	},
+	"k8s.job.podSecurityViolations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sJob).GetPodSecurityViolations()).ToDataRes(types.Array(types.Dict))
+	},
 	"k8s.cronjob.id": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sCronjob).GetId()).ToDataRes(types.String)
 	},
@@ -646,6 +852,12 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.cronjob.containers": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sCronjob).GetContainers()).ToDataRes(types.Array(types.Resource("k8s.container")))
 	},
+	"k8s.cronjob.podSecurityLevel": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sCronjob).GetPodSecurityLevel()).ToDataRes(types.String)
+	},
+	"k8s.cronjob.podSecurityViolations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sCronjob).GetPodSecurityViolations()).ToDataRes(types.Array(types.Dict))
+	},
 	"k8s.container.uid": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sContainer).GetUid()).ToDataRes(types.String)
 	},
@@ -661,6 +873,9 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.container.containerImage": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sContainer).GetContainerImage()).ToDataRes(types.Resource("container.image"))
 	},
+	"k8s.container.imageMetadata": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainer).GetImageMetadata()).ToDataRes(types.Resource("k8s.container.image"))
+	},
 	"k8s.container.command": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sContainer).GetCommand()).ToDataRes(types.Array(types.String))
 	},
@@ -700,6 +915,63 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.container.envFrom": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sContainer).GetEnvFrom()).ToDataRes(types.Dict)
 	},
+	"k8s.container.image.reference": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetReference()).ToDataRes(types.String)
+	},
+	"k8s.container.image.digest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetDigest()).ToDataRes(types.String)
+	},
+	"k8s.container.image.indexDigest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetIndexDigest()).ToDataRes(types.String)
+	},
+	"k8s.container.image.mediaType": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetMediaType()).ToDataRes(types.String)
+	},
+	"k8s.container.image.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetManifest()).ToDataRes(types.Dict)
+	},
+	"k8s.container.image.config": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetConfig()).ToDataRes(types.Dict)
+	},
+	"k8s.container.image.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetCreated()).ToDataRes(types.Time)
+	},
+	"k8s.container.image.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetLabels()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"k8s.container.image.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"k8s.container.image.baseImageName": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetBaseImageName()).ToDataRes(types.String)
+	},
+	"k8s.container.image.baseImageDigest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetBaseImageDigest()).ToDataRes(types.String)
+	},
+	"k8s.container.image.user": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetUser()).ToDataRes(types.String)
+	},
+	"k8s.container.image.exposedPorts": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetExposedPorts()).ToDataRes(types.Array(types.String))
+	},
+	"k8s.container.image.entrypoint": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetEntrypoint()).ToDataRes(types.Array(types.String))
+	},
+	"k8s.container.image.cmd": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetCmd()).ToDataRes(types.Array(types.String))
+	},
+	"k8s.container.image.env": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetEnv()).ToDataRes(types.Array(types.String))
+	},
+	"k8s.container.image.os": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetOs()).ToDataRes(types.String)
+	},
+	"k8s.container.image.architecture": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetArchitecture()).ToDataRes(types.String)
+	},
+	"k8s.container.image.referrers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sContainerImage).GetReferrers()).ToDataRes(types.Array(types.Dict))
+	},
 	"k8s.initContainer.uid": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sInitContainer).GetUid()).ToDataRes(types.String)
 	},
@@ -715,6 +987,9 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.initContainer.containerImage": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sInitContainer).GetContainerImage()).ToDataRes(types.Resource("container.image"))
 	},
+	"k8s.initContainer.imageMetadata": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sInitContainer).GetImageMetadata()).ToDataRes(types.Resource("k8s.container.image"))
+	},
 	"k8s.initContainer.command": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sInitContainer).GetCommand()).ToDataRes(types.Array(types.String))
 	},
@@ -763,6 +1038,9 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.ephemeralContainer.containerImage": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sEphemeralContainer).GetContainerImage()).ToDataRes(types.Resource("container.image"))
 	},
+	"k8s.ephemeralContainer.imageMetadata": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEphemeralContainer).GetImageMetadata()).ToDataRes(types.Resource("k8s.container.image"))
+	},
 	"k8s.ephemeralContainer.command": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sEphemeralContainer).GetCommand()).ToDataRes(types.Array(types.String))
 	},
@@ -829,6 +1107,33 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.secret.certificates": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sSecret).GetCertificates()).ToDataRes(types.Array(types.Resource("certificate")))
 	},
+	"k8s.secret.findings": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sSecret).GetFindings()).ToDataRes(types.Array(types.Resource("k8s.secretfinding")))
+	},
+	"k8s.secret.mountedBy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sSecret).GetMountedBy()).ToDataRes(types.Array(types.Resource("k8s.pod")))
+	},
+	"k8s.secret.envReferencedBy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sSecret).GetEnvReferencedBy()).ToDataRes(types.Array(types.Resource("k8s.pod")))
+	},
+	"k8s.secret.exposedViaEnv": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sSecret).GetExposedViaEnv()).ToDataRes(types.Bool)
+	},
+	"k8s.secret.unused": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sSecret).GetUnused()).ToDataRes(types.Bool)
+	},
+	"k8s.secretfinding.key": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sSecretfinding).GetKey()).ToDataRes(types.String)
+	},
+	"k8s.secretfinding.type": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sSecretfinding).GetType()).ToDataRes(types.String)
+	},
+	"k8s.secretfinding.description": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sSecretfinding).GetDescription()).ToDataRes(types.String)
+	},
+	"k8s.secretfinding.expires": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sSecretfinding).GetExpires()).ToDataRes(types.Time)
+	},
 	"k8s.configmap.id": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sConfigmap).GetId()).ToDataRes(types.String)
 	},
@@ -862,6 +1167,9 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.configmap.data": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sConfigmap).GetData()).ToDataRes(types.Map(types.String, types.String))
 	},
+	"k8s.configmap.findings": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sConfigmap).GetFindings()).ToDataRes(types.Array(types.Resource("k8s.secretfinding")))
+	},
 	"k8s.service.id": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sService).GetId()).ToDataRes(types.String)
 	},
@@ -1168,6 +1476,96 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.rbac.rolebinding.roleRef": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sRbacRolebinding).GetRoleRef()).ToDataRes(types.Dict)
 	},
+	"k8s.rbac.subjects": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbac).GetSubjects()).ToDataRes(types.Array(types.Resource("k8s.rbac.subject")))
+	},
+	"k8s.rbac.can.verb": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacCan).GetVerb()).ToDataRes(types.String)
+	},
+	"k8s.rbac.can.resource": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacCan).GetResource()).ToDataRes(types.String)
+	},
+	"k8s.rbac.can.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacCan).GetNamespace()).ToDataRes(types.String)
+	},
+	"k8s.rbac.can.subjects": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacCan).GetSubjects()).ToDataRes(types.Array(types.Resource("k8s.rbac.subject")))
+	},
+	"k8s.rbac.subject.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacSubject).GetKind()).ToDataRes(types.String)
+	},
+	"k8s.rbac.subject.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacSubject).GetName()).ToDataRes(types.String)
+	},
+	"k8s.rbac.subject.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacSubject).GetNamespace()).ToDataRes(types.String)
+	},
+	"k8s.rbac.subject.effectivePermissions": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacSubject).GetEffectivePermissions()).ToDataRes(types.Array(types.Resource("k8s.rbac.permission")))
+	},
+	"k8s.rbac.permission.verb": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacPermission).GetVerb()).ToDataRes(types.String)
+	},
+	"k8s.rbac.permission.apiGroup": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacPermission).GetApiGroup()).ToDataRes(types.String)
+	},
+	"k8s.rbac.permission.resource": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacPermission).GetResource()).ToDataRes(types.String)
+	},
+	"k8s.rbac.permission.resourceName": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacPermission).GetResourceName()).ToDataRes(types.String)
+	},
+	"k8s.rbac.permission.nonResourceUrl": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacPermission).GetNonResourceUrl()).ToDataRes(types.String)
+	},
+	"k8s.rbac.permission.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacPermission).GetNamespace()).ToDataRes(types.String)
+	},
+	"k8s.rbac.permission.role": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacPermission).GetRole()).ToDataRes(types.String)
+	},
+	"k8s.rbac.permission.binding": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sRbacPermission).GetBinding()).ToDataRes(types.String)
+	},
+	"k8s.helmRelease.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHelmRelease).GetName()).ToDataRes(types.String)
+	},
+	"k8s.helmRelease.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHelmRelease).GetNamespace()).ToDataRes(types.String)
+	},
+	"k8s.helmRelease.chart": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHelmRelease).GetChart()).ToDataRes(types.String)
+	},
+	"k8s.helmRelease.chartVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHelmRelease).GetChartVersion()).ToDataRes(types.String)
+	},
+	"k8s.helmRelease.appVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHelmRelease).GetAppVersion()).ToDataRes(types.String)
+	},
+	"k8s.helmRelease.status": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHelmRelease).GetStatus()).ToDataRes(types.String)
+	},
+	"k8s.helmRelease.revision": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHelmRelease).GetRevision()).ToDataRes(types.Int)
+	},
+	"k8s.helmRelease.description": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHelmRelease).GetDescription()).ToDataRes(types.String)
+	},
+	"k8s.helmRelease.values": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHelmRelease).GetValues()).ToDataRes(types.Dict)
+	},
+	"k8s.helmRelease.chartValues": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHelmRelease).GetChartValues()).ToDataRes(types.Dict)
+	},
+	"k8s.helmRelease.firstDeployed": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHelmRelease).GetFirstDeployed()).ToDataRes(types.Time)
+	},
+	"k8s.helmRelease.lastDeployed": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHelmRelease).GetLastDeployed()).ToDataRes(types.Time)
+	},
+	"k8s.helmRelease.history": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHelmRelease).GetHistory()).ToDataRes(types.Array(types.Resource("k8s.helmRelease")))
+	},
 	"k8s.podsecuritypolicy.id": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sPodsecuritypolicy).GetId()).ToDataRes(types.String)
 	},
@@ -1231,1688 +1629,6430 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"k8s.networkpolicy.spec": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlK8sNetworkpolicy).GetSpec()).ToDataRes(types.Dict)
 	},
-	"k8s.customresource.id": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sCustomresource).GetId()).ToDataRes(types.String)
+	"k8s.networkpolicy.peer.policy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sNetworkpolicyPeer).GetPolicy()).ToDataRes(types.String)
 	},
-	"k8s.customresource.uid": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sCustomresource).GetUid()).ToDataRes(types.String)
+	"k8s.networkpolicy.peer.any": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sNetworkpolicyPeer).GetAny()).ToDataRes(types.Bool)
 	},
-	"k8s.customresource.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sCustomresource).GetResourceVersion()).ToDataRes(types.String)
+	"k8s.networkpolicy.peer.namespaceSelector": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sNetworkpolicyPeer).GetNamespaceSelector()).ToDataRes(types.Dict)
 	},
-	"k8s.customresource.labels": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sCustomresource).GetLabels()).ToDataRes(types.Map(types.String, types.String))
+	"k8s.networkpolicy.peer.podSelector": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sNetworkpolicyPeer).GetPodSelector()).ToDataRes(types.Dict)
 	},
-	"k8s.customresource.annotations": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sCustomresource).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
+	"k8s.networkpolicy.peer.namespaces": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sNetworkpolicyPeer).GetNamespaces()).ToDataRes(types.Array(types.String))
 	},
-	"k8s.customresource.name": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sCustomresource).GetName()).ToDataRes(types.String)
+	"k8s.networkpolicy.peer.pods": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sNetworkpolicyPeer).GetPods()).ToDataRes(types.Array(types.Resource("k8s.pod")))
 	},
-	"k8s.customresource.namespace": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sCustomresource).GetNamespace()).ToDataRes(types.String)
+	"k8s.networkpolicy.peer.ipBlock": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sNetworkpolicyPeer).GetIpBlock()).ToDataRes(types.Dict)
 	},
-	"k8s.customresource.kind": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sCustomresource).GetKind()).ToDataRes(types.String)
+	"k8s.networkpolicy.peer.ports": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sNetworkpolicyPeer).GetPorts()).ToDataRes(types.Array(types.Dict))
 	},
-	"k8s.customresource.created": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sCustomresource).GetCreated()).ToDataRes(types.Time)
+	"k8s.persistentvolume.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetId()).ToDataRes(types.String)
 	},
-	"k8s.customresource.manifest": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sCustomresource).GetManifest()).ToDataRes(types.Dict)
+	"k8s.persistentvolume.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetUid()).ToDataRes(types.String)
 	},
-	"k8s.admissionreview.request": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sAdmissionreview).GetRequest()).ToDataRes(types.Resource("k8s.admissionrequest"))
+	"k8s.persistentvolume.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetResourceVersion()).ToDataRes(types.String)
 	},
-	"k8s.admissionrequest.name": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sAdmissionrequest).GetName()).ToDataRes(types.String)
+	"k8s.persistentvolume.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetLabels()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.admissionrequest.namespace": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sAdmissionrequest).GetNamespace()).ToDataRes(types.String)
+	"k8s.persistentvolume.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.admissionrequest.operation": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sAdmissionrequest).GetOperation()).ToDataRes(types.String)
+	"k8s.persistentvolume.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetName()).ToDataRes(types.String)
 	},
-	"k8s.admissionrequest.userInfo": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sAdmissionrequest).GetUserInfo()).ToDataRes(types.Resource("k8s.userinfo"))
+	"k8s.persistentvolume.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetKind()).ToDataRes(types.String)
 	},
-	"k8s.admissionrequest.object": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sAdmissionrequest).GetObject()).ToDataRes(types.Dict)
+	"k8s.persistentvolume.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetCreated()).ToDataRes(types.Time)
 	},
-	"k8s.admissionrequest.oldObject": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sAdmissionrequest).GetOldObject()).ToDataRes(types.Dict)
+	"k8s.persistentvolume.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetManifest()).ToDataRes(types.Dict)
 	},
-	"k8s.userinfo.username": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sUserinfo).GetUsername()).ToDataRes(types.String)
+	"k8s.persistentvolume.storageClassName": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetStorageClassName()).ToDataRes(types.String)
 	},
-	"k8s.userinfo.uid": func(r plugin.Resource) *plugin.DataRes {
-		return (r.(*mqlK8sUserinfo).GetUid()).ToDataRes(types.String)
+	"k8s.persistentvolume.capacity": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetCapacity()).ToDataRes(types.Map(types.String, types.String))
 	},
-}
-
-func GetData(resource plugin.Resource, field string, args map[string]*llx.RawData) *plugin.DataRes {
-	f, ok := getDataFields[resource.MqlName()+"."+field]
-	if !ok {
-		return &plugin.DataRes{Error: "cannot find '" + field + "' in resource '" + resource.MqlName() + "'"}
-	}
-
-	return f(resource)
-}
-
-var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
-	"k8s.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8s).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.serverVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).ServerVersion, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolume.accessModes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetAccessModes()).ToDataRes(types.Array(types.String))
 	},
-	"k8s.apiResources": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).ApiResources, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolume.reclaimPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetReclaimPolicy()).ToDataRes(types.String)
 	},
-	"k8s.namespaces": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Namespaces, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolume.volumeMode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetVolumeMode()).ToDataRes(types.String)
 	},
-	"k8s.nodes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Nodes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolume.phase": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetPhase()).ToDataRes(types.String)
 	},
-	"k8s.pods": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Pods, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolume.claimRef": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetClaimRef()).ToDataRes(types.Dict)
 	},
-	"k8s.deployments": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Deployments, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolume.spec": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolume).GetSpec()).ToDataRes(types.Dict)
 	},
-	"k8s.daemonsets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Daemonsets, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetId()).ToDataRes(types.String)
 	},
-	"k8s.statefulsets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Statefulsets, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetUid()).ToDataRes(types.String)
 	},
-	"k8s.replicasets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Replicasets, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetResourceVersion()).ToDataRes(types.String)
 	},
-	"k8s.jobs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Jobs, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetLabels()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.cronjobs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Cronjobs, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.secrets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Secrets, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetName()).ToDataRes(types.String)
 	},
-	"k8s.configmaps": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Configmaps, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetNamespace()).ToDataRes(types.String)
 	},
-	"k8s.services": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Services, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetKind()).ToDataRes(types.String)
 	},
-	"k8s.ingresses": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Ingresses, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetCreated()).ToDataRes(types.Time)
 	},
-	"k8s.serviceaccounts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Serviceaccounts, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetManifest()).ToDataRes(types.Dict)
 	},
-	"k8s.clusterroles": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Clusterroles, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.storageClassName": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetStorageClassName()).ToDataRes(types.String)
 	},
-	"k8s.clusterrolebindings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Clusterrolebindings, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.volumeName": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetVolumeName()).ToDataRes(types.String)
 	},
-	"k8s.roles": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Roles, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.accessModes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetAccessModes()).ToDataRes(types.Array(types.String))
 	},
-	"k8s.rolebindings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Rolebindings, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.requests": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetRequests()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.podSecurityPolicies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).PodSecurityPolicies, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.volumeMode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetVolumeMode()).ToDataRes(types.String)
 	},
-	"k8s.networkPolicies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).NetworkPolicies, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.phase": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetPhase()).ToDataRes(types.String)
 	},
-	"k8s.customresources": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8s).Customresources, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.persistentvolumeclaim.spec": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPersistentvolumeclaim).GetSpec()).ToDataRes(types.Dict)
 	},
-	"k8s.apiresource.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sApiresource).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.apiresource.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sApiresource).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.storageclass.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetId()).ToDataRes(types.String)
 	},
-	"k8s.apiresource.singularName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sApiresource).SingularName, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.storageclass.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetUid()).ToDataRes(types.String)
 	},
-	"k8s.apiresource.namespaced": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sApiresource).Namespaced, ok = plugin.RawToTValue[bool](v.Value, v.Error)
-		return
+	"k8s.storageclass.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetResourceVersion()).ToDataRes(types.String)
 	},
-	"k8s.apiresource.group": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sApiresource).Group, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.storageclass.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetLabels()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.apiresource.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sApiresource).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.storageclass.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.apiresource.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sApiresource).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.storageclass.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetName()).ToDataRes(types.String)
 	},
-	"k8s.apiresource.shortNames": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sApiresource).ShortNames, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.storageclass.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetKind()).ToDataRes(types.String)
 	},
-	"k8s.apiresource.categories": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sApiresource).Categories, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.storageclass.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetCreated()).ToDataRes(types.Time)
 	},
-	"k8s.namespace.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sNamespace).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.namespace.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNamespace).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.storageclass.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetManifest()).ToDataRes(types.Dict)
 	},
-	"k8s.namespace.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNamespace).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.storageclass.provisioner": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetProvisioner()).ToDataRes(types.String)
 	},
-	"k8s.namespace.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNamespace).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.storageclass.parameters": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetParameters()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.namespace.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNamespace).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
-		return
+	"k8s.storageclass.reclaimPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetReclaimPolicy()).ToDataRes(types.String)
 	},
-	"k8s.namespace.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNamespace).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.storageclass.volumeBindingMode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetVolumeBindingMode()).ToDataRes(types.String)
 	},
-	"k8s.namespace.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNamespace).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.storageclass.allowVolumeExpansion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetAllowVolumeExpansion()).ToDataRes(types.Bool)
 	},
-	"k8s.namespace.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNamespace).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.storageclass.mountOptions": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetMountOptions()).ToDataRes(types.Array(types.String))
 	},
-	"k8s.namespace.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNamespace).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.storageclass.isDefault": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sStorageclass).GetIsDefault()).ToDataRes(types.Bool)
 	},
-	"k8s.node.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sNode).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.node.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNode).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetId()).ToDataRes(types.String)
 	},
-	"k8s.node.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNode).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetUid()).ToDataRes(types.String)
 	},
-	"k8s.node.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNode).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetResourceVersion()).ToDataRes(types.String)
 	},
-	"k8s.node.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNode).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetLabels()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.node.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNode).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.node.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNode).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetName()).ToDataRes(types.String)
 	},
-	"k8s.node.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNode).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetNamespace()).ToDataRes(types.String)
 	},
-	"k8s.pod.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sPod).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.pod.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetKind()).ToDataRes(types.String)
 	},
-	"k8s.pod.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetCreated()).ToDataRes(types.Time)
 	},
-	"k8s.pod.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetManifest()).ToDataRes(types.Dict)
 	},
-	"k8s.pod.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.scaleTargetRef": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetScaleTargetRef()).ToDataRes(types.Dict)
 	},
-	"k8s.pod.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.minReplicas": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetMinReplicas()).ToDataRes(types.Int)
 	},
-	"k8s.pod.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.maxReplicas": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetMaxReplicas()).ToDataRes(types.Int)
 	},
-	"k8s.pod.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.metrics": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetMetrics()).ToDataRes(types.Array(types.Dict))
 	},
-	"k8s.pod.apiVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).ApiVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.behavior": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetBehavior()).ToDataRes(types.Dict)
 	},
-	"k8s.pod.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.horizontalpodautoscaler.spec": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHorizontalpodautoscaler).GetSpec()).ToDataRes(types.Dict)
 	},
-	"k8s.pod.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetId()).ToDataRes(types.String)
 	},
-	"k8s.pod.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetUid()).ToDataRes(types.String)
 	},
-	"k8s.pod.podSpec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).PodSpec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetResourceVersion()).ToDataRes(types.String)
 	},
-	"k8s.pod.ephemeralContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).EphemeralContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetLabels()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.pod.initContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).InitContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.pod.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetName()).ToDataRes(types.String)
 	},
-	"k8s.pod.node": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPod).Node, ok = plugin.RawToTValue[*mqlK8sNode](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetNamespace()).ToDataRes(types.String)
 	},
-	"k8s.deployment.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sDeployment).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.deployment.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDeployment).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetKind()).ToDataRes(types.String)
 	},
-	"k8s.deployment.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDeployment).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetCreated()).ToDataRes(types.Time)
 	},
-	"k8s.deployment.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDeployment).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetManifest()).ToDataRes(types.Dict)
 	},
-	"k8s.deployment.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDeployment).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.minAvailable": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetMinAvailable()).ToDataRes(types.String)
 	},
-	"k8s.deployment.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDeployment).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.maxUnavailable": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetMaxUnavailable()).ToDataRes(types.String)
 	},
-	"k8s.deployment.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDeployment).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.selector": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetSelector()).ToDataRes(types.Dict)
 	},
-	"k8s.deployment.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDeployment).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.unhealthyPodEvictionPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetUnhealthyPodEvictionPolicy()).ToDataRes(types.String)
 	},
-	"k8s.deployment.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDeployment).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.poddisruptionbudget.spec": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPoddisruptionbudget).GetSpec()).ToDataRes(types.Dict)
 	},
-	"k8s.deployment.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDeployment).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
-		return
+	"k8s.resourcequota.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetId()).ToDataRes(types.String)
 	},
-	"k8s.deployment.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDeployment).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.resourcequota.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetUid()).ToDataRes(types.String)
 	},
-	"k8s.deployment.podSpec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDeployment).PodSpec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.resourcequota.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetResourceVersion()).ToDataRes(types.String)
 	},
-	"k8s.deployment.initContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDeployment).InitContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.resourcequota.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetLabels()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.deployment.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDeployment).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.resourcequota.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.daemonset.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sDaemonset).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.daemonset.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDaemonset).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.resourcequota.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetName()).ToDataRes(types.String)
 	},
-	"k8s.daemonset.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDaemonset).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.resourcequota.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetNamespace()).ToDataRes(types.String)
 	},
-	"k8s.daemonset.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDaemonset).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.resourcequota.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetKind()).ToDataRes(types.String)
 	},
-	"k8s.daemonset.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDaemonset).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.resourcequota.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetCreated()).ToDataRes(types.Time)
 	},
-	"k8s.daemonset.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDaemonset).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.resourcequota.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetManifest()).ToDataRes(types.Dict)
 	},
-	"k8s.daemonset.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDaemonset).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.resourcequota.hard": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetHard()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.daemonset.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDaemonset).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.resourcequota.used": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetUsed()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.daemonset.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDaemonset).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.resourcequota.scopes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetScopes()).ToDataRes(types.Array(types.String))
 	},
-	"k8s.daemonset.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDaemonset).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
-		return
+	"k8s.resourcequota.scopeSelector": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetScopeSelector()).ToDataRes(types.Dict)
 	},
-	"k8s.daemonset.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDaemonset).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.resourcequota.spec": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sResourcequota).GetSpec()).ToDataRes(types.Dict)
 	},
-	"k8s.daemonset.podSpec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDaemonset).PodSpec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.limitrange.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sLimitrange).GetId()).ToDataRes(types.String)
 	},
-	"k8s.daemonset.initContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDaemonset).InitContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.limitrange.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sLimitrange).GetUid()).ToDataRes(types.String)
 	},
-	"k8s.daemonset.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sDaemonset).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.limitrange.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sLimitrange).GetResourceVersion()).ToDataRes(types.String)
 	},
-	"k8s.statefulset.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sStatefulset).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.statefulset.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sStatefulset).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.limitrange.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sLimitrange).GetLabels()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.statefulset.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sStatefulset).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.limitrange.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sLimitrange).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.statefulset.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sStatefulset).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.limitrange.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sLimitrange).GetName()).ToDataRes(types.String)
 	},
-	"k8s.statefulset.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sStatefulset).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.limitrange.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sLimitrange).GetNamespace()).ToDataRes(types.String)
 	},
-	"k8s.statefulset.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sStatefulset).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.limitrange.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sLimitrange).GetKind()).ToDataRes(types.String)
 	},
-	"k8s.statefulset.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sStatefulset).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.limitrange.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sLimitrange).GetCreated()).ToDataRes(types.Time)
 	},
-	"k8s.statefulset.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sStatefulset).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.limitrange.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sLimitrange).GetManifest()).ToDataRes(types.Dict)
 	},
-	"k8s.statefulset.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sStatefulset).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.limitrange.limits": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sLimitrange).GetLimits()).ToDataRes(types.Array(types.Dict))
 	},
-	"k8s.statefulset.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sStatefulset).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
-		return
+	"k8s.limitrange.spec": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sLimitrange).GetSpec()).ToDataRes(types.Dict)
 	},
-	"k8s.statefulset.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sStatefulset).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.priorityclass.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPriorityclass).GetId()).ToDataRes(types.String)
 	},
-	"k8s.statefulset.podSpec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sStatefulset).PodSpec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.priorityclass.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPriorityclass).GetUid()).ToDataRes(types.String)
 	},
-	"k8s.statefulset.initContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sStatefulset).InitContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.priorityclass.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPriorityclass).GetResourceVersion()).ToDataRes(types.String)
 	},
-	"k8s.statefulset.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sStatefulset).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.priorityclass.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPriorityclass).GetLabels()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.replicaset.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sReplicaset).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.replicaset.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sReplicaset).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.priorityclass.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPriorityclass).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.replicaset.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sReplicaset).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.priorityclass.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPriorityclass).GetName()).ToDataRes(types.String)
 	},
-	"k8s.replicaset.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sReplicaset).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.priorityclass.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPriorityclass).GetKind()).ToDataRes(types.String)
 	},
-	"k8s.replicaset.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sReplicaset).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.priorityclass.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPriorityclass).GetCreated()).ToDataRes(types.Time)
 	},
-	"k8s.replicaset.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sReplicaset).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.priorityclass.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPriorityclass).GetManifest()).ToDataRes(types.Dict)
 	},
-	"k8s.replicaset.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sReplicaset).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.priorityclass.value": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPriorityclass).GetValue()).ToDataRes(types.Int)
 	},
-	"k8s.replicaset.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sReplicaset).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.priorityclass.globalDefault": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPriorityclass).GetGlobalDefault()).ToDataRes(types.Bool)
 	},
-	"k8s.replicaset.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sReplicaset).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.priorityclass.preemptionPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPriorityclass).GetPreemptionPolicy()).ToDataRes(types.String)
 	},
-	"k8s.replicaset.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sReplicaset).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
-		return
+	"k8s.priorityclass.description": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sPriorityclass).GetDescription()).ToDataRes(types.String)
 	},
-	"k8s.replicaset.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sReplicaset).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.validatingwebhookconfiguration.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sValidatingwebhookconfiguration).GetId()).ToDataRes(types.String)
 	},
-	"k8s.replicaset.podSpec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sReplicaset).PodSpec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.validatingwebhookconfiguration.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sValidatingwebhookconfiguration).GetUid()).ToDataRes(types.String)
 	},
-	"k8s.replicaset.initContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sReplicaset).InitContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.validatingwebhookconfiguration.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sValidatingwebhookconfiguration).GetResourceVersion()).ToDataRes(types.String)
 	},
-	"k8s.replicaset.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sReplicaset).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.validatingwebhookconfiguration.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sValidatingwebhookconfiguration).GetLabels()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.job.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sJob).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.job.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sJob).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.validatingwebhookconfiguration.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sValidatingwebhookconfiguration).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.job.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sJob).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.validatingwebhookconfiguration.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sValidatingwebhookconfiguration).GetName()).ToDataRes(types.String)
 	},
-	"k8s.job.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sJob).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.validatingwebhookconfiguration.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sValidatingwebhookconfiguration).GetKind()).ToDataRes(types.String)
 	},
-	"k8s.job.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sJob).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.validatingwebhookconfiguration.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sValidatingwebhookconfiguration).GetCreated()).ToDataRes(types.Time)
 	},
-	"k8s.job.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sJob).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.validatingwebhookconfiguration.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sValidatingwebhookconfiguration).GetManifest()).ToDataRes(types.Dict)
 	},
-	"k8s.job.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sJob).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.validatingwebhookconfiguration.webhooks": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sValidatingwebhookconfiguration).GetWebhooks()).ToDataRes(types.Array(types.Resource("k8s.admissionwebhook")))
 	},
-	"k8s.job.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sJob).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.mutatingwebhookconfiguration.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sMutatingwebhookconfiguration).GetId()).ToDataRes(types.String)
 	},
-	"k8s.job.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sJob).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.mutatingwebhookconfiguration.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sMutatingwebhookconfiguration).GetUid()).ToDataRes(types.String)
 	},
-	"k8s.job.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sJob).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
-		return
+	"k8s.mutatingwebhookconfiguration.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sMutatingwebhookconfiguration).GetResourceVersion()).ToDataRes(types.String)
 	},
-	"k8s.job.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sJob).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.mutatingwebhookconfiguration.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sMutatingwebhookconfiguration).GetLabels()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.job.podSpec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sJob).PodSpec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.mutatingwebhookconfiguration.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sMutatingwebhookconfiguration).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.job.initContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sJob).InitContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.mutatingwebhookconfiguration.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sMutatingwebhookconfiguration).GetName()).ToDataRes(types.String)
 	},
-	"k8s.job.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sJob).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.mutatingwebhookconfiguration.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sMutatingwebhookconfiguration).GetKind()).ToDataRes(types.String)
 	},
-	"k8s.cronjob.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sCronjob).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.cronjob.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCronjob).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.mutatingwebhookconfiguration.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sMutatingwebhookconfiguration).GetCreated()).ToDataRes(types.Time)
 	},
-	"k8s.cronjob.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCronjob).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.mutatingwebhookconfiguration.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sMutatingwebhookconfiguration).GetManifest()).ToDataRes(types.Dict)
 	},
-	"k8s.cronjob.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCronjob).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.mutatingwebhookconfiguration.webhooks": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sMutatingwebhookconfiguration).GetWebhooks()).ToDataRes(types.Array(types.Resource("k8s.admissionwebhook")))
 	},
-	"k8s.cronjob.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCronjob).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetName()).ToDataRes(types.String)
 	},
-	"k8s.cronjob.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCronjob).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.failurePolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetFailurePolicy()).ToDataRes(types.String)
 	},
-	"k8s.cronjob.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCronjob).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.matchPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetMatchPolicy()).ToDataRes(types.String)
 	},
-	"k8s.cronjob.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCronjob).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.sideEffects": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetSideEffects()).ToDataRes(types.String)
 	},
-	"k8s.cronjob.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCronjob).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.timeoutSeconds": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetTimeoutSeconds()).ToDataRes(types.Int)
 	},
-	"k8s.cronjob.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCronjob).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.admissionReviewVersions": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetAdmissionReviewVersions()).ToDataRes(types.Array(types.String))
 	},
-	"k8s.cronjob.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCronjob).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.rules": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetRules()).ToDataRes(types.Array(types.Dict))
 	},
-	"k8s.cronjob.podSpec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCronjob).PodSpec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.namespaceSelector": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetNamespaceSelector()).ToDataRes(types.Dict)
 	},
-	"k8s.cronjob.initContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCronjob).InitContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.objectSelector": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetObjectSelector()).ToDataRes(types.Dict)
 	},
-	"k8s.cronjob.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCronjob).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.matchConditions": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetMatchConditions()).ToDataRes(types.Array(types.Dict))
 	},
-	"k8s.container.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sContainer).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.container.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.reinvocationPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetReinvocationPolicy()).ToDataRes(types.String)
 	},
-	"k8s.container.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.service": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetService()).ToDataRes(types.Dict)
 	},
-	"k8s.container.imageName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).ImageName, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.url": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetUrl()).ToDataRes(types.String)
 	},
-	"k8s.container.image": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).Image, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.caBundle": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetCaBundle()).ToDataRes(types.String)
 	},
-	"k8s.container.containerImage": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).ContainerImage, ok = plugin.RawToTValue[plugin.Resource](v.Value, v.Error)
-		return
+	"k8s.admissionwebhook.caBundleCertificates": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionwebhook).GetCaBundleCertificates()).ToDataRes(types.Array(types.Resource("certificate")))
 	},
-	"k8s.container.command": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).Command, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.gateway.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sGateway).GetId()).ToDataRes(types.String)
 	},
-	"k8s.container.args": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).Args, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.gateway.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sGateway).GetUid()).ToDataRes(types.String)
 	},
-	"k8s.container.resources": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).Resources, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.gateway.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sGateway).GetResourceVersion()).ToDataRes(types.String)
 	},
-	"k8s.container.volumeMounts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).VolumeMounts, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.gateway.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sGateway).GetLabels()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.container.volumeDevices": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).VolumeDevices, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.gateway.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sGateway).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.container.livenessProbe": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).LivenessProbe, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.gateway.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sGateway).GetName()).ToDataRes(types.String)
 	},
-	"k8s.container.readinessProbe": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).ReadinessProbe, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.gateway.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sGateway).GetNamespace()).ToDataRes(types.String)
 	},
-	"k8s.container.imagePullPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).ImagePullPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.gateway.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sGateway).GetKind()).ToDataRes(types.String)
 	},
-	"k8s.container.securityContext": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).SecurityContext, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.gateway.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sGateway).GetCreated()).ToDataRes(types.Time)
 	},
-	"k8s.container.workingDir": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).WorkingDir, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.gateway.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sGateway).GetManifest()).ToDataRes(types.Dict)
 	},
-	"k8s.container.tty": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).Tty, ok = plugin.RawToTValue[bool](v.Value, v.Error)
-		return
+	"k8s.gateway.gatewayClassName": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sGateway).GetGatewayClassName()).ToDataRes(types.String)
 	},
-	"k8s.container.env": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).Env, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.gateway.listeners": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sGateway).GetListeners()).ToDataRes(types.Array(types.Dict))
 	},
-	"k8s.container.envFrom": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sContainer).EnvFrom, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.gateway.addresses": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sGateway).GetAddresses()).ToDataRes(types.Array(types.Dict))
 	},
-	"k8s.initContainer.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sInitContainer).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.initContainer.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.gateway.spec": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sGateway).GetSpec()).ToDataRes(types.Dict)
 	},
-	"k8s.initContainer.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.httproute.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHttproute).GetId()).ToDataRes(types.String)
 	},
-	"k8s.initContainer.imageName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).ImageName, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.httproute.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHttproute).GetUid()).ToDataRes(types.String)
 	},
-	"k8s.initContainer.image": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).Image, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.httproute.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHttproute).GetResourceVersion()).ToDataRes(types.String)
 	},
-	"k8s.initContainer.containerImage": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).ContainerImage, ok = plugin.RawToTValue[plugin.Resource](v.Value, v.Error)
-		return
+	"k8s.httproute.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHttproute).GetLabels()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.initContainer.command": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).Command, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.httproute.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHttproute).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.initContainer.args": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).Args, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.httproute.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHttproute).GetName()).ToDataRes(types.String)
 	},
-	"k8s.initContainer.resources": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).Resources, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.httproute.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHttproute).GetNamespace()).ToDataRes(types.String)
 	},
-	"k8s.initContainer.volumeMounts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).VolumeMounts, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.httproute.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHttproute).GetKind()).ToDataRes(types.String)
 	},
-	"k8s.initContainer.volumeDevices": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).VolumeDevices, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.httproute.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHttproute).GetCreated()).ToDataRes(types.Time)
 	},
-	"k8s.initContainer.imagePullPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).ImagePullPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.httproute.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHttproute).GetManifest()).ToDataRes(types.Dict)
 	},
-	"k8s.initContainer.securityContext": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).SecurityContext, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.httproute.parentRefs": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHttproute).GetParentRefs()).ToDataRes(types.Array(types.Dict))
 	},
-	"k8s.initContainer.workingDir": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).WorkingDir, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.httproute.hostnames": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHttproute).GetHostnames()).ToDataRes(types.Array(types.String))
 	},
-	"k8s.initContainer.tty": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).Tty, ok = plugin.RawToTValue[bool](v.Value, v.Error)
-		return
+	"k8s.httproute.rules": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHttproute).GetRules()).ToDataRes(types.Array(types.Dict))
 	},
-	"k8s.initContainer.env": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).Env, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.httproute.spec": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sHttproute).GetSpec()).ToDataRes(types.Dict)
 	},
-	"k8s.initContainer.envFrom": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sInitContainer).EnvFrom, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.event.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetId()).ToDataRes(types.String)
 	},
-	"k8s.ephemeralContainer.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sEphemeralContainer).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.ephemeralContainer.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.event.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetUid()).ToDataRes(types.String)
 	},
-	"k8s.ephemeralContainer.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.event.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetResourceVersion()).ToDataRes(types.String)
 	},
-	"k8s.ephemeralContainer.imageName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).ImageName, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.event.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetLabels()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.ephemeralContainer.image": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).Image, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.event.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
 	},
-	"k8s.ephemeralContainer.containerImage": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).ContainerImage, ok = plugin.RawToTValue[plugin.Resource](v.Value, v.Error)
-		return
+	"k8s.event.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetName()).ToDataRes(types.String)
 	},
-	"k8s.ephemeralContainer.command": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).Command, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.event.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetNamespace()).ToDataRes(types.String)
 	},
-	"k8s.ephemeralContainer.args": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).Args, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.event.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetKind()).ToDataRes(types.String)
 	},
-	"k8s.ephemeralContainer.volumeMounts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).VolumeMounts, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.event.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetCreated()).ToDataRes(types.Time)
 	},
-	"k8s.ephemeralContainer.volumeDevices": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).VolumeDevices, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
-		return
+	"k8s.event.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetManifest()).ToDataRes(types.Dict)
 	},
-	"k8s.ephemeralContainer.imagePullPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).ImagePullPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.event.type": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetType()).ToDataRes(types.String)
 	},
-	"k8s.ephemeralContainer.securityContext": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).SecurityContext, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.event.reason": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetReason()).ToDataRes(types.String)
 	},
-	"k8s.ephemeralContainer.workingDir": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).WorkingDir, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
+	"k8s.event.message": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetMessage()).ToDataRes(types.String)
 	},
-	"k8s.ephemeralContainer.tty": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).Tty, ok = plugin.RawToTValue[bool](v.Value, v.Error)
-		return
+	"k8s.event.involvedObject": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetInvolvedObject()).ToDataRes(types.Dict)
 	},
-	"k8s.ephemeralContainer.env": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).Env, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.event.source": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetSource()).ToDataRes(types.String)
 	},
-	"k8s.ephemeralContainer.envFrom": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sEphemeralContainer).EnvFrom, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
-		return
+	"k8s.event.count": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetCount()).ToDataRes(types.Int)
 	},
-	"k8s.secret.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sSecret).__id, ok = v.Value.(string)
+	"k8s.event.firstTimestamp": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetFirstTimestamp()).ToDataRes(types.Time)
+	},
+	"k8s.event.lastTimestamp": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sEvent).GetLastTimestamp()).ToDataRes(types.Time)
+	},
+	"k8s.customresource.id": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sCustomresource).GetId()).ToDataRes(types.String)
+	},
+	"k8s.customresource.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sCustomresource).GetUid()).ToDataRes(types.String)
+	},
+	"k8s.customresource.resourceVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sCustomresource).GetResourceVersion()).ToDataRes(types.String)
+	},
+	"k8s.customresource.labels": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sCustomresource).GetLabels()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"k8s.customresource.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sCustomresource).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"k8s.customresource.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sCustomresource).GetName()).ToDataRes(types.String)
+	},
+	"k8s.customresource.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sCustomresource).GetNamespace()).ToDataRes(types.String)
+	},
+	"k8s.customresource.kind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sCustomresource).GetKind()).ToDataRes(types.String)
+	},
+	"k8s.customresource.created": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sCustomresource).GetCreated()).ToDataRes(types.Time)
+	},
+	"k8s.customresource.manifest": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sCustomresource).GetManifest()).ToDataRes(types.Dict)
+	},
+	"k8s.admissionreview.request": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionreview).GetRequest()).ToDataRes(types.Resource("k8s.admissionrequest"))
+	},
+	"k8s.admissionrequest.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionrequest).GetName()).ToDataRes(types.String)
+	},
+	"k8s.admissionrequest.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionrequest).GetNamespace()).ToDataRes(types.String)
+	},
+	"k8s.admissionrequest.operation": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionrequest).GetOperation()).ToDataRes(types.String)
+	},
+	"k8s.admissionrequest.userInfo": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionrequest).GetUserInfo()).ToDataRes(types.Resource("k8s.userinfo"))
+	},
+	"k8s.admissionrequest.object": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionrequest).GetObject()).ToDataRes(types.Dict)
+	},
+	"k8s.admissionrequest.oldObject": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAdmissionrequest).GetOldObject()).ToDataRes(types.Dict)
+	},
+	"k8s.userinfo.username": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sUserinfo).GetUsername()).ToDataRes(types.String)
+	},
+	"k8s.userinfo.uid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sUserinfo).GetUid()).ToDataRes(types.String)
+	},
+}
+
+func GetData(resource plugin.Resource, field string, args map[string]*llx.RawData) *plugin.DataRes {
+	f, ok := getDataFields[resource.MqlName()+"."+field]
+	if !ok {
+		return &plugin.DataRes{Error: "cannot find '" + field + "' in resource '" + resource.MqlName() + "'"}
+	}
+
+	return f(resource)
+}
+
+var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
+	"k8s.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8s).__id, ok = v.Value.(string)
 			return
 		},
-	"k8s.secret.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sSecret).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"k8s.secret.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sSecret).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.serverVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).ServerVersion, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.secret.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sSecret).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.apiResources": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).ApiResources, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.secret.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sSecret).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.namespaces": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Namespaces, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.secret.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sSecret).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.nodes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Nodes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.secret.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sSecret).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.pods": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Pods, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.secret.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sSecret).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.deployments": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Deployments, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.secret.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sSecret).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.daemonsets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Daemonsets, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.secret.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sSecret).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"k8s.statefulsets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Statefulsets, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.secret.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sSecret).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.replicasets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Replicasets, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.secret.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sSecret).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.jobs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Jobs, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.secret.certificates": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sSecret).Certificates, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"k8s.cronjobs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Cronjobs, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.configmap.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sConfigmap).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.configmap.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sConfigmap).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.secrets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Secrets, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.configmap.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sConfigmap).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.configmaps": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Configmaps, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.configmap.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sConfigmap).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.services": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Services, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.configmap.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sConfigmap).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.ingresses": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Ingresses, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.configmap.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sConfigmap).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.serviceaccounts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Serviceaccounts, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.configmap.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sConfigmap).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.clusterroles": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Clusterroles, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.configmap.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sConfigmap).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.clusterrolebindings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Clusterrolebindings, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.configmap.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sConfigmap).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.roles": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Roles, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.configmap.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sConfigmap).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"k8s.rolebindings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Rolebindings, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.configmap.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sConfigmap).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.podSecurityPolicies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).PodSecurityPolicies, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.configmap.data": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sConfigmap).Data, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.networkPolicies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).NetworkPolicies, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.service.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sService).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.service.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sService).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.customresources": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Customresources, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.service.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sService).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.helmReleases": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).HelmReleases, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.service.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sService).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.persistentVolumes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).PersistentVolumes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.service.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sService).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.persistentVolumeClaims": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).PersistentVolumeClaims, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.service.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sService).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.storageClasses": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).StorageClasses, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.service.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sService).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.horizontalPodAutoscalers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).HorizontalPodAutoscalers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.service.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sService).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.podDisruptionBudgets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).PodDisruptionBudgets, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.service.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sService).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.resourceQuotas": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).ResourceQuotas, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.service.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sService).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"k8s.limitRanges": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).LimitRanges, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.service.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sService).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.priorityClasses": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).PriorityClasses, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.service.spec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sService).Spec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.validatingWebhookConfigurations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).ValidatingWebhookConfigurations, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.ingressresourceref.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sIngressresourceref).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.ingressresourceref.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngressresourceref).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.mutatingWebhookConfigurations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).MutatingWebhookConfigurations, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.ingressresourceref.apiGroup": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngressresourceref).ApiGroup, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.gateways": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Gateways, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.ingressresourceref.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngressresourceref).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.httpRoutes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).HttpRoutes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.ingressresourceref.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngressresourceref).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.events": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8s).Events, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.ingressservicebackend.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sIngressservicebackend).__id, ok = v.Value.(string)
+	"k8s.apiresource.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sApiresource).__id, ok = v.Value.(string)
 			return
 		},
-	"k8s.ingressservicebackend.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngressservicebackend).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.apiresource.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sApiresource).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingressservicebackend.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngressservicebackend).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.apiresource.singularName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sApiresource).SingularName, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingressservicebackend.portName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngressservicebackend).PortName, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"k8s.ingressservicebackend.portNumber": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngressservicebackend).PortNumber, ok = plugin.RawToTValue[int64](v.Value, v.Error)
-		return
-	},
-	"k8s.ingressbackend.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sIngressbackend).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.ingressbackend.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngressbackend).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"k8s.ingressbackend.service": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngressbackend).Service, ok = plugin.RawToTValue[*mqlK8sIngressservicebackend](v.Value, v.Error)
+	"k8s.apiresource.namespaced": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sApiresource).Namespaced, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"k8s.ingressbackend.resourceRef": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngressbackend).ResourceRef, ok = plugin.RawToTValue[*mqlK8sIngressresourceref](v.Value, v.Error)
+	"k8s.apiresource.group": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sApiresource).Group, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingresshttprulepath.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sIngresshttprulepath).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.ingresshttprulepath.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngresshttprulepath).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.apiresource.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sApiresource).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingresshttprulepath.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngresshttprulepath).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.apiresource.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sApiresource).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingresshttprulepath.pathType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngresshttprulepath).PathType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.apiresource.shortNames": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sApiresource).ShortNames, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.ingresshttprulepath.backend": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngresshttprulepath).Backend, ok = plugin.RawToTValue[*mqlK8sIngressbackend](v.Value, v.Error)
+	"k8s.apiresource.categories": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sApiresource).Categories, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.ingressrule.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sIngressrule).__id, ok = v.Value.(string)
+	"k8s.namespace.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sNamespace).__id, ok = v.Value.(string)
 			return
 		},
-	"k8s.ingressrule.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngressrule).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.namespace.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNamespace).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingressrule.host": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngressrule).Host, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.namespace.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNamespace).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingressrule.httpPaths": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngressrule).HttpPaths, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"k8s.namespace.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNamespace).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingresstls.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sIngresstls).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.ingresstls.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngresstls).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.namespace.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNamespace).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
-	"k8s.ingresstls.hosts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngresstls).Hosts, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"k8s.namespace.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNamespace).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.ingresstls.certificates": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngresstls).Certificates, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"k8s.namespace.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNamespace).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingress.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sIngress).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.ingress.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngress).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.namespace.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNamespace).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.ingress.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngress).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.namespace.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNamespace).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.ingress.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngress).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.namespace.podSecurityEnforce": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNamespace).PodSecurityEnforce, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingress.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngress).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.namespace.podSecurityEnforceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNamespace).PodSecurityEnforceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingress.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngress).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.namespace.podSecurityAudit": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNamespace).PodSecurityAudit, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingress.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngress).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.namespace.podSecurityAuditVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNamespace).PodSecurityAuditVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingress.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngress).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.namespace.podSecurityWarn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNamespace).PodSecurityWarn, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingress.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngress).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.namespace.podSecurityWarnVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNamespace).PodSecurityWarnVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingress.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngress).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"k8s.node.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sNode).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.node.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNode).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingress.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngress).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.node.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNode).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.ingress.rules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngress).Rules, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"k8s.node.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNode).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.ingress.tls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sIngress).Tls, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"k8s.node.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNode).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.serviceaccount.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sServiceaccount).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.serviceaccount.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sServiceaccount).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.node.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNode).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.serviceaccount.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sServiceaccount).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.node.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNode).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.serviceaccount.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sServiceaccount).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.node.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNode).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.serviceaccount.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sServiceaccount).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.pod.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sPod).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.pod.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.serviceaccount.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sServiceaccount).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.pod.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.serviceaccount.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sServiceaccount).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.pod.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.serviceaccount.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sServiceaccount).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.pod.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.serviceaccount.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sServiceaccount).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.pod.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.serviceaccount.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sServiceaccount).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"k8s.pod.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.serviceaccount.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sServiceaccount).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.pod.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.serviceaccount.secrets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sServiceaccount).Secrets, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"k8s.pod.apiVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).ApiVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.serviceaccount.imagePullSecrets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sServiceaccount).ImagePullSecrets, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"k8s.pod.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.serviceaccount.automountServiceAccountToken": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sServiceaccount).AutomountServiceAccountToken, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"k8s.pod.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrole.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sRbacClusterrole).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.rbac.clusterrole.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrole).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.pod.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrole.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrole).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.pod.podSpec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).PodSpec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrole.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrole).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.pod.ephemeralContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).EphemeralContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrole.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrole).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.pod.initContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).InitContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrole.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrole).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.pod.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrole.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrole).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.pod.node": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).Node, ok = plugin.RawToTValue[*mqlK8sNode](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrole.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrole).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.pod.defaultDenyIngress": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).DefaultDenyIngress, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrole.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrole).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"k8s.pod.defaultDenyEgress": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).DefaultDenyEgress, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrole.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrole).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.pod.reachableFrom": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).ReachableFrom, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrole.rules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrole).Rules, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"k8s.pod.podSecurityLevel": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).PodSecurityLevel, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrole.aggregationRule": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrole).AggregationRule, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.pod.podSecurityViolations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPod).PodSecurityViolations, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrolebinding.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sRbacClusterrolebinding).__id, ok = v.Value.(string)
+	"k8s.pod.canReach.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sPodCanReach).__id, ok = v.Value.(string)
 			return
 		},
-	"k8s.rbac.clusterrolebinding.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrolebinding).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.pod.canReach.from": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodCanReach).From, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrolebinding.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrolebinding).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.pod.canReach.to": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodCanReach).To, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrolebinding.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrolebinding).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.pod.canReach.port": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodCanReach).Port, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrolebinding.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrolebinding).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.pod.canReach.protocol": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodCanReach).Protocol, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrolebinding.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrolebinding).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.pod.canReach.allowed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodCanReach).Allowed, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrolebinding.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrolebinding).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.deployment.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sDeployment).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.deployment.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrolebinding.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrolebinding).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.deployment.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrolebinding.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrolebinding).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"k8s.deployment.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrolebinding.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrolebinding).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.deployment.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrolebinding.subjects": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrolebinding).Subjects, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"k8s.deployment.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.clusterrolebinding.roleRef": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacClusterrolebinding).RoleRef, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.deployment.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.role.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sRbacRole).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.rbac.role.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRole).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"k8s.rbac.role.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRole).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"k8s.rbac.role.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRole).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.deployment.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.role.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRole).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.deployment.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.role.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRole).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.deployment.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.role.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRole).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.deployment.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.role.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRole).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.deployment.podSpec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).PodSpec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.role.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRole).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.deployment.initContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).InitContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.role.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRole).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"k8s.deployment.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.role.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRole).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.deployment.podSecurityLevel": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).PodSecurityLevel, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.role.rules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRole).Rules, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"k8s.deployment.podSecurityViolations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDeployment).PodSecurityViolations, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.rolebinding.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sRbacRolebinding).__id, ok = v.Value.(string)
+	"k8s.daemonset.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sDaemonset).__id, ok = v.Value.(string)
 			return
 		},
-	"k8s.rbac.rolebinding.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRolebinding).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.daemonset.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.rolebinding.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRolebinding).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.daemonset.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.rolebinding.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRolebinding).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.daemonset.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.rolebinding.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRolebinding).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.daemonset.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.rolebinding.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRolebinding).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.daemonset.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.rolebinding.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRolebinding).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.daemonset.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.rolebinding.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRolebinding).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.daemonset.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.rolebinding.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRolebinding).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.daemonset.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.rolebinding.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRolebinding).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"k8s.daemonset.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.rolebinding.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRolebinding).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.daemonset.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.rolebinding.subjects": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRolebinding).Subjects, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"k8s.daemonset.podSpec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).PodSpec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.rbac.rolebinding.roleRef": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sRbacRolebinding).RoleRef, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.daemonset.initContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).InitContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.podsecuritypolicy.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sPodsecuritypolicy).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.podsecuritypolicy.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPodsecuritypolicy).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.daemonset.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.podsecuritypolicy.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPodsecuritypolicy).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.daemonset.podSecurityLevel": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).PodSecurityLevel, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.podsecuritypolicy.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPodsecuritypolicy).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.daemonset.podSecurityViolations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sDaemonset).PodSecurityViolations, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.podsecuritypolicy.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPodsecuritypolicy).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.statefulset.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sStatefulset).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.statefulset.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.podsecuritypolicy.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPodsecuritypolicy).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.statefulset.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.podsecuritypolicy.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPodsecuritypolicy).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.statefulset.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.podsecuritypolicy.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPodsecuritypolicy).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.statefulset.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.podsecuritypolicy.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPodsecuritypolicy).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"k8s.statefulset.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.podsecuritypolicy.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPodsecuritypolicy).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.statefulset.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.podsecuritypolicy.spec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sPodsecuritypolicy).Spec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.statefulset.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.networkpolicy.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sNetworkpolicy).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.networkpolicy.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNetworkpolicy).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.statefulset.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.networkpolicy.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNetworkpolicy).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.statefulset.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
-	"k8s.networkpolicy.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNetworkpolicy).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.statefulset.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.networkpolicy.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNetworkpolicy).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.statefulset.podSpec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).PodSpec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.networkpolicy.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNetworkpolicy).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.statefulset.initContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).InitContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.networkpolicy.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNetworkpolicy).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.statefulset.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.networkpolicy.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNetworkpolicy).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.statefulset.podSecurityLevel": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).PodSecurityLevel, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.networkpolicy.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNetworkpolicy).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.statefulset.podSecurityViolations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStatefulset).PodSecurityViolations, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.networkpolicy.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNetworkpolicy).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"k8s.replicaset.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sReplicaset).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.replicaset.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sReplicaset).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.networkpolicy.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNetworkpolicy).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.replicaset.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sReplicaset).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.networkpolicy.spec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sNetworkpolicy).Spec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.replicaset.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sReplicaset).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.customresource.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sCustomresource).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.customresource.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCustomresource).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.replicaset.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sReplicaset).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.customresource.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCustomresource).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.replicaset.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sReplicaset).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.customresource.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCustomresource).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.replicaset.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sReplicaset).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.customresource.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCustomresource).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.replicaset.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sReplicaset).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.customresource.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCustomresource).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"k8s.replicaset.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sReplicaset).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.customresource.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCustomresource).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.replicaset.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sReplicaset).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
-	"k8s.customresource.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCustomresource).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.replicaset.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sReplicaset).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.customresource.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCustomresource).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.replicaset.podSpec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sReplicaset).PodSpec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.customresource.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCustomresource).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"k8s.replicaset.initContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sReplicaset).InitContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.customresource.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sCustomresource).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.replicaset.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sReplicaset).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.admissionreview.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sAdmissionreview).__id, ok = v.Value.(string)
+	"k8s.job.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sJob).__id, ok = v.Value.(string)
 			return
 		},
-	"k8s.admissionreview.request": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sAdmissionreview).Request, ok = plugin.RawToTValue[*mqlK8sAdmissionrequest](v.Value, v.Error)
+	"k8s.job.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.admissionrequest.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sAdmissionrequest).__id, ok = v.Value.(string)
-			return
-		},
-	"k8s.admissionrequest.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sAdmissionrequest).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.job.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.admissionrequest.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sAdmissionrequest).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.job.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.admissionrequest.operation": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sAdmissionrequest).Operation, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.job.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.admissionrequest.userInfo": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sAdmissionrequest).UserInfo, ok = plugin.RawToTValue[*mqlK8sUserinfo](v.Value, v.Error)
+	"k8s.job.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"k8s.admissionrequest.object": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sAdmissionrequest).Object, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.job.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.admissionrequest.oldObject": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sAdmissionrequest).OldObject, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"k8s.job.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.userinfo.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlK8sUserinfo).__id, ok = v.Value.(string)
+	"k8s.job.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.job.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.job.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.job.podSpec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).PodSpec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.job.initContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).InitContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.job.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.job.podSecurityLevel": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).PodSecurityLevel, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.job.podSecurityViolations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sJob).PodSecurityViolations, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.cronjob.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sCronjob).__id, ok = v.Value.(string)
 			return
 		},
-	"k8s.userinfo.username": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sUserinfo).Username, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.cronjob.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"k8s.userinfo.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlK8sUserinfo).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"k8s.cronjob.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.cronjob.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.cronjob.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.cronjob.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.cronjob.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.cronjob.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.cronjob.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.cronjob.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.cronjob.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.cronjob.podSpec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).PodSpec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.cronjob.initContainers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).InitContainers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.cronjob.containers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).Containers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.cronjob.podSecurityLevel": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).PodSecurityLevel, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.cronjob.podSecurityViolations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCronjob).PodSecurityViolations, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sContainer).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.container.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.imageName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).ImageName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).Image, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.containerImage": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).ContainerImage, ok = plugin.RawToTValue[plugin.Resource](v.Value, v.Error)
+		return
+	},
+	"k8s.container.imageMetadata": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).ImageMetadata, ok = plugin.RawToTValue[*mqlK8sContainerImage](v.Value, v.Error)
+		return
+	},
+	"k8s.container.command": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).Command, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.args": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).Args, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.resources": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).Resources, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.volumeMounts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).VolumeMounts, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.volumeDevices": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).VolumeDevices, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.livenessProbe": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).LivenessProbe, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.readinessProbe": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).ReadinessProbe, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.imagePullPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).ImagePullPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.securityContext": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).SecurityContext, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.workingDir": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).WorkingDir, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.tty": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).Tty, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"k8s.container.env": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).Env, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.envFrom": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainer).EnvFrom, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sContainerImage).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.container.image.reference": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).Reference, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.digest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).Digest, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.indexDigest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).IndexDigest, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.mediaType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).MediaType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.config": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).Config, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.baseImageName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).BaseImageName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.baseImageDigest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).BaseImageDigest, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).User, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.exposedPorts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).ExposedPorts, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.entrypoint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).Entrypoint, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.cmd": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).Cmd, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.env": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).Env, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.os": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).Os, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.architecture": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).Architecture, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.container.image.referrers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sContainerImage).Referrers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sInitContainer).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.initContainer.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.imageName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).ImageName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.image": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).Image, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.containerImage": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).ContainerImage, ok = plugin.RawToTValue[plugin.Resource](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.imageMetadata": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).ImageMetadata, ok = plugin.RawToTValue[*mqlK8sContainerImage](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.command": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).Command, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.args": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).Args, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.resources": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).Resources, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
+	"k8s.initContainer.volumeMounts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).VolumeMounts, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.volumeDevices": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).VolumeDevices, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.imagePullPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).ImagePullPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.securityContext": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).SecurityContext, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.workingDir": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).WorkingDir, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.tty": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).Tty, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.env": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).Env, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.initContainer.envFrom": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sInitContainer).EnvFrom, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sEphemeralContainer).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.ephemeralContainer.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.imageName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).ImageName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.image": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).Image, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.containerImage": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).ContainerImage, ok = plugin.RawToTValue[plugin.Resource](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.imageMetadata": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).ImageMetadata, ok = plugin.RawToTValue[*mqlK8sContainerImage](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.command": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).Command, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.args": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).Args, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.volumeMounts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).VolumeMounts, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.volumeDevices": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).VolumeDevices, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.imagePullPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).ImagePullPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.securityContext": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).SecurityContext, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.workingDir": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).WorkingDir, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.tty": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).Tty, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.env": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).Env, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ephemeralContainer.envFrom": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEphemeralContainer).EnvFrom, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sSecret).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.secret.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.certificates": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).Certificates, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.findings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).Findings, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.mountedBy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).MountedBy, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.envReferencedBy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).EnvReferencedBy, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.exposedViaEnv": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).ExposedViaEnv, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"k8s.secret.unused": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecret).Unused, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"k8s.secretfinding.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sSecretfinding).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.secretfinding.key": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecretfinding).Key, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.secretfinding.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecretfinding).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.secretfinding.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecretfinding).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.secretfinding.expires": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sSecretfinding).Expires, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.configmap.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sConfigmap).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.configmap.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sConfigmap).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.configmap.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sConfigmap).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.configmap.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sConfigmap).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.configmap.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sConfigmap).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.configmap.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sConfigmap).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.configmap.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sConfigmap).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.configmap.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sConfigmap).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.configmap.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sConfigmap).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.configmap.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sConfigmap).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.configmap.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sConfigmap).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.configmap.data": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sConfigmap).Data, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.configmap.findings": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sConfigmap).Findings, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.service.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sService).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.service.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sService).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.service.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sService).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.service.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sService).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.service.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sService).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.service.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sService).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.service.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sService).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.service.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sService).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.service.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sService).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.service.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sService).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.service.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sService).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.service.spec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sService).Spec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ingressresourceref.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sIngressresourceref).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.ingressresourceref.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngressresourceref).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingressresourceref.apiGroup": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngressresourceref).ApiGroup, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingressresourceref.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngressresourceref).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingressresourceref.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngressresourceref).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingressservicebackend.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sIngressservicebackend).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.ingressservicebackend.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngressservicebackend).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingressservicebackend.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngressservicebackend).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingressservicebackend.portName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngressservicebackend).PortName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingressservicebackend.portNumber": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngressservicebackend).PortNumber, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"k8s.ingressbackend.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sIngressbackend).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.ingressbackend.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngressbackend).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingressbackend.service": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngressbackend).Service, ok = plugin.RawToTValue[*mqlK8sIngressservicebackend](v.Value, v.Error)
+		return
+	},
+	"k8s.ingressbackend.resourceRef": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngressbackend).ResourceRef, ok = plugin.RawToTValue[*mqlK8sIngressresourceref](v.Value, v.Error)
+		return
+	},
+	"k8s.ingresshttprulepath.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sIngresshttprulepath).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.ingresshttprulepath.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngresshttprulepath).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingresshttprulepath.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngresshttprulepath).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingresshttprulepath.pathType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngresshttprulepath).PathType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingresshttprulepath.backend": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngresshttprulepath).Backend, ok = plugin.RawToTValue[*mqlK8sIngressbackend](v.Value, v.Error)
+		return
+	},
+	"k8s.ingressrule.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sIngressrule).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.ingressrule.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngressrule).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingressrule.host": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngressrule).Host, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingressrule.httpPaths": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngressrule).HttpPaths, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ingresstls.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sIngresstls).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.ingresstls.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngresstls).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingresstls.hosts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngresstls).Hosts, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ingresstls.certificates": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngresstls).Certificates, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ingress.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sIngress).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.ingress.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngress).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingress.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngress).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingress.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngress).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingress.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngress).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ingress.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngress).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ingress.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngress).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingress.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngress).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingress.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngress).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.ingress.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngress).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.ingress.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngress).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ingress.rules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngress).Rules, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.ingress.tls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sIngress).Tls, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.serviceaccount.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sServiceaccount).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.serviceaccount.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sServiceaccount).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.serviceaccount.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sServiceaccount).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.serviceaccount.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sServiceaccount).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.serviceaccount.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sServiceaccount).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.serviceaccount.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sServiceaccount).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.serviceaccount.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sServiceaccount).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.serviceaccount.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sServiceaccount).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.serviceaccount.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sServiceaccount).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.serviceaccount.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sServiceaccount).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.serviceaccount.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sServiceaccount).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.serviceaccount.secrets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sServiceaccount).Secrets, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.serviceaccount.imagePullSecrets": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sServiceaccount).ImagePullSecrets, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.serviceaccount.automountServiceAccountToken": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sServiceaccount).AutomountServiceAccountToken, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrole.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sRbacClusterrole).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.rbac.clusterrole.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrole).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrole.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrole).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrole.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrole).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrole.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrole).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrole.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrole).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrole.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrole).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrole.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrole).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrole.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrole).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrole.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrole).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrole.rules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrole).Rules, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrole.aggregationRule": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrole).AggregationRule, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrolebinding.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sRbacClusterrolebinding).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.rbac.clusterrolebinding.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrolebinding).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrolebinding.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrolebinding).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrolebinding.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrolebinding).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrolebinding.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrolebinding).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrolebinding.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrolebinding).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrolebinding.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrolebinding).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrolebinding.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrolebinding).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrolebinding.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrolebinding).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrolebinding.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrolebinding).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrolebinding.subjects": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrolebinding).Subjects, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.clusterrolebinding.roleRef": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacClusterrolebinding).RoleRef, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.role.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sRbacRole).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.rbac.role.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRole).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.role.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRole).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.role.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRole).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.role.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRole).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.role.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRole).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.role.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRole).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.role.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRole).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.role.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRole).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.role.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRole).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.role.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRole).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.role.rules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRole).Rules, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.rolebinding.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sRbacRolebinding).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.rbac.rolebinding.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRolebinding).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.rolebinding.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRolebinding).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.rolebinding.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRolebinding).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.rolebinding.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRolebinding).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.rolebinding.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRolebinding).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.rolebinding.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRolebinding).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.rolebinding.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRolebinding).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.rolebinding.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRolebinding).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.rolebinding.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRolebinding).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.rolebinding.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRolebinding).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.rolebinding.subjects": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRolebinding).Subjects, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.rolebinding.roleRef": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacRolebinding).RoleRef, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sRbac).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.rbac.subjects": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbac).Subjects, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.can.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sRbacCan).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.rbac.can.verb": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacCan).Verb, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.can.resource": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacCan).Resource, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.can.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacCan).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.can.subjects": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacCan).Subjects, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.subject.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sRbacSubject).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.rbac.subject.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacSubject).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.subject.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacSubject).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.subject.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacSubject).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.subject.effectivePermissions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacSubject).EffectivePermissions, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.permission.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sRbacPermission).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.rbac.permission.verb": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacPermission).Verb, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.permission.apiGroup": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacPermission).ApiGroup, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.permission.resource": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacPermission).Resource, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.permission.resourceName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacPermission).ResourceName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.permission.nonResourceUrl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacPermission).NonResourceUrl, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.permission.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacPermission).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.permission.role": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacPermission).Role, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.rbac.permission.binding": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sRbacPermission).Binding, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.helmRelease.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sHelmRelease).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.helmRelease.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHelmRelease).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.helmRelease.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHelmRelease).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.helmRelease.chart": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHelmRelease).Chart, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.helmRelease.chartVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHelmRelease).ChartVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.helmRelease.appVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHelmRelease).AppVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.helmRelease.status": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHelmRelease).Status, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.helmRelease.revision": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHelmRelease).Revision, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"k8s.helmRelease.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHelmRelease).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.helmRelease.values": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHelmRelease).Values, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.helmRelease.chartValues": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHelmRelease).ChartValues, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.helmRelease.firstDeployed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHelmRelease).FirstDeployed, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.helmRelease.lastDeployed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHelmRelease).LastDeployed, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.helmRelease.history": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHelmRelease).History, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.podsecuritypolicy.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sPodsecuritypolicy).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.podsecuritypolicy.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodsecuritypolicy).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.podsecuritypolicy.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodsecuritypolicy).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.podsecuritypolicy.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodsecuritypolicy).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.podsecuritypolicy.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodsecuritypolicy).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.podsecuritypolicy.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodsecuritypolicy).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.podsecuritypolicy.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodsecuritypolicy).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.podsecuritypolicy.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodsecuritypolicy).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.podsecuritypolicy.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodsecuritypolicy).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.podsecuritypolicy.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodsecuritypolicy).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.podsecuritypolicy.spec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPodsecuritypolicy).Spec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sNetworkpolicy).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.networkpolicy.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicy).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicy).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicy).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicy).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicy).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicy).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicy).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicy).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicy).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicy).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.spec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicy).Spec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.peer.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sNetworkpolicyPeer).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.networkpolicy.peer.policy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicyPeer).Policy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.peer.any": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicyPeer).Any, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.peer.namespaceSelector": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicyPeer).NamespaceSelector, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.peer.podSelector": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicyPeer).PodSelector, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.peer.namespaces": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicyPeer).Namespaces, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.peer.pods": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicyPeer).Pods, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.peer.ipBlock": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicyPeer).IpBlock, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.networkpolicy.peer.ports": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sNetworkpolicyPeer).Ports, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sPersistentvolume).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.persistentvolume.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.storageClassName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).StorageClassName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.capacity": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).Capacity, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.accessModes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).AccessModes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.reclaimPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).ReclaimPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.volumeMode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).VolumeMode, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.phase": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).Phase, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.claimRef": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).ClaimRef, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolume.spec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolume).Spec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sPersistentvolumeclaim).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.persistentvolumeclaim.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.storageClassName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).StorageClassName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.volumeName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).VolumeName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.accessModes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).AccessModes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.requests": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).Requests, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.volumeMode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).VolumeMode, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.phase": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).Phase, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.persistentvolumeclaim.spec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPersistentvolumeclaim).Spec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sStorageclass).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.storageclass.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.provisioner": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).Provisioner, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.parameters": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).Parameters, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.reclaimPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).ReclaimPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.volumeBindingMode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).VolumeBindingMode, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.allowVolumeExpansion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).AllowVolumeExpansion, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.mountOptions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).MountOptions, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.storageclass.isDefault": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sStorageclass).IsDefault, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sHorizontalpodautoscaler).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.horizontalpodautoscaler.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.scaleTargetRef": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).ScaleTargetRef, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.minReplicas": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).MinReplicas, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.maxReplicas": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).MaxReplicas, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.metrics": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).Metrics, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.behavior": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).Behavior, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.horizontalpodautoscaler.spec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHorizontalpodautoscaler).Spec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sPoddisruptionbudget).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.poddisruptionbudget.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.minAvailable": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).MinAvailable, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.maxUnavailable": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).MaxUnavailable, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.selector": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).Selector, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.unhealthyPodEvictionPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).UnhealthyPodEvictionPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.poddisruptionbudget.spec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPoddisruptionbudget).Spec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sResourcequota).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.resourcequota.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.hard": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).Hard, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.used": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).Used, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.scopes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).Scopes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.scopeSelector": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).ScopeSelector, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.resourcequota.spec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sResourcequota).Spec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.limitrange.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sLimitrange).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.limitrange.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sLimitrange).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.limitrange.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sLimitrange).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.limitrange.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sLimitrange).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.limitrange.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sLimitrange).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.limitrange.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sLimitrange).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.limitrange.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sLimitrange).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.limitrange.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sLimitrange).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.limitrange.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sLimitrange).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.limitrange.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sLimitrange).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.limitrange.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sLimitrange).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.limitrange.limits": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sLimitrange).Limits, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.limitrange.spec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sLimitrange).Spec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.priorityclass.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sPriorityclass).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.priorityclass.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPriorityclass).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.priorityclass.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPriorityclass).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.priorityclass.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPriorityclass).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.priorityclass.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPriorityclass).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.priorityclass.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPriorityclass).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.priorityclass.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPriorityclass).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.priorityclass.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPriorityclass).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.priorityclass.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPriorityclass).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.priorityclass.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPriorityclass).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.priorityclass.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPriorityclass).Value, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"k8s.priorityclass.globalDefault": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPriorityclass).GlobalDefault, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"k8s.priorityclass.preemptionPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPriorityclass).PreemptionPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.priorityclass.description": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sPriorityclass).Description, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.validatingwebhookconfiguration.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sValidatingwebhookconfiguration).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.validatingwebhookconfiguration.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sValidatingwebhookconfiguration).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.validatingwebhookconfiguration.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sValidatingwebhookconfiguration).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.validatingwebhookconfiguration.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sValidatingwebhookconfiguration).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.validatingwebhookconfiguration.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sValidatingwebhookconfiguration).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.validatingwebhookconfiguration.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sValidatingwebhookconfiguration).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.validatingwebhookconfiguration.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sValidatingwebhookconfiguration).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.validatingwebhookconfiguration.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sValidatingwebhookconfiguration).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.validatingwebhookconfiguration.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sValidatingwebhookconfiguration).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.validatingwebhookconfiguration.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sValidatingwebhookconfiguration).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.validatingwebhookconfiguration.webhooks": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sValidatingwebhookconfiguration).Webhooks, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.mutatingwebhookconfiguration.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sMutatingwebhookconfiguration).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.mutatingwebhookconfiguration.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sMutatingwebhookconfiguration).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.mutatingwebhookconfiguration.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sMutatingwebhookconfiguration).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.mutatingwebhookconfiguration.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sMutatingwebhookconfiguration).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.mutatingwebhookconfiguration.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sMutatingwebhookconfiguration).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.mutatingwebhookconfiguration.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sMutatingwebhookconfiguration).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.mutatingwebhookconfiguration.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sMutatingwebhookconfiguration).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.mutatingwebhookconfiguration.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sMutatingwebhookconfiguration).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.mutatingwebhookconfiguration.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sMutatingwebhookconfiguration).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.mutatingwebhookconfiguration.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sMutatingwebhookconfiguration).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.mutatingwebhookconfiguration.webhooks": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sMutatingwebhookconfiguration).Webhooks, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sAdmissionwebhook).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.admissionwebhook.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.failurePolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).FailurePolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.matchPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).MatchPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.sideEffects": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).SideEffects, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.timeoutSeconds": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).TimeoutSeconds, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.admissionReviewVersions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).AdmissionReviewVersions, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.rules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).Rules, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.namespaceSelector": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).NamespaceSelector, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.objectSelector": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).ObjectSelector, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.matchConditions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).MatchConditions, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.reinvocationPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).ReinvocationPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.service": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).Service, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.url": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).Url, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.caBundle": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).CaBundle, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionwebhook.caBundleCertificates": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionwebhook).CaBundleCertificates, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.gateway.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sGateway).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.gateway.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sGateway).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.gateway.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sGateway).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.gateway.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sGateway).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.gateway.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sGateway).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.gateway.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sGateway).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.gateway.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sGateway).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.gateway.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sGateway).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.gateway.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sGateway).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.gateway.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sGateway).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.gateway.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sGateway).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.gateway.gatewayClassName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sGateway).GatewayClassName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.gateway.listeners": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sGateway).Listeners, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.gateway.addresses": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sGateway).Addresses, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.gateway.spec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sGateway).Spec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.httproute.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sHttproute).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.httproute.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHttproute).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.httproute.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHttproute).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.httproute.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHttproute).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.httproute.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHttproute).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.httproute.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHttproute).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.httproute.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHttproute).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.httproute.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHttproute).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.httproute.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHttproute).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.httproute.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHttproute).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.httproute.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHttproute).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.httproute.parentRefs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHttproute).ParentRefs, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.httproute.hostnames": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHttproute).Hostnames, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.httproute.rules": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHttproute).Rules, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.httproute.spec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sHttproute).Spec, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.event.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sEvent).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.event.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.event.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.event.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.event.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.event.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.event.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.event.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.event.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.event.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.event.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.event.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.event.reason": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).Reason, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.event.message": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).Message, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.event.involvedObject": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).InvolvedObject, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.event.source": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).Source, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.event.count": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).Count, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"k8s.event.firstTimestamp": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).FirstTimestamp, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.event.lastTimestamp": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sEvent).LastTimestamp, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.customresource.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sCustomresource).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.customresource.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCustomresource).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.customresource.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCustomresource).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.customresource.resourceVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCustomresource).ResourceVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.customresource.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCustomresource).Labels, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.customresource.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCustomresource).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.customresource.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCustomresource).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.customresource.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCustomresource).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.customresource.kind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCustomresource).Kind, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.customresource.created": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCustomresource).Created, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.customresource.manifest": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sCustomresource).Manifest, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionreview.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sAdmissionreview).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.admissionreview.request": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionreview).Request, ok = plugin.RawToTValue[*mqlK8sAdmissionrequest](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionrequest.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sAdmissionrequest).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.admissionrequest.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionrequest).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionrequest.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionrequest).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionrequest.operation": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionrequest).Operation, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionrequest.userInfo": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionrequest).UserInfo, ok = plugin.RawToTValue[*mqlK8sUserinfo](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionrequest.object": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionrequest).Object, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.admissionrequest.oldObject": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAdmissionrequest).OldObject, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.userinfo.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sUserinfo).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.userinfo.username": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sUserinfo).Username, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.userinfo.uid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sUserinfo).Uid, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+}
+
+func SetData(resource plugin.Resource, field string, val *llx.RawData) error {
+	f, ok := setDataFields[resource.MqlName() + "." + field]
+	if !ok {
+		return errors.New("[k8s] cannot set '"+field+"' in resource '"+resource.MqlName()+"', field not found")
+	}
+
+	if ok := f(resource, val); !ok {
+		return errors.New("[k8s] cannot set '"+field+"' in resource '"+resource.MqlName()+"', type does not match")
+	}
+	return nil
+}
+
+func SetAllData(resource plugin.Resource, args map[string]*llx.RawData) error {
+	var err error
+	for k, v := range args {
+		if err = SetData(resource, k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mqlK8s for the k8s resource
+type mqlK8s struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sInternal
+	ServerVersion plugin.TValue[interface{}]
+	ApiResources plugin.TValue[[]interface{}]
+	Namespaces plugin.TValue[[]interface{}]
+	Nodes plugin.TValue[[]interface{}]
+	Pods plugin.TValue[[]interface{}]
+	Deployments plugin.TValue[[]interface{}]
+	Daemonsets plugin.TValue[[]interface{}]
+	Statefulsets plugin.TValue[[]interface{}]
+	Replicasets plugin.TValue[[]interface{}]
+	Jobs plugin.TValue[[]interface{}]
+	Cronjobs plugin.TValue[[]interface{}]
+	Secrets plugin.TValue[[]interface{}]
+	Configmaps plugin.TValue[[]interface{}]
+	Services plugin.TValue[[]interface{}]
+	Ingresses plugin.TValue[[]interface{}]
+	Serviceaccounts plugin.TValue[[]interface{}]
+	Clusterroles plugin.TValue[[]interface{}]
+	Clusterrolebindings plugin.TValue[[]interface{}]
+	Roles plugin.TValue[[]interface{}]
+	Rolebindings plugin.TValue[[]interface{}]
+	PodSecurityPolicies plugin.TValue[[]interface{}]
+	NetworkPolicies plugin.TValue[[]interface{}]
+	Customresources plugin.TValue[[]interface{}]
+	HelmReleases plugin.TValue[[]interface{}]
+	PersistentVolumes plugin.TValue[[]interface{}]
+	PersistentVolumeClaims plugin.TValue[[]interface{}]
+	StorageClasses plugin.TValue[[]interface{}]
+	HorizontalPodAutoscalers plugin.TValue[[]interface{}]
+	PodDisruptionBudgets plugin.TValue[[]interface{}]
+	ResourceQuotas plugin.TValue[[]interface{}]
+	LimitRanges plugin.TValue[[]interface{}]
+	PriorityClasses plugin.TValue[[]interface{}]
+	ValidatingWebhookConfigurations plugin.TValue[[]interface{}]
+	MutatingWebhookConfigurations plugin.TValue[[]interface{}]
+	Gateways plugin.TValue[[]interface{}]
+	HttpRoutes plugin.TValue[[]interface{}]
+	Events plugin.TValue[[]interface{}]
+}
+
+// createK8s creates a new instance of this resource
+func createK8s(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8s{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8s) MqlName() string {
+	return "k8s"
+}
+
+func (c *mqlK8s) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8s) GetServerVersion() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.ServerVersion, func() (interface{}, error) {
+		return c.serverVersion()
+	})
+}
+
+func (c *mqlK8s) GetApiResources() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.ApiResources, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "apiResources")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.apiResources()
+	})
+}
+
+func (c *mqlK8s) GetNamespaces() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Namespaces, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "namespaces")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.namespaces()
+	})
+}
+
+func (c *mqlK8s) GetNodes() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Nodes, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "nodes")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.nodes()
+	})
+}
+
+func (c *mqlK8s) GetPods() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Pods, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "pods")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.pods()
+	})
+}
+
+func (c *mqlK8s) GetDeployments() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Deployments, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "deployments")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.deployments()
+	})
+}
+
+func (c *mqlK8s) GetDaemonsets() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Daemonsets, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "daemonsets")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.daemonsets()
+	})
+}
+
+func (c *mqlK8s) GetStatefulsets() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Statefulsets, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "statefulsets")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.statefulsets()
+	})
+}
+
+func (c *mqlK8s) GetReplicasets() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Replicasets, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "replicasets")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.replicasets()
+	})
+}
+
+func (c *mqlK8s) GetJobs() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Jobs, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "jobs")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.jobs()
+	})
+}
+
+func (c *mqlK8s) GetCronjobs() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Cronjobs, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "cronjobs")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.cronjobs()
+	})
+}
+
+func (c *mqlK8s) GetSecrets() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Secrets, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "secrets")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.secrets()
+	})
+}
+
+func (c *mqlK8s) GetConfigmaps() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Configmaps, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "configmaps")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.configmaps()
+	})
+}
+
+func (c *mqlK8s) GetServices() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Services, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "services")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.services()
+	})
+}
+
+func (c *mqlK8s) GetIngresses() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Ingresses, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "ingresses")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.ingresses()
+	})
+}
+
+func (c *mqlK8s) GetServiceaccounts() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Serviceaccounts, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "serviceaccounts")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.serviceaccounts()
+	})
+}
+
+func (c *mqlK8s) GetClusterroles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Clusterroles, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "clusterroles")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.clusterroles()
+	})
+}
+
+func (c *mqlK8s) GetClusterrolebindings() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Clusterrolebindings, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "clusterrolebindings")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.clusterrolebindings()
+	})
+}
+
+func (c *mqlK8s) GetRoles() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Roles, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "roles")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.roles()
+	})
+}
+
+func (c *mqlK8s) GetRolebindings() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Rolebindings, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "rolebindings")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.rolebindings()
+	})
+}
+
+func (c *mqlK8s) GetPodSecurityPolicies() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.PodSecurityPolicies, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "podSecurityPolicies")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.podSecurityPolicies()
+	})
+}
+
+func (c *mqlK8s) GetNetworkPolicies() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.NetworkPolicies, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "networkPolicies")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.networkPolicies()
+	})
+}
+
+func (c *mqlK8s) GetCustomresources() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Customresources, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "customresources")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.customresources()
+	})
+}
+
+func (c *mqlK8s) GetHelmReleases() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.HelmReleases, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "helmReleases")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.helmReleases()
+	})
+}
+
+func (c *mqlK8s) GetPersistentVolumes() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.PersistentVolumes, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "persistentVolumes")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.persistentVolumes()
+	})
+}
+
+func (c *mqlK8s) GetPersistentVolumeClaims() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.PersistentVolumeClaims, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "persistentVolumeClaims")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.persistentVolumeClaims()
+	})
+}
+
+func (c *mqlK8s) GetStorageClasses() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.StorageClasses, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "storageClasses")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.storageClasses()
+	})
+}
+
+func (c *mqlK8s) GetHorizontalPodAutoscalers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.HorizontalPodAutoscalers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "horizontalPodAutoscalers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.horizontalPodAutoscalers()
+	})
+}
+
+func (c *mqlK8s) GetPodDisruptionBudgets() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.PodDisruptionBudgets, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "podDisruptionBudgets")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.podDisruptionBudgets()
+	})
+}
+
+func (c *mqlK8s) GetResourceQuotas() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.ResourceQuotas, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "resourceQuotas")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.resourceQuotas()
+	})
+}
+
+func (c *mqlK8s) GetLimitRanges() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.LimitRanges, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "limitRanges")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.limitRanges()
+	})
+}
+
+func (c *mqlK8s) GetPriorityClasses() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.PriorityClasses, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "priorityClasses")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.priorityClasses()
+	})
+}
+
+func (c *mqlK8s) GetValidatingWebhookConfigurations() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.ValidatingWebhookConfigurations, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "validatingWebhookConfigurations")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.validatingWebhookConfigurations()
+	})
+}
+
+func (c *mqlK8s) GetMutatingWebhookConfigurations() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.MutatingWebhookConfigurations, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "mutatingWebhookConfigurations")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.mutatingWebhookConfigurations()
+	})
+}
+
+func (c *mqlK8s) GetGateways() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Gateways, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "gateways")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.gateways()
+	})
+}
+
+func (c *mqlK8s) GetHttpRoutes() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.HttpRoutes, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "httpRoutes")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.httpRoutes()
+	})
+}
+
+func (c *mqlK8s) GetEvents() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Events, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "events")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.events()
+	})
+}
+
+// mqlK8sApiresource for the k8s.apiresource resource
+type mqlK8sApiresource struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlK8sApiresourceInternal it will be used here
+	Name plugin.TValue[string]
+	SingularName plugin.TValue[string]
+	Namespaced plugin.TValue[bool]
+	Group plugin.TValue[string]
+	Version plugin.TValue[string]
+	Kind plugin.TValue[string]
+	ShortNames plugin.TValue[[]interface{}]
+	Categories plugin.TValue[[]interface{}]
+}
+
+// createK8sApiresource creates a new instance of this resource
+func createK8sApiresource(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sApiresource{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.apiresource", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sApiresource) MqlName() string {
+	return "k8s.apiresource"
+}
+
+func (c *mqlK8sApiresource) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sApiresource) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sApiresource) GetSingularName() *plugin.TValue[string] {
+	return &c.SingularName
+}
+
+func (c *mqlK8sApiresource) GetNamespaced() *plugin.TValue[bool] {
+	return &c.Namespaced
+}
+
+func (c *mqlK8sApiresource) GetGroup() *plugin.TValue[string] {
+	return &c.Group
+}
+
+func (c *mqlK8sApiresource) GetVersion() *plugin.TValue[string] {
+	return &c.Version
+}
+
+func (c *mqlK8sApiresource) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
+
+func (c *mqlK8sApiresource) GetShortNames() *plugin.TValue[[]interface{}] {
+	return &c.ShortNames
+}
+
+func (c *mqlK8sApiresource) GetCategories() *plugin.TValue[[]interface{}] {
+	return &c.Categories
+}
+
+// mqlK8sNamespace for the k8s.namespace resource
+type mqlK8sNamespace struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sNamespaceInternal
+	Id plugin.TValue[string]
+	Uid plugin.TValue[string]
+	Name plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	Kind plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	PodSecurityEnforce plugin.TValue[string]
+	PodSecurityEnforceVersion plugin.TValue[string]
+	PodSecurityAudit plugin.TValue[string]
+	PodSecurityAuditVersion plugin.TValue[string]
+	PodSecurityWarn plugin.TValue[string]
+	PodSecurityWarnVersion plugin.TValue[string]
+}
+
+// createK8sNamespace creates a new instance of this resource
+func createK8sNamespace(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sNamespace{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.namespace", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sNamespace) MqlName() string {
+	return "k8s.namespace"
+}
+
+func (c *mqlK8sNamespace) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sNamespace) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlK8sNamespace) GetUid() *plugin.TValue[string] {
+	return &c.Uid
+}
+
+func (c *mqlK8sNamespace) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sNamespace) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
+}
+
+func (c *mqlK8sNamespace) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
+}
+
+func (c *mqlK8sNamespace) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
+
+func (c *mqlK8sNamespace) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
+	})
+}
+
+func (c *mqlK8sNamespace) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
+
+func (c *mqlK8sNamespace) GetPodSecurityEnforce() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PodSecurityEnforce, func() (string, error) {
+		return c.podSecurityEnforce()
+	})
+}
+
+func (c *mqlK8sNamespace) GetPodSecurityEnforceVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PodSecurityEnforceVersion, func() (string, error) {
+		return c.podSecurityEnforceVersion()
+	})
+}
+
+func (c *mqlK8sNamespace) GetPodSecurityAudit() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PodSecurityAudit, func() (string, error) {
+		return c.podSecurityAudit()
+	})
+}
+
+func (c *mqlK8sNamespace) GetPodSecurityAuditVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PodSecurityAuditVersion, func() (string, error) {
+		return c.podSecurityAuditVersion()
+	})
+}
+
+func (c *mqlK8sNamespace) GetPodSecurityWarn() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PodSecurityWarn, func() (string, error) {
+		return c.podSecurityWarn()
+	})
+}
+
+func (c *mqlK8sNamespace) GetPodSecurityWarnVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PodSecurityWarnVersion, func() (string, error) {
+		return c.podSecurityWarnVersion()
+	})
+}
+
+// mqlK8sNode for the k8s.node resource
+type mqlK8sNode struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sNodeInternal
+	Id plugin.TValue[string]
+	Uid plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	ResourceVersion plugin.TValue[string]
+	Name plugin.TValue[string]
+	Kind plugin.TValue[string]
+}
+
+// createK8sNode creates a new instance of this resource
+func createK8sNode(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sNode{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.node", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sNode) MqlName() string {
+	return "k8s.node"
+}
+
+func (c *mqlK8sNode) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sNode) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlK8sNode) GetUid() *plugin.TValue[string] {
+	return &c.Uid
+}
+
+func (c *mqlK8sNode) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
+	})
+}
+
+func (c *mqlK8sNode) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
+
+func (c *mqlK8sNode) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
+}
+
+func (c *mqlK8sNode) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sNode) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
+
+// mqlK8sPod for the k8s.pod resource
+type mqlK8sPod struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sPodInternal
+	Id plugin.TValue[string]
+	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	Name plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	ApiVersion plugin.TValue[string]
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	PodSpec plugin.TValue[interface{}]
+	EphemeralContainers plugin.TValue[[]interface{}]
+	InitContainers plugin.TValue[[]interface{}]
+	Containers plugin.TValue[[]interface{}]
+	Node plugin.TValue[*mqlK8sNode]
+	DefaultDenyIngress plugin.TValue[bool]
+	DefaultDenyEgress plugin.TValue[bool]
+	ReachableFrom plugin.TValue[[]interface{}]
+	PodSecurityLevel plugin.TValue[string]
+	PodSecurityViolations plugin.TValue[[]interface{}]
+}
+
+// createK8sPod creates a new instance of this resource
+func createK8sPod(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sPod{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.pod", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sPod) MqlName() string {
+	return "k8s.pod"
+}
+
+func (c *mqlK8sPod) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sPod) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlK8sPod) GetUid() *plugin.TValue[string] {
+	return &c.Uid
+}
+
+func (c *mqlK8sPod) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
+}
+
+func (c *mqlK8sPod) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
+	})
+}
+
+func (c *mqlK8sPod) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
+
+func (c *mqlK8sPod) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sPod) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
+
+func (c *mqlK8sPod) GetApiVersion() *plugin.TValue[string] {
+	return &c.ApiVersion
+}
+
+func (c *mqlK8sPod) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
+
+func (c *mqlK8sPod) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
+}
+
+func (c *mqlK8sPod) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
+}
+
+func (c *mqlK8sPod) GetPodSpec() *plugin.TValue[interface{}] {
+	return &c.PodSpec
+}
+
+func (c *mqlK8sPod) GetEphemeralContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.EphemeralContainers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.pod", c.__id, "ephemeralContainers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.ephemeralContainers()
+	})
+}
+
+func (c *mqlK8sPod) GetInitContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.InitContainers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.pod", c.__id, "initContainers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.initContainers()
+	})
+}
+
+func (c *mqlK8sPod) GetContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.pod", c.__id, "containers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.containers()
+	})
+}
+
+func (c *mqlK8sPod) GetNode() *plugin.TValue[*mqlK8sNode] {
+	return plugin.GetOrCompute[*mqlK8sNode](&c.Node, func() (*mqlK8sNode, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.pod", c.__id, "node")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlK8sNode), nil
+			}
+		}
+
+		return c.node()
+	})
+}
+
+func (c *mqlK8sPod) GetDefaultDenyIngress() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.DefaultDenyIngress, func() (bool, error) {
+		return c.defaultDenyIngress()
+	})
+}
+
+func (c *mqlK8sPod) GetDefaultDenyEgress() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.DefaultDenyEgress, func() (bool, error) {
+		return c.defaultDenyEgress()
+	})
+}
+
+func (c *mqlK8sPod) GetReachableFrom() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.ReachableFrom, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.pod", c.__id, "reachableFrom")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.reachableFrom()
+	})
+}
+
+func (c *mqlK8sPod) GetPodSecurityLevel() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PodSecurityLevel, func() (string, error) {
+		return c.podSecurityLevel()
+	})
+}
+
+func (c *mqlK8sPod) GetPodSecurityViolations() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.PodSecurityViolations, func() ([]interface{}, error) {
+		return c.podSecurityViolations()
+	})
+}
+
+// mqlK8sPodCanReach for the k8s.pod.canReach resource
+type mqlK8sPodCanReach struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlK8sPodCanReachInternal it will be used here
+	From plugin.TValue[string]
+	To plugin.TValue[string]
+	Port plugin.TValue[int64]
+	Protocol plugin.TValue[string]
+	Allowed plugin.TValue[bool]
+}
+
+// createK8sPodCanReach creates a new instance of this resource
+func createK8sPodCanReach(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sPodCanReach{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.pod.canReach", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sPodCanReach) MqlName() string {
+	return "k8s.pod.canReach"
+}
+
+func (c *mqlK8sPodCanReach) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sPodCanReach) GetFrom() *plugin.TValue[string] {
+	return &c.From
+}
+
+func (c *mqlK8sPodCanReach) GetTo() *plugin.TValue[string] {
+	return &c.To
+}
+
+func (c *mqlK8sPodCanReach) GetPort() *plugin.TValue[int64] {
+	return &c.Port
+}
+
+func (c *mqlK8sPodCanReach) GetProtocol() *plugin.TValue[string] {
+	return &c.Protocol
+}
+
+func (c *mqlK8sPodCanReach) GetAllowed() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Allowed, func() (bool, error) {
+		return c.allowed()
+	})
+}
+
+// mqlK8sDeployment for the k8s.deployment resource
+type mqlK8sDeployment struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sDeploymentInternal
+	Id plugin.TValue[string]
+	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	Name plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	PodSpec plugin.TValue[interface{}]
+	InitContainers plugin.TValue[[]interface{}]
+	Containers plugin.TValue[[]interface{}]
+	PodSecurityLevel plugin.TValue[string]
+	PodSecurityViolations plugin.TValue[[]interface{}]
+}
+
+// createK8sDeployment creates a new instance of this resource
+func createK8sDeployment(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sDeployment{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.deployment", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sDeployment) MqlName() string {
+	return "k8s.deployment"
+}
+
+func (c *mqlK8sDeployment) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sDeployment) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlK8sDeployment) GetUid() *plugin.TValue[string] {
+	return &c.Uid
+}
+
+func (c *mqlK8sDeployment) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
+}
+
+func (c *mqlK8sDeployment) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
+	})
+}
+
+func (c *mqlK8sDeployment) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
+
+func (c *mqlK8sDeployment) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sDeployment) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
+
+func (c *mqlK8sDeployment) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
+
+func (c *mqlK8sDeployment) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
+}
+
+func (c *mqlK8sDeployment) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
+}
+
+func (c *mqlK8sDeployment) GetPodSpec() *plugin.TValue[interface{}] {
+	return &c.PodSpec
+}
+
+func (c *mqlK8sDeployment) GetInitContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.InitContainers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.deployment", c.__id, "initContainers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.initContainers()
+	})
+}
+
+func (c *mqlK8sDeployment) GetContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.deployment", c.__id, "containers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.containers()
+	})
+}
+
+func (c *mqlK8sDeployment) GetPodSecurityLevel() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PodSecurityLevel, func() (string, error) {
+		return c.podSecurityLevel()
+	})
+}
+
+func (c *mqlK8sDeployment) GetPodSecurityViolations() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.PodSecurityViolations, func() ([]interface{}, error) {
+		return c.podSecurityViolations()
+	})
+}
+
+// mqlK8sDaemonset for the k8s.daemonset resource
+type mqlK8sDaemonset struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sDaemonsetInternal
+	Id plugin.TValue[string]
+	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	Name plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	PodSpec plugin.TValue[interface{}]
+	InitContainers plugin.TValue[[]interface{}]
+	Containers plugin.TValue[[]interface{}]
+	PodSecurityLevel plugin.TValue[string]
+	PodSecurityViolations plugin.TValue[[]interface{}]
+}
+
+// createK8sDaemonset creates a new instance of this resource
+func createK8sDaemonset(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sDaemonset{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.daemonset", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sDaemonset) MqlName() string {
+	return "k8s.daemonset"
+}
+
+func (c *mqlK8sDaemonset) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sDaemonset) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlK8sDaemonset) GetUid() *plugin.TValue[string] {
+	return &c.Uid
+}
+
+func (c *mqlK8sDaemonset) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
+}
+
+func (c *mqlK8sDaemonset) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
+	})
+}
+
+func (c *mqlK8sDaemonset) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
+
+func (c *mqlK8sDaemonset) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sDaemonset) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
+
+func (c *mqlK8sDaemonset) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
+
+func (c *mqlK8sDaemonset) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
+}
+
+func (c *mqlK8sDaemonset) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
+}
+
+func (c *mqlK8sDaemonset) GetPodSpec() *plugin.TValue[interface{}] {
+	return &c.PodSpec
+}
+
+func (c *mqlK8sDaemonset) GetInitContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.InitContainers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.daemonset", c.__id, "initContainers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.initContainers()
+	})
+}
+
+func (c *mqlK8sDaemonset) GetContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.daemonset", c.__id, "containers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.containers()
+	})
+}
+
+func (c *mqlK8sDaemonset) GetPodSecurityLevel() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PodSecurityLevel, func() (string, error) {
+		return c.podSecurityLevel()
+	})
+}
+
+func (c *mqlK8sDaemonset) GetPodSecurityViolations() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.PodSecurityViolations, func() ([]interface{}, error) {
+		return c.podSecurityViolations()
+	})
+}
+
+// mqlK8sStatefulset for the k8s.statefulset resource
+type mqlK8sStatefulset struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sStatefulsetInternal
+	Id plugin.TValue[string]
+	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	Name plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	PodSpec plugin.TValue[interface{}]
+	InitContainers plugin.TValue[[]interface{}]
+	Containers plugin.TValue[[]interface{}]
+	PodSecurityLevel plugin.TValue[string]
+	PodSecurityViolations plugin.TValue[[]interface{}]
+}
+
+// createK8sStatefulset creates a new instance of this resource
+func createK8sStatefulset(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sStatefulset{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.statefulset", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sStatefulset) MqlName() string {
+	return "k8s.statefulset"
+}
+
+func (c *mqlK8sStatefulset) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sStatefulset) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlK8sStatefulset) GetUid() *plugin.TValue[string] {
+	return &c.Uid
+}
+
+func (c *mqlK8sStatefulset) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
+}
+
+func (c *mqlK8sStatefulset) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
+	})
+}
+
+func (c *mqlK8sStatefulset) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
+
+func (c *mqlK8sStatefulset) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sStatefulset) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
+
+func (c *mqlK8sStatefulset) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
+
+func (c *mqlK8sStatefulset) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
+}
+
+func (c *mqlK8sStatefulset) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
+}
+
+func (c *mqlK8sStatefulset) GetPodSpec() *plugin.TValue[interface{}] {
+	return &c.PodSpec
+}
+
+func (c *mqlK8sStatefulset) GetInitContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.InitContainers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.statefulset", c.__id, "initContainers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.initContainers()
+	})
+}
+
+func (c *mqlK8sStatefulset) GetContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.statefulset", c.__id, "containers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.containers()
+	})
+}
+
+func (c *mqlK8sStatefulset) GetPodSecurityLevel() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PodSecurityLevel, func() (string, error) {
+		return c.podSecurityLevel()
+	})
+}
+
+func (c *mqlK8sStatefulset) GetPodSecurityViolations() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.PodSecurityViolations, func() ([]interface{}, error) {
+		return c.podSecurityViolations()
+	})
+}
+
+// mqlK8sReplicaset for the k8s.replicaset resource
+type mqlK8sReplicaset struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sReplicasetInternal
+	Id plugin.TValue[string]
+	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	Name plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	PodSpec plugin.TValue[interface{}]
+	InitContainers plugin.TValue[[]interface{}]
+	Containers plugin.TValue[[]interface{}]
+}
+
+// createK8sReplicaset creates a new instance of this resource
+func createK8sReplicaset(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sReplicaset{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.replicaset", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sReplicaset) MqlName() string {
+	return "k8s.replicaset"
+}
+
+func (c *mqlK8sReplicaset) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sReplicaset) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlK8sReplicaset) GetUid() *plugin.TValue[string] {
+	return &c.Uid
+}
+
+func (c *mqlK8sReplicaset) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
+}
+
+func (c *mqlK8sReplicaset) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
+	})
+}
+
+func (c *mqlK8sReplicaset) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
+
+func (c *mqlK8sReplicaset) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sReplicaset) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
+
+func (c *mqlK8sReplicaset) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
+
+func (c *mqlK8sReplicaset) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
+}
+
+func (c *mqlK8sReplicaset) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
+}
+
+func (c *mqlK8sReplicaset) GetPodSpec() *plugin.TValue[interface{}] {
+	return &c.PodSpec
+}
+
+func (c *mqlK8sReplicaset) GetInitContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.InitContainers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.replicaset", c.__id, "initContainers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.initContainers()
+	})
+}
+
+func (c *mqlK8sReplicaset) GetContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.replicaset", c.__id, "containers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.containers()
+	})
+}
+
+// mqlK8sJob for the k8s.job resource
+type mqlK8sJob struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sJobInternal
+	Id plugin.TValue[string]
+	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	Name plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	PodSpec plugin.TValue[interface{}]
+	InitContainers plugin.TValue[[]interface{}]
+	Containers plugin.TValue[[]interface{}]
+	PodSecurityLevel plugin.TValue[string]
+	PodSecurityViolations plugin.TValue[[]interface{}]
+}
+
+// createK8sJob creates a new instance of this resource
+func createK8sJob(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sJob{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.job", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sJob) MqlName() string {
+	return "k8s.job"
+}
+
+func (c *mqlK8sJob) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sJob) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlK8sJob) GetUid() *plugin.TValue[string] {
+	return &c.Uid
+}
+
+func (c *mqlK8sJob) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
+}
+
+func (c *mqlK8sJob) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
+	})
+}
+
+func (c *mqlK8sJob) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
+
+func (c *mqlK8sJob) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sJob) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
+
+func (c *mqlK8sJob) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
+
+func (c *mqlK8sJob) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
+}
+
+func (c *mqlK8sJob) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
+}
+
+func (c *mqlK8sJob) GetPodSpec() *plugin.TValue[interface{}] {
+	return &c.PodSpec
+}
+
+func (c *mqlK8sJob) GetInitContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.InitContainers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.job", c.__id, "initContainers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.initContainers()
+	})
+}
+
+func (c *mqlK8sJob) GetContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.job", c.__id, "containers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.containers()
+	})
+}
+
+func (c *mqlK8sJob) GetPodSecurityLevel() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PodSecurityLevel, func() (string, error) {
+		return c.podSecurityLevel()
+	})
+}
+
+func (c *mqlK8sJob) GetPodSecurityViolations() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.PodSecurityViolations, func() ([]interface{}, error) {
+		return c.podSecurityViolations()
+	})
+}
+
+// mqlK8sCronjob for the k8s.cronjob resource
+type mqlK8sCronjob struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sCronjobInternal
+	Id plugin.TValue[string]
+	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	Name plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	PodSpec plugin.TValue[interface{}]
+	InitContainers plugin.TValue[[]interface{}]
+	Containers plugin.TValue[[]interface{}]
+	PodSecurityLevel plugin.TValue[string]
+	PodSecurityViolations plugin.TValue[[]interface{}]
+}
+
+// createK8sCronjob creates a new instance of this resource
+func createK8sCronjob(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sCronjob{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.cronjob", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sCronjob) MqlName() string {
+	return "k8s.cronjob"
+}
+
+func (c *mqlK8sCronjob) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sCronjob) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlK8sCronjob) GetUid() *plugin.TValue[string] {
+	return &c.Uid
+}
+
+func (c *mqlK8sCronjob) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
+}
+
+func (c *mqlK8sCronjob) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
+	})
+}
+
+func (c *mqlK8sCronjob) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
+
+func (c *mqlK8sCronjob) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sCronjob) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
+
+func (c *mqlK8sCronjob) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
+
+func (c *mqlK8sCronjob) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
+}
+
+func (c *mqlK8sCronjob) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
+}
+
+func (c *mqlK8sCronjob) GetPodSpec() *plugin.TValue[interface{}] {
+	return &c.PodSpec
+}
+
+func (c *mqlK8sCronjob) GetInitContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.InitContainers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.cronjob", c.__id, "initContainers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.initContainers()
+	})
+}
+
+func (c *mqlK8sCronjob) GetContainers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.cronjob", c.__id, "containers")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.containers()
+	})
+}
+
+func (c *mqlK8sCronjob) GetPodSecurityLevel() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PodSecurityLevel, func() (string, error) {
+		return c.podSecurityLevel()
+	})
+}
+
+func (c *mqlK8sCronjob) GetPodSecurityViolations() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.PodSecurityViolations, func() ([]interface{}, error) {
+		return c.podSecurityViolations()
+	})
+}
+
+// mqlK8sContainer for the k8s.container resource
+type mqlK8sContainer struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sContainerInternal
+	Uid plugin.TValue[string]
+	Name plugin.TValue[string]
+	ImageName plugin.TValue[string]
+	Image plugin.TValue[string]
+	ContainerImage plugin.TValue[plugin.Resource]
+	ImageMetadata plugin.TValue[*mqlK8sContainerImage]
+	Command plugin.TValue[[]interface{}]
+	Args plugin.TValue[[]interface{}]
+	Resources plugin.TValue[interface{}]
+	VolumeMounts plugin.TValue[[]interface{}]
+	VolumeDevices plugin.TValue[[]interface{}]
+	LivenessProbe plugin.TValue[interface{}]
+	ReadinessProbe plugin.TValue[interface{}]
+	ImagePullPolicy plugin.TValue[string]
+	SecurityContext plugin.TValue[interface{}]
+	WorkingDir plugin.TValue[string]
+	Tty plugin.TValue[bool]
+	Env plugin.TValue[interface{}]
+	EnvFrom plugin.TValue[interface{}]
+}
+
+// createK8sContainer creates a new instance of this resource
+func createK8sContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sContainer{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.container", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sContainer) MqlName() string {
+	return "k8s.container"
+}
+
+func (c *mqlK8sContainer) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sContainer) GetUid() *plugin.TValue[string] {
+	return &c.Uid
+}
+
+func (c *mqlK8sContainer) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sContainer) GetImageName() *plugin.TValue[string] {
+	return &c.ImageName
+}
+
+func (c *mqlK8sContainer) GetImage() *plugin.TValue[string] {
+	return &c.Image
+}
+
+func (c *mqlK8sContainer) GetContainerImage() *plugin.TValue[plugin.Resource] {
+	return plugin.GetOrCompute[plugin.Resource](&c.ContainerImage, func() (plugin.Resource, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.container", c.__id, "containerImage")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(plugin.Resource), nil
+			}
+		}
+
+		return c.containerImage()
+	})
+}
+
+func (c *mqlK8sContainer) GetImageMetadata() *plugin.TValue[*mqlK8sContainerImage] {
+	return plugin.GetOrCompute[*mqlK8sContainerImage](&c.ImageMetadata, func() (*mqlK8sContainerImage, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.container", c.__id, "imageMetadata")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlK8sContainerImage), nil
+			}
+		}
+
+		return c.imageMetadata()
+	})
+}
+
+func (c *mqlK8sContainer) GetCommand() *plugin.TValue[[]interface{}] {
+	return &c.Command
+}
+
+func (c *mqlK8sContainer) GetArgs() *plugin.TValue[[]interface{}] {
+	return &c.Args
+}
+
+func (c *mqlK8sContainer) GetResources() *plugin.TValue[interface{}] {
+	return &c.Resources
+}
+
+func (c *mqlK8sContainer) GetVolumeMounts() *plugin.TValue[[]interface{}] {
+	return &c.VolumeMounts
+}
+
+func (c *mqlK8sContainer) GetVolumeDevices() *plugin.TValue[[]interface{}] {
+	return &c.VolumeDevices
+}
+
+func (c *mqlK8sContainer) GetLivenessProbe() *plugin.TValue[interface{}] {
+	return &c.LivenessProbe
+}
+
+func (c *mqlK8sContainer) GetReadinessProbe() *plugin.TValue[interface{}] {
+	return &c.ReadinessProbe
+}
+
+func (c *mqlK8sContainer) GetImagePullPolicy() *plugin.TValue[string] {
+	return &c.ImagePullPolicy
+}
+
+func (c *mqlK8sContainer) GetSecurityContext() *plugin.TValue[interface{}] {
+	return &c.SecurityContext
+}
+
+func (c *mqlK8sContainer) GetWorkingDir() *plugin.TValue[string] {
+	return &c.WorkingDir
+}
+
+func (c *mqlK8sContainer) GetTty() *plugin.TValue[bool] {
+	return &c.Tty
+}
+
+func (c *mqlK8sContainer) GetEnv() *plugin.TValue[interface{}] {
+	return &c.Env
+}
+
+func (c *mqlK8sContainer) GetEnvFrom() *plugin.TValue[interface{}] {
+	return &c.EnvFrom
+}
+
+// mqlK8sContainerImage for the k8s.container.image resource
+type mqlK8sContainerImage struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sContainerImageInternal
+	Reference plugin.TValue[string]
+	Digest plugin.TValue[string]
+	IndexDigest plugin.TValue[string]
+	MediaType plugin.TValue[string]
+	Manifest plugin.TValue[interface{}]
+	Config plugin.TValue[interface{}]
+	Created plugin.TValue[*time.Time]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	BaseImageName plugin.TValue[string]
+	BaseImageDigest plugin.TValue[string]
+	User plugin.TValue[string]
+	ExposedPorts plugin.TValue[[]interface{}]
+	Entrypoint plugin.TValue[[]interface{}]
+	Cmd plugin.TValue[[]interface{}]
+	Env plugin.TValue[[]interface{}]
+	Os plugin.TValue[string]
+	Architecture plugin.TValue[string]
+	Referrers plugin.TValue[[]interface{}]
+}
+
+// createK8sContainerImage creates a new instance of this resource
+func createK8sContainerImage(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sContainerImage{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.container.image", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sContainerImage) MqlName() string {
+	return "k8s.container.image"
+}
+
+func (c *mqlK8sContainerImage) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sContainerImage) GetReference() *plugin.TValue[string] {
+	return &c.Reference
+}
+
+func (c *mqlK8sContainerImage) GetDigest() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Digest, func() (string, error) {
+		return c.digest()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetIndexDigest() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.IndexDigest, func() (string, error) {
+		return c.indexDigest()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetMediaType() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.MediaType, func() (string, error) {
+		return c.mediaType()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetManifest() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Manifest, func() (interface{}, error) {
+		return c.manifest()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetConfig() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Config, func() (interface{}, error) {
+		return c.config()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetCreated() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.Created, func() (*time.Time, error) {
+		return c.created()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetBaseImageName() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.BaseImageName, func() (string, error) {
+		return c.baseImageName()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetBaseImageDigest() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.BaseImageDigest, func() (string, error) {
+		return c.baseImageDigest()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetUser() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.User, func() (string, error) {
+		return c.user()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetExposedPorts() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.ExposedPorts, func() ([]interface{}, error) {
+		return c.exposedPorts()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetEntrypoint() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Entrypoint, func() ([]interface{}, error) {
+		return c.entrypoint()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetCmd() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Cmd, func() ([]interface{}, error) {
+		return c.cmd()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetEnv() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Env, func() ([]interface{}, error) {
+		return c.env()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetOs() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Os, func() (string, error) {
+		return c.os()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetArchitecture() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Architecture, func() (string, error) {
+		return c.architecture()
+	})
+}
+
+func (c *mqlK8sContainerImage) GetReferrers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Referrers, func() ([]interface{}, error) {
+		return c.referrers()
+	})
+}
+
+// mqlK8sInitContainer for the k8s.initContainer resource
+type mqlK8sInitContainer struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sInitContainerInternal
+	Uid plugin.TValue[string]
+	Name plugin.TValue[string]
+	ImageName plugin.TValue[string]
+	Image plugin.TValue[string]
+	ContainerImage plugin.TValue[plugin.Resource]
+	ImageMetadata plugin.TValue[*mqlK8sContainerImage]
+	Command plugin.TValue[[]interface{}]
+	Args plugin.TValue[[]interface{}]
+	Resources plugin.TValue[interface{}]
+	VolumeMounts plugin.TValue[[]interface{}]
+	VolumeDevices plugin.TValue[[]interface{}]
+	ImagePullPolicy plugin.TValue[string]
+	SecurityContext plugin.TValue[interface{}]
+	WorkingDir plugin.TValue[string]
+	Tty plugin.TValue[bool]
+	Env plugin.TValue[interface{}]
+	EnvFrom plugin.TValue[interface{}]
+}
+
+// createK8sInitContainer creates a new instance of this resource
+func createK8sInitContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sInitContainer{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.initContainer", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sInitContainer) MqlName() string {
+	return "k8s.initContainer"
+}
+
+func (c *mqlK8sInitContainer) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sInitContainer) GetUid() *plugin.TValue[string] {
+	return &c.Uid
+}
+
+func (c *mqlK8sInitContainer) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sInitContainer) GetImageName() *plugin.TValue[string] {
+	return &c.ImageName
+}
+
+func (c *mqlK8sInitContainer) GetImage() *plugin.TValue[string] {
+	return &c.Image
 }
 
-func SetData(resource plugin.Resource, field string, val *llx.RawData) error {
-	f, ok := setDataFields[resource.MqlName() + "." + field]
-	if !ok {
-		return errors.New("[k8s] cannot set '"+field+"' in resource '"+resource.MqlName()+"', field not found")
+func (c *mqlK8sInitContainer) GetContainerImage() *plugin.TValue[plugin.Resource] {
+	return plugin.GetOrCompute[plugin.Resource](&c.ContainerImage, func() (plugin.Resource, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.initContainer", c.__id, "containerImage")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(plugin.Resource), nil
+			}
+		}
+
+		return c.containerImage()
+	})
+}
+
+func (c *mqlK8sInitContainer) GetImageMetadata() *plugin.TValue[*mqlK8sContainerImage] {
+	return plugin.GetOrCompute[*mqlK8sContainerImage](&c.ImageMetadata, func() (*mqlK8sContainerImage, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.initContainer", c.__id, "imageMetadata")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlK8sContainerImage), nil
+			}
+		}
+
+		return c.imageMetadata()
+	})
+}
+
+func (c *mqlK8sInitContainer) GetCommand() *plugin.TValue[[]interface{}] {
+	return &c.Command
+}
+
+func (c *mqlK8sInitContainer) GetArgs() *plugin.TValue[[]interface{}] {
+	return &c.Args
+}
+
+func (c *mqlK8sInitContainer) GetResources() *plugin.TValue[interface{}] {
+	return &c.Resources
+}
+
+func (c *mqlK8sInitContainer) GetVolumeMounts() *plugin.TValue[[]interface{}] {
+	return &c.VolumeMounts
+}
+
+func (c *mqlK8sInitContainer) GetVolumeDevices() *plugin.TValue[[]interface{}] {
+	return &c.VolumeDevices
+}
+
+func (c *mqlK8sInitContainer) GetImagePullPolicy() *plugin.TValue[string] {
+	return &c.ImagePullPolicy
+}
+
+func (c *mqlK8sInitContainer) GetSecurityContext() *plugin.TValue[interface{}] {
+	return &c.SecurityContext
+}
+
+func (c *mqlK8sInitContainer) GetWorkingDir() *plugin.TValue[string] {
+	return &c.WorkingDir
+}
+
+func (c *mqlK8sInitContainer) GetTty() *plugin.TValue[bool] {
+	return &c.Tty
+}
+
+func (c *mqlK8sInitContainer) GetEnv() *plugin.TValue[interface{}] {
+	return &c.Env
+}
+
+func (c *mqlK8sInitContainer) GetEnvFrom() *plugin.TValue[interface{}] {
+	return &c.EnvFrom
+}
+
+// mqlK8sEphemeralContainer for the k8s.ephemeralContainer resource
+type mqlK8sEphemeralContainer struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sEphemeralContainerInternal
+	Uid plugin.TValue[string]
+	Name plugin.TValue[string]
+	ImageName plugin.TValue[string]
+	Image plugin.TValue[string]
+	ContainerImage plugin.TValue[plugin.Resource]
+	ImageMetadata plugin.TValue[*mqlK8sContainerImage]
+	Command plugin.TValue[[]interface{}]
+	Args plugin.TValue[[]interface{}]
+	VolumeMounts plugin.TValue[[]interface{}]
+	VolumeDevices plugin.TValue[[]interface{}]
+	ImagePullPolicy plugin.TValue[string]
+	SecurityContext plugin.TValue[interface{}]
+	WorkingDir plugin.TValue[string]
+	Tty plugin.TValue[bool]
+	Env plugin.TValue[interface{}]
+	EnvFrom plugin.TValue[interface{}]
+}
+
+// createK8sEphemeralContainer creates a new instance of this resource
+func createK8sEphemeralContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sEphemeralContainer{
+		MqlRuntime: runtime,
 	}
 
-	if ok := f(resource, val); !ok {
-		return errors.New("[k8s] cannot set '"+field+"' in resource '"+resource.MqlName()+"', type does not match")
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
 	}
-	return nil
-}
 
-func SetAllData(resource plugin.Resource, args map[string]*llx.RawData) error {
-	var err error
-	for k, v := range args {
-		if err = SetData(resource, k, v); err != nil {
-			return err
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
 		}
 	}
-	return nil
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.ephemeralContainer", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-// mqlK8s for the k8s resource
-type mqlK8s struct {
+func (c *mqlK8sEphemeralContainer) MqlName() string {
+	return "k8s.ephemeralContainer"
+}
+
+func (c *mqlK8sEphemeralContainer) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sEphemeralContainer) GetUid() *plugin.TValue[string] {
+	return &c.Uid
+}
+
+func (c *mqlK8sEphemeralContainer) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sEphemeralContainer) GetImageName() *plugin.TValue[string] {
+	return &c.ImageName
+}
+
+func (c *mqlK8sEphemeralContainer) GetImage() *plugin.TValue[string] {
+	return &c.Image
+}
+
+func (c *mqlK8sEphemeralContainer) GetContainerImage() *plugin.TValue[plugin.Resource] {
+	return plugin.GetOrCompute[plugin.Resource](&c.ContainerImage, func() (plugin.Resource, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.ephemeralContainer", c.__id, "containerImage")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(plugin.Resource), nil
+			}
+		}
+
+		return c.containerImage()
+	})
+}
+
+func (c *mqlK8sEphemeralContainer) GetImageMetadata() *plugin.TValue[*mqlK8sContainerImage] {
+	return plugin.GetOrCompute[*mqlK8sContainerImage](&c.ImageMetadata, func() (*mqlK8sContainerImage, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.ephemeralContainer", c.__id, "imageMetadata")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlK8sContainerImage), nil
+			}
+		}
+
+		return c.imageMetadata()
+	})
+}
+
+func (c *mqlK8sEphemeralContainer) GetCommand() *plugin.TValue[[]interface{}] {
+	return &c.Command
+}
+
+func (c *mqlK8sEphemeralContainer) GetArgs() *plugin.TValue[[]interface{}] {
+	return &c.Args
+}
+
+func (c *mqlK8sEphemeralContainer) GetVolumeMounts() *plugin.TValue[[]interface{}] {
+	return &c.VolumeMounts
+}
+
+func (c *mqlK8sEphemeralContainer) GetVolumeDevices() *plugin.TValue[[]interface{}] {
+	return &c.VolumeDevices
+}
+
+func (c *mqlK8sEphemeralContainer) GetImagePullPolicy() *plugin.TValue[string] {
+	return &c.ImagePullPolicy
+}
+
+func (c *mqlK8sEphemeralContainer) GetSecurityContext() *plugin.TValue[interface{}] {
+	return &c.SecurityContext
+}
+
+func (c *mqlK8sEphemeralContainer) GetWorkingDir() *plugin.TValue[string] {
+	return &c.WorkingDir
+}
+
+func (c *mqlK8sEphemeralContainer) GetTty() *plugin.TValue[bool] {
+	return &c.Tty
+}
+
+func (c *mqlK8sEphemeralContainer) GetEnv() *plugin.TValue[interface{}] {
+	return &c.Env
+}
+
+func (c *mqlK8sEphemeralContainer) GetEnvFrom() *plugin.TValue[interface{}] {
+	return &c.EnvFrom
+}
+
+// mqlK8sSecret for the k8s.secret resource
+type mqlK8sSecret struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sInternal
-	ServerVersion plugin.TValue[interface{}]
-	ApiResources plugin.TValue[[]interface{}]
-	Namespaces plugin.TValue[[]interface{}]
-	Nodes plugin.TValue[[]interface{}]
-	Pods plugin.TValue[[]interface{}]
-	Deployments plugin.TValue[[]interface{}]
-	Daemonsets plugin.TValue[[]interface{}]
-	Statefulsets plugin.TValue[[]interface{}]
-	Replicasets plugin.TValue[[]interface{}]
-	Jobs plugin.TValue[[]interface{}]
-	Cronjobs plugin.TValue[[]interface{}]
-	Secrets plugin.TValue[[]interface{}]
-	Configmaps plugin.TValue[[]interface{}]
-	Services plugin.TValue[[]interface{}]
-	Ingresses plugin.TValue[[]interface{}]
-	Serviceaccounts plugin.TValue[[]interface{}]
-	Clusterroles plugin.TValue[[]interface{}]
-	Clusterrolebindings plugin.TValue[[]interface{}]
-	Roles plugin.TValue[[]interface{}]
-	Rolebindings plugin.TValue[[]interface{}]
-	PodSecurityPolicies plugin.TValue[[]interface{}]
-	NetworkPolicies plugin.TValue[[]interface{}]
-	Customresources plugin.TValue[[]interface{}]
+	mqlK8sSecretInternal
+	Id plugin.TValue[string]
+	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	Name plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	Type plugin.TValue[string]
+	Certificates plugin.TValue[[]interface{}]
+	Findings plugin.TValue[[]interface{}]
+	MountedBy plugin.TValue[[]interface{}]
+	EnvReferencedBy plugin.TValue[[]interface{}]
+	ExposedViaEnv plugin.TValue[bool]
+	Unused plugin.TValue[bool]
 }
 
-// createK8s creates a new instance of this resource
-func createK8s(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8s{
+// createK8sSecret creates a new instance of this resource
+func createK8sSecret(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sSecret{
 		MqlRuntime: runtime,
 	}
 
@@ -2921,10 +8061,15 @@ func createK8s(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Re
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.secret", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -2934,24 +8079,66 @@ func createK8s(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Re
 	return res, nil
 }
 
-func (c *mqlK8s) MqlName() string {
-	return "k8s"
+func (c *mqlK8sSecret) MqlName() string {
+	return "k8s.secret"
 }
 
-func (c *mqlK8s) MqlID() string {
+func (c *mqlK8sSecret) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8s) GetServerVersion() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.ServerVersion, func() (interface{}, error) {
-		return c.serverVersion()
+func (c *mqlK8sSecret) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlK8sSecret) GetUid() *plugin.TValue[string] {
+	return &c.Uid
+}
+
+func (c *mqlK8sSecret) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
+}
+
+func (c *mqlK8sSecret) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
 	})
 }
 
-func (c *mqlK8s) GetApiResources() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.ApiResources, func() ([]interface{}, error) {
+func (c *mqlK8sSecret) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
+
+func (c *mqlK8sSecret) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sSecret) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
+
+func (c *mqlK8sSecret) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
+
+func (c *mqlK8sSecret) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
+}
+
+func (c *mqlK8sSecret) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
+}
+
+func (c *mqlK8sSecret) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
+
+func (c *mqlK8sSecret) GetCertificates() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Certificates, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "apiResources")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.secret", c.__id, "certificates")
 			if err != nil {
 				return nil, err
 			}
@@ -2960,14 +8147,14 @@ func (c *mqlK8s) GetApiResources() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.apiResources()
+		return c.certificates()
 	})
 }
 
-func (c *mqlK8s) GetNamespaces() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Namespaces, func() ([]interface{}, error) {
+func (c *mqlK8sSecret) GetFindings() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Findings, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "namespaces")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.secret", c.__id, "findings")
 			if err != nil {
 				return nil, err
 			}
@@ -2976,14 +8163,14 @@ func (c *mqlK8s) GetNamespaces() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.namespaces()
+		return c.findings()
 	})
 }
 
-func (c *mqlK8s) GetNodes() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Nodes, func() ([]interface{}, error) {
+func (c *mqlK8sSecret) GetMountedBy() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.MountedBy, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "nodes")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.secret", c.__id, "mountedBy")
 			if err != nil {
 				return nil, err
 			}
@@ -2992,14 +8179,14 @@ func (c *mqlK8s) GetNodes() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.nodes()
+		return c.mountedBy()
 	})
 }
 
-func (c *mqlK8s) GetPods() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Pods, func() ([]interface{}, error) {
+func (c *mqlK8sSecret) GetEnvReferencedBy() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.EnvReferencedBy, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "pods")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.secret", c.__id, "envReferencedBy")
 			if err != nil {
 				return nil, err
 			}
@@ -3008,94 +8195,189 @@ func (c *mqlK8s) GetPods() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.pods()
+		return c.envReferencedBy()
 	})
 }
 
-func (c *mqlK8s) GetDeployments() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Deployments, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "deployments")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlK8sSecret) GetExposedViaEnv() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.ExposedViaEnv, func() (bool, error) {
+		return c.exposedViaEnv()
+	})
+}
 
-		return c.deployments()
+func (c *mqlK8sSecret) GetUnused() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Unused, func() (bool, error) {
+		return c.unused()
 	})
 }
 
-func (c *mqlK8s) GetDaemonsets() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Daemonsets, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "daemonsets")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
+// mqlK8sSecretfinding for the k8s.secretfinding resource
+type mqlK8sSecretfinding struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlK8sSecretfindingInternal it will be used here
+	Key plugin.TValue[string]
+	Type plugin.TValue[string]
+	Description plugin.TValue[string]
+	Expires plugin.TValue[*time.Time]
+}
+
+// createK8sSecretfinding creates a new instance of this resource
+func createK8sSecretfinding(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sSecretfinding{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.secretfinding", res.__id)
+		if err != nil || args == nil {
+			return res, err
 		}
+		return res, SetAllData(res, args)
+	}
 
-		return c.daemonsets()
-	})
+	return res, nil
 }
 
-func (c *mqlK8s) GetStatefulsets() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Statefulsets, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "statefulsets")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
+func (c *mqlK8sSecretfinding) MqlName() string {
+	return "k8s.secretfinding"
+}
+
+func (c *mqlK8sSecretfinding) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sSecretfinding) GetKey() *plugin.TValue[string] {
+	return &c.Key
+}
+
+func (c *mqlK8sSecretfinding) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
+
+func (c *mqlK8sSecretfinding) GetDescription() *plugin.TValue[string] {
+	return &c.Description
+}
+
+func (c *mqlK8sSecretfinding) GetExpires() *plugin.TValue[*time.Time] {
+	return &c.Expires
+}
+
+// mqlK8sConfigmap for the k8s.configmap resource
+type mqlK8sConfigmap struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sConfigmapInternal
+	Id plugin.TValue[string]
+	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	Name plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	Data plugin.TValue[map[string]interface{}]
+	Findings plugin.TValue[[]interface{}]
+}
+
+// createK8sConfigmap creates a new instance of this resource
+func createK8sConfigmap(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sConfigmap{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		return c.statefulsets()
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.configmap", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sConfigmap) MqlName() string {
+	return "k8s.configmap"
+}
+
+func (c *mqlK8sConfigmap) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sConfigmap) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlK8sConfigmap) GetUid() *plugin.TValue[string] {
+	return &c.Uid
+}
+
+func (c *mqlK8sConfigmap) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
+}
+
+func (c *mqlK8sConfigmap) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
 	})
 }
 
-func (c *mqlK8s) GetReplicasets() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Replicasets, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "replicasets")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlK8sConfigmap) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
+
+func (c *mqlK8sConfigmap) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sConfigmap) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
 
-		return c.replicasets()
-	})
+func (c *mqlK8sConfigmap) GetKind() *plugin.TValue[string] {
+	return &c.Kind
 }
 
-func (c *mqlK8s) GetJobs() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Jobs, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "jobs")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlK8sConfigmap) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
+}
 
-		return c.jobs()
-	})
+func (c *mqlK8sConfigmap) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
 }
 
-func (c *mqlK8s) GetCronjobs() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Cronjobs, func() ([]interface{}, error) {
+func (c *mqlK8sConfigmap) GetData() *plugin.TValue[map[string]interface{}] {
+	return &c.Data
+}
+
+func (c *mqlK8sConfigmap) GetFindings() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Findings, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "cronjobs")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.configmap", c.__id, "findings")
 			if err != nil {
 				return nil, err
 			}
@@ -3104,220 +8386,191 @@ func (c *mqlK8s) GetCronjobs() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.cronjobs()
+		return c.findings()
 	})
 }
 
-func (c *mqlK8s) GetSecrets() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Secrets, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "secrets")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
+// mqlK8sService for the k8s.service resource
+type mqlK8sService struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sServiceInternal
+	Id plugin.TValue[string]
+	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	Name plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	Spec plugin.TValue[interface{}]
+}
+
+// createK8sService creates a new instance of this resource
+func createK8sService(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sService{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		return c.secrets()
-	})
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.service", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-func (c *mqlK8s) GetConfigmaps() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Configmaps, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "configmaps")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlK8sService) MqlName() string {
+	return "k8s.service"
+}
 
-		return c.configmaps()
-	})
+func (c *mqlK8sService) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlK8s) GetServices() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Services, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "services")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlK8sService) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
 
-		return c.services()
-	})
+func (c *mqlK8sService) GetUid() *plugin.TValue[string] {
+	return &c.Uid
 }
 
-func (c *mqlK8s) GetIngresses() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Ingresses, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "ingresses")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlK8sService) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
+}
 
-		return c.ingresses()
+func (c *mqlK8sService) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
 	})
 }
 
-func (c *mqlK8s) GetServiceaccounts() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Serviceaccounts, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "serviceaccounts")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.serviceaccounts()
+func (c *mqlK8sService) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
 	})
 }
 
-func (c *mqlK8s) GetClusterroles() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Clusterroles, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "clusterroles")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlK8sService) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
 
-		return c.clusterroles()
-	})
+func (c *mqlK8sService) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
 }
 
-func (c *mqlK8s) GetClusterrolebindings() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Clusterrolebindings, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "clusterrolebindings")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlK8sService) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
 
-		return c.clusterrolebindings()
-	})
+func (c *mqlK8sService) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
 }
 
-func (c *mqlK8s) GetRoles() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Roles, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "roles")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlK8sService) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
+}
 
-		return c.roles()
-	})
+func (c *mqlK8sService) GetSpec() *plugin.TValue[interface{}] {
+	return &c.Spec
 }
 
-func (c *mqlK8s) GetRolebindings() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Rolebindings, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "rolebindings")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
+// mqlK8sIngressresourceref for the k8s.ingressresourceref resource
+type mqlK8sIngressresourceref struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlK8sIngressresourcerefInternal it will be used here
+	Id plugin.TValue[string]
+	ApiGroup plugin.TValue[string]
+	Kind plugin.TValue[string]
+	Name plugin.TValue[string]
+}
+
+// createK8sIngressresourceref creates a new instance of this resource
+func createK8sIngressresourceref(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sIngressresourceref{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		return c.rolebindings()
-	})
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.ingressresourceref", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-func (c *mqlK8s) GetPodSecurityPolicies() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.PodSecurityPolicies, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "podSecurityPolicies")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlK8sIngressresourceref) MqlName() string {
+	return "k8s.ingressresourceref"
+}
 
-		return c.podSecurityPolicies()
-	})
+func (c *mqlK8sIngressresourceref) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlK8s) GetNetworkPolicies() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.NetworkPolicies, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "networkPolicies")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlK8sIngressresourceref) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
 
-		return c.networkPolicies()
-	})
+func (c *mqlK8sIngressresourceref) GetApiGroup() *plugin.TValue[string] {
+	return &c.ApiGroup
 }
 
-func (c *mqlK8s) GetCustomresources() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Customresources, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s", c.__id, "customresources")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlK8sIngressresourceref) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
 
-		return c.customresources()
-	})
+func (c *mqlK8sIngressresourceref) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-// mqlK8sApiresource for the k8s.apiresource resource
-type mqlK8sApiresource struct {
+// mqlK8sIngressservicebackend for the k8s.ingressservicebackend resource
+type mqlK8sIngressservicebackend struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlK8sApiresourceInternal it will be used here
+	// optional: if you define mqlK8sIngressservicebackendInternal it will be used here
+	Id plugin.TValue[string]
 	Name plugin.TValue[string]
-	SingularName plugin.TValue[string]
-	Namespaced plugin.TValue[bool]
-	Group plugin.TValue[string]
-	Version plugin.TValue[string]
-	Kind plugin.TValue[string]
-	ShortNames plugin.TValue[[]interface{}]
-	Categories plugin.TValue[[]interface{}]
+	PortName plugin.TValue[string]
+	PortNumber plugin.TValue[int64]
 }
 
-// createK8sApiresource creates a new instance of this resource
-func createK8sApiresource(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sApiresource{
+// createK8sIngressservicebackend creates a new instance of this resource
+func createK8sIngressservicebackend(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sIngressservicebackend{
 		MqlRuntime: runtime,
 	}
 
@@ -3334,7 +8587,7 @@ func createK8sApiresource(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.apiresource", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.ingressservicebackend", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3344,64 +8597,103 @@ func createK8sApiresource(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlK8sApiresource) MqlName() string {
-	return "k8s.apiresource"
+func (c *mqlK8sIngressservicebackend) MqlName() string {
+	return "k8s.ingressservicebackend"
 }
 
-func (c *mqlK8sApiresource) MqlID() string {
+func (c *mqlK8sIngressservicebackend) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sApiresource) GetName() *plugin.TValue[string] {
+func (c *mqlK8sIngressservicebackend) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlK8sIngressservicebackend) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sApiresource) GetSingularName() *plugin.TValue[string] {
-	return &c.SingularName
+func (c *mqlK8sIngressservicebackend) GetPortName() *plugin.TValue[string] {
+	return &c.PortName
 }
 
-func (c *mqlK8sApiresource) GetNamespaced() *plugin.TValue[bool] {
-	return &c.Namespaced
+func (c *mqlK8sIngressservicebackend) GetPortNumber() *plugin.TValue[int64] {
+	return &c.PortNumber
 }
 
-func (c *mqlK8sApiresource) GetGroup() *plugin.TValue[string] {
-	return &c.Group
+// mqlK8sIngressbackend for the k8s.ingressbackend resource
+type mqlK8sIngressbackend struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlK8sIngressbackendInternal it will be used here
+	Id plugin.TValue[string]
+	Service plugin.TValue[*mqlK8sIngressservicebackend]
+	ResourceRef plugin.TValue[*mqlK8sIngressresourceref]
 }
 
-func (c *mqlK8sApiresource) GetVersion() *plugin.TValue[string] {
-	return &c.Version
+// createK8sIngressbackend creates a new instance of this resource
+func createK8sIngressbackend(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sIngressbackend{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.ingressbackend", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-func (c *mqlK8sApiresource) GetKind() *plugin.TValue[string] {
-	return &c.Kind
+func (c *mqlK8sIngressbackend) MqlName() string {
+	return "k8s.ingressbackend"
 }
 
-func (c *mqlK8sApiresource) GetShortNames() *plugin.TValue[[]interface{}] {
-	return &c.ShortNames
+func (c *mqlK8sIngressbackend) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlK8sApiresource) GetCategories() *plugin.TValue[[]interface{}] {
-	return &c.Categories
+func (c *mqlK8sIngressbackend) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-// mqlK8sNamespace for the k8s.namespace resource
-type mqlK8sNamespace struct {
+func (c *mqlK8sIngressbackend) GetService() *plugin.TValue[*mqlK8sIngressservicebackend] {
+	return &c.Service
+}
+
+func (c *mqlK8sIngressbackend) GetResourceRef() *plugin.TValue[*mqlK8sIngressresourceref] {
+	return &c.ResourceRef
+}
+
+// mqlK8sIngresshttprulepath for the k8s.ingresshttprulepath resource
+type mqlK8sIngresshttprulepath struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sNamespaceInternal
+	// optional: if you define mqlK8sIngresshttprulepathInternal it will be used here
 	Id plugin.TValue[string]
-	Uid plugin.TValue[string]
-	Name plugin.TValue[string]
-	Created plugin.TValue[*time.Time]
-	Manifest plugin.TValue[interface{}]
-	Kind plugin.TValue[string]
-	Labels plugin.TValue[map[string]interface{}]
-	Annotations plugin.TValue[map[string]interface{}]
+	Path plugin.TValue[string]
+	PathType plugin.TValue[string]
+	Backend plugin.TValue[*mqlK8sIngressbackend]
 }
 
-// createK8sNamespace creates a new instance of this resource
-func createK8sNamespace(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sNamespace{
+// createK8sIngresshttprulepath creates a new instance of this resource
+func createK8sIngresshttprulepath(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sIngresshttprulepath{
 		MqlRuntime: runtime,
 	}
 
@@ -3418,7 +8710,7 @@ func createK8sNamespace(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.namespace", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.ingresshttprulepath", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3428,67 +8720,102 @@ func createK8sNamespace(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlK8sNamespace) MqlName() string {
-	return "k8s.namespace"
+func (c *mqlK8sIngresshttprulepath) MqlName() string {
+	return "k8s.ingresshttprulepath"
 }
 
-func (c *mqlK8sNamespace) MqlID() string {
+func (c *mqlK8sIngresshttprulepath) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sNamespace) GetId() *plugin.TValue[string] {
+func (c *mqlK8sIngresshttprulepath) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sNamespace) GetUid() *plugin.TValue[string] {
-	return &c.Uid
+func (c *mqlK8sIngresshttprulepath) GetPath() *plugin.TValue[string] {
+	return &c.Path
 }
 
-func (c *mqlK8sNamespace) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlK8sIngresshttprulepath) GetPathType() *plugin.TValue[string] {
+	return &c.PathType
 }
 
-func (c *mqlK8sNamespace) GetCreated() *plugin.TValue[*time.Time] {
-	return &c.Created
+func (c *mqlK8sIngresshttprulepath) GetBackend() *plugin.TValue[*mqlK8sIngressbackend] {
+	return &c.Backend
 }
 
-func (c *mqlK8sNamespace) GetManifest() *plugin.TValue[interface{}] {
-	return &c.Manifest
+// mqlK8sIngressrule for the k8s.ingressrule resource
+type mqlK8sIngressrule struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlK8sIngressruleInternal it will be used here
+	Id plugin.TValue[string]
+	Host plugin.TValue[string]
+	HttpPaths plugin.TValue[[]interface{}]
 }
 
-func (c *mqlK8sNamespace) GetKind() *plugin.TValue[string] {
-	return &c.Kind
+// createK8sIngressrule creates a new instance of this resource
+func createK8sIngressrule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sIngressrule{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.ingressrule", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-func (c *mqlK8sNamespace) GetLabels() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
-		return c.labels()
-	})
+func (c *mqlK8sIngressrule) MqlName() string {
+	return "k8s.ingressrule"
+}
+
+func (c *mqlK8sIngressrule) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sIngressrule) GetId() *plugin.TValue[string] {
+	return &c.Id
+}
+
+func (c *mqlK8sIngressrule) GetHost() *plugin.TValue[string] {
+	return &c.Host
 }
 
-func (c *mqlK8sNamespace) GetAnnotations() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
-		return c.annotations()
-	})
+func (c *mqlK8sIngressrule) GetHttpPaths() *plugin.TValue[[]interface{}] {
+	return &c.HttpPaths
 }
 
-// mqlK8sNode for the k8s.node resource
-type mqlK8sNode struct {
+// mqlK8sIngresstls for the k8s.ingresstls resource
+type mqlK8sIngresstls struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sNodeInternal
+	// optional: if you define mqlK8sIngresstlsInternal it will be used here
 	Id plugin.TValue[string]
-	Uid plugin.TValue[string]
-	Labels plugin.TValue[map[string]interface{}]
-	Annotations plugin.TValue[map[string]interface{}]
-	ResourceVersion plugin.TValue[string]
-	Name plugin.TValue[string]
-	Kind plugin.TValue[string]
+	Hosts plugin.TValue[[]interface{}]
+	Certificates plugin.TValue[[]interface{}]
 }
 
-// createK8sNode creates a new instance of this resource
-func createK8sNode(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sNode{
+// createK8sIngresstls creates a new instance of this resource
+func createK8sIngresstls(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sIngresstls{
 		MqlRuntime: runtime,
 	}
 
@@ -3505,7 +8832,7 @@ func createK8sNode(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.node", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.ingresstls", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3515,51 +8842,31 @@ func createK8sNode(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlK8sNode) MqlName() string {
-	return "k8s.node"
+func (c *mqlK8sIngresstls) MqlName() string {
+	return "k8s.ingresstls"
 }
 
-func (c *mqlK8sNode) MqlID() string {
+func (c *mqlK8sIngresstls) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sNode) GetId() *plugin.TValue[string] {
+func (c *mqlK8sIngresstls) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sNode) GetUid() *plugin.TValue[string] {
-	return &c.Uid
-}
-
-func (c *mqlK8sNode) GetLabels() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
-		return c.labels()
-	})
-}
-
-func (c *mqlK8sNode) GetAnnotations() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
-		return c.annotations()
-	})
-}
-
-func (c *mqlK8sNode) GetResourceVersion() *plugin.TValue[string] {
-	return &c.ResourceVersion
-}
-
-func (c *mqlK8sNode) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlK8sIngresstls) GetHosts() *plugin.TValue[[]interface{}] {
+	return &c.Hosts
 }
 
-func (c *mqlK8sNode) GetKind() *plugin.TValue[string] {
-	return &c.Kind
+func (c *mqlK8sIngresstls) GetCertificates() *plugin.TValue[[]interface{}] {
+	return &c.Certificates
 }
 
-// mqlK8sPod for the k8s.pod resource
-type mqlK8sPod struct {
+// mqlK8sIngress for the k8s.ingress resource
+type mqlK8sIngress struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sPodInternal
+	mqlK8sIngressInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
@@ -3567,20 +8874,16 @@ type mqlK8sPod struct {
 	Annotations plugin.TValue[map[string]interface{}]
 	Name plugin.TValue[string]
 	Namespace plugin.TValue[string]
-	ApiVersion plugin.TValue[string]
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
-	PodSpec plugin.TValue[interface{}]
-	EphemeralContainers plugin.TValue[[]interface{}]
-	InitContainers plugin.TValue[[]interface{}]
-	Containers plugin.TValue[[]interface{}]
-	Node plugin.TValue[*mqlK8sNode]
+	Rules plugin.TValue[[]interface{}]
+	Tls plugin.TValue[[]interface{}]
 }
 
-// createK8sPod creates a new instance of this resource
-func createK8sPod(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sPod{
+// createK8sIngress creates a new instance of this resource
+func createK8sIngress(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sIngress{
 		MqlRuntime: runtime,
 	}
 
@@ -3597,7 +8900,7 @@ func createK8sPod(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.pod", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.ingress", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3607,102 +8910,66 @@ func createK8sPod(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlK8sPod) MqlName() string {
-	return "k8s.pod"
+func (c *mqlK8sIngress) MqlName() string {
+	return "k8s.ingress"
 }
 
-func (c *mqlK8sPod) MqlID() string {
+func (c *mqlK8sIngress) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sPod) GetId() *plugin.TValue[string] {
+func (c *mqlK8sIngress) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sPod) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sIngress) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sPod) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sIngress) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sPod) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sIngress) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sPod) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sIngress) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sPod) GetName() *plugin.TValue[string] {
+func (c *mqlK8sIngress) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sPod) GetNamespace() *plugin.TValue[string] {
+func (c *mqlK8sIngress) GetNamespace() *plugin.TValue[string] {
 	return &c.Namespace
 }
 
-func (c *mqlK8sPod) GetApiVersion() *plugin.TValue[string] {
-	return &c.ApiVersion
-}
-
-func (c *mqlK8sPod) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sIngress) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sPod) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sIngress) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sPod) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sIngress) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sPod) GetPodSpec() *plugin.TValue[interface{}] {
-	return &c.PodSpec
-}
-
-func (c *mqlK8sPod) GetEphemeralContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.EphemeralContainers, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.pod", c.__id, "ephemeralContainers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.ephemeralContainers()
-	})
-}
-
-func (c *mqlK8sPod) GetInitContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.InitContainers, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.pod", c.__id, "initContainers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.initContainers()
-	})
+func (c *mqlK8sIngress) GetRules() *plugin.TValue[[]interface{}] {
+	return &c.Rules
 }
 
-func (c *mqlK8sPod) GetContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
+func (c *mqlK8sIngress) GetTls() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Tls, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.pod", c.__id, "containers")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.ingress", c.__id, "tls")
 			if err != nil {
 				return nil, err
 			}
@@ -3711,31 +8978,15 @@ func (c *mqlK8sPod) GetContainers() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.containers()
-	})
-}
-
-func (c *mqlK8sPod) GetNode() *plugin.TValue[*mqlK8sNode] {
-	return plugin.GetOrCompute[*mqlK8sNode](&c.Node, func() (*mqlK8sNode, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.pod", c.__id, "node")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlK8sNode), nil
-			}
-		}
-
-		return c.node()
+		return c.tls()
 	})
 }
 
-// mqlK8sDeployment for the k8s.deployment resource
-type mqlK8sDeployment struct {
+// mqlK8sServiceaccount for the k8s.serviceaccount resource
+type mqlK8sServiceaccount struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sDeploymentInternal
+	mqlK8sServiceaccountInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
@@ -3746,14 +8997,14 @@ type mqlK8sDeployment struct {
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
-	PodSpec plugin.TValue[interface{}]
-	InitContainers plugin.TValue[[]interface{}]
-	Containers plugin.TValue[[]interface{}]
+	Secrets plugin.TValue[[]interface{}]
+	ImagePullSecrets plugin.TValue[[]interface{}]
+	AutomountServiceAccountToken plugin.TValue[bool]
 }
 
-// createK8sDeployment creates a new instance of this resource
-func createK8sDeployment(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sDeployment{
+// createK8sServiceaccount creates a new instance of this resource
+func createK8sServiceaccount(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sServiceaccount{
 		MqlRuntime: runtime,
 	}
 
@@ -3770,7 +9021,7 @@ func createK8sDeployment(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.deployment", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.serviceaccount", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3780,117 +9031,91 @@ func createK8sDeployment(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlK8sDeployment) MqlName() string {
-	return "k8s.deployment"
+func (c *mqlK8sServiceaccount) MqlName() string {
+	return "k8s.serviceaccount"
 }
 
-func (c *mqlK8sDeployment) MqlID() string {
+func (c *mqlK8sServiceaccount) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sDeployment) GetId() *plugin.TValue[string] {
+func (c *mqlK8sServiceaccount) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sDeployment) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sServiceaccount) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sDeployment) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sServiceaccount) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sDeployment) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sServiceaccount) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sDeployment) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sServiceaccount) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sDeployment) GetName() *plugin.TValue[string] {
+func (c *mqlK8sServiceaccount) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sDeployment) GetNamespace() *plugin.TValue[string] {
+func (c *mqlK8sServiceaccount) GetNamespace() *plugin.TValue[string] {
 	return &c.Namespace
 }
 
-func (c *mqlK8sDeployment) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sServiceaccount) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sDeployment) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sServiceaccount) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sDeployment) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sServiceaccount) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sDeployment) GetPodSpec() *plugin.TValue[interface{}] {
-	return &c.PodSpec
-}
-
-func (c *mqlK8sDeployment) GetInitContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.InitContainers, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.deployment", c.__id, "initContainers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.initContainers()
-	})
-}
-
-func (c *mqlK8sDeployment) GetContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.deployment", c.__id, "containers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlK8sServiceaccount) GetSecrets() *plugin.TValue[[]interface{}] {
+	return &c.Secrets
+}
 
-		return c.containers()
-	})
+func (c *mqlK8sServiceaccount) GetImagePullSecrets() *plugin.TValue[[]interface{}] {
+	return &c.ImagePullSecrets
 }
 
-// mqlK8sDaemonset for the k8s.daemonset resource
-type mqlK8sDaemonset struct {
+func (c *mqlK8sServiceaccount) GetAutomountServiceAccountToken() *plugin.TValue[bool] {
+	return &c.AutomountServiceAccountToken
+}
+
+// mqlK8sRbacClusterrole for the k8s.rbac.clusterrole resource
+type mqlK8sRbacClusterrole struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sDaemonsetInternal
+	mqlK8sRbacClusterroleInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
 	Labels plugin.TValue[map[string]interface{}]
 	Annotations plugin.TValue[map[string]interface{}]
 	Name plugin.TValue[string]
-	Namespace plugin.TValue[string]
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
-	PodSpec plugin.TValue[interface{}]
-	InitContainers plugin.TValue[[]interface{}]
-	Containers plugin.TValue[[]interface{}]
+	Rules plugin.TValue[[]interface{}]
+	AggregationRule plugin.TValue[interface{}]
 }
 
-// createK8sDaemonset creates a new instance of this resource
-func createK8sDaemonset(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sDaemonset{
+// createK8sRbacClusterrole creates a new instance of this resource
+func createK8sRbacClusterrole(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sRbacClusterrole{
 		MqlRuntime: runtime,
 	}
 
@@ -3907,7 +9132,7 @@ func createK8sDaemonset(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.daemonset", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.rbac.clusterrole", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3917,117 +9142,83 @@ func createK8sDaemonset(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlK8sDaemonset) MqlName() string {
-	return "k8s.daemonset"
+func (c *mqlK8sRbacClusterrole) MqlName() string {
+	return "k8s.rbac.clusterrole"
 }
 
-func (c *mqlK8sDaemonset) MqlID() string {
+func (c *mqlK8sRbacClusterrole) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sDaemonset) GetId() *plugin.TValue[string] {
+func (c *mqlK8sRbacClusterrole) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sDaemonset) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sRbacClusterrole) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sDaemonset) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sRbacClusterrole) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sDaemonset) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sRbacClusterrole) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sDaemonset) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sRbacClusterrole) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sDaemonset) GetName() *plugin.TValue[string] {
+func (c *mqlK8sRbacClusterrole) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sDaemonset) GetNamespace() *plugin.TValue[string] {
-	return &c.Namespace
-}
-
-func (c *mqlK8sDaemonset) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sRbacClusterrole) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sDaemonset) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sRbacClusterrole) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sDaemonset) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sRbacClusterrole) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sDaemonset) GetPodSpec() *plugin.TValue[interface{}] {
-	return &c.PodSpec
-}
-
-func (c *mqlK8sDaemonset) GetInitContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.InitContainers, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.daemonset", c.__id, "initContainers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.initContainers()
-	})
+func (c *mqlK8sRbacClusterrole) GetRules() *plugin.TValue[[]interface{}] {
+	return &c.Rules
 }
 
-func (c *mqlK8sDaemonset) GetContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.daemonset", c.__id, "containers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.containers()
-	})
+func (c *mqlK8sRbacClusterrole) GetAggregationRule() *plugin.TValue[interface{}] {
+	return &c.AggregationRule
 }
 
-// mqlK8sStatefulset for the k8s.statefulset resource
-type mqlK8sStatefulset struct {
+// mqlK8sRbacClusterrolebinding for the k8s.rbac.clusterrolebinding resource
+type mqlK8sRbacClusterrolebinding struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sStatefulsetInternal
+	mqlK8sRbacClusterrolebindingInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
 	Labels plugin.TValue[map[string]interface{}]
 	Annotations plugin.TValue[map[string]interface{}]
 	Name plugin.TValue[string]
-	Namespace plugin.TValue[string]
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
-	PodSpec plugin.TValue[interface{}]
-	InitContainers plugin.TValue[[]interface{}]
-	Containers plugin.TValue[[]interface{}]
+	Subjects plugin.TValue[[]interface{}]
+	RoleRef plugin.TValue[interface{}]
 }
 
-// createK8sStatefulset creates a new instance of this resource
-func createK8sStatefulset(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sStatefulset{
+// createK8sRbacClusterrolebinding creates a new instance of this resource
+func createK8sRbacClusterrolebinding(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sRbacClusterrolebinding{
 		MqlRuntime: runtime,
 	}
 
@@ -4044,7 +9235,7 @@ func createK8sStatefulset(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.statefulset", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.rbac.clusterrolebinding", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4054,99 +9245,67 @@ func createK8sStatefulset(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlK8sStatefulset) MqlName() string {
-	return "k8s.statefulset"
+func (c *mqlK8sRbacClusterrolebinding) MqlName() string {
+	return "k8s.rbac.clusterrolebinding"
 }
 
-func (c *mqlK8sStatefulset) MqlID() string {
+func (c *mqlK8sRbacClusterrolebinding) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sStatefulset) GetId() *plugin.TValue[string] {
+func (c *mqlK8sRbacClusterrolebinding) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sStatefulset) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sRbacClusterrolebinding) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sStatefulset) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sRbacClusterrolebinding) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sStatefulset) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sRbacClusterrolebinding) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sStatefulset) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sRbacClusterrolebinding) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sStatefulset) GetName() *plugin.TValue[string] {
+func (c *mqlK8sRbacClusterrolebinding) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sStatefulset) GetNamespace() *plugin.TValue[string] {
-	return &c.Namespace
-}
-
-func (c *mqlK8sStatefulset) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sRbacClusterrolebinding) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sStatefulset) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sRbacClusterrolebinding) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sStatefulset) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sRbacClusterrolebinding) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sStatefulset) GetPodSpec() *plugin.TValue[interface{}] {
-	return &c.PodSpec
-}
-
-func (c *mqlK8sStatefulset) GetInitContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.InitContainers, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.statefulset", c.__id, "initContainers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.initContainers()
-	})
+func (c *mqlK8sRbacClusterrolebinding) GetSubjects() *plugin.TValue[[]interface{}] {
+	return &c.Subjects
 }
 
-func (c *mqlK8sStatefulset) GetContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.statefulset", c.__id, "containers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.containers()
-	})
+func (c *mqlK8sRbacClusterrolebinding) GetRoleRef() *plugin.TValue[interface{}] {
+	return &c.RoleRef
 }
 
-// mqlK8sReplicaset for the k8s.replicaset resource
-type mqlK8sReplicaset struct {
+// mqlK8sRbacRole for the k8s.rbac.role resource
+type mqlK8sRbacRole struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sReplicasetInternal
+	mqlK8sRbacRoleInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
@@ -4157,14 +9316,12 @@ type mqlK8sReplicaset struct {
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
-	PodSpec plugin.TValue[interface{}]
-	InitContainers plugin.TValue[[]interface{}]
-	Containers plugin.TValue[[]interface{}]
+	Rules plugin.TValue[[]interface{}]
 }
 
-// createK8sReplicaset creates a new instance of this resource
-func createK8sReplicaset(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sReplicaset{
+// createK8sRbacRole creates a new instance of this resource
+func createK8sRbacRole(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sRbacRole{
 		MqlRuntime: runtime,
 	}
 
@@ -4181,7 +9338,7 @@ func createK8sReplicaset(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.replicaset", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.rbac.role", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4191,99 +9348,67 @@ func createK8sReplicaset(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlK8sReplicaset) MqlName() string {
-	return "k8s.replicaset"
+func (c *mqlK8sRbacRole) MqlName() string {
+	return "k8s.rbac.role"
 }
 
-func (c *mqlK8sReplicaset) MqlID() string {
+func (c *mqlK8sRbacRole) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sReplicaset) GetId() *plugin.TValue[string] {
+func (c *mqlK8sRbacRole) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sReplicaset) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sRbacRole) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sReplicaset) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sRbacRole) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sReplicaset) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sRbacRole) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sReplicaset) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sRbacRole) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sReplicaset) GetName() *plugin.TValue[string] {
+func (c *mqlK8sRbacRole) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sReplicaset) GetNamespace() *plugin.TValue[string] {
+func (c *mqlK8sRbacRole) GetNamespace() *plugin.TValue[string] {
 	return &c.Namespace
 }
 
-func (c *mqlK8sReplicaset) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sRbacRole) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sReplicaset) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sRbacRole) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sReplicaset) GetManifest() *plugin.TValue[interface{}] {
-	return &c.Manifest
-}
-
-func (c *mqlK8sReplicaset) GetPodSpec() *plugin.TValue[interface{}] {
-	return &c.PodSpec
-}
-
-func (c *mqlK8sReplicaset) GetInitContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.InitContainers, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.replicaset", c.__id, "initContainers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.initContainers()
-	})
-}
-
-func (c *mqlK8sReplicaset) GetContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.replicaset", c.__id, "containers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlK8sRbacRole) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
+}
 
-		return c.containers()
-	})
+func (c *mqlK8sRbacRole) GetRules() *plugin.TValue[[]interface{}] {
+	return &c.Rules
 }
 
-// mqlK8sJob for the k8s.job resource
-type mqlK8sJob struct {
+// mqlK8sRbacRolebinding for the k8s.rbac.rolebinding resource
+type mqlK8sRbacRolebinding struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sJobInternal
+	mqlK8sRbacRolebindingInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
@@ -4294,14 +9419,13 @@ type mqlK8sJob struct {
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
-	PodSpec plugin.TValue[interface{}]
-	InitContainers plugin.TValue[[]interface{}]
-	Containers plugin.TValue[[]interface{}]
+	Subjects plugin.TValue[[]interface{}]
+	RoleRef plugin.TValue[interface{}]
 }
 
-// createK8sJob creates a new instance of this resource
-func createK8sJob(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sJob{
+// createK8sRbacRolebinding creates a new instance of this resource
+func createK8sRbacRolebinding(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sRbacRolebinding{
 		MqlRuntime: runtime,
 	}
 
@@ -4318,7 +9442,7 @@ func createK8sJob(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.job", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.rbac.rolebinding", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4328,82 +9452,110 @@ func createK8sJob(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlK8sJob) MqlName() string {
-	return "k8s.job"
+func (c *mqlK8sRbacRolebinding) MqlName() string {
+	return "k8s.rbac.rolebinding"
 }
 
-func (c *mqlK8sJob) MqlID() string {
+func (c *mqlK8sRbacRolebinding) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sJob) GetId() *plugin.TValue[string] {
+func (c *mqlK8sRbacRolebinding) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sJob) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sRbacRolebinding) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sJob) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sRbacRolebinding) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sJob) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sRbacRolebinding) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sJob) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sRbacRolebinding) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sJob) GetName() *plugin.TValue[string] {
+func (c *mqlK8sRbacRolebinding) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sJob) GetNamespace() *plugin.TValue[string] {
+func (c *mqlK8sRbacRolebinding) GetNamespace() *plugin.TValue[string] {
 	return &c.Namespace
 }
 
-func (c *mqlK8sJob) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sRbacRolebinding) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sJob) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sRbacRolebinding) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sJob) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sRbacRolebinding) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sJob) GetPodSpec() *plugin.TValue[interface{}] {
-	return &c.PodSpec
+func (c *mqlK8sRbacRolebinding) GetSubjects() *plugin.TValue[[]interface{}] {
+	return &c.Subjects
 }
 
-func (c *mqlK8sJob) GetInitContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.InitContainers, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.job", c.__id, "initContainers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
+func (c *mqlK8sRbacRolebinding) GetRoleRef() *plugin.TValue[interface{}] {
+	return &c.RoleRef
+}
+
+// mqlK8sRbac for the k8s.rbac resource
+type mqlK8sRbac struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sRbacInternal
+	Subjects plugin.TValue[[]interface{}]
+}
+
+// createK8sRbac creates a new instance of this resource
+func createK8sRbac(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sRbac{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.rbac", res.__id)
+		if err != nil || args == nil {
+			return res, err
 		}
+		return res, SetAllData(res, args)
+	}
 
-		return c.initContainers()
-	})
+	return res, nil
 }
 
-func (c *mqlK8sJob) GetContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
+func (c *mqlK8sRbac) MqlName() string {
+	return "k8s.rbac"
+}
+
+func (c *mqlK8sRbac) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sRbac) GetSubjects() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Subjects, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.job", c.__id, "containers")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.rbac", c.__id, "subjects")
 			if err != nil {
 				return nil, err
 			}
@@ -4412,33 +9564,24 @@ func (c *mqlK8sJob) GetContainers() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.containers()
+		return c.subjects()
 	})
 }
 
-// mqlK8sCronjob for the k8s.cronjob resource
-type mqlK8sCronjob struct {
+// mqlK8sRbacCan for the k8s.rbac.can resource
+type mqlK8sRbacCan struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sCronjobInternal
-	Id plugin.TValue[string]
-	Uid plugin.TValue[string]
-	ResourceVersion plugin.TValue[string]
-	Labels plugin.TValue[map[string]interface{}]
-	Annotations plugin.TValue[map[string]interface{}]
-	Name plugin.TValue[string]
+	// optional: if you define mqlK8sRbacCanInternal it will be used here
+	Verb plugin.TValue[string]
+	Resource plugin.TValue[string]
 	Namespace plugin.TValue[string]
-	Kind plugin.TValue[string]
-	Created plugin.TValue[*time.Time]
-	Manifest plugin.TValue[interface{}]
-	PodSpec plugin.TValue[interface{}]
-	InitContainers plugin.TValue[[]interface{}]
-	Containers plugin.TValue[[]interface{}]
+	Subjects plugin.TValue[[]interface{}]
 }
 
-// createK8sCronjob creates a new instance of this resource
-func createK8sCronjob(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sCronjob{
+// createK8sRbacCan creates a new instance of this resource
+func createK8sRbacCan(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sRbacCan{
 		MqlRuntime: runtime,
 	}
 
@@ -4455,7 +9598,7 @@ func createK8sCronjob(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.cronjob", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.rbac.can", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4465,82 +9608,30 @@ func createK8sCronjob(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlK8sCronjob) MqlName() string {
-	return "k8s.cronjob"
+func (c *mqlK8sRbacCan) MqlName() string {
+	return "k8s.rbac.can"
 }
 
-func (c *mqlK8sCronjob) MqlID() string {
+func (c *mqlK8sRbacCan) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sCronjob) GetId() *plugin.TValue[string] {
-	return &c.Id
-}
-
-func (c *mqlK8sCronjob) GetUid() *plugin.TValue[string] {
-	return &c.Uid
-}
-
-func (c *mqlK8sCronjob) GetResourceVersion() *plugin.TValue[string] {
-	return &c.ResourceVersion
-}
-
-func (c *mqlK8sCronjob) GetLabels() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
-		return c.labels()
-	})
-}
-
-func (c *mqlK8sCronjob) GetAnnotations() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
-		return c.annotations()
-	})
+func (c *mqlK8sRbacCan) GetVerb() *plugin.TValue[string] {
+	return &c.Verb
 }
 
-func (c *mqlK8sCronjob) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlK8sRbacCan) GetResource() *plugin.TValue[string] {
+	return &c.Resource
 }
 
-func (c *mqlK8sCronjob) GetNamespace() *plugin.TValue[string] {
+func (c *mqlK8sRbacCan) GetNamespace() *plugin.TValue[string] {
 	return &c.Namespace
 }
 
-func (c *mqlK8sCronjob) GetKind() *plugin.TValue[string] {
-	return &c.Kind
-}
-
-func (c *mqlK8sCronjob) GetCreated() *plugin.TValue[*time.Time] {
-	return &c.Created
-}
-
-func (c *mqlK8sCronjob) GetManifest() *plugin.TValue[interface{}] {
-	return &c.Manifest
-}
-
-func (c *mqlK8sCronjob) GetPodSpec() *plugin.TValue[interface{}] {
-	return &c.PodSpec
-}
-
-func (c *mqlK8sCronjob) GetInitContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.InitContainers, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.cronjob", c.__id, "initContainers")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.initContainers()
-	})
-}
-
-func (c *mqlK8sCronjob) GetContainers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Containers, func() ([]interface{}, error) {
+func (c *mqlK8sRbacCan) GetSubjects() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Subjects, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.cronjob", c.__id, "containers")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.rbac.can", c.__id, "subjects")
 			if err != nil {
 				return nil, err
 			}
@@ -4549,38 +9640,24 @@ func (c *mqlK8sCronjob) GetContainers() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.containers()
+		return c.subjects()
 	})
 }
 
-// mqlK8sContainer for the k8s.container resource
-type mqlK8sContainer struct {
+// mqlK8sRbacSubject for the k8s.rbac.subject resource
+type mqlK8sRbacSubject struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlK8sContainerInternal it will be used here
-	Uid plugin.TValue[string]
+	// optional: if you define mqlK8sRbacSubjectInternal it will be used here
+	Kind plugin.TValue[string]
 	Name plugin.TValue[string]
-	ImageName plugin.TValue[string]
-	Image plugin.TValue[string]
-	ContainerImage plugin.TValue[plugin.Resource]
-	Command plugin.TValue[[]interface{}]
-	Args plugin.TValue[[]interface{}]
-	Resources plugin.TValue[interface{}]
-	VolumeMounts plugin.TValue[[]interface{}]
-	VolumeDevices plugin.TValue[[]interface{}]
-	LivenessProbe plugin.TValue[interface{}]
-	ReadinessProbe plugin.TValue[interface{}]
-	ImagePullPolicy plugin.TValue[string]
-	SecurityContext plugin.TValue[interface{}]
-	WorkingDir plugin.TValue[string]
-	Tty plugin.TValue[bool]
-	Env plugin.TValue[interface{}]
-	EnvFrom plugin.TValue[interface{}]
+	Namespace plugin.TValue[string]
+	EffectivePermissions plugin.TValue[[]interface{}]
 }
 
-// createK8sContainer creates a new instance of this resource
-func createK8sContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sContainer{
+// createK8sRbacSubject creates a new instance of this resource
+func createK8sRbacSubject(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sRbacSubject{
 		MqlRuntime: runtime,
 	}
 
@@ -4597,7 +9674,7 @@ func createK8sContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.container", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.rbac.subject", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4607,124 +9684,144 @@ func createK8sContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlK8sContainer) MqlName() string {
-	return "k8s.container"
+func (c *mqlK8sRbacSubject) MqlName() string {
+	return "k8s.rbac.subject"
 }
 
-func (c *mqlK8sContainer) MqlID() string {
+func (c *mqlK8sRbacSubject) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sContainer) GetUid() *plugin.TValue[string] {
-	return &c.Uid
+func (c *mqlK8sRbacSubject) GetKind() *plugin.TValue[string] {
+	return &c.Kind
 }
 
-func (c *mqlK8sContainer) GetName() *plugin.TValue[string] {
+func (c *mqlK8sRbacSubject) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sContainer) GetImageName() *plugin.TValue[string] {
-	return &c.ImageName
-}
-
-func (c *mqlK8sContainer) GetImage() *plugin.TValue[string] {
-	return &c.Image
+func (c *mqlK8sRbacSubject) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
 }
 
-func (c *mqlK8sContainer) GetContainerImage() *plugin.TValue[plugin.Resource] {
-	return plugin.GetOrCompute[plugin.Resource](&c.ContainerImage, func() (plugin.Resource, error) {
+func (c *mqlK8sRbacSubject) GetEffectivePermissions() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.EffectivePermissions, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.container", c.__id, "containerImage")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.rbac.subject", c.__id, "effectivePermissions")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(plugin.Resource), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		return c.containerImage()
+		return c.effectivePermissions()
 	})
 }
 
-func (c *mqlK8sContainer) GetCommand() *plugin.TValue[[]interface{}] {
-	return &c.Command
+// mqlK8sRbacPermission for the k8s.rbac.permission resource
+type mqlK8sRbacPermission struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlK8sRbacPermissionInternal it will be used here
+	Verb plugin.TValue[string]
+	ApiGroup plugin.TValue[string]
+	Resource plugin.TValue[string]
+	ResourceName plugin.TValue[string]
+	NonResourceUrl plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Role plugin.TValue[string]
+	Binding plugin.TValue[string]
 }
 
-func (c *mqlK8sContainer) GetArgs() *plugin.TValue[[]interface{}] {
-	return &c.Args
-}
+// createK8sRbacPermission creates a new instance of this resource
+func createK8sRbacPermission(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sRbacPermission{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.rbac.permission", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
 
-func (c *mqlK8sContainer) GetResources() *plugin.TValue[interface{}] {
-	return &c.Resources
+	return res, nil
 }
 
-func (c *mqlK8sContainer) GetVolumeMounts() *plugin.TValue[[]interface{}] {
-	return &c.VolumeMounts
+func (c *mqlK8sRbacPermission) MqlName() string {
+	return "k8s.rbac.permission"
 }
 
-func (c *mqlK8sContainer) GetVolumeDevices() *plugin.TValue[[]interface{}] {
-	return &c.VolumeDevices
+func (c *mqlK8sRbacPermission) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlK8sContainer) GetLivenessProbe() *plugin.TValue[interface{}] {
-	return &c.LivenessProbe
+func (c *mqlK8sRbacPermission) GetVerb() *plugin.TValue[string] {
+	return &c.Verb
 }
 
-func (c *mqlK8sContainer) GetReadinessProbe() *plugin.TValue[interface{}] {
-	return &c.ReadinessProbe
+func (c *mqlK8sRbacPermission) GetApiGroup() *plugin.TValue[string] {
+	return &c.ApiGroup
 }
 
-func (c *mqlK8sContainer) GetImagePullPolicy() *plugin.TValue[string] {
-	return &c.ImagePullPolicy
+func (c *mqlK8sRbacPermission) GetResource() *plugin.TValue[string] {
+	return &c.Resource
 }
 
-func (c *mqlK8sContainer) GetSecurityContext() *plugin.TValue[interface{}] {
-	return &c.SecurityContext
+func (c *mqlK8sRbacPermission) GetResourceName() *plugin.TValue[string] {
+	return &c.ResourceName
 }
 
-func (c *mqlK8sContainer) GetWorkingDir() *plugin.TValue[string] {
-	return &c.WorkingDir
+func (c *mqlK8sRbacPermission) GetNonResourceUrl() *plugin.TValue[string] {
+	return &c.NonResourceUrl
 }
 
-func (c *mqlK8sContainer) GetTty() *plugin.TValue[bool] {
-	return &c.Tty
+func (c *mqlK8sRbacPermission) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
 }
 
-func (c *mqlK8sContainer) GetEnv() *plugin.TValue[interface{}] {
-	return &c.Env
+func (c *mqlK8sRbacPermission) GetRole() *plugin.TValue[string] {
+	return &c.Role
 }
 
-func (c *mqlK8sContainer) GetEnvFrom() *plugin.TValue[interface{}] {
-	return &c.EnvFrom
+func (c *mqlK8sRbacPermission) GetBinding() *plugin.TValue[string] {
+	return &c.Binding
 }
 
-// mqlK8sInitContainer for the k8s.initContainer resource
-type mqlK8sInitContainer struct {
+// mqlK8sHelmRelease for the k8s.helmRelease resource
+type mqlK8sHelmRelease struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlK8sInitContainerInternal it will be used here
-	Uid plugin.TValue[string]
+	mqlK8sHelmReleaseInternal
 	Name plugin.TValue[string]
-	ImageName plugin.TValue[string]
-	Image plugin.TValue[string]
-	ContainerImage plugin.TValue[plugin.Resource]
-	Command plugin.TValue[[]interface{}]
-	Args plugin.TValue[[]interface{}]
-	Resources plugin.TValue[interface{}]
-	VolumeMounts plugin.TValue[[]interface{}]
-	VolumeDevices plugin.TValue[[]interface{}]
-	ImagePullPolicy plugin.TValue[string]
-	SecurityContext plugin.TValue[interface{}]
-	WorkingDir plugin.TValue[string]
-	Tty plugin.TValue[bool]
-	Env plugin.TValue[interface{}]
-	EnvFrom plugin.TValue[interface{}]
-}
-
-// createK8sInitContainer creates a new instance of this resource
-func createK8sInitContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sInitContainer{
+	Namespace plugin.TValue[string]
+	Chart plugin.TValue[string]
+	ChartVersion plugin.TValue[string]
+	AppVersion plugin.TValue[string]
+	Status plugin.TValue[string]
+	Revision plugin.TValue[int64]
+	Description plugin.TValue[string]
+	Values plugin.TValue[interface{}]
+	ChartValues plugin.TValue[interface{}]
+	FirstDeployed plugin.TValue[*time.Time]
+	LastDeployed plugin.TValue[*time.Time]
+	History plugin.TValue[[]interface{}]
+}
+
+// createK8sHelmRelease creates a new instance of this resource
+func createK8sHelmRelease(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sHelmRelease{
 		MqlRuntime: runtime,
 	}
 
@@ -4733,15 +9830,10 @@ func createK8sInitContainer(runtime *plugin.Runtime, args map[string]*llx.RawDat
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.initContainer", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.helmRelease", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4751,115 +9843,98 @@ func createK8sInitContainer(runtime *plugin.Runtime, args map[string]*llx.RawDat
 	return res, nil
 }
 
-func (c *mqlK8sInitContainer) MqlName() string {
-	return "k8s.initContainer"
+func (c *mqlK8sHelmRelease) MqlName() string {
+	return "k8s.helmRelease"
 }
 
-func (c *mqlK8sInitContainer) MqlID() string {
+func (c *mqlK8sHelmRelease) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sInitContainer) GetUid() *plugin.TValue[string] {
-	return &c.Uid
-}
-
-func (c *mqlK8sInitContainer) GetName() *plugin.TValue[string] {
+func (c *mqlK8sHelmRelease) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sInitContainer) GetImageName() *plugin.TValue[string] {
-	return &c.ImageName
-}
-
-func (c *mqlK8sInitContainer) GetImage() *plugin.TValue[string] {
-	return &c.Image
+func (c *mqlK8sHelmRelease) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
 }
 
-func (c *mqlK8sInitContainer) GetContainerImage() *plugin.TValue[plugin.Resource] {
-	return plugin.GetOrCompute[plugin.Resource](&c.ContainerImage, func() (plugin.Resource, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.initContainer", c.__id, "containerImage")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(plugin.Resource), nil
-			}
-		}
-
-		return c.containerImage()
-	})
+func (c *mqlK8sHelmRelease) GetChart() *plugin.TValue[string] {
+	return &c.Chart
 }
 
-func (c *mqlK8sInitContainer) GetCommand() *plugin.TValue[[]interface{}] {
-	return &c.Command
+func (c *mqlK8sHelmRelease) GetChartVersion() *plugin.TValue[string] {
+	return &c.ChartVersion
 }
 
-func (c *mqlK8sInitContainer) GetArgs() *plugin.TValue[[]interface{}] {
-	return &c.Args
+func (c *mqlK8sHelmRelease) GetAppVersion() *plugin.TValue[string] {
+	return &c.AppVersion
 }
 
-func (c *mqlK8sInitContainer) GetResources() *plugin.TValue[interface{}] {
-	return &c.Resources
+func (c *mqlK8sHelmRelease) GetStatus() *plugin.TValue[string] {
+	return &c.Status
 }
 
-func (c *mqlK8sInitContainer) GetVolumeMounts() *plugin.TValue[[]interface{}] {
-	return &c.VolumeMounts
+func (c *mqlK8sHelmRelease) GetRevision() *plugin.TValue[int64] {
+	return &c.Revision
 }
 
-func (c *mqlK8sInitContainer) GetVolumeDevices() *plugin.TValue[[]interface{}] {
-	return &c.VolumeDevices
+func (c *mqlK8sHelmRelease) GetDescription() *plugin.TValue[string] {
+	return &c.Description
 }
 
-func (c *mqlK8sInitContainer) GetImagePullPolicy() *plugin.TValue[string] {
-	return &c.ImagePullPolicy
+func (c *mqlK8sHelmRelease) GetValues() *plugin.TValue[interface{}] {
+	return &c.Values
 }
 
-func (c *mqlK8sInitContainer) GetSecurityContext() *plugin.TValue[interface{}] {
-	return &c.SecurityContext
+func (c *mqlK8sHelmRelease) GetChartValues() *plugin.TValue[interface{}] {
+	return &c.ChartValues
 }
 
-func (c *mqlK8sInitContainer) GetWorkingDir() *plugin.TValue[string] {
-	return &c.WorkingDir
+func (c *mqlK8sHelmRelease) GetFirstDeployed() *plugin.TValue[*time.Time] {
+	return &c.FirstDeployed
 }
 
-func (c *mqlK8sInitContainer) GetTty() *plugin.TValue[bool] {
-	return &c.Tty
+func (c *mqlK8sHelmRelease) GetLastDeployed() *plugin.TValue[*time.Time] {
+	return &c.LastDeployed
 }
 
-func (c *mqlK8sInitContainer) GetEnv() *plugin.TValue[interface{}] {
-	return &c.Env
-}
+func (c *mqlK8sHelmRelease) GetHistory() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.History, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.helmRelease", c.__id, "history")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlK8sInitContainer) GetEnvFrom() *plugin.TValue[interface{}] {
-	return &c.EnvFrom
+		return c.history()
+	})
 }
 
-// mqlK8sEphemeralContainer for the k8s.ephemeralContainer resource
-type mqlK8sEphemeralContainer struct {
+// mqlK8sPodsecuritypolicy for the k8s.podsecuritypolicy resource
+type mqlK8sPodsecuritypolicy struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlK8sEphemeralContainerInternal it will be used here
+	mqlK8sPodsecuritypolicyInternal
+	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
 	Name plugin.TValue[string]
-	ImageName plugin.TValue[string]
-	Image plugin.TValue[string]
-	ContainerImage plugin.TValue[plugin.Resource]
-	Command plugin.TValue[[]interface{}]
-	Args plugin.TValue[[]interface{}]
-	VolumeMounts plugin.TValue[[]interface{}]
-	VolumeDevices plugin.TValue[[]interface{}]
-	ImagePullPolicy plugin.TValue[string]
-	SecurityContext plugin.TValue[interface{}]
-	WorkingDir plugin.TValue[string]
-	Tty plugin.TValue[bool]
-	Env plugin.TValue[interface{}]
-	EnvFrom plugin.TValue[interface{}]
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	Spec plugin.TValue[interface{}]
 }
 
-// createK8sEphemeralContainer creates a new instance of this resource
-func createK8sEphemeralContainer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sEphemeralContainer{
+// createK8sPodsecuritypolicy creates a new instance of this resource
+func createK8sPodsecuritypolicy(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sPodsecuritypolicy{
 		MqlRuntime: runtime,
 	}
 
@@ -4876,7 +9951,7 @@ func createK8sEphemeralContainer(runtime *plugin.Runtime, args map[string]*llx.R
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.ephemeralContainer", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.podsecuritypolicy", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -4886,91 +9961,63 @@ func createK8sEphemeralContainer(runtime *plugin.Runtime, args map[string]*llx.R
 	return res, nil
 }
 
-func (c *mqlK8sEphemeralContainer) MqlName() string {
-	return "k8s.ephemeralContainer"
+func (c *mqlK8sPodsecuritypolicy) MqlName() string {
+	return "k8s.podsecuritypolicy"
 }
 
-func (c *mqlK8sEphemeralContainer) MqlID() string {
+func (c *mqlK8sPodsecuritypolicy) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sEphemeralContainer) GetUid() *plugin.TValue[string] {
-	return &c.Uid
-}
-
-func (c *mqlK8sEphemeralContainer) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlK8sPodsecuritypolicy) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlK8sEphemeralContainer) GetImageName() *plugin.TValue[string] {
-	return &c.ImageName
+func (c *mqlK8sPodsecuritypolicy) GetUid() *plugin.TValue[string] {
+	return &c.Uid
 }
 
-func (c *mqlK8sEphemeralContainer) GetImage() *plugin.TValue[string] {
-	return &c.Image
+func (c *mqlK8sPodsecuritypolicy) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
 }
 
-func (c *mqlK8sEphemeralContainer) GetContainerImage() *plugin.TValue[plugin.Resource] {
-	return plugin.GetOrCompute[plugin.Resource](&c.ContainerImage, func() (plugin.Resource, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.ephemeralContainer", c.__id, "containerImage")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(plugin.Resource), nil
-			}
-		}
-
-		return c.containerImage()
+func (c *mqlK8sPodsecuritypolicy) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
 	})
 }
 
-func (c *mqlK8sEphemeralContainer) GetCommand() *plugin.TValue[[]interface{}] {
-	return &c.Command
-}
-
-func (c *mqlK8sEphemeralContainer) GetArgs() *plugin.TValue[[]interface{}] {
-	return &c.Args
-}
-
-func (c *mqlK8sEphemeralContainer) GetVolumeMounts() *plugin.TValue[[]interface{}] {
-	return &c.VolumeMounts
-}
-
-func (c *mqlK8sEphemeralContainer) GetVolumeDevices() *plugin.TValue[[]interface{}] {
-	return &c.VolumeDevices
-}
-
-func (c *mqlK8sEphemeralContainer) GetImagePullPolicy() *plugin.TValue[string] {
-	return &c.ImagePullPolicy
+func (c *mqlK8sPodsecuritypolicy) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
 }
 
-func (c *mqlK8sEphemeralContainer) GetSecurityContext() *plugin.TValue[interface{}] {
-	return &c.SecurityContext
+func (c *mqlK8sPodsecuritypolicy) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlK8sEphemeralContainer) GetWorkingDir() *plugin.TValue[string] {
-	return &c.WorkingDir
+func (c *mqlK8sPodsecuritypolicy) GetKind() *plugin.TValue[string] {
+	return &c.Kind
 }
 
-func (c *mqlK8sEphemeralContainer) GetTty() *plugin.TValue[bool] {
-	return &c.Tty
+func (c *mqlK8sPodsecuritypolicy) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
 }
 
-func (c *mqlK8sEphemeralContainer) GetEnv() *plugin.TValue[interface{}] {
-	return &c.Env
+func (c *mqlK8sPodsecuritypolicy) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
 }
 
-func (c *mqlK8sEphemeralContainer) GetEnvFrom() *plugin.TValue[interface{}] {
-	return &c.EnvFrom
+func (c *mqlK8sPodsecuritypolicy) GetSpec() *plugin.TValue[interface{}] {
+	return &c.Spec
 }
 
-// mqlK8sSecret for the k8s.secret resource
-type mqlK8sSecret struct {
+// mqlK8sNetworkpolicy for the k8s.networkpolicy resource
+type mqlK8sNetworkpolicy struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sSecretInternal
+	mqlK8sNetworkpolicyInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
@@ -4981,13 +10028,12 @@ type mqlK8sSecret struct {
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
-	Type plugin.TValue[string]
-	Certificates plugin.TValue[[]interface{}]
+	Spec plugin.TValue[interface{}]
 }
 
-// createK8sSecret creates a new instance of this resource
-func createK8sSecret(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sSecret{
+// createK8sNetworkpolicy creates a new instance of this resource
+func createK8sNetworkpolicy(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sNetworkpolicy{
 		MqlRuntime: runtime,
 	}
 
@@ -5004,7 +10050,7 @@ func createK8sSecret(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.secret", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.networkpolicy", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5014,66 +10060,133 @@ func createK8sSecret(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlK8sSecret) MqlName() string {
-	return "k8s.secret"
+func (c *mqlK8sNetworkpolicy) MqlName() string {
+	return "k8s.networkpolicy"
 }
 
-func (c *mqlK8sSecret) MqlID() string {
+func (c *mqlK8sNetworkpolicy) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sSecret) GetId() *plugin.TValue[string] {
+func (c *mqlK8sNetworkpolicy) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sSecret) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sNetworkpolicy) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sSecret) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sNetworkpolicy) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sSecret) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sNetworkpolicy) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sSecret) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sNetworkpolicy) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sSecret) GetName() *plugin.TValue[string] {
+func (c *mqlK8sNetworkpolicy) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sSecret) GetNamespace() *plugin.TValue[string] {
+func (c *mqlK8sNetworkpolicy) GetNamespace() *plugin.TValue[string] {
 	return &c.Namespace
 }
 
-func (c *mqlK8sSecret) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sNetworkpolicy) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sSecret) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sNetworkpolicy) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sSecret) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sNetworkpolicy) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sSecret) GetType() *plugin.TValue[string] {
-	return &c.Type
+func (c *mqlK8sNetworkpolicy) GetSpec() *plugin.TValue[interface{}] {
+	return &c.Spec
 }
 
-func (c *mqlK8sSecret) GetCertificates() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Certificates, func() ([]interface{}, error) {
+// mqlK8sNetworkpolicyPeer for the k8s.networkpolicy.peer resource
+type mqlK8sNetworkpolicyPeer struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlK8sNetworkpolicyPeerInternal
+	Policy plugin.TValue[string]
+	Any plugin.TValue[bool]
+	NamespaceSelector plugin.TValue[interface{}]
+	PodSelector plugin.TValue[interface{}]
+	Namespaces plugin.TValue[[]interface{}]
+	Pods plugin.TValue[[]interface{}]
+	IpBlock plugin.TValue[interface{}]
+	Ports plugin.TValue[[]interface{}]
+}
+
+// createK8sNetworkpolicyPeer creates a new instance of this resource
+func createK8sNetworkpolicyPeer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sNetworkpolicyPeer{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.networkpolicy.peer", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sNetworkpolicyPeer) MqlName() string {
+	return "k8s.networkpolicy.peer"
+}
+
+func (c *mqlK8sNetworkpolicyPeer) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sNetworkpolicyPeer) GetPolicy() *plugin.TValue[string] {
+	return &c.Policy
+}
+
+func (c *mqlK8sNetworkpolicyPeer) GetAny() *plugin.TValue[bool] {
+	return &c.Any
+}
+
+func (c *mqlK8sNetworkpolicyPeer) GetNamespaceSelector() *plugin.TValue[interface{}] {
+	return &c.NamespaceSelector
+}
+
+func (c *mqlK8sNetworkpolicyPeer) GetPodSelector() *plugin.TValue[interface{}] {
+	return &c.PodSelector
+}
+
+func (c *mqlK8sNetworkpolicyPeer) GetNamespaces() *plugin.TValue[[]interface{}] {
+	return &c.Namespaces
+}
+
+func (c *mqlK8sNetworkpolicyPeer) GetPods() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Pods, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.secret", c.__id, "certificates")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.networkpolicy.peer", c.__id, "pods")
 			if err != nil {
 				return nil, err
 			}
@@ -5082,31 +10195,45 @@ func (c *mqlK8sSecret) GetCertificates() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.certificates()
+		return c.pods()
 	})
 }
 
-// mqlK8sConfigmap for the k8s.configmap resource
-type mqlK8sConfigmap struct {
+func (c *mqlK8sNetworkpolicyPeer) GetIpBlock() *plugin.TValue[interface{}] {
+	return &c.IpBlock
+}
+
+func (c *mqlK8sNetworkpolicyPeer) GetPorts() *plugin.TValue[[]interface{}] {
+	return &c.Ports
+}
+
+// mqlK8sPersistentvolume for the k8s.persistentvolume resource
+type mqlK8sPersistentvolume struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sConfigmapInternal
+	mqlK8sPersistentvolumeInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
 	Labels plugin.TValue[map[string]interface{}]
 	Annotations plugin.TValue[map[string]interface{}]
 	Name plugin.TValue[string]
-	Namespace plugin.TValue[string]
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
-	Data plugin.TValue[map[string]interface{}]
+	StorageClassName plugin.TValue[string]
+	Capacity plugin.TValue[map[string]interface{}]
+	AccessModes plugin.TValue[[]interface{}]
+	ReclaimPolicy plugin.TValue[string]
+	VolumeMode plugin.TValue[string]
+	Phase plugin.TValue[string]
+	ClaimRef plugin.TValue[interface{}]
+	Spec plugin.TValue[interface{}]
 }
 
-// createK8sConfigmap creates a new instance of this resource
-func createK8sConfigmap(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sConfigmap{
+// createK8sPersistentvolume creates a new instance of this resource
+func createK8sPersistentvolume(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sPersistentvolume{
 		MqlRuntime: runtime,
 	}
 
@@ -5123,7 +10250,7 @@ func createK8sConfigmap(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.configmap", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.persistentvolume", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5133,67 +10260,91 @@ func createK8sConfigmap(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlK8sConfigmap) MqlName() string {
-	return "k8s.configmap"
+func (c *mqlK8sPersistentvolume) MqlName() string {
+	return "k8s.persistentvolume"
 }
 
-func (c *mqlK8sConfigmap) MqlID() string {
+func (c *mqlK8sPersistentvolume) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sConfigmap) GetId() *plugin.TValue[string] {
+func (c *mqlK8sPersistentvolume) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sConfigmap) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sPersistentvolume) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sConfigmap) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sPersistentvolume) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sConfigmap) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sPersistentvolume) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sConfigmap) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sPersistentvolume) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sConfigmap) GetName() *plugin.TValue[string] {
+func (c *mqlK8sPersistentvolume) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sConfigmap) GetNamespace() *plugin.TValue[string] {
-	return &c.Namespace
-}
-
-func (c *mqlK8sConfigmap) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sPersistentvolume) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sConfigmap) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sPersistentvolume) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sConfigmap) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sPersistentvolume) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sConfigmap) GetData() *plugin.TValue[map[string]interface{}] {
-	return &c.Data
+func (c *mqlK8sPersistentvolume) GetStorageClassName() *plugin.TValue[string] {
+	return &c.StorageClassName
 }
 
-// mqlK8sService for the k8s.service resource
-type mqlK8sService struct {
+func (c *mqlK8sPersistentvolume) GetCapacity() *plugin.TValue[map[string]interface{}] {
+	return &c.Capacity
+}
+
+func (c *mqlK8sPersistentvolume) GetAccessModes() *plugin.TValue[[]interface{}] {
+	return &c.AccessModes
+}
+
+func (c *mqlK8sPersistentvolume) GetReclaimPolicy() *plugin.TValue[string] {
+	return &c.ReclaimPolicy
+}
+
+func (c *mqlK8sPersistentvolume) GetVolumeMode() *plugin.TValue[string] {
+	return &c.VolumeMode
+}
+
+func (c *mqlK8sPersistentvolume) GetPhase() *plugin.TValue[string] {
+	return &c.Phase
+}
+
+func (c *mqlK8sPersistentvolume) GetClaimRef() *plugin.TValue[interface{}] {
+	return &c.ClaimRef
+}
+
+func (c *mqlK8sPersistentvolume) GetSpec() *plugin.TValue[interface{}] {
+	return &c.Spec
+}
+
+// mqlK8sPersistentvolumeclaim for the k8s.persistentvolumeclaim resource
+type mqlK8sPersistentvolumeclaim struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sServiceInternal
+	mqlK8sPersistentvolumeclaimInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
@@ -5204,12 +10355,18 @@ type mqlK8sService struct {
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
+	StorageClassName plugin.TValue[string]
+	VolumeName plugin.TValue[string]
+	AccessModes plugin.TValue[[]interface{}]
+	Requests plugin.TValue[map[string]interface{}]
+	VolumeMode plugin.TValue[string]
+	Phase plugin.TValue[string]
 	Spec plugin.TValue[interface{}]
 }
 
-// createK8sService creates a new instance of this resource
-func createK8sService(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sService{
+// createK8sPersistentvolumeclaim creates a new instance of this resource
+func createK8sPersistentvolumeclaim(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sPersistentvolumeclaim{
 		MqlRuntime: runtime,
 	}
 
@@ -5226,7 +10383,7 @@ func createK8sService(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.service", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.persistentvolumeclaim", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5236,76 +10393,112 @@ func createK8sService(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlK8sService) MqlName() string {
-	return "k8s.service"
+func (c *mqlK8sPersistentvolumeclaim) MqlName() string {
+	return "k8s.persistentvolumeclaim"
 }
 
-func (c *mqlK8sService) MqlID() string {
+func (c *mqlK8sPersistentvolumeclaim) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sService) GetId() *plugin.TValue[string] {
+func (c *mqlK8sPersistentvolumeclaim) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sService) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sPersistentvolumeclaim) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sService) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sPersistentvolumeclaim) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sService) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sPersistentvolumeclaim) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sService) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sPersistentvolumeclaim) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sService) GetName() *plugin.TValue[string] {
+func (c *mqlK8sPersistentvolumeclaim) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sService) GetNamespace() *plugin.TValue[string] {
+func (c *mqlK8sPersistentvolumeclaim) GetNamespace() *plugin.TValue[string] {
 	return &c.Namespace
 }
 
-func (c *mqlK8sService) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sPersistentvolumeclaim) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sService) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sPersistentvolumeclaim) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sService) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sPersistentvolumeclaim) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sService) GetSpec() *plugin.TValue[interface{}] {
+func (c *mqlK8sPersistentvolumeclaim) GetStorageClassName() *plugin.TValue[string] {
+	return &c.StorageClassName
+}
+
+func (c *mqlK8sPersistentvolumeclaim) GetVolumeName() *plugin.TValue[string] {
+	return &c.VolumeName
+}
+
+func (c *mqlK8sPersistentvolumeclaim) GetAccessModes() *plugin.TValue[[]interface{}] {
+	return &c.AccessModes
+}
+
+func (c *mqlK8sPersistentvolumeclaim) GetRequests() *plugin.TValue[map[string]interface{}] {
+	return &c.Requests
+}
+
+func (c *mqlK8sPersistentvolumeclaim) GetVolumeMode() *plugin.TValue[string] {
+	return &c.VolumeMode
+}
+
+func (c *mqlK8sPersistentvolumeclaim) GetPhase() *plugin.TValue[string] {
+	return &c.Phase
+}
+
+func (c *mqlK8sPersistentvolumeclaim) GetSpec() *plugin.TValue[interface{}] {
 	return &c.Spec
 }
 
-// mqlK8sIngressresourceref for the k8s.ingressresourceref resource
-type mqlK8sIngressresourceref struct {
+// mqlK8sStorageclass for the k8s.storageclass resource
+type mqlK8sStorageclass struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlK8sIngressresourcerefInternal it will be used here
+	mqlK8sStorageclassInternal
 	Id plugin.TValue[string]
-	ApiGroup plugin.TValue[string]
-	Kind plugin.TValue[string]
+	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
 	Name plugin.TValue[string]
-}
-
-// createK8sIngressresourceref creates a new instance of this resource
-func createK8sIngressresourceref(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sIngressresourceref{
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	Provisioner plugin.TValue[string]
+	Parameters plugin.TValue[map[string]interface{}]
+	ReclaimPolicy plugin.TValue[string]
+	VolumeBindingMode plugin.TValue[string]
+	AllowVolumeExpansion plugin.TValue[bool]
+	MountOptions plugin.TValue[[]interface{}]
+	IsDefault plugin.TValue[bool]
+}
+
+// createK8sStorageclass creates a new instance of this resource
+func createK8sStorageclass(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sStorageclass{
 		MqlRuntime: runtime,
 	}
 
@@ -5322,7 +10515,7 @@ func createK8sIngressresourceref(runtime *plugin.Runtime, args map[string]*llx.R
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.ingressresourceref", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.storageclass", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5332,107 +10525,108 @@ func createK8sIngressresourceref(runtime *plugin.Runtime, args map[string]*llx.R
 	return res, nil
 }
 
-func (c *mqlK8sIngressresourceref) MqlName() string {
-	return "k8s.ingressresourceref"
+func (c *mqlK8sStorageclass) MqlName() string {
+	return "k8s.storageclass"
 }
 
-func (c *mqlK8sIngressresourceref) MqlID() string {
+func (c *mqlK8sStorageclass) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sIngressresourceref) GetId() *plugin.TValue[string] {
+func (c *mqlK8sStorageclass) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sIngressresourceref) GetApiGroup() *plugin.TValue[string] {
-	return &c.ApiGroup
+func (c *mqlK8sStorageclass) GetUid() *plugin.TValue[string] {
+	return &c.Uid
 }
 
-func (c *mqlK8sIngressresourceref) GetKind() *plugin.TValue[string] {
-	return &c.Kind
+func (c *mqlK8sStorageclass) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
 }
 
-func (c *mqlK8sIngressresourceref) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlK8sStorageclass) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
+	})
 }
 
-// mqlK8sIngressservicebackend for the k8s.ingressservicebackend resource
-type mqlK8sIngressservicebackend struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlK8sIngressservicebackendInternal it will be used here
-	Id plugin.TValue[string]
-	Name plugin.TValue[string]
-	PortName plugin.TValue[string]
-	PortNumber plugin.TValue[int64]
+func (c *mqlK8sStorageclass) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
 }
 
-// createK8sIngressservicebackend creates a new instance of this resource
-func createK8sIngressservicebackend(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sIngressservicebackend{
-		MqlRuntime: runtime,
-	}
+func (c *mqlK8sStorageclass) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
 
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
+func (c *mqlK8sStorageclass) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+func (c *mqlK8sStorageclass) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
+}
 
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.ingressservicebackend", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
+func (c *mqlK8sStorageclass) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
+}
 
-	return res, nil
+func (c *mqlK8sStorageclass) GetProvisioner() *plugin.TValue[string] {
+	return &c.Provisioner
 }
 
-func (c *mqlK8sIngressservicebackend) MqlName() string {
-	return "k8s.ingressservicebackend"
+func (c *mqlK8sStorageclass) GetParameters() *plugin.TValue[map[string]interface{}] {
+	return &c.Parameters
 }
 
-func (c *mqlK8sIngressservicebackend) MqlID() string {
-	return c.__id
+func (c *mqlK8sStorageclass) GetReclaimPolicy() *plugin.TValue[string] {
+	return &c.ReclaimPolicy
 }
 
-func (c *mqlK8sIngressservicebackend) GetId() *plugin.TValue[string] {
-	return &c.Id
+func (c *mqlK8sStorageclass) GetVolumeBindingMode() *plugin.TValue[string] {
+	return &c.VolumeBindingMode
 }
 
-func (c *mqlK8sIngressservicebackend) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlK8sStorageclass) GetAllowVolumeExpansion() *plugin.TValue[bool] {
+	return &c.AllowVolumeExpansion
 }
 
-func (c *mqlK8sIngressservicebackend) GetPortName() *plugin.TValue[string] {
-	return &c.PortName
+func (c *mqlK8sStorageclass) GetMountOptions() *plugin.TValue[[]interface{}] {
+	return &c.MountOptions
 }
 
-func (c *mqlK8sIngressservicebackend) GetPortNumber() *plugin.TValue[int64] {
-	return &c.PortNumber
+func (c *mqlK8sStorageclass) GetIsDefault() *plugin.TValue[bool] {
+	return &c.IsDefault
 }
 
-// mqlK8sIngressbackend for the k8s.ingressbackend resource
-type mqlK8sIngressbackend struct {
+// mqlK8sHorizontalpodautoscaler for the k8s.horizontalpodautoscaler resource
+type mqlK8sHorizontalpodautoscaler struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlK8sIngressbackendInternal it will be used here
+	mqlK8sHorizontalpodautoscalerInternal
 	Id plugin.TValue[string]
-	Service plugin.TValue[*mqlK8sIngressservicebackend]
-	ResourceRef plugin.TValue[*mqlK8sIngressresourceref]
+	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	Name plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	ScaleTargetRef plugin.TValue[interface{}]
+	MinReplicas plugin.TValue[int64]
+	MaxReplicas plugin.TValue[int64]
+	Metrics plugin.TValue[[]interface{}]
+	Behavior plugin.TValue[interface{}]
+	Spec plugin.TValue[interface{}]
 }
 
-// createK8sIngressbackend creates a new instance of this resource
-func createK8sIngressbackend(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sIngressbackend{
+// createK8sHorizontalpodautoscaler creates a new instance of this resource
+func createK8sHorizontalpodautoscaler(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sHorizontalpodautoscaler{
 		MqlRuntime: runtime,
 	}
 
@@ -5449,7 +10643,7 @@ func createK8sIngressbackend(runtime *plugin.Runtime, args map[string]*llx.RawDa
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.ingressbackend", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.horizontalpodautoscaler", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5459,103 +10653,107 @@ func createK8sIngressbackend(runtime *plugin.Runtime, args map[string]*llx.RawDa
 	return res, nil
 }
 
-func (c *mqlK8sIngressbackend) MqlName() string {
-	return "k8s.ingressbackend"
+func (c *mqlK8sHorizontalpodautoscaler) MqlName() string {
+	return "k8s.horizontalpodautoscaler"
 }
 
-func (c *mqlK8sIngressbackend) MqlID() string {
+func (c *mqlK8sHorizontalpodautoscaler) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sIngressbackend) GetId() *plugin.TValue[string] {
+func (c *mqlK8sHorizontalpodautoscaler) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sIngressbackend) GetService() *plugin.TValue[*mqlK8sIngressservicebackend] {
-	return &c.Service
+func (c *mqlK8sHorizontalpodautoscaler) GetUid() *plugin.TValue[string] {
+	return &c.Uid
 }
 
-func (c *mqlK8sIngressbackend) GetResourceRef() *plugin.TValue[*mqlK8sIngressresourceref] {
-	return &c.ResourceRef
+func (c *mqlK8sHorizontalpodautoscaler) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
 }
 
-// mqlK8sIngresshttprulepath for the k8s.ingresshttprulepath resource
-type mqlK8sIngresshttprulepath struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlK8sIngresshttprulepathInternal it will be used here
-	Id plugin.TValue[string]
-	Path plugin.TValue[string]
-	PathType plugin.TValue[string]
-	Backend plugin.TValue[*mqlK8sIngressbackend]
+func (c *mqlK8sHorizontalpodautoscaler) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
+	})
 }
 
-// createK8sIngresshttprulepath creates a new instance of this resource
-func createK8sIngresshttprulepath(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sIngresshttprulepath{
-		MqlRuntime: runtime,
-	}
+func (c *mqlK8sHorizontalpodautoscaler) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
 
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
+func (c *mqlK8sHorizontalpodautoscaler) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+func (c *mqlK8sHorizontalpodautoscaler) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
 
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.ingresshttprulepath", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
+func (c *mqlK8sHorizontalpodautoscaler) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
 
-	return res, nil
+func (c *mqlK8sHorizontalpodautoscaler) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
 }
 
-func (c *mqlK8sIngresshttprulepath) MqlName() string {
-	return "k8s.ingresshttprulepath"
+func (c *mqlK8sHorizontalpodautoscaler) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
 }
 
-func (c *mqlK8sIngresshttprulepath) MqlID() string {
-	return c.__id
+func (c *mqlK8sHorizontalpodautoscaler) GetScaleTargetRef() *plugin.TValue[interface{}] {
+	return &c.ScaleTargetRef
 }
 
-func (c *mqlK8sIngresshttprulepath) GetId() *plugin.TValue[string] {
-	return &c.Id
+func (c *mqlK8sHorizontalpodautoscaler) GetMinReplicas() *plugin.TValue[int64] {
+	return &c.MinReplicas
 }
 
-func (c *mqlK8sIngresshttprulepath) GetPath() *plugin.TValue[string] {
-	return &c.Path
+func (c *mqlK8sHorizontalpodautoscaler) GetMaxReplicas() *plugin.TValue[int64] {
+	return &c.MaxReplicas
 }
 
-func (c *mqlK8sIngresshttprulepath) GetPathType() *plugin.TValue[string] {
-	return &c.PathType
+func (c *mqlK8sHorizontalpodautoscaler) GetMetrics() *plugin.TValue[[]interface{}] {
+	return &c.Metrics
 }
 
-func (c *mqlK8sIngresshttprulepath) GetBackend() *plugin.TValue[*mqlK8sIngressbackend] {
-	return &c.Backend
+func (c *mqlK8sHorizontalpodautoscaler) GetBehavior() *plugin.TValue[interface{}] {
+	return &c.Behavior
 }
 
-// mqlK8sIngressrule for the k8s.ingressrule resource
-type mqlK8sIngressrule struct {
+func (c *mqlK8sHorizontalpodautoscaler) GetSpec() *plugin.TValue[interface{}] {
+	return &c.Spec
+}
+
+// mqlK8sPoddisruptionbudget for the k8s.poddisruptionbudget resource
+type mqlK8sPoddisruptionbudget struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlK8sIngressruleInternal it will be used here
+	mqlK8sPoddisruptionbudgetInternal
 	Id plugin.TValue[string]
-	Host plugin.TValue[string]
-	HttpPaths plugin.TValue[[]interface{}]
+	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	Name plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	MinAvailable plugin.TValue[string]
+	MaxUnavailable plugin.TValue[string]
+	Selector plugin.TValue[interface{}]
+	UnhealthyPodEvictionPolicy plugin.TValue[string]
+	Spec plugin.TValue[interface{}]
 }
 
-// createK8sIngressrule creates a new instance of this resource
-func createK8sIngressrule(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sIngressrule{
+// createK8sPoddisruptionbudget creates a new instance of this resource
+func createK8sPoddisruptionbudget(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sPoddisruptionbudget{
 		MqlRuntime: runtime,
 	}
 
@@ -5572,7 +10770,7 @@ func createK8sIngressrule(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.ingressrule", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.poddisruptionbudget", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5582,39 +10780,103 @@ func createK8sIngressrule(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlK8sIngressrule) MqlName() string {
-	return "k8s.ingressrule"
+func (c *mqlK8sPoddisruptionbudget) MqlName() string {
+	return "k8s.poddisruptionbudget"
 }
 
-func (c *mqlK8sIngressrule) MqlID() string {
+func (c *mqlK8sPoddisruptionbudget) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sIngressrule) GetId() *plugin.TValue[string] {
+func (c *mqlK8sPoddisruptionbudget) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sIngressrule) GetHost() *plugin.TValue[string] {
-	return &c.Host
+func (c *mqlK8sPoddisruptionbudget) GetUid() *plugin.TValue[string] {
+	return &c.Uid
 }
 
-func (c *mqlK8sIngressrule) GetHttpPaths() *plugin.TValue[[]interface{}] {
-	return &c.HttpPaths
+func (c *mqlK8sPoddisruptionbudget) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
 }
 
-// mqlK8sIngresstls for the k8s.ingresstls resource
-type mqlK8sIngresstls struct {
+func (c *mqlK8sPoddisruptionbudget) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
+	})
+}
+
+func (c *mqlK8sPoddisruptionbudget) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
+
+func (c *mqlK8sPoddisruptionbudget) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sPoddisruptionbudget) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
+
+func (c *mqlK8sPoddisruptionbudget) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
+
+func (c *mqlK8sPoddisruptionbudget) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
+}
+
+func (c *mqlK8sPoddisruptionbudget) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
+}
+
+func (c *mqlK8sPoddisruptionbudget) GetMinAvailable() *plugin.TValue[string] {
+	return &c.MinAvailable
+}
+
+func (c *mqlK8sPoddisruptionbudget) GetMaxUnavailable() *plugin.TValue[string] {
+	return &c.MaxUnavailable
+}
+
+func (c *mqlK8sPoddisruptionbudget) GetSelector() *plugin.TValue[interface{}] {
+	return &c.Selector
+}
+
+func (c *mqlK8sPoddisruptionbudget) GetUnhealthyPodEvictionPolicy() *plugin.TValue[string] {
+	return &c.UnhealthyPodEvictionPolicy
+}
+
+func (c *mqlK8sPoddisruptionbudget) GetSpec() *plugin.TValue[interface{}] {
+	return &c.Spec
+}
+
+// mqlK8sResourcequota for the k8s.resourcequota resource
+type mqlK8sResourcequota struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlK8sIngresstlsInternal it will be used here
+	mqlK8sResourcequotaInternal
 	Id plugin.TValue[string]
-	Hosts plugin.TValue[[]interface{}]
-	Certificates plugin.TValue[[]interface{}]
+	Uid plugin.TValue[string]
+	ResourceVersion plugin.TValue[string]
+	Labels plugin.TValue[map[string]interface{}]
+	Annotations plugin.TValue[map[string]interface{}]
+	Name plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Kind plugin.TValue[string]
+	Created plugin.TValue[*time.Time]
+	Manifest plugin.TValue[interface{}]
+	Hard plugin.TValue[map[string]interface{}]
+	Used plugin.TValue[map[string]interface{}]
+	Scopes plugin.TValue[[]interface{}]
+	ScopeSelector plugin.TValue[interface{}]
+	Spec plugin.TValue[interface{}]
 }
 
-// createK8sIngresstls creates a new instance of this resource
-func createK8sIngresstls(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sIngresstls{
+// createK8sResourcequota creates a new instance of this resource
+func createK8sResourcequota(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sResourcequota{
 		MqlRuntime: runtime,
 	}
 
@@ -5631,7 +10893,7 @@ func createK8sIngresstls(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.ingresstls", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.resourcequota", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5641,31 +10903,83 @@ func createK8sIngresstls(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlK8sIngresstls) MqlName() string {
-	return "k8s.ingresstls"
+func (c *mqlK8sResourcequota) MqlName() string {
+	return "k8s.resourcequota"
 }
 
-func (c *mqlK8sIngresstls) MqlID() string {
+func (c *mqlK8sResourcequota) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sIngresstls) GetId() *plugin.TValue[string] {
+func (c *mqlK8sResourcequota) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sIngresstls) GetHosts() *plugin.TValue[[]interface{}] {
-	return &c.Hosts
+func (c *mqlK8sResourcequota) GetUid() *plugin.TValue[string] {
+	return &c.Uid
 }
 
-func (c *mqlK8sIngresstls) GetCertificates() *plugin.TValue[[]interface{}] {
-	return &c.Certificates
+func (c *mqlK8sResourcequota) GetResourceVersion() *plugin.TValue[string] {
+	return &c.ResourceVersion
 }
 
-// mqlK8sIngress for the k8s.ingress resource
-type mqlK8sIngress struct {
+func (c *mqlK8sResourcequota) GetLabels() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
+		return c.labels()
+	})
+}
+
+func (c *mqlK8sResourcequota) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
+		return c.annotations()
+	})
+}
+
+func (c *mqlK8sResourcequota) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sResourcequota) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
+
+func (c *mqlK8sResourcequota) GetKind() *plugin.TValue[string] {
+	return &c.Kind
+}
+
+func (c *mqlK8sResourcequota) GetCreated() *plugin.TValue[*time.Time] {
+	return &c.Created
+}
+
+func (c *mqlK8sResourcequota) GetManifest() *plugin.TValue[interface{}] {
+	return &c.Manifest
+}
+
+func (c *mqlK8sResourcequota) GetHard() *plugin.TValue[map[string]interface{}] {
+	return &c.Hard
+}
+
+func (c *mqlK8sResourcequota) GetUsed() *plugin.TValue[map[string]interface{}] {
+	return &c.Used
+}
+
+func (c *mqlK8sResourcequota) GetScopes() *plugin.TValue[[]interface{}] {
+	return &c.Scopes
+}
+
+func (c *mqlK8sResourcequota) GetScopeSelector() *plugin.TValue[interface{}] {
+	return &c.ScopeSelector
+}
+
+func (c *mqlK8sResourcequota) GetSpec() *plugin.TValue[interface{}] {
+	return &c.Spec
+}
+
+// mqlK8sLimitrange for the k8s.limitrange resource
+type mqlK8sLimitrange struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sIngressInternal
+	mqlK8sLimitrangeInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
@@ -5676,13 +10990,13 @@ type mqlK8sIngress struct {
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
-	Rules plugin.TValue[[]interface{}]
-	Tls plugin.TValue[[]interface{}]
+	Limits plugin.TValue[[]interface{}]
+	Spec plugin.TValue[interface{}]
 }
 
-// createK8sIngress creates a new instance of this resource
-func createK8sIngress(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sIngress{
+// createK8sLimitrange creates a new instance of this resource
+func createK8sLimitrange(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sLimitrange{
 		MqlRuntime: runtime,
 	}
 
@@ -5699,7 +11013,7 @@ func createK8sIngress(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.ingress", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.limitrange", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5709,101 +11023,89 @@ func createK8sIngress(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlK8sIngress) MqlName() string {
-	return "k8s.ingress"
+func (c *mqlK8sLimitrange) MqlName() string {
+	return "k8s.limitrange"
 }
 
-func (c *mqlK8sIngress) MqlID() string {
+func (c *mqlK8sLimitrange) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sIngress) GetId() *plugin.TValue[string] {
+func (c *mqlK8sLimitrange) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sIngress) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sLimitrange) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sIngress) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sLimitrange) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sIngress) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sLimitrange) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sIngress) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sLimitrange) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sIngress) GetName() *plugin.TValue[string] {
+func (c *mqlK8sLimitrange) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sIngress) GetNamespace() *plugin.TValue[string] {
+func (c *mqlK8sLimitrange) GetNamespace() *plugin.TValue[string] {
 	return &c.Namespace
 }
 
-func (c *mqlK8sIngress) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sLimitrange) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sIngress) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sLimitrange) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sIngress) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sLimitrange) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sIngress) GetRules() *plugin.TValue[[]interface{}] {
-	return &c.Rules
+func (c *mqlK8sLimitrange) GetLimits() *plugin.TValue[[]interface{}] {
+	return &c.Limits
 }
 
-func (c *mqlK8sIngress) GetTls() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Tls, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.ingress", c.__id, "tls")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		return c.tls()
-	})
+func (c *mqlK8sLimitrange) GetSpec() *plugin.TValue[interface{}] {
+	return &c.Spec
 }
 
-// mqlK8sServiceaccount for the k8s.serviceaccount resource
-type mqlK8sServiceaccount struct {
+// mqlK8sPriorityclass for the k8s.priorityclass resource
+type mqlK8sPriorityclass struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sServiceaccountInternal
+	mqlK8sPriorityclassInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
 	Labels plugin.TValue[map[string]interface{}]
 	Annotations plugin.TValue[map[string]interface{}]
 	Name plugin.TValue[string]
-	Namespace plugin.TValue[string]
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
-	Secrets plugin.TValue[[]interface{}]
-	ImagePullSecrets plugin.TValue[[]interface{}]
-	AutomountServiceAccountToken plugin.TValue[bool]
+	Value plugin.TValue[int64]
+	GlobalDefault plugin.TValue[bool]
+	PreemptionPolicy plugin.TValue[string]
+	Description plugin.TValue[string]
 }
 
-// createK8sServiceaccount creates a new instance of this resource
-func createK8sServiceaccount(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sServiceaccount{
+// createK8sPriorityclass creates a new instance of this resource
+func createK8sPriorityclass(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sPriorityclass{
 		MqlRuntime: runtime,
 	}
 
@@ -5820,7 +11122,7 @@ func createK8sServiceaccount(runtime *plugin.Runtime, args map[string]*llx.RawDa
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.serviceaccount", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.priorityclass", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5830,75 +11132,75 @@ func createK8sServiceaccount(runtime *plugin.Runtime, args map[string]*llx.RawDa
 	return res, nil
 }
 
-func (c *mqlK8sServiceaccount) MqlName() string {
-	return "k8s.serviceaccount"
+func (c *mqlK8sPriorityclass) MqlName() string {
+	return "k8s.priorityclass"
 }
 
-func (c *mqlK8sServiceaccount) MqlID() string {
+func (c *mqlK8sPriorityclass) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sServiceaccount) GetId() *plugin.TValue[string] {
+func (c *mqlK8sPriorityclass) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sServiceaccount) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sPriorityclass) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sServiceaccount) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sPriorityclass) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sServiceaccount) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sPriorityclass) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sServiceaccount) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sPriorityclass) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sServiceaccount) GetName() *plugin.TValue[string] {
+func (c *mqlK8sPriorityclass) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sServiceaccount) GetNamespace() *plugin.TValue[string] {
-	return &c.Namespace
-}
-
-func (c *mqlK8sServiceaccount) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sPriorityclass) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sServiceaccount) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sPriorityclass) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sServiceaccount) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sPriorityclass) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sServiceaccount) GetSecrets() *plugin.TValue[[]interface{}] {
-	return &c.Secrets
+func (c *mqlK8sPriorityclass) GetValue() *plugin.TValue[int64] {
+	return &c.Value
 }
 
-func (c *mqlK8sServiceaccount) GetImagePullSecrets() *plugin.TValue[[]interface{}] {
-	return &c.ImagePullSecrets
+func (c *mqlK8sPriorityclass) GetGlobalDefault() *plugin.TValue[bool] {
+	return &c.GlobalDefault
 }
 
-func (c *mqlK8sServiceaccount) GetAutomountServiceAccountToken() *plugin.TValue[bool] {
-	return &c.AutomountServiceAccountToken
+func (c *mqlK8sPriorityclass) GetPreemptionPolicy() *plugin.TValue[string] {
+	return &c.PreemptionPolicy
 }
 
-// mqlK8sRbacClusterrole for the k8s.rbac.clusterrole resource
-type mqlK8sRbacClusterrole struct {
+func (c *mqlK8sPriorityclass) GetDescription() *plugin.TValue[string] {
+	return &c.Description
+}
+
+// mqlK8sValidatingwebhookconfiguration for the k8s.validatingwebhookconfiguration resource
+type mqlK8sValidatingwebhookconfiguration struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sRbacClusterroleInternal
+	mqlK8sValidatingwebhookconfigurationInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
@@ -5908,13 +11210,12 @@ type mqlK8sRbacClusterrole struct {
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
-	Rules plugin.TValue[[]interface{}]
-	AggregationRule plugin.TValue[interface{}]
+	Webhooks plugin.TValue[[]interface{}]
 }
 
-// createK8sRbacClusterrole creates a new instance of this resource
-func createK8sRbacClusterrole(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sRbacClusterrole{
+// createK8sValidatingwebhookconfiguration creates a new instance of this resource
+func createK8sValidatingwebhookconfiguration(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sValidatingwebhookconfiguration{
 		MqlRuntime: runtime,
 	}
 
@@ -5931,7 +11232,7 @@ func createK8sRbacClusterrole(runtime *plugin.Runtime, args map[string]*llx.RawD
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.rbac.clusterrole", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.validatingwebhookconfiguration", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -5941,67 +11242,63 @@ func createK8sRbacClusterrole(runtime *plugin.Runtime, args map[string]*llx.RawD
 	return res, nil
 }
 
-func (c *mqlK8sRbacClusterrole) MqlName() string {
-	return "k8s.rbac.clusterrole"
+func (c *mqlK8sValidatingwebhookconfiguration) MqlName() string {
+	return "k8s.validatingwebhookconfiguration"
 }
 
-func (c *mqlK8sRbacClusterrole) MqlID() string {
+func (c *mqlK8sValidatingwebhookconfiguration) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sRbacClusterrole) GetId() *plugin.TValue[string] {
+func (c *mqlK8sValidatingwebhookconfiguration) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sRbacClusterrole) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sValidatingwebhookconfiguration) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sRbacClusterrole) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sValidatingwebhookconfiguration) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sRbacClusterrole) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sValidatingwebhookconfiguration) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sRbacClusterrole) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sValidatingwebhookconfiguration) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sRbacClusterrole) GetName() *plugin.TValue[string] {
+func (c *mqlK8sValidatingwebhookconfiguration) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sRbacClusterrole) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sValidatingwebhookconfiguration) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sRbacClusterrole) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sValidatingwebhookconfiguration) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sRbacClusterrole) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sValidatingwebhookconfiguration) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sRbacClusterrole) GetRules() *plugin.TValue[[]interface{}] {
-	return &c.Rules
-}
-
-func (c *mqlK8sRbacClusterrole) GetAggregationRule() *plugin.TValue[interface{}] {
-	return &c.AggregationRule
+func (c *mqlK8sValidatingwebhookconfiguration) GetWebhooks() *plugin.TValue[[]interface{}] {
+	return &c.Webhooks
 }
 
-// mqlK8sRbacClusterrolebinding for the k8s.rbac.clusterrolebinding resource
-type mqlK8sRbacClusterrolebinding struct {
+// mqlK8sMutatingwebhookconfiguration for the k8s.mutatingwebhookconfiguration resource
+type mqlK8sMutatingwebhookconfiguration struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sRbacClusterrolebindingInternal
+	mqlK8sMutatingwebhookconfigurationInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
@@ -6011,13 +11308,12 @@ type mqlK8sRbacClusterrolebinding struct {
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
-	Subjects plugin.TValue[[]interface{}]
-	RoleRef plugin.TValue[interface{}]
+	Webhooks plugin.TValue[[]interface{}]
 }
 
-// createK8sRbacClusterrolebinding creates a new instance of this resource
-func createK8sRbacClusterrolebinding(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sRbacClusterrolebinding{
+// createK8sMutatingwebhookconfiguration creates a new instance of this resource
+func createK8sMutatingwebhookconfiguration(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sMutatingwebhookconfiguration{
 		MqlRuntime: runtime,
 	}
 
@@ -6034,7 +11330,7 @@ func createK8sRbacClusterrolebinding(runtime *plugin.Runtime, args map[string]*l
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.rbac.clusterrolebinding", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.mutatingwebhookconfiguration", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6044,83 +11340,83 @@ func createK8sRbacClusterrolebinding(runtime *plugin.Runtime, args map[string]*l
 	return res, nil
 }
 
-func (c *mqlK8sRbacClusterrolebinding) MqlName() string {
-	return "k8s.rbac.clusterrolebinding"
+func (c *mqlK8sMutatingwebhookconfiguration) MqlName() string {
+	return "k8s.mutatingwebhookconfiguration"
 }
 
-func (c *mqlK8sRbacClusterrolebinding) MqlID() string {
+func (c *mqlK8sMutatingwebhookconfiguration) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sRbacClusterrolebinding) GetId() *plugin.TValue[string] {
+func (c *mqlK8sMutatingwebhookconfiguration) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sRbacClusterrolebinding) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sMutatingwebhookconfiguration) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sRbacClusterrolebinding) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sMutatingwebhookconfiguration) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sRbacClusterrolebinding) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sMutatingwebhookconfiguration) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sRbacClusterrolebinding) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sMutatingwebhookconfiguration) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sRbacClusterrolebinding) GetName() *plugin.TValue[string] {
+func (c *mqlK8sMutatingwebhookconfiguration) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sRbacClusterrolebinding) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sMutatingwebhookconfiguration) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sRbacClusterrolebinding) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sMutatingwebhookconfiguration) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sRbacClusterrolebinding) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sMutatingwebhookconfiguration) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sRbacClusterrolebinding) GetSubjects() *plugin.TValue[[]interface{}] {
-	return &c.Subjects
-}
-
-func (c *mqlK8sRbacClusterrolebinding) GetRoleRef() *plugin.TValue[interface{}] {
-	return &c.RoleRef
+func (c *mqlK8sMutatingwebhookconfiguration) GetWebhooks() *plugin.TValue[[]interface{}] {
+	return &c.Webhooks
 }
 
-// mqlK8sRbacRole for the k8s.rbac.role resource
-type mqlK8sRbacRole struct {
+// mqlK8sAdmissionwebhook for the k8s.admissionwebhook resource
+type mqlK8sAdmissionwebhook struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sRbacRoleInternal
-	Id plugin.TValue[string]
-	Uid plugin.TValue[string]
-	ResourceVersion plugin.TValue[string]
-	Labels plugin.TValue[map[string]interface{}]
-	Annotations plugin.TValue[map[string]interface{}]
+	// optional: if you define mqlK8sAdmissionwebhookInternal it will be used here
 	Name plugin.TValue[string]
-	Namespace plugin.TValue[string]
-	Kind plugin.TValue[string]
-	Created plugin.TValue[*time.Time]
-	Manifest plugin.TValue[interface{}]
+	FailurePolicy plugin.TValue[string]
+	MatchPolicy plugin.TValue[string]
+	SideEffects plugin.TValue[string]
+	TimeoutSeconds plugin.TValue[int64]
+	AdmissionReviewVersions plugin.TValue[[]interface{}]
 	Rules plugin.TValue[[]interface{}]
-}
-
-// createK8sRbacRole creates a new instance of this resource
-func createK8sRbacRole(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sRbacRole{
+	NamespaceSelector plugin.TValue[interface{}]
+	ObjectSelector plugin.TValue[interface{}]
+	MatchConditions plugin.TValue[[]interface{}]
+	ReinvocationPolicy plugin.TValue[string]
+	Service plugin.TValue[interface{}]
+	Url plugin.TValue[string]
+	CaBundle plugin.TValue[string]
+	CaBundleCertificates plugin.TValue[[]interface{}]
+}
+
+// createK8sAdmissionwebhook creates a new instance of this resource
+func createK8sAdmissionwebhook(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sAdmissionwebhook{
 		MqlRuntime: runtime,
 	}
 
@@ -6129,15 +11425,10 @@ func createK8sRbacRole(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.rbac.role", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.admissionwebhook", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6147,67 +11438,91 @@ func createK8sRbacRole(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlK8sRbacRole) MqlName() string {
-	return "k8s.rbac.role"
+func (c *mqlK8sAdmissionwebhook) MqlName() string {
+	return "k8s.admissionwebhook"
 }
 
-func (c *mqlK8sRbacRole) MqlID() string {
+func (c *mqlK8sAdmissionwebhook) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sRbacRole) GetId() *plugin.TValue[string] {
-	return &c.Id
+func (c *mqlK8sAdmissionwebhook) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlK8sRbacRole) GetUid() *plugin.TValue[string] {
-	return &c.Uid
+func (c *mqlK8sAdmissionwebhook) GetFailurePolicy() *plugin.TValue[string] {
+	return &c.FailurePolicy
 }
 
-func (c *mqlK8sRbacRole) GetResourceVersion() *plugin.TValue[string] {
-	return &c.ResourceVersion
+func (c *mqlK8sAdmissionwebhook) GetMatchPolicy() *plugin.TValue[string] {
+	return &c.MatchPolicy
 }
 
-func (c *mqlK8sRbacRole) GetLabels() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
-		return c.labels()
-	})
+func (c *mqlK8sAdmissionwebhook) GetSideEffects() *plugin.TValue[string] {
+	return &c.SideEffects
 }
 
-func (c *mqlK8sRbacRole) GetAnnotations() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
-		return c.annotations()
-	})
+func (c *mqlK8sAdmissionwebhook) GetTimeoutSeconds() *plugin.TValue[int64] {
+	return &c.TimeoutSeconds
 }
 
-func (c *mqlK8sRbacRole) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlK8sAdmissionwebhook) GetAdmissionReviewVersions() *plugin.TValue[[]interface{}] {
+	return &c.AdmissionReviewVersions
 }
 
-func (c *mqlK8sRbacRole) GetNamespace() *plugin.TValue[string] {
-	return &c.Namespace
+func (c *mqlK8sAdmissionwebhook) GetRules() *plugin.TValue[[]interface{}] {
+	return &c.Rules
 }
 
-func (c *mqlK8sRbacRole) GetKind() *plugin.TValue[string] {
-	return &c.Kind
+func (c *mqlK8sAdmissionwebhook) GetNamespaceSelector() *plugin.TValue[interface{}] {
+	return &c.NamespaceSelector
 }
 
-func (c *mqlK8sRbacRole) GetCreated() *plugin.TValue[*time.Time] {
-	return &c.Created
+func (c *mqlK8sAdmissionwebhook) GetObjectSelector() *plugin.TValue[interface{}] {
+	return &c.ObjectSelector
 }
 
-func (c *mqlK8sRbacRole) GetManifest() *plugin.TValue[interface{}] {
-	return &c.Manifest
+func (c *mqlK8sAdmissionwebhook) GetMatchConditions() *plugin.TValue[[]interface{}] {
+	return &c.MatchConditions
 }
 
-func (c *mqlK8sRbacRole) GetRules() *plugin.TValue[[]interface{}] {
-	return &c.Rules
+func (c *mqlK8sAdmissionwebhook) GetReinvocationPolicy() *plugin.TValue[string] {
+	return &c.ReinvocationPolicy
 }
 
-// mqlK8sRbacRolebinding for the k8s.rbac.rolebinding resource
-type mqlK8sRbacRolebinding struct {
+func (c *mqlK8sAdmissionwebhook) GetService() *plugin.TValue[interface{}] {
+	return &c.Service
+}
+
+func (c *mqlK8sAdmissionwebhook) GetUrl() *plugin.TValue[string] {
+	return &c.Url
+}
+
+func (c *mqlK8sAdmissionwebhook) GetCaBundle() *plugin.TValue[string] {
+	return &c.CaBundle
+}
+
+func (c *mqlK8sAdmissionwebhook) GetCaBundleCertificates() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.CaBundleCertificates, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.admissionwebhook", c.__id, "caBundleCertificates")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.caBundleCertificates()
+	})
+}
+
+// mqlK8sGateway for the k8s.gateway resource
+type mqlK8sGateway struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sRbacRolebindingInternal
+	mqlK8sGatewayInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
@@ -6218,13 +11533,15 @@ type mqlK8sRbacRolebinding struct {
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
-	Subjects plugin.TValue[[]interface{}]
-	RoleRef plugin.TValue[interface{}]
+	GatewayClassName plugin.TValue[string]
+	Listeners plugin.TValue[[]interface{}]
+	Addresses plugin.TValue[[]interface{}]
+	Spec plugin.TValue[interface{}]
 }
 
-// createK8sRbacRolebinding creates a new instance of this resource
-func createK8sRbacRolebinding(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sRbacRolebinding{
+// createK8sGateway creates a new instance of this resource
+func createK8sGateway(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sGateway{
 		MqlRuntime: runtime,
 	}
 
@@ -6241,7 +11558,7 @@ func createK8sRbacRolebinding(runtime *plugin.Runtime, args map[string]*llx.RawD
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.rbac.rolebinding", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.gateway", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6251,86 +11568,98 @@ func createK8sRbacRolebinding(runtime *plugin.Runtime, args map[string]*llx.RawD
 	return res, nil
 }
 
-func (c *mqlK8sRbacRolebinding) MqlName() string {
-	return "k8s.rbac.rolebinding"
+func (c *mqlK8sGateway) MqlName() string {
+	return "k8s.gateway"
 }
 
-func (c *mqlK8sRbacRolebinding) MqlID() string {
+func (c *mqlK8sGateway) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sRbacRolebinding) GetId() *plugin.TValue[string] {
+func (c *mqlK8sGateway) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sRbacRolebinding) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sGateway) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sRbacRolebinding) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sGateway) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sRbacRolebinding) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sGateway) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sRbacRolebinding) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sGateway) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sRbacRolebinding) GetName() *plugin.TValue[string] {
+func (c *mqlK8sGateway) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sRbacRolebinding) GetNamespace() *plugin.TValue[string] {
+func (c *mqlK8sGateway) GetNamespace() *plugin.TValue[string] {
 	return &c.Namespace
 }
 
-func (c *mqlK8sRbacRolebinding) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sGateway) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sRbacRolebinding) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sGateway) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sRbacRolebinding) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sGateway) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sRbacRolebinding) GetSubjects() *plugin.TValue[[]interface{}] {
-	return &c.Subjects
+func (c *mqlK8sGateway) GetGatewayClassName() *plugin.TValue[string] {
+	return &c.GatewayClassName
 }
 
-func (c *mqlK8sRbacRolebinding) GetRoleRef() *plugin.TValue[interface{}] {
-	return &c.RoleRef
+func (c *mqlK8sGateway) GetListeners() *plugin.TValue[[]interface{}] {
+	return &c.Listeners
 }
 
-// mqlK8sPodsecuritypolicy for the k8s.podsecuritypolicy resource
-type mqlK8sPodsecuritypolicy struct {
+func (c *mqlK8sGateway) GetAddresses() *plugin.TValue[[]interface{}] {
+	return &c.Addresses
+}
+
+func (c *mqlK8sGateway) GetSpec() *plugin.TValue[interface{}] {
+	return &c.Spec
+}
+
+// mqlK8sHttproute for the k8s.httproute resource
+type mqlK8sHttproute struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sPodsecuritypolicyInternal
+	mqlK8sHttprouteInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
 	Labels plugin.TValue[map[string]interface{}]
 	Annotations plugin.TValue[map[string]interface{}]
 	Name plugin.TValue[string]
+	Namespace plugin.TValue[string]
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
+	ParentRefs plugin.TValue[[]interface{}]
+	Hostnames plugin.TValue[[]interface{}]
+	Rules plugin.TValue[[]interface{}]
 	Spec plugin.TValue[interface{}]
 }
 
-// createK8sPodsecuritypolicy creates a new instance of this resource
-func createK8sPodsecuritypolicy(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sPodsecuritypolicy{
+// createK8sHttproute creates a new instance of this resource
+func createK8sHttproute(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sHttproute{
 		MqlRuntime: runtime,
 	}
 
@@ -6347,7 +11676,7 @@ func createK8sPodsecuritypolicy(runtime *plugin.Runtime, args map[string]*llx.Ra
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.podsecuritypolicy", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.httproute", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6357,63 +11686,79 @@ func createK8sPodsecuritypolicy(runtime *plugin.Runtime, args map[string]*llx.Ra
 	return res, nil
 }
 
-func (c *mqlK8sPodsecuritypolicy) MqlName() string {
-	return "k8s.podsecuritypolicy"
+func (c *mqlK8sHttproute) MqlName() string {
+	return "k8s.httproute"
 }
 
-func (c *mqlK8sPodsecuritypolicy) MqlID() string {
+func (c *mqlK8sHttproute) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sPodsecuritypolicy) GetId() *plugin.TValue[string] {
+func (c *mqlK8sHttproute) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sPodsecuritypolicy) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sHttproute) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sPodsecuritypolicy) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sHttproute) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sPodsecuritypolicy) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sHttproute) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sPodsecuritypolicy) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sHttproute) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sPodsecuritypolicy) GetName() *plugin.TValue[string] {
+func (c *mqlK8sHttproute) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sPodsecuritypolicy) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sHttproute) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
+
+func (c *mqlK8sHttproute) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sPodsecuritypolicy) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sHttproute) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sPodsecuritypolicy) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sHttproute) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sPodsecuritypolicy) GetSpec() *plugin.TValue[interface{}] {
+func (c *mqlK8sHttproute) GetParentRefs() *plugin.TValue[[]interface{}] {
+	return &c.ParentRefs
+}
+
+func (c *mqlK8sHttproute) GetHostnames() *plugin.TValue[[]interface{}] {
+	return &c.Hostnames
+}
+
+func (c *mqlK8sHttproute) GetRules() *plugin.TValue[[]interface{}] {
+	return &c.Rules
+}
+
+func (c *mqlK8sHttproute) GetSpec() *plugin.TValue[interface{}] {
 	return &c.Spec
 }
 
-// mqlK8sNetworkpolicy for the k8s.networkpolicy resource
-type mqlK8sNetworkpolicy struct {
+// mqlK8sEvent for the k8s.event resource
+type mqlK8sEvent struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlK8sNetworkpolicyInternal
+	mqlK8sEventInternal
 	Id plugin.TValue[string]
 	Uid plugin.TValue[string]
 	ResourceVersion plugin.TValue[string]
@@ -6424,12 +11769,19 @@ type mqlK8sNetworkpolicy struct {
 	Kind plugin.TValue[string]
 	Created plugin.TValue[*time.Time]
 	Manifest plugin.TValue[interface{}]
-	Spec plugin.TValue[interface{}]
-}
-
-// createK8sNetworkpolicy creates a new instance of this resource
-func createK8sNetworkpolicy(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlK8sNetworkpolicy{
+	Type plugin.TValue[string]
+	Reason plugin.TValue[string]
+	Message plugin.TValue[string]
+	InvolvedObject plugin.TValue[interface{}]
+	Source plugin.TValue[string]
+	Count plugin.TValue[int64]
+	FirstTimestamp plugin.TValue[*time.Time]
+	LastTimestamp plugin.TValue[*time.Time]
+}
+
+// createK8sEvent creates a new instance of this resource
+func createK8sEvent(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sEvent{
 		MqlRuntime: runtime,
 	}
 
@@ -6446,7 +11798,7 @@ func createK8sNetworkpolicy(runtime *plugin.Runtime, args map[string]*llx.RawDat
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("k8s.networkpolicy", res.__id)
+		args, err = runtime.ResourceFromRecording("k8s.event", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -6456,60 +11808,88 @@ func createK8sNetworkpolicy(runtime *plugin.Runtime, args map[string]*llx.RawDat
 	return res, nil
 }
 
-func (c *mqlK8sNetworkpolicy) MqlName() string {
-	return "k8s.networkpolicy"
+func (c *mqlK8sEvent) MqlName() string {
+	return "k8s.event"
 }
 
-func (c *mqlK8sNetworkpolicy) MqlID() string {
+func (c *mqlK8sEvent) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlK8sNetworkpolicy) GetId() *plugin.TValue[string] {
+func (c *mqlK8sEvent) GetId() *plugin.TValue[string] {
 	return &c.Id
 }
 
-func (c *mqlK8sNetworkpolicy) GetUid() *plugin.TValue[string] {
+func (c *mqlK8sEvent) GetUid() *plugin.TValue[string] {
 	return &c.Uid
 }
 
-func (c *mqlK8sNetworkpolicy) GetResourceVersion() *plugin.TValue[string] {
+func (c *mqlK8sEvent) GetResourceVersion() *plugin.TValue[string] {
 	return &c.ResourceVersion
 }
 
-func (c *mqlK8sNetworkpolicy) GetLabels() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sEvent) GetLabels() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Labels, func() (map[string]interface{}, error) {
 		return c.labels()
 	})
 }
 
-func (c *mqlK8sNetworkpolicy) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+func (c *mqlK8sEvent) GetAnnotations() *plugin.TValue[map[string]interface{}] {
 	return plugin.GetOrCompute[map[string]interface{}](&c.Annotations, func() (map[string]interface{}, error) {
 		return c.annotations()
 	})
 }
 
-func (c *mqlK8sNetworkpolicy) GetName() *plugin.TValue[string] {
+func (c *mqlK8sEvent) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlK8sNetworkpolicy) GetNamespace() *plugin.TValue[string] {
+func (c *mqlK8sEvent) GetNamespace() *plugin.TValue[string] {
 	return &c.Namespace
 }
 
-func (c *mqlK8sNetworkpolicy) GetKind() *plugin.TValue[string] {
+func (c *mqlK8sEvent) GetKind() *plugin.TValue[string] {
 	return &c.Kind
 }
 
-func (c *mqlK8sNetworkpolicy) GetCreated() *plugin.TValue[*time.Time] {
+func (c *mqlK8sEvent) GetCreated() *plugin.TValue[*time.Time] {
 	return &c.Created
 }
 
-func (c *mqlK8sNetworkpolicy) GetManifest() *plugin.TValue[interface{}] {
+func (c *mqlK8sEvent) GetManifest() *plugin.TValue[interface{}] {
 	return &c.Manifest
 }
 
-func (c *mqlK8sNetworkpolicy) GetSpec() *plugin.TValue[interface{}] {
-	return &c.Spec
+func (c *mqlK8sEvent) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
+
+func (c *mqlK8sEvent) GetReason() *plugin.TValue[string] {
+	return &c.Reason
+}
+
+func (c *mqlK8sEvent) GetMessage() *plugin.TValue[string] {
+	return &c.Message
+}
+
+func (c *mqlK8sEvent) GetInvolvedObject() *plugin.TValue[interface{}] {
+	return &c.InvolvedObject
+}
+
+func (c *mqlK8sEvent) GetSource() *plugin.TValue[string] {
+	return &c.Source
+}
+
+func (c *mqlK8sEvent) GetCount() *plugin.TValue[int64] {
+	return &c.Count
+}
+
+func (c *mqlK8sEvent) GetFirstTimestamp() *plugin.TValue[*time.Time] {
+	return &c.FirstTimestamp
+}
+
+func (c *mqlK8sEvent) GetLastTimestamp() *plugin.TValue[*time.Time] {
+	return &c.LastTimestamp
 }
 
 // mqlK8sCustomresource for the k8s.customresource resource
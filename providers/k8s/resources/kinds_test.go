@@ -0,0 +1,178 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/k8s/connection/manifest"
+)
+
+func kindsRuntime(t *testing.T, file string) *plugin.Runtime {
+	conn, err := manifest.NewConnection(0, &inventory.Asset{
+		Connections: []*inventory.Config{{}},
+	}, manifest.WithManifestFile(file))
+	require.NoError(t, err)
+	return &plugin.Runtime{Connection: conn}
+}
+
+func TestStorage(t *testing.T) {
+	k := &mqlK8s{MqlRuntime: kindsRuntime(t, "./testdata/kinds.yaml")}
+
+	pvs, err := k.persistentVolumes()
+	require.NoError(t, err)
+	require.Len(t, pvs, 1)
+	pv := pvs[0].(*mqlK8sPersistentvolume)
+	assert.Equal(t, "data", pv.Name.Data)
+	assert.Equal(t, map[string]interface{}{"storage": "10Gi"}, pv.Capacity.Data)
+	assert.Equal(t, []interface{}{"ReadWriteOnce"}, pv.AccessModes.Data)
+	assert.Equal(t, "Retain", pv.ReclaimPolicy.Data)
+
+	pvcs, err := k.persistentVolumeClaims()
+	require.NoError(t, err)
+	require.Len(t, pvcs, 1)
+	pvc := pvcs[0].(*mqlK8sPersistentvolumeclaim)
+	assert.Equal(t, "app", pvc.Namespace.Data)
+	assert.Equal(t, "fast", pvc.StorageClassName.Data)
+	assert.Equal(t, map[string]interface{}{"storage": "5Gi"}, pvc.Requests.Data)
+
+	scs, err := k.storageClasses()
+	require.NoError(t, err)
+	require.Len(t, scs, 1)
+	sc := scs[0].(*mqlK8sStorageclass)
+	assert.Equal(t, "ebs.csi.aws.com", sc.Provisioner.Data)
+	assert.Equal(t, "WaitForFirstConsumer", sc.VolumeBindingMode.Data)
+	assert.True(t, sc.AllowVolumeExpansion.Data)
+	assert.True(t, sc.IsDefault.Data)
+	assert.Equal(t, "true", sc.Parameters.Data["encrypted"])
+}
+
+func TestWorkloadPolicies(t *testing.T) {
+	k := &mqlK8s{MqlRuntime: kindsRuntime(t, "./testdata/kinds.yaml")}
+
+	hpas, err := k.horizontalPodAutoscalers()
+	require.NoError(t, err)
+	require.Len(t, hpas, 2)
+	for _, h := range hpas {
+		hpa := h.(*mqlK8sHorizontalpodautoscaler)
+		require.Len(t, hpa.Metrics.Data, 1)
+		metric := hpa.Metrics.Data[0].(map[string]interface{})
+		assert.Equal(t, "Resource", metric["type"])
+		switch hpa.Name.Data {
+		case "web":
+			assert.Equal(t, int64(2), hpa.MinReplicas.Data)
+			assert.Equal(t, int64(10), hpa.MaxReplicas.Data)
+		case "worker":
+			// autoscaling/v1 objects are converted to the v2 format
+			assert.Equal(t, int64(1), hpa.MinReplicas.Data)
+			assert.Equal(t, "cpu", metric["resource"].(map[string]interface{})["name"])
+		default:
+			t.Fatal("unexpected autoscaler " + hpa.Name.Data)
+		}
+	}
+
+	pdbs, err := k.podDisruptionBudgets()
+	require.NoError(t, err)
+	require.Len(t, pdbs, 1)
+	pdb := pdbs[0].(*mqlK8sPoddisruptionbudget)
+	assert.Equal(t, "50%", pdb.MinAvailable.Data)
+	assert.Equal(t, "", pdb.MaxUnavailable.Data)
+
+	quotas, err := k.resourceQuotas()
+	require.NoError(t, err)
+	require.Len(t, quotas, 1)
+	assert.Equal(t, map[string]interface{}{"requests.cpu": "4", "limits.memory": "8Gi"}, quotas[0].(*mqlK8sResourcequota).Hard.Data)
+
+	limitRanges, err := k.limitRanges()
+	require.NoError(t, err)
+	require.Len(t, limitRanges, 1)
+	assert.Len(t, limitRanges[0].(*mqlK8sLimitrange).Limits.Data, 1)
+
+	pcs, err := k.priorityClasses()
+	require.NoError(t, err)
+	require.Len(t, pcs, 1)
+	assert.Equal(t, int64(1000000), pcs[0].(*mqlK8sPriorityclass).Value.Data)
+}
+
+func TestAdmissionWebhooks(t *testing.T) {
+	k := &mqlK8s{MqlRuntime: kindsRuntime(t, "./testdata/kinds.yaml")}
+
+	validating, err := k.validatingWebhookConfigurations()
+	require.NoError(t, err)
+	require.Len(t, validating, 1)
+	webhooks := validating[0].(*mqlK8sValidatingwebhookconfiguration).Webhooks.Data
+	require.Len(t, webhooks, 1)
+	webhook := webhooks[0].(*mqlK8sAdmissionwebhook)
+	assert.Equal(t, "validate.policy.example.com", webhook.Name.Data)
+	assert.Equal(t, "Ignore", webhook.FailurePolicy.Data)
+	assert.Equal(t, "policy", webhook.Service.Data.(map[string]interface{})["name"])
+	assert.NotEmpty(t, webhook.NamespaceSelector.Data.(map[string]interface{})["matchExpressions"])
+	assert.Len(t, webhook.Rules.Data, 1)
+	certs, err := webhook.caBundleCertificates()
+	require.NoError(t, err)
+	assert.Empty(t, certs)
+
+	mutating, err := k.mutatingWebhookConfigurations()
+	require.NoError(t, err)
+	require.Len(t, mutating, 1)
+	webhooks = mutating[0].(*mqlK8sMutatingwebhookconfiguration).Webhooks.Data
+	require.Len(t, webhooks, 1)
+	webhook = webhooks[0].(*mqlK8sAdmissionwebhook)
+	assert.Equal(t, "Fail", webhook.FailurePolicy.Data)
+	assert.Equal(t, "IfNeeded", webhook.ReinvocationPolicy.Data)
+	assert.Equal(t, "https://injector.example.com/mutate", webhook.Url.Data)
+}
+
+func TestGatewayApi(t *testing.T) {
+	k := &mqlK8s{MqlRuntime: kindsRuntime(t, "./testdata/kinds.yaml")}
+
+	gateways, err := k.gateways()
+	require.NoError(t, err)
+	require.Len(t, gateways, 1)
+	gateway := gateways[0].(*mqlK8sGateway)
+	assert.Equal(t, "external", gateway.GatewayClassName.Data)
+	require.Len(t, gateway.Listeners.Data, 1)
+	assert.Equal(t, "HTTPS", gateway.Listeners.Data[0].(map[string]interface{})["protocol"])
+
+	routes, err := k.httpRoutes()
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	route := routes[0].(*mqlK8sHttproute)
+	assert.Equal(t, []interface{}{"www.example.com"}, route.Hostnames.Data)
+	assert.Len(t, route.ParentRefs.Data, 1)
+
+	// manifests without the Gateway API have no gateways
+	k = &mqlK8s{MqlRuntime: kindsRuntime(t, "./testdata/rbac.yaml")}
+	gateways, err = k.gateways()
+	require.NoError(t, err)
+	assert.Empty(t, gateways)
+}
+
+func TestEvents(t *testing.T) {
+	k := &mqlK8s{MqlRuntime: kindsRuntime(t, "./testdata/kinds.yaml")}
+
+	events, err := k.events()
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	backoff := events[0].(*mqlK8sEvent)
+	assert.Equal(t, "Warning", backoff.Type.Data)
+	assert.Equal(t, "BackOff", backoff.Reason.Data)
+	assert.Equal(t, "kubelet", backoff.Source.Data)
+	assert.Equal(t, int64(12), backoff.Count.Data)
+	assert.Equal(t, "web-6d4cf56db6-x2x8k", backoff.InvolvedObject.Data.(map[string]interface{})["name"])
+	assert.Equal(t, time.Date(2023, 10, 10, 8, 0, 0, 0, time.UTC), backoff.FirstTimestamp.Data.UTC())
+	assert.Equal(t, time.Date(2023, 10, 10, 9, 30, 0, 0, time.UTC), backoff.LastTimestamp.Data.UTC())
+
+	// events of the events.k8s.io API only have an event time
+	scheduled := events[1].(*mqlK8sEvent)
+	assert.Equal(t, "default-scheduler", scheduled.Source.Data)
+	assert.Equal(t, int64(1), scheduled.Count.Data)
+	assert.Equal(t, time.Date(2023, 10, 10, 7, 59, 0, 0, time.UTC), scheduled.FirstTimestamp.Data.UTC())
+	assert.Equal(t, scheduled.FirstTimestamp.Data, scheduled.LastTimestamp.Data)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/v9/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type mqlK8sLimitrangeInternal struct {
+	lock sync.Mutex
+	obj  *corev1.LimitRange
+}
+
+func (k *mqlK8s) limitRanges() ([]interface{}, error) {
+	return k8sResourceToMql(k.MqlRuntime, "limitranges", func(kind string, resource runtime.Object, obj metav1.Object, objT metav1.Type) (interface{}, error) {
+		ts := obj.GetCreationTimestamp()
+
+		manifest, err := convert.JsonToDict(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		limitRange, ok := resource.(*corev1.LimitRange)
+		if !ok {
+			return nil, errors.New("not a k8s limitrange")
+		}
+
+		spec, err := convert.JsonToDict(limitRange.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		limits, err := convert.JsonToDictSlice(limitRange.Spec.Limits)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := CreateResource(k.MqlRuntime, "k8s.limitrange", map[string]*llx.RawData{
+			"id":              llx.StringData(objIdFromK8sObj(obj, objT)),
+			"uid":             llx.StringData(string(obj.GetUID())),
+			"resourceVersion": llx.StringData(obj.GetResourceVersion()),
+			"name":            llx.StringData(obj.GetName()),
+			"namespace":       llx.StringData(obj.GetNamespace()),
+			"kind":            llx.StringData(objT.GetKind()),
+			"created":         llx.TimeData(ts.Time),
+			"manifest":        llx.DictData(manifest),
+			"limits":          llx.ArrayData(limits, types.Dict),
+			"spec":            llx.DictData(spec),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.(*mqlK8sLimitrange).obj = limitRange
+		return r, nil
+	})
+}
+
+func (k *mqlK8sLimitrange) id() (string, error) {
+	return k.Id.Data, nil
+}
+
+func initK8sLimitrange(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initNamespacedResource[*mqlK8sLimitrange](runtime, args, func(k *mqlK8s) *plugin.TValue[[]interface{}] { return k.GetLimitRanges() })
+}
+
+func (k *mqlK8sLimitrange) annotations() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetAnnotations()), nil
+}
+
+func (k *mqlK8sLimitrange) labels() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetLabels()), nil
+}
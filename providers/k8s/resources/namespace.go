@@ -35,7 +35,8 @@ func (k *mqlK8s) namespaces() ([]interface{}, error) {
 	}
 
 	resp := make([]interface{}, 0, len(nss))
-	for _, ns := range nss {
+	for i := range nss {
+		ns := nss[i]
 		ts := ns.GetCreationTimestamp()
 
 		manifest, err := convert.JsonToDict(ns)
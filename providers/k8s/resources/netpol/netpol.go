@@ -0,0 +1,390 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package netpol evaluates Kubernetes network policies to compute which
+// sources may reach a pod. It implements the NetworkPolicy semantics of the
+// Kubernetes API and only relies on pods, namespaces, and policies, which
+// means it works the same way for live clusters and for manifests.
+package netpol
+
+import (
+	"net"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// NamespaceNameLabel is set on all namespaces by the API server
+const NamespaceNameLabel = "kubernetes.io/metadata.name"
+
+// Port is a port that traffic is allowed on
+type Port struct {
+	// Protocol is TCP, UDP, or SCTP
+	Protocol string
+	// Port is 0 if all ports of the protocol are allowed
+	Port int32
+	// EndPort is set for port ranges
+	EndPort int32
+	// Name is set for named ports that could not be resolved
+	Name string
+}
+
+// Peer is a source that may reach a pod
+type Peer struct {
+	// Policy that allows the traffic, e.g. default/allow-app. It is empty if
+	// the pod is not isolated by any policy.
+	Policy string
+	// Any is set if traffic from all sources is allowed, including
+	// sources outside the cluster
+	Any bool
+	// NamespaceSelector and PodSelector are the selectors of the policy peer
+	NamespaceSelector *metav1.LabelSelector
+	PodSelector       *metav1.LabelSelector
+	// Namespaces that are matched by the peer
+	Namespaces []string
+	// Pods that are matched by the peer
+	Pods []*corev1.Pod
+	// IPBlock is set for peers that allow IP ranges
+	IPBlock *networkingv1.IPBlock
+	// Ports that the traffic is allowed on. All ports are allowed if empty.
+	Ports []Port
+}
+
+type Analyzer struct {
+	pods     []*corev1.Pod
+	policies []*networkingv1.NetworkPolicy
+	// namespaces maps all known namespaces to their labels
+	namespaces map[string]labels.Set
+}
+
+// New creates an analyzer. Namespaces that are only referenced by pods or
+// policies are treated as namespaces without custom labels.
+func New(pods []*corev1.Pod, namespaces []*corev1.Namespace, policies []*networkingv1.NetworkPolicy) *Analyzer {
+	a := &Analyzer{
+		pods:       pods,
+		policies:   policies,
+		namespaces: map[string]labels.Set{},
+	}
+	for _, ns := range namespaces {
+		a.addNamespace(ns.Name, ns.Labels)
+	}
+	for _, pod := range pods {
+		a.addNamespace(pod.Namespace, nil)
+	}
+	for _, policy := range policies {
+		a.addNamespace(policy.Namespace, nil)
+	}
+	return a
+}
+
+func (a *Analyzer) addNamespace(name string, l map[string]string) {
+	if name == "" {
+		return
+	}
+	if _, ok := a.namespaces[name]; ok && l == nil {
+		return
+	}
+	set := labels.Set{}
+	for k, v := range l {
+		set[k] = v
+	}
+	set[NamespaceNameLabel] = name
+	a.namespaces[name] = set
+}
+
+// Namespaces returns the names of all known namespaces
+func (a *Analyzer) Namespaces() []string {
+	res := make([]string, 0, len(a.namespaces))
+	for ns := range a.namespaces {
+		res = append(res, ns)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// matches evaluates a label selector. A nil selector matches nothing and an
+// empty selector matches everything.
+func matches(selector *metav1.LabelSelector, l map[string]string) bool {
+	if selector == nil {
+		return false
+	}
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false
+	}
+	return s.Matches(labels.Set(l))
+}
+
+func hasPolicyType(policy *networkingv1.NetworkPolicy, policyType networkingv1.PolicyType) bool {
+	if len(policy.Spec.PolicyTypes) == 0 {
+		// policies without types always affect ingress and affect egress
+		// if they have egress rules
+		return policyType == networkingv1.PolicyTypeIngress || len(policy.Spec.Egress) > 0
+	}
+	for _, t := range policy.Spec.PolicyTypes {
+		if t == policyType {
+			return true
+		}
+	}
+	return false
+}
+
+// selecting returns the policies of the given type that select the pod
+func (a *Analyzer) selecting(pod *corev1.Pod, policyType networkingv1.PolicyType) []*networkingv1.NetworkPolicy {
+	res := []*networkingv1.NetworkPolicy{}
+	for _, policy := range a.policies {
+		if policy.Namespace != pod.Namespace || !hasPolicyType(policy, policyType) {
+			continue
+		}
+		if matches(&policy.Spec.PodSelector, pod.Labels) {
+			res = append(res, policy)
+		}
+	}
+	return res
+}
+
+// IngressIsolated returns true if the pod is selected by a policy for
+// ingress. Only traffic that one of these policies allows may reach it.
+func (a *Analyzer) IngressIsolated(pod *corev1.Pod) bool {
+	return len(a.selecting(pod, networkingv1.PolicyTypeIngress)) > 0
+}
+
+// EgressIsolated returns true if the pod is selected by a policy for
+// egress. It may only connect to destinations that one of these policies
+// allows.
+func (a *Analyzer) EgressIsolated(pod *corev1.Pod) bool {
+	return len(a.selecting(pod, networkingv1.PolicyTypeEgress)) > 0
+}
+
+// ReachableFrom returns all sources that may reach the pod. Named ports
+// are resolved with the ports of the pod's containers.
+func (a *Analyzer) ReachableFrom(pod *corev1.Pod) []Peer {
+	policies := a.selecting(pod, networkingv1.PolicyTypeIngress)
+	if len(policies) == 0 {
+		return []Peer{a.anyPeer("", nil)}
+	}
+
+	res := []Peer{}
+	for _, policy := range policies {
+		for _, rule := range policy.Spec.Ingress {
+			ports := resolvePorts(rule.Ports, pod)
+			if len(rule.Ports) > 0 && len(ports) == 0 {
+				// none of the named ports exist on the pod
+				continue
+			}
+			if len(rule.From) == 0 {
+				res = append(res, a.anyPeer(policyKey(policy), ports))
+				continue
+			}
+			for _, peer := range rule.From {
+				res = append(res, a.peer(policy, peer, ports))
+			}
+		}
+	}
+	return res
+}
+
+func policyKey(policy *networkingv1.NetworkPolicy) string {
+	return policy.Namespace + "/" + policy.Name
+}
+
+func (a *Analyzer) anyPeer(policy string, ports []Port) Peer {
+	return Peer{
+		Policy:     policy,
+		Any:        true,
+		Namespaces: a.Namespaces(),
+		Pods:       a.pods,
+		Ports:      ports,
+	}
+}
+
+func (a *Analyzer) peer(policy *networkingv1.NetworkPolicy, peer networkingv1.NetworkPolicyPeer, ports []Port) Peer {
+	res := Peer{
+		Policy:            policyKey(policy),
+		NamespaceSelector: peer.NamespaceSelector,
+		PodSelector:       peer.PodSelector,
+		IPBlock:           peer.IPBlock,
+		Ports:             ports,
+	}
+	if peer.IPBlock != nil {
+		return res
+	}
+
+	for _, ns := range a.Namespaces() {
+		if a.namespaceMatches(policy, peer, ns) {
+			res.Namespaces = append(res.Namespaces, ns)
+		}
+	}
+	for _, pod := range a.pods {
+		if a.podMatches(policy, peer, pod) {
+			res.Pods = append(res.Pods, pod)
+		}
+	}
+	return res
+}
+
+// namespaceMatches checks if the namespace is matched by the peer. Peers
+// without namespace selector only match the namespace of the policy.
+func (a *Analyzer) namespaceMatches(policy *networkingv1.NetworkPolicy, peer networkingv1.NetworkPolicyPeer, namespace string) bool {
+	if peer.NamespaceSelector == nil {
+		return namespace == policy.Namespace
+	}
+	return matches(peer.NamespaceSelector, a.namespaces[namespace])
+}
+
+func (a *Analyzer) podMatches(policy *networkingv1.NetworkPolicy, peer networkingv1.NetworkPolicyPeer, pod *corev1.Pod) bool {
+	if peer.IPBlock != nil {
+		return ipBlockMatches(peer.IPBlock, pod.Status.PodIP)
+	}
+	if !a.namespaceMatches(policy, peer, pod.Namespace) {
+		return false
+	}
+	return peer.PodSelector == nil || matches(peer.PodSelector, pod.Labels)
+}
+
+// ipBlockMatches checks if the IP is in the block. Pods without an IP,
+// e.g. in manifests, are never matched.
+func ipBlockMatches(block *networkingv1.IPBlock, ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	_, cidr, err := net.ParseCIDR(block.CIDR)
+	if err != nil || !cidr.Contains(addr) {
+		return false
+	}
+	for _, except := range block.Except {
+		if _, e, err := net.ParseCIDR(except); err == nil && e.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+func protocol(p *corev1.Protocol) string {
+	if p == nil || *p == "" {
+		return string(corev1.ProtocolTCP)
+	}
+	return string(*p)
+}
+
+// resolvePorts converts the ports of a rule. Named ports are resolved with
+// the container ports of the destination pod, if it is known.
+func resolvePorts(ports []networkingv1.NetworkPolicyPort, pod *corev1.Pod) []Port {
+	res := []Port{}
+	for _, p := range ports {
+		port := Port{Protocol: protocol(p.Protocol)}
+		if p.Port != nil {
+			if name := p.Port.StrVal; p.Port.IntVal == 0 && name != "" {
+				if pod == nil {
+					port.Name = name
+				} else if port.Port = namedPort(pod, name, port.Protocol); port.Port == 0 {
+					continue
+				}
+			} else {
+				port.Port = p.Port.IntVal
+			}
+		}
+		if p.EndPort != nil {
+			port.EndPort = *p.EndPort
+		}
+		res = append(res, port)
+	}
+	return res
+}
+
+func namedPort(pod *corev1.Pod, name string, protocol string) int32 {
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Name == name && strings.EqualFold(protocolOrTCP(p.Protocol), protocol) {
+				return p.ContainerPort
+			}
+		}
+	}
+	return 0
+}
+
+func protocolOrTCP(p corev1.Protocol) string {
+	return protocol(&p)
+}
+
+// allows checks if the port is in the list. An empty list allows all ports.
+func allows(ports []Port, port int32, protocol string) bool {
+	if len(ports) == 0 {
+		return true
+	}
+	for _, p := range ports {
+		if !strings.EqualFold(p.Protocol, protocol) {
+			continue
+		}
+		if p.Port == 0 && p.Name == "" {
+			return true
+		}
+		if p.Port == port || (p.EndPort != 0 && p.Port <= port && port <= p.EndPort) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanReach checks if the source pod may connect to the port of the
+// destination pod. Both the egress policies of the source and the ingress
+// policies of the destination must allow the connection.
+func (a *Analyzer) CanReach(from *corev1.Pod, to *corev1.Pod, port int32, protocol string) bool {
+	if protocol == "" {
+		protocol = string(corev1.ProtocolTCP)
+	}
+	return a.allowed(from, to, to, networkingv1.PolicyTypeIngress, port, protocol) &&
+		a.allowed(from, to, from, networkingv1.PolicyTypeEgress, port, protocol)
+}
+
+// allowed checks if the policies that select the subject allow the
+// connection in the given direction
+func (a *Analyzer) allowed(from *corev1.Pod, to *corev1.Pod, subject *corev1.Pod, policyType networkingv1.PolicyType, port int32, protocol string) bool {
+	policies := a.selecting(subject, policyType)
+	if len(policies) == 0 {
+		return true
+	}
+
+	for _, policy := range policies {
+		if policyType == networkingv1.PolicyTypeIngress {
+			for _, rule := range policy.Spec.Ingress {
+				if a.ruleAllows(policy, rule.From, rule.Ports, from, to, port, protocol) {
+					return true
+				}
+			}
+		} else {
+			for _, rule := range policy.Spec.Egress {
+				if a.ruleAllows(policy, rule.To, rule.Ports, to, to, port, protocol) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// ruleAllows checks if the rule allows the peer pod on the port of the
+// destination pod
+func (a *Analyzer) ruleAllows(policy *networkingv1.NetworkPolicy, peers []networkingv1.NetworkPolicyPeer, rulePorts []networkingv1.NetworkPolicyPort, peer *corev1.Pod, to *corev1.Pod, port int32, protocol string) bool {
+	ports := resolvePorts(rulePorts, to)
+	if len(rulePorts) > 0 && len(ports) == 0 {
+		return false
+	}
+	if !allows(ports, port, protocol) {
+		return false
+	}
+	if len(peers) == 0 {
+		return true
+	}
+	for _, p := range peers {
+		if a.podMatches(policy, p, peer) {
+			return true
+		}
+	}
+	return false
+}
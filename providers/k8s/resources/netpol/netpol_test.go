@@ -0,0 +1,153 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package netpol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func pod(namespace, name string, l map[string]string, ports ...corev1.ContainerPort) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: l},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: name, Ports: ports}},
+		},
+	}
+}
+
+func policy(namespace, name string, spec networkingv1.NetworkPolicySpec) *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       spec,
+	}
+}
+
+func tcp(port intstr.IntOrString) networkingv1.NetworkPolicyPort {
+	protocol := corev1.ProtocolTCP
+	return networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &port}
+}
+
+func TestReachability(t *testing.T) {
+	db := pod("db", "postgres", map[string]string{"app": "postgres"}, corev1.ContainerPort{Name: "sql", ContainerPort: 5432})
+	web := pod("app", "web", map[string]string{"app": "web"})
+	other := pod("other", "client", map[string]string{"app": "web"})
+	cache := pod("db", "redis", map[string]string{"app": "redis"})
+
+	namespaces := []*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "app", Labels: map[string]string{"team": "app"}}},
+	}
+	policies := []*networkingv1.NetworkPolicy{
+		// default deny for the db namespace
+		policy("db", "default-deny", networkingv1.NetworkPolicySpec{
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+		}),
+		policy("db", "allow-app", networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "postgres"}},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From: []networkingv1.NetworkPolicyPeer{{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "app"}},
+					PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				}, {
+					IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}},
+				}},
+				Ports: []networkingv1.NetworkPolicyPort{tcp(intstr.FromString("sql"))},
+			}},
+		}),
+	}
+
+	a := New([]*corev1.Pod{db, web, other, cache}, namespaces, policies)
+	assert.Equal(t, []string{"app", "db", "other"}, a.Namespaces())
+
+	assert.True(t, a.IngressIsolated(db))
+	assert.True(t, a.EgressIsolated(db))
+	assert.False(t, a.IngressIsolated(web))
+	assert.False(t, a.EgressIsolated(web))
+
+	t.Run("reachable from", func(t *testing.T) {
+		peers := a.ReachableFrom(db)
+		require.Len(t, peers, 2)
+		assert.Equal(t, "db/allow-app", peers[0].Policy)
+		assert.False(t, peers[0].Any)
+		assert.Equal(t, []string{"app"}, peers[0].Namespaces)
+		assert.Equal(t, []*corev1.Pod{web}, peers[0].Pods)
+		assert.Equal(t, []Port{{Protocol: "TCP", Port: 5432}}, peers[0].Ports)
+		assert.Equal(t, "10.0.0.0/8", peers[1].IPBlock.CIDR)
+		assert.Empty(t, peers[1].Pods)
+
+		assert.Empty(t, a.ReachableFrom(cache))
+
+		peers = a.ReachableFrom(web)
+		require.Len(t, peers, 1)
+		assert.True(t, peers[0].Any)
+		assert.Equal(t, "", peers[0].Policy)
+		assert.Empty(t, peers[0].Ports)
+	})
+
+	t.Run("can reach", func(t *testing.T) {
+		assert.True(t, a.CanReach(web, db, 5432, "TCP"))
+		assert.True(t, a.CanReach(web, db, 5432, ""))
+		assert.False(t, a.CanReach(web, db, 5432, "UDP"))
+		assert.False(t, a.CanReach(web, db, 22, "TCP"))
+		assert.False(t, a.CanReach(other, db, 5432, "TCP"))
+		assert.False(t, a.CanReach(cache, db, 5432, "TCP"))
+		// db is isolated for egress
+		assert.False(t, a.CanReach(db, web, 80, "TCP"))
+		assert.True(t, a.CanReach(other, web, 80, "TCP"))
+	})
+
+	t.Run("ip blocks", func(t *testing.T) {
+		inside := pod("other", "inside", nil)
+		inside.Status.PodIP = "10.2.0.4"
+		excluded := pod("other", "excluded", nil)
+		excluded.Status.PodIP = "10.1.0.4"
+		a := New([]*corev1.Pod{db, inside, excluded}, nil, policies)
+		assert.True(t, a.CanReach(inside, db, 5432, "TCP"))
+		assert.False(t, a.CanReach(excluded, db, 5432, "TCP"))
+	})
+}
+
+func TestPolicyTypes(t *testing.T) {
+	web := pod("app", "web", map[string]string{"app": "web"})
+	api := pod("app", "api", map[string]string{"app": "api"})
+
+	// policies without types only restrict egress if they have egress rules
+	a := New([]*corev1.Pod{web, api}, nil, []*networkingv1.NetworkPolicy{
+		policy("app", "ingress", networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				Ports: []networkingv1.NetworkPolicyPort{tcp(intstr.FromInt(8080))},
+			}},
+		}),
+		policy("app", "egress", networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Egress: []networkingv1.NetworkPolicyEgressRule{{
+				To: []networkingv1.NetworkPolicyPeer{{
+					PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+				}},
+			}},
+		}),
+	})
+
+	assert.True(t, a.IngressIsolated(api))
+	assert.False(t, a.EgressIsolated(api))
+	assert.True(t, a.IngressIsolated(web))
+	assert.True(t, a.EgressIsolated(web))
+
+	peers := a.ReachableFrom(api)
+	require.Len(t, peers, 1)
+	assert.True(t, peers[0].Any)
+	assert.Equal(t, "app/ingress", peers[0].Policy)
+	assert.Equal(t, []Port{{Protocol: "TCP", Port: 8080}}, peers[0].Ports)
+
+	assert.True(t, a.CanReach(web, api, 8080, "TCP"))
+	assert.False(t, a.CanReach(web, api, 8081, "TCP"))
+	assert.False(t, a.CanReach(api, web, 80, "TCP"))
+}
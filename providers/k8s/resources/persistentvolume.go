@@ -0,0 +1,100 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/v9/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type mqlK8sPersistentvolumeInternal struct {
+	lock sync.Mutex
+	obj  *corev1.PersistentVolume
+}
+
+func accessModes(modes []corev1.PersistentVolumeAccessMode) []interface{} {
+	res := make([]interface{}, 0, len(modes))
+	for _, mode := range modes {
+		res = append(res, string(mode))
+	}
+	return res
+}
+
+func (k *mqlK8s) persistentVolumes() ([]interface{}, error) {
+	return k8sResourceToMql(k.MqlRuntime, "persistentvolumes", func(kind string, resource runtime.Object, obj metav1.Object, objT metav1.Type) (interface{}, error) {
+		ts := obj.GetCreationTimestamp()
+
+		manifest, err := convert.JsonToDict(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		pv, ok := resource.(*corev1.PersistentVolume)
+		if !ok {
+			return nil, errors.New("not a k8s persistentvolume")
+		}
+
+		spec, err := convert.JsonToDict(pv.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		claimRef, err := convert.JsonToDict(pv.Spec.ClaimRef)
+		if err != nil {
+			return nil, err
+		}
+
+		var volumeMode string
+		if pv.Spec.VolumeMode != nil {
+			volumeMode = string(*pv.Spec.VolumeMode)
+		}
+
+		r, err := CreateResource(k.MqlRuntime, "k8s.persistentvolume", map[string]*llx.RawData{
+			"id":               llx.StringData(objIdFromK8sObj(obj, objT)),
+			"uid":              llx.StringData(string(obj.GetUID())),
+			"resourceVersion":  llx.StringData(obj.GetResourceVersion()),
+			"name":             llx.StringData(obj.GetName()),
+			"kind":             llx.StringData(objT.GetKind()),
+			"created":          llx.TimeData(ts.Time),
+			"manifest":         llx.DictData(manifest),
+			"storageClassName": llx.StringData(pv.Spec.StorageClassName),
+			"capacity":         llx.MapData(resourceListToMap(pv.Spec.Capacity), types.String),
+			"accessModes":      llx.ArrayData(accessModes(pv.Spec.AccessModes), types.String),
+			"reclaimPolicy":    llx.StringData(string(pv.Spec.PersistentVolumeReclaimPolicy)),
+			"volumeMode":       llx.StringData(volumeMode),
+			"phase":            llx.StringData(string(pv.Status.Phase)),
+			"claimRef":         llx.DictData(claimRef),
+			"spec":             llx.DictData(spec),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.(*mqlK8sPersistentvolume).obj = pv
+		return r, nil
+	})
+}
+
+func (k *mqlK8sPersistentvolume) id() (string, error) {
+	return k.Id.Data, nil
+}
+
+func initK8sPersistentvolume(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initResource[*mqlK8sPersistentvolume](runtime, args, func(k *mqlK8s) *plugin.TValue[[]interface{}] { return k.GetPersistentVolumes() })
+}
+
+func (k *mqlK8sPersistentvolume) annotations() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetAnnotations()), nil
+}
+
+func (k *mqlK8sPersistentvolume) labels() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetLabels()), nil
+}
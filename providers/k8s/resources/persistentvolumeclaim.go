@@ -0,0 +1,87 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/v9/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type mqlK8sPersistentvolumeclaimInternal struct {
+	lock sync.Mutex
+	obj  *corev1.PersistentVolumeClaim
+}
+
+func (k *mqlK8s) persistentVolumeClaims() ([]interface{}, error) {
+	return k8sResourceToMql(k.MqlRuntime, "persistentvolumeclaims", func(kind string, resource runtime.Object, obj metav1.Object, objT metav1.Type) (interface{}, error) {
+		ts := obj.GetCreationTimestamp()
+
+		manifest, err := convert.JsonToDict(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		pvc, ok := resource.(*corev1.PersistentVolumeClaim)
+		if !ok {
+			return nil, errors.New("not a k8s persistentvolumeclaim")
+		}
+
+		spec, err := convert.JsonToDict(pvc.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		var volumeMode string
+		if pvc.Spec.VolumeMode != nil {
+			volumeMode = string(*pvc.Spec.VolumeMode)
+		}
+
+		r, err := CreateResource(k.MqlRuntime, "k8s.persistentvolumeclaim", map[string]*llx.RawData{
+			"id":               llx.StringData(objIdFromK8sObj(obj, objT)),
+			"uid":              llx.StringData(string(obj.GetUID())),
+			"resourceVersion":  llx.StringData(obj.GetResourceVersion()),
+			"name":             llx.StringData(obj.GetName()),
+			"namespace":        llx.StringData(obj.GetNamespace()),
+			"kind":             llx.StringData(objT.GetKind()),
+			"created":          llx.TimeData(ts.Time),
+			"manifest":         llx.DictData(manifest),
+			"storageClassName": llx.StringData(convert.ToString(pvc.Spec.StorageClassName)),
+			"volumeName":       llx.StringData(pvc.Spec.VolumeName),
+			"accessModes":      llx.ArrayData(accessModes(pvc.Spec.AccessModes), types.String),
+			"requests":         llx.MapData(resourceListToMap(pvc.Spec.Resources.Requests), types.String),
+			"volumeMode":       llx.StringData(volumeMode),
+			"phase":            llx.StringData(string(pvc.Status.Phase)),
+			"spec":             llx.DictData(spec),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.(*mqlK8sPersistentvolumeclaim).obj = pvc
+		return r, nil
+	})
+}
+
+func (k *mqlK8sPersistentvolumeclaim) id() (string, error) {
+	return k.Id.Data, nil
+}
+
+func initK8sPersistentvolumeclaim(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initNamespacedResource[*mqlK8sPersistentvolumeclaim](runtime, args, func(k *mqlK8s) *plugin.TValue[[]interface{}] { return k.GetPersistentVolumeClaims() })
+}
+
+func (k *mqlK8sPersistentvolumeclaim) annotations() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetAnnotations()), nil
+}
+
+func (k *mqlK8sPersistentvolumeclaim) labels() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetLabels()), nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/util/convert"
+	policyv1 "k8s.io/api/policy/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+type mqlK8sPoddisruptionbudgetInternal struct {
+	lock sync.Mutex
+	obj  metav1.Object
+}
+
+func intOrStringToString(v *intstr.IntOrString) string {
+	if v == nil {
+		return ""
+	}
+	return v.String()
+}
+
+func (k *mqlK8s) podDisruptionBudgets() ([]interface{}, error) {
+	return k8sResourceToMql(k.MqlRuntime, "poddisruptionbudgets", func(kind string, resource runtime.Object, obj metav1.Object, objT metav1.Type) (interface{}, error) {
+		ts := obj.GetCreationTimestamp()
+
+		manifest, err := convert.JsonToDict(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		var minAvailable, maxUnavailable, unhealthyPodEvictionPolicy string
+		var selector *metav1.LabelSelector
+		switch pdb := resource.(type) {
+		case *policyv1.PodDisruptionBudget:
+			minAvailable = intOrStringToString(pdb.Spec.MinAvailable)
+			maxUnavailable = intOrStringToString(pdb.Spec.MaxUnavailable)
+			selector = pdb.Spec.Selector
+			if pdb.Spec.UnhealthyPodEvictionPolicy != nil {
+				unhealthyPodEvictionPolicy = string(*pdb.Spec.UnhealthyPodEvictionPolicy)
+			}
+		case *policyv1beta1.PodDisruptionBudget:
+			minAvailable = intOrStringToString(pdb.Spec.MinAvailable)
+			maxUnavailable = intOrStringToString(pdb.Spec.MaxUnavailable)
+			selector = pdb.Spec.Selector
+			if pdb.Spec.UnhealthyPodEvictionPolicy != nil {
+				unhealthyPodEvictionPolicy = string(*pdb.Spec.UnhealthyPodEvictionPolicy)
+			}
+		default:
+			return nil, errors.New("not a k8s poddisruptionbudget")
+		}
+
+		// the spec of the version that the object was read with
+		spec, _ := manifest["spec"].(map[string]interface{})
+
+		selectorDict, err := convert.JsonToDict(selector)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := CreateResource(k.MqlRuntime, "k8s.poddisruptionbudget", map[string]*llx.RawData{
+			"id":                         llx.StringData(objIdFromK8sObj(obj, objT)),
+			"uid":                        llx.StringData(string(obj.GetUID())),
+			"resourceVersion":            llx.StringData(obj.GetResourceVersion()),
+			"name":                       llx.StringData(obj.GetName()),
+			"namespace":                  llx.StringData(obj.GetNamespace()),
+			"kind":                       llx.StringData(objT.GetKind()),
+			"created":                    llx.TimeData(ts.Time),
+			"manifest":                   llx.DictData(manifest),
+			"minAvailable":               llx.StringData(minAvailable),
+			"maxUnavailable":             llx.StringData(maxUnavailable),
+			"selector":                   llx.DictData(selectorDict),
+			"unhealthyPodEvictionPolicy": llx.StringData(unhealthyPodEvictionPolicy),
+			"spec":                       llx.DictData(spec),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.(*mqlK8sPoddisruptionbudget).obj = obj
+		return r, nil
+	})
+}
+
+func (k *mqlK8sPoddisruptionbudget) id() (string, error) {
+	return k.Id.Data, nil
+}
+
+func initK8sPoddisruptionbudget(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initNamespacedResource[*mqlK8sPoddisruptionbudget](runtime, args, func(k *mqlK8s) *plugin.TValue[[]interface{}] { return k.GetPodDisruptionBudgets() })
+}
+
+func (k *mqlK8sPoddisruptionbudget) annotations() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetAnnotations()), nil
+}
+
+func (k *mqlK8sPoddisruptionbudget) labels() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetLabels()), nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/k8s/resources/pss"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/pod-security-admission/api"
+)
+
+// podSecurityVersion returns the version of the standards that the
+// namespace enforces. Namespaces that aren't part of the asset, e.g. of
+// manifests, use the latest version.
+func podSecurityVersion(runtime *plugin.Runtime, namespace string) (api.Version, error) {
+	obj, err := CreateResource(runtime, "k8s", nil)
+	if err != nil {
+		return api.Version{}, err
+	}
+	nss := obj.(*mqlK8s).GetNamespaces()
+	if nss.Error != nil {
+		return api.Version{}, nss.Error
+	}
+	for _, n := range nss.Data {
+		ns := n.(*mqlK8sNamespace)
+		if ns.Name.Data == namespace && ns.obj != nil {
+			return pss.Version(ns.obj.Labels, pss.LabelEnforceVersion)
+		}
+	}
+	return api.LatestVersion(), nil
+}
+
+func podSecurity(runtime *plugin.Runtime, namespace string, meta *metav1.ObjectMeta, spec *corev1.PodSpec) ([]pss.Violation, error) {
+	version, err := podSecurityVersion(runtime, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return pss.Evaluate(meta, spec, version)
+}
+
+func podSecurityLevel(runtime *plugin.Runtime, namespace string, meta *metav1.ObjectMeta, spec *corev1.PodSpec) (string, error) {
+	violations, err := podSecurity(runtime, namespace, meta, spec)
+	if err != nil {
+		return "", err
+	}
+	return pss.Level(violations), nil
+}
+
+func podSecurityViolations(runtime *plugin.Runtime, namespace string, meta *metav1.ObjectMeta, spec *corev1.PodSpec) ([]interface{}, error) {
+	violations, err := podSecurity(runtime, namespace, meta, spec)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]interface{}, 0, len(violations))
+	for _, v := range violations {
+		res = append(res, map[string]interface{}{
+			"level":   v.Level,
+			"control": v.Control,
+			"message": v.Message,
+		})
+	}
+	return res, nil
+}
+
+func (k *mqlK8sPod) podSecurityLevel() (string, error) {
+	return podSecurityLevel(k.MqlRuntime, k.obj.Namespace, &k.obj.ObjectMeta, &k.obj.Spec)
+}
+
+func (k *mqlK8sPod) podSecurityViolations() ([]interface{}, error) {
+	return podSecurityViolations(k.MqlRuntime, k.obj.Namespace, &k.obj.ObjectMeta, &k.obj.Spec)
+}
+
+func (k *mqlK8sDeployment) podSecurityLevel() (string, error) {
+	tpl := &k.obj.Spec.Template
+	return podSecurityLevel(k.MqlRuntime, k.obj.Namespace, &tpl.ObjectMeta, &tpl.Spec)
+}
+
+func (k *mqlK8sDeployment) podSecurityViolations() ([]interface{}, error) {
+	tpl := &k.obj.Spec.Template
+	return podSecurityViolations(k.MqlRuntime, k.obj.Namespace, &tpl.ObjectMeta, &tpl.Spec)
+}
+
+func (k *mqlK8sDaemonset) podSecurityLevel() (string, error) {
+	tpl := &k.obj.Spec.Template
+	return podSecurityLevel(k.MqlRuntime, k.obj.Namespace, &tpl.ObjectMeta, &tpl.Spec)
+}
+
+func (k *mqlK8sDaemonset) podSecurityViolations() ([]interface{}, error) {
+	tpl := &k.obj.Spec.Template
+	return podSecurityViolations(k.MqlRuntime, k.obj.Namespace, &tpl.ObjectMeta, &tpl.Spec)
+}
+
+func (k *mqlK8sStatefulset) podSecurityLevel() (string, error) {
+	tpl := &k.obj.Spec.Template
+	return podSecurityLevel(k.MqlRuntime, k.obj.Namespace, &tpl.ObjectMeta, &tpl.Spec)
+}
+
+func (k *mqlK8sStatefulset) podSecurityViolations() ([]interface{}, error) {
+	tpl := &k.obj.Spec.Template
+	return podSecurityViolations(k.MqlRuntime, k.obj.Namespace, &tpl.ObjectMeta, &tpl.Spec)
+}
+
+func (k *mqlK8sJob) podSecurityLevel() (string, error) {
+	tpl := &k.obj.Spec.Template
+	return podSecurityLevel(k.MqlRuntime, k.obj.Namespace, &tpl.ObjectMeta, &tpl.Spec)
+}
+
+func (k *mqlK8sJob) podSecurityViolations() ([]interface{}, error) {
+	tpl := &k.obj.Spec.Template
+	return podSecurityViolations(k.MqlRuntime, k.obj.Namespace, &tpl.ObjectMeta, &tpl.Spec)
+}
+
+func (k *mqlK8sCronjob) podSecurityLevel() (string, error) {
+	tpl := &k.obj.Spec.JobTemplate.Spec.Template
+	return podSecurityLevel(k.MqlRuntime, k.obj.Namespace, &tpl.ObjectMeta, &tpl.Spec)
+}
+
+func (k *mqlK8sCronjob) podSecurityViolations() ([]interface{}, error) {
+	tpl := &k.obj.Spec.JobTemplate.Spec.Template
+	return podSecurityViolations(k.MqlRuntime, k.obj.Namespace, &tpl.ObjectMeta, &tpl.Spec)
+}
+
+func (k *mqlK8sNamespace) podSecurityEnforce() (string, error) {
+	return k.obj.Labels[pss.LabelEnforce], nil
+}
+
+func (k *mqlK8sNamespace) podSecurityEnforceVersion() (string, error) {
+	return k.obj.Labels[pss.LabelEnforceVersion], nil
+}
+
+func (k *mqlK8sNamespace) podSecurityAudit() (string, error) {
+	return k.obj.Labels[pss.LabelAudit], nil
+}
+
+func (k *mqlK8sNamespace) podSecurityAuditVersion() (string, error) {
+	return k.obj.Labels[pss.LabelAuditVersion], nil
+}
+
+func (k *mqlK8sNamespace) podSecurityWarn() (string, error) {
+	return k.obj.Labels[pss.LabelWarn], nil
+}
+
+func (k *mqlK8sNamespace) podSecurityWarnVersion() (string, error) {
+	return k.obj.Labels[pss.LabelWarnVersion], nil
+}
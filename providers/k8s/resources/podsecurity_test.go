@@ -0,0 +1,73 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/pod-security-admission/api"
+)
+
+func TestPodSecurity(t *testing.T) {
+	k := &mqlK8s{MqlRuntime: kindsRuntime(t, "./testdata/pss.yaml")}
+
+	deployments, err := k.deployments()
+	require.NoError(t, err)
+	require.Len(t, deployments, 1)
+	deployment := deployments[0].(*mqlK8sDeployment)
+	level, err := deployment.podSecurityLevel()
+	require.NoError(t, err)
+	assert.Equal(t, "restricted", level)
+	violations, err := deployment.podSecurityViolations()
+	require.NoError(t, err)
+	assert.Empty(t, violations)
+
+	cronjobs, err := k.cronjobs()
+	require.NoError(t, err)
+	require.Len(t, cronjobs, 1)
+	cronjob := cronjobs[0].(*mqlK8sCronjob)
+	level, err = cronjob.podSecurityLevel()
+	require.NoError(t, err)
+	assert.Equal(t, "privileged", level)
+	violations, err = cronjob.podSecurityViolations()
+	require.NoError(t, err)
+	require.NotEmpty(t, violations)
+	assert.Equal(t, map[string]interface{}{
+		"level":   "baseline",
+		"control": "host namespaces",
+		"message": "hostNetwork=true",
+	}, violations[0])
+
+	// the version is the one that the namespace enforces
+	version, err := podSecurityVersion(k.MqlRuntime, "app")
+	require.NoError(t, err)
+	assert.Equal(t, api.MajorMinorVersion(1, 28), version)
+	version, err = podSecurityVersion(k.MqlRuntime, "other")
+	require.NoError(t, err)
+	assert.True(t, version.Latest())
+
+	namespaces, err := k.namespaces()
+	require.NoError(t, err)
+	var ns *mqlK8sNamespace
+	for _, n := range namespaces {
+		if n.(*mqlK8sNamespace).Name.Data == "app" {
+			ns = n.(*mqlK8sNamespace)
+		}
+	}
+	require.NotNil(t, ns)
+	enforce, err := ns.podSecurityEnforce()
+	require.NoError(t, err)
+	assert.Equal(t, "baseline", enforce)
+	enforceVersion, err := ns.podSecurityEnforceVersion()
+	require.NoError(t, err)
+	assert.Equal(t, "v1.28", enforceVersion)
+	warn, err := ns.podSecurityWarn()
+	require.NoError(t, err)
+	assert.Equal(t, "restricted", warn)
+	audit, err := ns.podSecurityAudit()
+	require.NoError(t, err)
+	assert.Equal(t, "", audit)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package pss evaluates pod specs against the Kubernetes Pod Security
+// Standards with the checks of the upstream pod security admission
+// controller.
+// see https://kubernetes.io/docs/concepts/security/pod-security-standards/
+package pss
+
+import (
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/pod-security-admission/api"
+	"k8s.io/pod-security-admission/policy"
+)
+
+// Levels of the Pod Security Standards, from least to most restrictive
+const (
+	LevelPrivileged = string(api.LevelPrivileged)
+	LevelBaseline   = string(api.LevelBaseline)
+	LevelRestricted = string(api.LevelRestricted)
+)
+
+// Namespace labels that configure pod security admission
+const (
+	LabelEnforce        = api.EnforceLevelLabel
+	LabelEnforceVersion = api.EnforceVersionLabel
+	LabelAudit          = api.AuditLevelLabel
+	LabelAuditVersion   = api.AuditVersionLabel
+	LabelWarn           = api.WarnLevelLabel
+	LabelWarnVersion    = api.WarnVersionLabel
+)
+
+// Violation is a check of the standards that a pod spec fails
+type Violation struct {
+	// Level that the check is part of: baseline or restricted
+	Level string
+	// Control is the reason of the check, e.g. "host namespaces"
+	Control string
+	// Message describes the failing fields
+	Message string
+}
+
+var (
+	evaluatorOnce sync.Once
+	evaluator     policy.Evaluator
+	evaluatorErr  error
+)
+
+func defaultEvaluator() (policy.Evaluator, error) {
+	evaluatorOnce.Do(func() {
+		evaluator, evaluatorErr = policy.NewEvaluator(policy.DefaultChecks())
+	})
+	return evaluator, evaluatorErr
+}
+
+// Version returns the version of the standards that the version label of
+// the namespace configures. Namespaces without the label use the latest
+// version, like the admission controller.
+func Version(namespaceLabels map[string]string, label string) (api.Version, error) {
+	version, ok := namespaceLabels[label]
+	if !ok || version == "" {
+		return api.LatestVersion(), nil
+	}
+	return api.ParseVersion(version)
+}
+
+func failed(results []policy.CheckResult) []policy.CheckResult {
+	res := []policy.CheckResult{}
+	for _, r := range results {
+		if !r.Allowed {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+// Evaluate returns all checks of the version of the standards that the pod
+// fails. The metadata is the one of the pod or of the pod template.
+func Evaluate(meta *metav1.ObjectMeta, spec *corev1.PodSpec, version api.Version) ([]Violation, error) {
+	e, err := defaultEvaluator()
+	if err != nil {
+		return nil, err
+	}
+	if meta == nil {
+		meta = &metav1.ObjectMeta{}
+	}
+
+	res := []Violation{}
+	baselineReasons := map[string]struct{}{}
+	for _, r := range failed(e.EvaluatePod(api.LevelVersion{Level: api.LevelBaseline, Version: version}, meta, spec)) {
+		baselineReasons[r.ForbiddenReason] = struct{}{}
+		res = append(res, Violation{Level: LevelBaseline, Control: r.ForbiddenReason, Message: r.ForbiddenDetail})
+	}
+	// the restricted level includes the baseline checks
+	for _, r := range failed(e.EvaluatePod(api.LevelVersion{Level: api.LevelRestricted, Version: version}, meta, spec)) {
+		if _, ok := baselineReasons[r.ForbiddenReason]; ok {
+			continue
+		}
+		res = append(res, Violation{Level: LevelRestricted, Control: r.ForbiddenReason, Message: r.ForbiddenDetail})
+	}
+	return res, nil
+}
+
+// Level returns the most restrictive level that a pod with the violations
+// complies with
+func Level(violations []Violation) string {
+	level := LevelRestricted
+	for _, v := range violations {
+		if v.Level == LevelBaseline {
+			return LevelPrivileged
+		}
+		level = LevelBaseline
+	}
+	return level
+}
@@ -0,0 +1,138 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package pss
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/pod-security-admission/api"
+)
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func restrictedSpec() *corev1.PodSpec {
+	return &corev1.PodSpec{
+		SecurityContext: &corev1.PodSecurityContext{
+			RunAsNonRoot:   boolPtr(true),
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		},
+		Containers: []corev1.Container{{
+			Name: "app",
+			SecurityContext: &corev1.SecurityContext{
+				AllowPrivilegeEscalation: boolPtr(false),
+				Capabilities: &corev1.Capabilities{
+					Drop: []corev1.Capability{"ALL"},
+					Add:  []corev1.Capability{"NET_BIND_SERVICE"},
+				},
+			},
+		}},
+		Volumes: []corev1.Volume{{
+			Name:         "cache",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		}},
+	}
+}
+
+func controls(violations []Violation) []string {
+	res := []string{}
+	for _, v := range violations {
+		res = append(res, v.Level+"/"+v.Control)
+	}
+	return res
+}
+
+func TestEvaluate(t *testing.T) {
+	latest := api.LatestVersion()
+
+	t.Run("restricted", func(t *testing.T) {
+		violations, err := Evaluate(nil, restrictedSpec(), latest)
+		require.NoError(t, err)
+		assert.Empty(t, violations)
+		assert.Equal(t, LevelRestricted, Level(violations))
+	})
+
+	t.Run("baseline", func(t *testing.T) {
+		spec := &corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app"}},
+		}
+		violations, err := Evaluate(nil, spec, latest)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"restricted/allowPrivilegeEscalation != false",
+			"restricted/unrestricted capabilities",
+			"restricted/runAsNonRoot != true",
+			"restricted/seccompProfile",
+		}, controls(violations))
+		assert.Equal(t, LevelBaseline, Level(violations))
+
+		// windows pods are exempt from linux-specific checks
+		spec.OS = &corev1.PodOS{Name: corev1.Windows}
+		violations, err = Evaluate(nil, spec, latest)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"restricted/runAsNonRoot != true"}, controls(violations))
+	})
+
+	t.Run("versions", func(t *testing.T) {
+		spec := &corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app"}},
+		}
+		// dropping all capabilities is only required since v1.22
+		violations, err := Evaluate(nil, spec, api.MajorMinorVersion(1, 21))
+		require.NoError(t, err)
+		assert.NotContains(t, controls(violations), "restricted/unrestricted capabilities")
+	})
+
+	t.Run("privileged", func(t *testing.T) {
+		spec := restrictedSpec()
+		spec.HostNetwork = true
+		spec.HostPID = true
+		spec.SecurityContext.Sysctls = []corev1.Sysctl{{Name: "net.ipv4.tcp_syncookies"}, {Name: "kernel.msgmax"}}
+		spec.Containers[0].SecurityContext.Privileged = boolPtr(true)
+		spec.Containers[0].SecurityContext.Capabilities.Add = append(spec.Containers[0].SecurityContext.Capabilities.Add, "SYS_ADMIN")
+		spec.Containers[0].Ports = []corev1.ContainerPort{{ContainerPort: 80, HostPort: 8080}}
+		spec.Volumes = append(spec.Volumes, corev1.Volume{
+			Name:         "host",
+			VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}},
+		})
+		meta := &metav1.ObjectMeta{Annotations: map[string]string{
+			"container.apparmor.security.beta.kubernetes.io/app": "unconfined",
+		}}
+
+		violations, err := Evaluate(meta, spec, latest)
+		require.NoError(t, err)
+		assert.Equal(t, LevelPrivileged, Level(violations))
+		assert.Equal(t, []string{
+			"baseline/forbidden AppArmor profile",
+			"baseline/non-default capabilities",
+			"baseline/host namespaces",
+			"baseline/hostPath volumes",
+			"baseline/hostPort",
+			"baseline/privileged",
+			"baseline/forbidden sysctls",
+			"restricted/unrestricted capabilities",
+			"restricted/restricted volume types",
+		}, controls(violations))
+		assert.Equal(t, "hostNetwork=true, hostPID=true", violations[2].Message)
+		assert.Equal(t, "kernel.msgmax", violations[6].Message)
+	})
+}
+
+func TestVersion(t *testing.T) {
+	version, err := Version(map[string]string{LabelEnforceVersion: "v1.25"}, LabelEnforceVersion)
+	require.NoError(t, err)
+	assert.Equal(t, api.MajorMinorVersion(1, 25), version)
+
+	version, err = Version(map[string]string{LabelEnforce: "baseline"}, LabelEnforceVersion)
+	require.NoError(t, err)
+	assert.True(t, version.Latest())
+
+	_, err = Version(map[string]string{LabelEnforceVersion: "1.25"}, LabelEnforceVersion)
+	assert.Error(t, err)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/k8s/resources/rbac"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+type mqlK8sRbacInternal struct {
+	lock     sync.Mutex
+	analyzer *rbac.Analyzer
+}
+
+// getAnalyzer builds the RBAC analyzer from all roles and bindings once
+func (k *mqlK8sRbac) getAnalyzer() (*rbac.Analyzer, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if k.analyzer != nil {
+		return k.analyzer, nil
+	}
+
+	kt, err := k8sProvider(k.MqlRuntime.Connection)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterRoles := []*rbacv1.ClusterRole{}
+	roles := []*rbacv1.Role{}
+	clusterRoleBindings := []*rbacv1.ClusterRoleBinding{}
+	roleBindings := []*rbacv1.RoleBinding{}
+	serviceAccounts := []*corev1.ServiceAccount{}
+	for _, kind := range []string{"clusterroles", "roles", "clusterrolebindings", "rolebinding", "serviceaccounts"} {
+		result, err := kt.Resources(kind, "", "")
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range result.Resources {
+			switch obj := resource.(type) {
+			case *rbacv1.ClusterRole:
+				clusterRoles = append(clusterRoles, obj)
+			case *rbacv1.Role:
+				roles = append(roles, obj)
+			case *rbacv1.ClusterRoleBinding:
+				clusterRoleBindings = append(clusterRoleBindings, obj)
+			case *rbacv1.RoleBinding:
+				roleBindings = append(roleBindings, obj)
+			case *corev1.ServiceAccount:
+				serviceAccounts = append(serviceAccounts, obj)
+			}
+		}
+	}
+
+	k.analyzer = rbac.New(clusterRoles, roles, clusterRoleBindings, roleBindings, serviceAccounts)
+	return k.analyzer, nil
+}
+
+func rbacAnalyzer(runtime *plugin.Runtime) (*rbac.Analyzer, error) {
+	obj, err := CreateResource(runtime, "k8s.rbac", nil)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*mqlK8sRbac).getAnalyzer()
+}
+
+func newMqlRbacSubjects(runtime *plugin.Runtime, subjects []rbac.Subject) ([]interface{}, error) {
+	res := make([]interface{}, 0, len(subjects))
+	for _, s := range subjects {
+		r, err := CreateResource(runtime, "k8s.rbac.subject", map[string]*llx.RawData{
+			"kind":      llx.StringData(s.Kind),
+			"name":      llx.StringData(s.Name),
+			"namespace": llx.StringData(s.Namespace),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+func (k *mqlK8sRbac) subjects() ([]interface{}, error) {
+	analyzer, err := k.getAnalyzer()
+	if err != nil {
+		return nil, err
+	}
+	return newMqlRbacSubjects(k.MqlRuntime, analyzer.Subjects())
+}
+
+func initK8sRbacCan(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if args["verb"] == nil || args["resource"] == nil {
+		return nil, nil, errors.New("k8s.rbac.can requires a verb and a resource")
+	}
+	if args["namespace"] == nil {
+		args["namespace"] = llx.StringData("")
+	}
+	return args, nil, nil
+}
+
+func (k *mqlK8sRbacCan) id() (string, error) {
+	return k.Verb.Data + "/" + k.Resource.Data + "/" + k.Namespace.Data, nil
+}
+
+func (k *mqlK8sRbacCan) subjects() ([]interface{}, error) {
+	analyzer, err := rbacAnalyzer(k.MqlRuntime)
+	if err != nil {
+		return nil, err
+	}
+	return newMqlRbacSubjects(k.MqlRuntime, analyzer.WhoCan(k.Verb.Data, k.Resource.Data, k.Namespace.Data))
+}
+
+func (k *mqlK8sRbacSubject) id() (string, error) {
+	return rbac.Subject{Kind: k.Kind.Data, Name: k.Name.Data, Namespace: k.Namespace.Data}.Key(), nil
+}
+
+func (k *mqlK8sRbacSubject) effectivePermissions() ([]interface{}, error) {
+	analyzer, err := rbacAnalyzer(k.MqlRuntime)
+	if err != nil {
+		return nil, err
+	}
+
+	subject := rbac.Subject{Kind: k.Kind.Data, Name: k.Name.Data, Namespace: k.Namespace.Data}
+	permissions := analyzer.Permissions(subject)
+	res := make([]interface{}, 0, len(permissions))
+	for i, p := range permissions {
+		r, err := CreateResource(k.MqlRuntime, "k8s.rbac.permission", map[string]*llx.RawData{
+			"__id":           llx.StringData(subject.Key() + "/" + strconv.Itoa(i)),
+			"verb":           llx.StringData(p.Verb),
+			"apiGroup":       llx.StringData(p.APIGroup),
+			"resource":       llx.StringData(p.Resource),
+			"resourceName":   llx.StringData(p.ResourceName),
+			"nonResourceUrl": llx.StringData(p.NonResourceURL),
+			"namespace":      llx.StringData(p.Namespace),
+			"role":           llx.StringData(p.Role),
+			"binding":        llx.StringData(p.Binding),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
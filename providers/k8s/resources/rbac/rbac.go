@@ -0,0 +1,423 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package rbac computes the effective permissions of Kubernetes RBAC
+// subjects. It only relies on the RBAC objects, which means it works the
+// same way for live clusters and for manifests.
+package rbac
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Groups that subjects belong to implicitly
+// see https://kubernetes.io/docs/reference/access-authn-authz/rbac/#referring-to-subjects
+const (
+	GroupAuthenticated   = "system:authenticated"
+	GroupServiceAccounts = "system:serviceaccounts"
+)
+
+// WildcardVerbs are the verbs that a wildcard verb is expanded to. They
+// include the special verbs that allow privilege escalation.
+// see https://kubernetes.io/docs/reference/access-authn-authz/rbac/#privilege-escalation-prevention-and-bootstrapping
+var WildcardVerbs = []string{
+	"get", "list", "watch", "create", "update", "patch", "delete", "deletecollection",
+	"escalate", "bind", "impersonate",
+}
+
+// maxAggregationDepth limits how often aggregated cluster roles are resolved
+const maxAggregationDepth = 8
+
+type Subject struct {
+	// Kind is User, Group, or ServiceAccount
+	Kind string
+	Name string
+	// Namespace is only set for service accounts
+	Namespace string
+}
+
+// Key identifies the subject, e.g. ServiceAccount/kube-system/default
+func (s Subject) Key() string {
+	if s.Namespace == "" {
+		return s.Kind + "/" + s.Name
+	}
+	return s.Kind + "/" + s.Namespace + "/" + s.Name
+}
+
+// groups returns the groups the subject belongs to implicitly
+func (s Subject) groups() []string {
+	switch s.Kind {
+	case rbacv1.ServiceAccountKind:
+		return []string{GroupAuthenticated, GroupServiceAccounts, GroupServiceAccounts + ":" + s.Namespace}
+	case rbacv1.UserKind:
+		return []string{GroupAuthenticated}
+	default:
+		return nil
+	}
+}
+
+type Permission struct {
+	Verb           string
+	APIGroup       string
+	Resource       string
+	ResourceName   string
+	NonResourceURL string
+	// Namespace is empty for permissions that apply to all namespaces
+	Namespace string
+	// Role that grants the permission, e.g. ClusterRole/admin
+	Role string
+	// Binding that grants the permission, e.g. RoleBinding/default/admins
+	Binding string
+}
+
+// grant is a rule that is bound to a subject
+type grant struct {
+	rule      rbacv1.PolicyRule
+	namespace string
+	role      string
+	binding   string
+}
+
+type Analyzer struct {
+	clusterRoles        map[string][]rbacv1.PolicyRule
+	roles               map[string][]rbacv1.PolicyRule
+	clusterRoleBindings []*rbacv1.ClusterRoleBinding
+	roleBindings        []*rbacv1.RoleBinding
+	serviceAccounts     []*corev1.ServiceAccount
+}
+
+// New creates an analyzer for the RBAC objects. Aggregated cluster roles
+// are resolved, since manifests don't contain the rules that the
+// controller manager adds to them.
+func New(clusterRoles []*rbacv1.ClusterRole, roles []*rbacv1.Role, clusterRoleBindings []*rbacv1.ClusterRoleBinding,
+	roleBindings []*rbacv1.RoleBinding, serviceAccounts []*corev1.ServiceAccount,
+) *Analyzer {
+	res := &Analyzer{
+		clusterRoles:        aggregate(clusterRoles),
+		roles:               map[string][]rbacv1.PolicyRule{},
+		clusterRoleBindings: clusterRoleBindings,
+		roleBindings:        roleBindings,
+		serviceAccounts:     serviceAccounts,
+	}
+	for _, role := range roles {
+		res.roles[role.Namespace+"/"+role.Name] = role.Rules
+	}
+	return res
+}
+
+func containsRule(rules []rbacv1.PolicyRule, rule rbacv1.PolicyRule) bool {
+	for i := range rules {
+		if reflect.DeepEqual(rules[i], rule) {
+			return true
+		}
+	}
+	return false
+}
+
+// aggregate returns the rules of all cluster roles, including the ones of
+// aggregated cluster roles
+// see https://kubernetes.io/docs/reference/access-authn-authz/rbac/#aggregated-clusterroles
+func aggregate(clusterRoles []*rbacv1.ClusterRole) map[string][]rbacv1.PolicyRule {
+	res := make(map[string][]rbacv1.PolicyRule, len(clusterRoles))
+	for _, role := range clusterRoles {
+		res[role.Name] = append([]rbacv1.PolicyRule{}, role.Rules...)
+	}
+
+	// aggregated roles may aggregate other aggregated roles
+	for depth := 0; depth < maxAggregationDepth; depth++ {
+		changed := false
+		for _, role := range clusterRoles {
+			if role.AggregationRule == nil {
+				continue
+			}
+			for i := range role.AggregationRule.ClusterRoleSelectors {
+				selector, err := metav1.LabelSelectorAsSelector(&role.AggregationRule.ClusterRoleSelectors[i])
+				if err != nil || selector.Empty() {
+					continue
+				}
+				for _, other := range clusterRoles {
+					if other.Name == role.Name || !selector.Matches(labels.Set(other.Labels)) {
+						continue
+					}
+					for _, rule := range res[other.Name] {
+						if !containsRule(res[role.Name], rule) {
+							res[role.Name] = append(res[role.Name], rule)
+							changed = true
+						}
+					}
+				}
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	return res
+}
+
+// Subjects returns all subjects of bindings and all service accounts
+func (a *Analyzer) Subjects() []Subject {
+	seen := map[string]struct{}{}
+	res := []Subject{}
+	add := func(s Subject) {
+		if _, ok := seen[s.Key()]; ok {
+			return
+		}
+		seen[s.Key()] = struct{}{}
+		res = append(res, s)
+	}
+
+	for _, binding := range a.clusterRoleBindings {
+		for i := range binding.Subjects {
+			add(toSubject(binding.Subjects[i], ""))
+		}
+	}
+	for _, binding := range a.roleBindings {
+		for i := range binding.Subjects {
+			add(toSubject(binding.Subjects[i], binding.Namespace))
+		}
+	}
+	for _, sa := range a.serviceAccounts {
+		add(Subject{Kind: rbacv1.ServiceAccountKind, Name: sa.Name, Namespace: sa.Namespace})
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Key() < res[j].Key() })
+	return res
+}
+
+// toSubject converts the subject of a binding. Service accounts of role
+// bindings default to the namespace of the binding.
+func toSubject(s rbacv1.Subject, bindingNamespace string) Subject {
+	res := Subject{Kind: s.Kind, Name: s.Name}
+	if s.Kind == rbacv1.ServiceAccountKind {
+		res.Namespace = s.Namespace
+		if res.Namespace == "" {
+			res.Namespace = bindingNamespace
+		}
+	}
+	return res
+}
+
+// bound checks if the binding applies to the subject, either directly or
+// via one of its groups
+func bound(subjects []rbacv1.Subject, bindingNamespace string, s Subject) bool {
+	groups := s.groups()
+	for i := range subjects {
+		bs := toSubject(subjects[i], bindingNamespace)
+		if bs == s {
+			return true
+		}
+		if bs.Kind == rbacv1.GroupKind {
+			for _, group := range groups {
+				if bs.Name == group {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (a *Analyzer) grants(s Subject) []grant {
+	res := []grant{}
+	for _, binding := range a.clusterRoleBindings {
+		if binding.RoleRef.Kind != "ClusterRole" || !bound(binding.Subjects, "", s) {
+			continue
+		}
+		for _, rule := range a.clusterRoles[binding.RoleRef.Name] {
+			res = append(res, grant{
+				rule:    rule,
+				role:    "ClusterRole/" + binding.RoleRef.Name,
+				binding: "ClusterRoleBinding/" + binding.Name,
+			})
+		}
+	}
+
+	for _, binding := range a.roleBindings {
+		if !bound(binding.Subjects, binding.Namespace, s) {
+			continue
+		}
+		var rules []rbacv1.PolicyRule
+		var role string
+		switch binding.RoleRef.Kind {
+		case "ClusterRole":
+			rules = a.clusterRoles[binding.RoleRef.Name]
+			role = "ClusterRole/" + binding.RoleRef.Name
+		case "Role":
+			rules = a.roles[binding.Namespace+"/"+binding.RoleRef.Name]
+			role = "Role/" + binding.Namespace + "/" + binding.RoleRef.Name
+		}
+		for _, rule := range rules {
+			res = append(res, grant{
+				rule:      rule,
+				namespace: binding.Namespace,
+				role:      role,
+				binding:   "RoleBinding/" + binding.Namespace + "/" + binding.Name,
+			})
+		}
+	}
+	return res
+}
+
+func expandVerbs(verbs []string) []string {
+	res := []string{}
+	for _, verb := range verbs {
+		if verb == rbacv1.VerbAll {
+			res = append(res, WildcardVerbs...)
+		} else {
+			res = append(res, verb)
+		}
+	}
+	return res
+}
+
+// orAll returns the values or a single empty value, which is needed to
+// iterate over optional rule fields
+func orAll(values []string) []string {
+	if len(values) == 0 {
+		return []string{""}
+	}
+	return values
+}
+
+// Permissions returns the effective permissions of the subject. Wildcard
+// verbs are expanded, wildcard resources are not, since the available
+// resources depend on the cluster.
+func (a *Analyzer) Permissions(s Subject) []Permission {
+	seen := map[Permission]struct{}{}
+	res := []Permission{}
+	add := func(p Permission) {
+		if _, ok := seen[p]; !ok {
+			seen[p] = struct{}{}
+			res = append(res, p)
+		}
+	}
+
+	for _, g := range a.grants(s) {
+		for _, verb := range expandVerbs(g.rule.Verbs) {
+			// non-resource URLs are only granted by cluster role bindings
+			if g.namespace == "" {
+				for _, url := range g.rule.NonResourceURLs {
+					add(Permission{Verb: verb, NonResourceURL: url, Role: g.role, Binding: g.binding})
+				}
+			}
+			for _, group := range g.rule.APIGroups {
+				for _, resource := range g.rule.Resources {
+					for _, name := range orAll(g.rule.ResourceNames) {
+						add(Permission{
+							Verb:         verb,
+							APIGroup:     group,
+							Resource:     resource,
+							ResourceName: name,
+							Namespace:    g.namespace,
+							Role:         g.role,
+							Binding:      g.binding,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		x, y := res[i], res[j]
+		if x.Namespace != y.Namespace {
+			return x.Namespace < y.Namespace
+		}
+		if x.APIGroup != y.APIGroup {
+			return x.APIGroup < y.APIGroup
+		}
+		if x.Resource != y.Resource {
+			return x.Resource < y.Resource
+		}
+		return x.Verb < y.Verb
+	})
+	return res
+}
+
+// ParseResource splits resources like deployments.apps or pods/exec into
+// the resource and its API group. Resources without a group belong to the
+// core group, which is empty.
+func ParseResource(resource string) (string, string) {
+	name, subresource, hasSub := strings.Cut(resource, "/")
+	name, group, _ := strings.Cut(name, ".")
+	if hasSub {
+		name += "/" + subresource
+	}
+	return name, group
+}
+
+func verbMatches(rule rbacv1.PolicyRule, verb string) bool {
+	for _, v := range rule.Verbs {
+		if v == rbacv1.VerbAll || v == verb {
+			return true
+		}
+	}
+	return false
+}
+
+func groupMatches(rule rbacv1.PolicyRule, group string) bool {
+	for _, g := range rule.APIGroups {
+		if g == rbacv1.APIGroupAll || g == group {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceMatches follows the rules of the RBAC authorizer, which supports
+// wildcards for resources and subresources
+func resourceMatches(rule rbacv1.PolicyRule, resource string) bool {
+	name, subresource, hasSub := strings.Cut(resource, "/")
+	for _, r := range rule.Resources {
+		switch {
+		case r == rbacv1.ResourceAll, r == resource:
+			return true
+		case hasSub && r == name+"/*":
+			return true
+		case hasSub && r == "*/"+subresource:
+			return true
+		}
+	}
+	return false
+}
+
+// Can checks if the subject may perform the verb on the resource in the
+// namespace. An empty namespace requires the permission for all
+// namespaces. Resources of other groups than the core group must contain
+// their API group, e.g. deployments.apps. Rules that are restricted to
+// resource names are not taken into account.
+func (a *Analyzer) Can(s Subject, verb string, resource string, namespace string) bool {
+	name, group := ParseResource(resource)
+	for _, g := range a.grants(s) {
+		if g.namespace != "" && g.namespace != namespace {
+			continue
+		}
+		if len(g.rule.ResourceNames) != 0 {
+			continue
+		}
+		if verbMatches(g.rule, verb) && groupMatches(g.rule, group) && resourceMatches(g.rule, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// WhoCan returns all subjects that may perform the verb on the resource
+// in the namespace
+func (a *Analyzer) WhoCan(verb string, resource string, namespace string) []Subject {
+	res := []Subject{}
+	for _, s := range a.Subjects() {
+		if a.Can(s, verb, resource, namespace) {
+			res = append(res, s)
+		}
+	}
+	return res
+}
@@ -0,0 +1,194 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func clusterRole(name string, labels map[string]string, rules ...rbacv1.PolicyRule) *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Rules:      rules,
+	}
+}
+
+func testAnalyzer() *Analyzer {
+	// aggregated roles have no rules in manifests
+	admin := clusterRole("admin", nil)
+	admin.AggregationRule = &rbacv1.AggregationRule{
+		ClusterRoleSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"rbac.example.com/aggregate-to-admin": "true"}}},
+	}
+
+	clusterRoles := []*rbacv1.ClusterRole{
+		admin,
+		clusterRole("pod-admin", map[string]string{"rbac.example.com/aggregate-to-admin": "true"}, rbacv1.PolicyRule{
+			APIGroups: []string{""}, Resources: []string{"pods", "pods/exec"}, Verbs: []string{"*"},
+		}),
+		clusterRole("secret-reader", nil, rbacv1.PolicyRule{
+			APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get", "list"},
+		}),
+		clusterRole("deployer", nil, rbacv1.PolicyRule{
+			APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"create", "update"},
+		}),
+		clusterRole("health", nil, rbacv1.PolicyRule{
+			NonResourceURLs: []string{"/healthz"}, Verbs: []string{"get"},
+		}),
+	}
+	roles := []*rbacv1.Role{{
+		ObjectMeta: metav1.ObjectMeta{Name: "config-reader", Namespace: "team-a"},
+		Rules: []rbacv1.PolicyRule{{
+			APIGroups: []string{""}, Resources: []string{"configmaps"}, ResourceNames: []string{"app-config"}, Verbs: []string{"get"},
+		}},
+	}}
+	clusterRoleBindings := []*rbacv1.ClusterRoleBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "secret-readers"},
+			Subjects:   []rbacv1.Subject{{Kind: "Group", Name: "auditors"}, {Kind: "ServiceAccount", Name: "backup", Namespace: "ops"}},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "secret-reader"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "health"},
+			Subjects:   []rbacv1.Subject{{Kind: "Group", Name: GroupAuthenticated}},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "health"},
+		},
+	}
+	roleBindings := []*rbacv1.RoleBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "admins", Namespace: "kube-system"},
+			Subjects:   []rbacv1.Subject{{Kind: "ServiceAccount", Name: "operator"}, {Kind: "User", Name: "alice"}},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "admin"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "deployers", Namespace: "team-a"},
+			Subjects:   []rbacv1.Subject{{Kind: "Group", Name: GroupServiceAccounts + ":team-a"}},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "deployer"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "team-a"},
+			Subjects:   []rbacv1.Subject{{Kind: "User", Name: "bob"}},
+			RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "config-reader"},
+		},
+	}
+	serviceAccounts := []*corev1.ServiceAccount{
+		{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "team-a"}},
+	}
+	return New(clusterRoles, roles, clusterRoleBindings, roleBindings, serviceAccounts)
+}
+
+func TestSubjects(t *testing.T) {
+	keys := []string{}
+	for _, s := range testAnalyzer().Subjects() {
+		keys = append(keys, s.Key())
+	}
+	assert.Equal(t, []string{
+		"Group/auditors",
+		"Group/system:authenticated",
+		"Group/system:serviceaccounts:team-a",
+		"ServiceAccount/kube-system/operator",
+		"ServiceAccount/ops/backup",
+		"ServiceAccount/team-a/default",
+		"User/alice",
+		"User/bob",
+	}, keys)
+}
+
+func TestAggregation(t *testing.T) {
+	a := testAnalyzer()
+	require.Len(t, a.clusterRoles["admin"], 1)
+	assert.Equal(t, []string{"pods", "pods/exec"}, a.clusterRoles["admin"][0].Resources)
+}
+
+func TestPermissions(t *testing.T) {
+	a := testAnalyzer()
+	operator := Subject{Kind: "ServiceAccount", Name: "operator", Namespace: "kube-system"}
+
+	perms := a.Permissions(operator)
+	// the wildcard is expanded for both resources, plus the non-resource URL
+	// of all authenticated subjects
+	require.Len(t, perms, 2*len(WildcardVerbs)+1)
+	assert.Equal(t, Permission{
+		Verb: "get", NonResourceURL: "/healthz", Role: "ClusterRole/health", Binding: "ClusterRoleBinding/health",
+	}, perms[0])
+	assert.Contains(t, perms, Permission{
+		Verb: "escalate", Resource: "pods", Namespace: "kube-system", Role: "ClusterRole/admin", Binding: "RoleBinding/kube-system/admins",
+	})
+	assert.Contains(t, perms, Permission{
+		Verb: "create", Resource: "pods", Namespace: "kube-system", Role: "ClusterRole/admin", Binding: "RoleBinding/kube-system/admins",
+	})
+
+	bob := a.Permissions(Subject{Kind: "User", Name: "bob"})
+	assert.Contains(t, bob, Permission{
+		Verb: "get", Resource: "configmaps", ResourceName: "app-config", Namespace: "team-a",
+		Role: "Role/team-a/config-reader", Binding: "RoleBinding/team-a/config",
+	})
+}
+
+func TestCan(t *testing.T) {
+	a := testAnalyzer()
+	operator := Subject{Kind: "ServiceAccount", Name: "operator", Namespace: "kube-system"}
+	teamDefault := Subject{Kind: "ServiceAccount", Name: "default", Namespace: "team-a"}
+	bob := Subject{Kind: "User", Name: "bob"}
+
+	assert.True(t, a.Can(operator, "create", "pods", "kube-system"))
+	// the wildcard verb includes special verbs
+	assert.True(t, a.Can(operator, "escalate", "pods", "kube-system"))
+	assert.True(t, a.Can(operator, "impersonate", "pods", "kube-system"))
+	assert.True(t, a.Can(operator, "create", "pods/exec", "kube-system"))
+	assert.False(t, a.Can(operator, "create", "pods/log", "kube-system"))
+	assert.False(t, a.Can(operator, "create", "pods", "default"))
+	// role bindings don't grant cluster-wide permissions
+	assert.False(t, a.Can(operator, "create", "pods", ""))
+
+	// via the group of all service accounts of the namespace
+	assert.True(t, a.Can(teamDefault, "create", "deployments.apps", "team-a"))
+	// resources without a group belong to the core group
+	assert.False(t, a.Can(teamDefault, "create", "deployments", "team-a"))
+	assert.False(t, a.Can(teamDefault, "create", "deployments.extensions", "team-a"))
+
+	// rules that are restricted to resource names don't apply
+	assert.False(t, a.Can(bob, "get", "configmaps", "team-a"))
+}
+
+func TestWhoCan(t *testing.T) {
+	a := testAnalyzer()
+	keys := func(subjects []Subject) []string {
+		res := []string{}
+		for _, s := range subjects {
+			res = append(res, s.Key())
+		}
+		return res
+	}
+
+	assert.Equal(t, []string{"Group/auditors", "ServiceAccount/ops/backup"}, keys(a.WhoCan("list", "secrets", "")))
+	assert.Equal(t, []string{"Group/auditors", "ServiceAccount/ops/backup"}, keys(a.WhoCan("get", "secrets", "kube-system")))
+	assert.Equal(t, []string{"ServiceAccount/kube-system/operator", "User/alice"}, keys(a.WhoCan("create", "pods", "kube-system")))
+	assert.Equal(t, []string{"Group/system:serviceaccounts:team-a", "ServiceAccount/team-a/default"}, keys(a.WhoCan("update", "deployments.apps", "team-a")))
+	assert.Empty(t, a.WhoCan("delete", "nodes", ""))
+}
+
+func TestParseResource(t *testing.T) {
+	tests := []struct {
+		resource string
+		name     string
+		group    string
+	}{
+		{"pods", "pods", ""},
+		{"pods/exec", "pods/exec", ""},
+		{"deployments.apps", "deployments", "apps"},
+		{"deployments.apps/scale", "deployments/scale", "apps"},
+		{"clusterroles.rbac.authorization.k8s.io", "clusterroles", "rbac.authorization.k8s.io"},
+	}
+	for _, test := range tests {
+		name, group := ParseResource(test.resource)
+		assert.Equal(t, test.name, name, test.resource)
+		assert.Equal(t, test.group, group, test.resource)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/k8s/connection/manifest"
+)
+
+func TestRbacManifest(t *testing.T) {
+	conn, err := manifest.NewConnection(0, &inventory.Asset{
+		Connections: []*inventory.Config{{}},
+	}, manifest.WithManifestFile("./testdata/rbac.yaml"))
+	require.NoError(t, err)
+	runtime := &plugin.Runtime{Connection: conn}
+
+	res, err := NewResource(runtime, "k8s.rbac", nil)
+	require.NoError(t, err)
+	subjects := res.(*mqlK8sRbac).GetSubjects()
+	require.NoError(t, subjects.Error)
+	require.Len(t, subjects.Data, 2)
+
+	prometheus := subjects.Data[0].(*mqlK8sRbacSubject)
+	assert.Equal(t, "ServiceAccount", prometheus.Kind.Data)
+	assert.Equal(t, "monitoring", prometheus.Namespace.Data)
+	permissions := prometheus.GetEffectivePermissions()
+	require.NoError(t, permissions.Error)
+	// the aggregated cluster role grants 3 verbs on 3 resources
+	require.Len(t, permissions.Data, 9)
+	permission := permissions.Data[0].(*mqlK8sRbacPermission)
+	assert.Equal(t, "ClusterRole/monitoring", permission.Role.Data)
+	assert.Equal(t, "ClusterRoleBinding/monitoring", permission.Binding.Data)
+	assert.Equal(t, "", permission.Namespace.Data)
+
+	can, err := NewResource(runtime, "k8s.rbac.can", map[string]*llx.RawData{
+		"verb":      llx.StringData("delete"),
+		"resource":  llx.StringData("secrets"),
+		"namespace": llx.StringData("monitoring"),
+	})
+	require.NoError(t, err)
+	allowed := can.(*mqlK8sRbacCan).GetSubjects()
+	require.NoError(t, allowed.Error)
+	require.Len(t, allowed.Data, 1)
+	assert.Equal(t, "jane", allowed.Data[0].(*mqlK8sRbacSubject).Name.Data)
+
+	can, err = NewResource(runtime, "k8s.rbac.can", map[string]*llx.RawData{
+		"verb":     llx.StringData("list"),
+		"resource": llx.StringData("pods"),
+	})
+	require.NoError(t, err)
+	allowed = can.(*mqlK8sRbacCan).GetSubjects()
+	require.NoError(t, allowed.Error)
+	require.Len(t, allowed.Data, 1)
+	assert.Equal(t, "prometheus", allowed.Data[0].(*mqlK8sRbacSubject).Name.Data)
+}
@@ -0,0 +1,206 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"strconv"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/v9/providers/k8s/resources/netpol"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type mqlK8sNetworkpolicyPeerInternal struct {
+	allowedPods []*corev1.Pod
+}
+
+func podKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// getNetpolAnalyzer builds the network policy analyzer from all pods,
+// namespaces, and network policies once
+func (k *mqlK8s) getNetpolAnalyzer() (*netpol.Analyzer, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if k.netpolAnalyzer != nil {
+		return k.netpolAnalyzer, nil
+	}
+
+	kt, err := k8sProvider(k.MqlRuntime.Connection)
+	if err != nil {
+		return nil, err
+	}
+
+	list := k.GetPods()
+	if list.Error != nil {
+		return nil, list.Error
+	}
+	k.podsByKey = make(map[string]*mqlK8sPod, len(list.Data))
+	pods := make([]*corev1.Pod, 0, len(list.Data))
+	for i := range list.Data {
+		p := list.Data[i].(*mqlK8sPod)
+		k.podsByKey[podKey(p.obj.Namespace, p.obj.Name)] = p
+		pods = append(pods, p.obj)
+	}
+
+	// namespace objects are listed as resources, since their labels are
+	// needed and manifests only provide them this way
+	namespaces := []*corev1.Namespace{}
+	policies := []*networkingv1.NetworkPolicy{}
+	for _, kind := range []string{"namespaces", "networkpolicies"} {
+		result, err := kt.Resources(kind, "", "")
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range result.Resources {
+			switch obj := resource.(type) {
+			case *corev1.Namespace:
+				namespaces = append(namespaces, obj)
+			case *networkingv1.NetworkPolicy:
+				policies = append(policies, obj)
+			}
+		}
+	}
+
+	k.netpolAnalyzer = netpol.New(pods, namespaces, policies)
+	return k.netpolAnalyzer, nil
+}
+
+func netpolAnalyzer(runtime *plugin.Runtime) (*mqlK8s, *netpol.Analyzer, error) {
+	obj, err := CreateResource(runtime, "k8s", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	k := obj.(*mqlK8s)
+	analyzer, err := k.getNetpolAnalyzer()
+	return k, analyzer, err
+}
+
+func (k *mqlK8sPod) defaultDenyIngress() (bool, error) {
+	_, analyzer, err := netpolAnalyzer(k.MqlRuntime)
+	if err != nil {
+		return false, err
+	}
+	return analyzer.IngressIsolated(k.obj), nil
+}
+
+func (k *mqlK8sPod) defaultDenyEgress() (bool, error) {
+	_, analyzer, err := netpolAnalyzer(k.MqlRuntime)
+	if err != nil {
+		return false, err
+	}
+	return analyzer.EgressIsolated(k.obj), nil
+}
+
+func netpolPorts(ports []netpol.Port) []interface{} {
+	res := make([]interface{}, 0, len(ports))
+	for _, p := range ports {
+		port := map[string]interface{}{
+			"protocol": p.Protocol,
+		}
+		if p.Port != 0 {
+			port["port"] = int64(p.Port)
+		}
+		if p.EndPort != 0 {
+			port["endPort"] = int64(p.EndPort)
+		}
+		if p.Name != "" {
+			port["name"] = p.Name
+		}
+		res = append(res, port)
+	}
+	return res
+}
+
+func (k *mqlK8sPod) reachableFrom() ([]interface{}, error) {
+	_, analyzer, err := netpolAnalyzer(k.MqlRuntime)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := analyzer.ReachableFrom(k.obj)
+	res := make([]interface{}, 0, len(peers))
+	for i, peer := range peers {
+		namespaceSelector, err := convert.JsonToDict(peer.NamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+		podSelector, err := convert.JsonToDict(peer.PodSelector)
+		if err != nil {
+			return nil, err
+		}
+		ipBlock, err := convert.JsonToDict(peer.IPBlock)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := CreateResource(k.MqlRuntime, "k8s.networkpolicy.peer", map[string]*llx.RawData{
+			"__id":              llx.StringData(k.Id.Data + "/peer/" + strconv.Itoa(i)),
+			"policy":            llx.StringData(peer.Policy),
+			"any":               llx.BoolData(peer.Any),
+			"namespaceSelector": llx.DictData(namespaceSelector),
+			"podSelector":       llx.DictData(podSelector),
+			"namespaces":        llx.ArrayData(convert.SliceAnyToInterface(peer.Namespaces), "string"),
+			"ipBlock":           llx.DictData(ipBlock),
+			"ports":             llx.ArrayData(netpolPorts(peer.Ports), "dict"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.(*mqlK8sNetworkpolicyPeer).allowedPods = peer.Pods
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+func (k *mqlK8sNetworkpolicyPeer) pods() ([]interface{}, error) {
+	k8s, _, err := netpolAnalyzer(k.MqlRuntime)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, 0, len(k.allowedPods))
+	for _, pod := range k.allowedPods {
+		if p, ok := k8s.podsByKey[podKey(pod.Namespace, pod.Name)]; ok {
+			res = append(res, p)
+		}
+	}
+	return res, nil
+}
+
+func initK8sPodCanReach(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if args["from"] == nil || args["to"] == nil || args["port"] == nil {
+		return nil, nil, errors.New("k8s.pod.canReach requires a source pod, a destination pod, and a port")
+	}
+	if args["protocol"] == nil {
+		args["protocol"] = llx.StringData(string(corev1.ProtocolTCP))
+	}
+	return args, nil, nil
+}
+
+func (k *mqlK8sPodCanReach) id() (string, error) {
+	return k.From.Data + "/" + k.To.Data + "/" + strconv.FormatInt(k.Port.Data, 10) + "/" + k.Protocol.Data, nil
+}
+
+func (k *mqlK8sPodCanReach) allowed() (bool, error) {
+	k8s, analyzer, err := netpolAnalyzer(k.MqlRuntime)
+	if err != nil {
+		return false, err
+	}
+
+	from, ok := k8s.podsByKey[k.From.Data]
+	if !ok {
+		return false, errors.New("cannot find pod " + k.From.Data)
+	}
+	to, ok := k8s.podsByKey[k.To.Data]
+	if !ok {
+		return false, errors.New("cannot find pod " + k.To.Data)
+	}
+	return analyzer.CanReach(from.obj, to.obj, int32(k.Port.Data), k.Protocol.Data), nil
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/llx"
+)
+
+func TestReachability(t *testing.T) {
+	runtime := kindsRuntime(t, "./testdata/netpol.yaml")
+	k := &mqlK8s{MqlRuntime: runtime}
+
+	pods, err := k.pods()
+	require.NoError(t, err)
+	require.Len(t, pods, 2)
+	var web, db *mqlK8sPod
+	for _, p := range pods {
+		pod := p.(*mqlK8sPod)
+		if pod.Name.Data == "web" {
+			web = pod
+		} else {
+			db = pod
+		}
+	}
+
+	isolated, err := db.defaultDenyIngress()
+	require.NoError(t, err)
+	assert.True(t, isolated)
+	isolated, err = db.defaultDenyEgress()
+	require.NoError(t, err)
+	assert.False(t, isolated)
+	isolated, err = web.defaultDenyIngress()
+	require.NoError(t, err)
+	assert.False(t, isolated)
+
+	peers, err := db.reachableFrom()
+	require.NoError(t, err)
+	require.Len(t, peers, 1)
+	peer := peers[0].(*mqlK8sNetworkpolicyPeer)
+	assert.Equal(t, "db/allow-app", peer.Policy.Data)
+	assert.False(t, peer.Any.Data)
+	assert.Equal(t, []interface{}{"app"}, peer.Namespaces.Data)
+	assert.Equal(t, []interface{}{map[string]interface{}{"protocol": "TCP", "port": int64(5432)}}, peer.Ports.Data)
+	peerPods, err := peer.pods()
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{web}, peerPods)
+
+	for _, tc := range []struct {
+		from    string
+		to      string
+		port    int64
+		allowed bool
+	}{
+		{"app/web", "db/postgres", 5432, true},
+		{"app/web", "db/postgres", 22, false},
+		{"db/postgres", "app/web", 80, true},
+	} {
+		r, err := NewResource(runtime, "k8s.pod.canReach", map[string]*llx.RawData{
+			"from": llx.StringData(tc.from),
+			"to":   llx.StringData(tc.to),
+			"port": llx.IntData(tc.port),
+		})
+		require.NoError(t, err)
+		allowed, err := r.(*mqlK8sPodCanReach).allowed()
+		require.NoError(t, err)
+		assert.Equal(t, tc.allowed, allowed, tc.from+" -> "+tc.to)
+	}
+}
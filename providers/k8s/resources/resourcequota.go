@@ -0,0 +1,90 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/v9/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type mqlK8sResourcequotaInternal struct {
+	lock sync.Mutex
+	obj  *corev1.ResourceQuota
+}
+
+func (k *mqlK8s) resourceQuotas() ([]interface{}, error) {
+	return k8sResourceToMql(k.MqlRuntime, "resourcequotas", func(kind string, resource runtime.Object, obj metav1.Object, objT metav1.Type) (interface{}, error) {
+		ts := obj.GetCreationTimestamp()
+
+		manifest, err := convert.JsonToDict(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		quota, ok := resource.(*corev1.ResourceQuota)
+		if !ok {
+			return nil, errors.New("not a k8s resourcequota")
+		}
+
+		spec, err := convert.JsonToDict(quota.Spec)
+		if err != nil {
+			return nil, err
+		}
+
+		scopeSelector, err := convert.JsonToDict(quota.Spec.ScopeSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		scopes := make([]interface{}, 0, len(quota.Spec.Scopes))
+		for _, scope := range quota.Spec.Scopes {
+			scopes = append(scopes, string(scope))
+		}
+
+		r, err := CreateResource(k.MqlRuntime, "k8s.resourcequota", map[string]*llx.RawData{
+			"id":              llx.StringData(objIdFromK8sObj(obj, objT)),
+			"uid":             llx.StringData(string(obj.GetUID())),
+			"resourceVersion": llx.StringData(obj.GetResourceVersion()),
+			"name":            llx.StringData(obj.GetName()),
+			"namespace":       llx.StringData(obj.GetNamespace()),
+			"kind":            llx.StringData(objT.GetKind()),
+			"created":         llx.TimeData(ts.Time),
+			"manifest":        llx.DictData(manifest),
+			"hard":            llx.MapData(resourceListToMap(quota.Spec.Hard), types.String),
+			"used":            llx.MapData(resourceListToMap(quota.Status.Used), types.String),
+			"scopes":          llx.ArrayData(scopes, types.String),
+			"scopeSelector":   llx.DictData(scopeSelector),
+			"spec":            llx.DictData(spec),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.(*mqlK8sResourcequota).obj = quota
+		return r, nil
+	})
+}
+
+func (k *mqlK8sResourcequota) id() (string, error) {
+	return k.Id.Data, nil
+}
+
+func initK8sResourcequota(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initNamespacedResource[*mqlK8sResourcequota](runtime, args, func(k *mqlK8s) *plugin.TValue[[]interface{}] { return k.GetResourceQuotas() })
+}
+
+func (k *mqlK8sResourcequota) annotations() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetAnnotations()), nil
+}
+
+func (k *mqlK8sResourcequota) labels() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetLabels()), nil
+}
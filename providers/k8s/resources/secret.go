@@ -29,6 +29,7 @@ func (k *mqlK8s) secrets() ([]interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
+		redactSecretManifest(manifest)
 
 		s, ok := resource.(*corev1.Secret)
 		if !ok {
@@ -64,7 +65,7 @@ func initK8sSecret(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[s
 }
 
 func (k *mqlK8sSecret) annotations() (map[string]interface{}, error) {
-	return convert.MapToInterfaceMap(k.obj.GetAnnotations()), nil
+	return redactSecretAnnotations(convert.MapToInterfaceMap(k.obj.GetAnnotations())), nil
 }
 
 func (k *mqlK8sSecret) labels() (map[string]interface{}, error) {
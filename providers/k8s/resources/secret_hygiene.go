@@ -0,0 +1,279 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"sort"
+	"strconv"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/k8s/connection/shared/resources"
+	"go.mondoo.com/cnquery/v9/providers/k8s/resources/secretscan"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+const (
+	redactedValue = "<redacted>"
+
+	lastAppliedAnnotation    = "kubectl.kubernetes.io/last-applied-configuration"
+	helmReleaseSecretType    = "helm.sh/release.v1"
+	bootstrapTokenSecretType = "bootstrap.kubernetes.io/token"
+)
+
+// secretUsage is how pods, workloads, service accounts, and ingresses use a secret
+type secretUsage struct {
+	mountedBy       []interface{}
+	envReferencedBy []interface{}
+	env             bool
+	used            bool
+}
+
+// secretUsageIndex has the usage of secrets and the service accounts, by namespace/name
+type secretUsageIndex struct {
+	secrets         map[string]*secretUsage
+	serviceAccounts map[string]struct{}
+}
+
+func (i *secretUsageIndex) get(namespace, name string) *secretUsage {
+	key := podKey(namespace, name)
+	u, ok := i.secrets[key]
+	if !ok {
+		u = &secretUsage{}
+		i.secrets[key] = u
+	}
+	return u
+}
+
+func (i *secretUsageIndex) addPodSpec(namespace string, spec *corev1.PodSpec, pod *mqlK8sPod) {
+	refs := secretscan.PodSpecReferences(spec)
+	for _, name := range refs.Volumes {
+		u := i.get(namespace, name)
+		u.used = true
+		if pod != nil {
+			u.mountedBy = append(u.mountedBy, pod)
+		}
+	}
+	for _, name := range refs.Env {
+		u := i.get(namespace, name)
+		u.used = true
+		u.env = true
+		if pod != nil {
+			u.envReferencedBy = append(u.envReferencedBy, pod)
+		}
+	}
+	for _, name := range refs.ImagePull {
+		i.get(namespace, name).used = true
+	}
+}
+
+// getSecretUsage indexes the secrets that are referenced in the cluster once
+func (k *mqlK8s) getSecretUsage() (*secretUsageIndex, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	if k.secretUsage != nil {
+		return k.secretUsage, nil
+	}
+
+	kt, err := k8sProvider(k.MqlRuntime.Connection)
+	if err != nil {
+		return nil, err
+	}
+
+	index := &secretUsageIndex{
+		secrets:         map[string]*secretUsage{},
+		serviceAccounts: map[string]struct{}{},
+	}
+
+	pods := k.GetPods()
+	if pods.Error != nil {
+		return nil, pods.Error
+	}
+	for i := range pods.Data {
+		p := pods.Data[i].(*mqlK8sPod)
+		index.addPodSpec(p.obj.Namespace, &p.obj.Spec, p)
+	}
+
+	// workloads may not have pods yet, e.g. in manifests or when they are scaled down
+	for _, kind := range []string{"deployments", "daemonsets", "statefulsets", "replicasets", "jobs", "cronjobs"} {
+		result, err := kt.Resources(kind, "", "")
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range result.Resources {
+			obj, err := meta.Accessor(resource)
+			if err != nil {
+				return nil, err
+			}
+			spec, err := resources.GetPodSpec(resource)
+			if err != nil {
+				continue
+			}
+			index.addPodSpec(obj.GetNamespace(), spec, nil)
+		}
+	}
+
+	for _, kind := range []string{"serviceaccounts", "ingresses"} {
+		result, err := kt.Resources(kind, "", "")
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range result.Resources {
+			switch obj := resource.(type) {
+			case *corev1.ServiceAccount:
+				index.serviceAccounts[podKey(obj.Namespace, obj.Name)] = struct{}{}
+				for _, s := range obj.Secrets {
+					index.get(obj.Namespace, s.Name).used = true
+				}
+				for _, s := range obj.ImagePullSecrets {
+					index.get(obj.Namespace, s.Name).used = true
+				}
+			case *networkingv1.Ingress:
+				for _, tls := range obj.Spec.TLS {
+					if tls.SecretName != "" {
+						index.get(obj.Namespace, tls.SecretName).used = true
+					}
+				}
+			}
+		}
+	}
+
+	k.secretUsage = index
+	return index, nil
+}
+
+func (k *mqlK8sSecret) usage() (*secretUsageIndex, *secretUsage, error) {
+	obj, err := CreateResource(k.MqlRuntime, "k8s", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	index, err := obj.(*mqlK8s).getSecretUsage()
+	if err != nil {
+		return nil, nil, err
+	}
+	if u, ok := index.secrets[podKey(k.Namespace.Data, k.Name.Data)]; ok {
+		return index, u, nil
+	}
+	return index, &secretUsage{}, nil
+}
+
+func (k *mqlK8sSecret) mountedBy() ([]interface{}, error) {
+	_, u, err := k.usage()
+	if err != nil {
+		return nil, err
+	}
+	return append([]interface{}{}, u.mountedBy...), nil
+}
+
+func (k *mqlK8sSecret) envReferencedBy() ([]interface{}, error) {
+	_, u, err := k.usage()
+	if err != nil {
+		return nil, err
+	}
+	return append([]interface{}{}, u.envReferencedBy...), nil
+}
+
+func (k *mqlK8sSecret) exposedViaEnv() (bool, error) {
+	_, u, err := k.usage()
+	if err != nil {
+		return false, err
+	}
+	return u.env, nil
+}
+
+func (k *mqlK8sSecret) unused() (bool, error) {
+	index, u, err := k.usage()
+	if err != nil {
+		return false, err
+	}
+	if u.used {
+		return false, nil
+	}
+
+	// secrets that are managed by the cluster or by tools are used outside of pods
+	switch k.obj.Type {
+	case helmReleaseSecretType, bootstrapTokenSecretType:
+		return false, nil
+	case corev1.SecretTypeServiceAccountToken:
+		_, ok := index.serviceAccounts[podKey(k.obj.Namespace, k.obj.Annotations[corev1.ServiceAccountNameKey])]
+		return !ok, nil
+	}
+	return true, nil
+}
+
+func (k *mqlK8sSecret) findings() ([]interface{}, error) {
+	data := map[string][]byte{}
+	for key, value := range k.obj.StringData {
+		data[key] = []byte(value)
+	}
+	for key, value := range k.obj.Data {
+		data[key] = value
+	}
+	return newMqlSecretFindings(k.MqlRuntime, k.Id.Data, data)
+}
+
+func (k *mqlK8sConfigmap) findings() ([]interface{}, error) {
+	data := map[string][]byte{}
+	for key, value := range k.obj.BinaryData {
+		data[key] = value
+	}
+	for key, value := range k.obj.Data {
+		data[key] = []byte(value)
+	}
+	return newMqlSecretFindings(k.MqlRuntime, k.Id.Data, data)
+}
+
+func newMqlSecretFindings(runtime *plugin.Runtime, id string, data map[string][]byte) ([]interface{}, error) {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	res := []interface{}{}
+	for _, key := range keys {
+		for i, f := range secretscan.Scan(key, data[key]) {
+			r, err := CreateResource(runtime, "k8s.secretfinding", map[string]*llx.RawData{
+				"__id":        llx.StringData(id + "/" + key + "/" + strconv.Itoa(i)),
+				"key":         llx.StringData(f.Key),
+				"type":        llx.StringData(f.Type),
+				"description": llx.StringData(f.Description),
+				"expires":     llx.TimeDataPtr(f.Expires),
+			})
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, r)
+		}
+	}
+	return res, nil
+}
+
+// redactSecretManifest replaces the values of a secret manifest, so they are
+// not part of reports or recordings. The keys are kept.
+func redactSecretManifest(manifest map[string]interface{}) {
+	for _, field := range []string{"data", "stringData"} {
+		if values, ok := manifest[field].(map[string]interface{}); ok {
+			for key := range values {
+				values[key] = redactedValue
+			}
+		}
+	}
+	if metadata, ok := manifest["metadata"].(map[string]interface{}); ok {
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			redactSecretAnnotations(annotations)
+		}
+	}
+}
+
+// redactSecretAnnotations redacts the configuration that kubectl apply stores,
+// since it includes the values of the secret
+func redactSecretAnnotations(annotations map[string]interface{}) map[string]interface{} {
+	if _, ok := annotations[lastAppliedAnnotation]; ok {
+		annotations[lastAppliedAnnotation] = redactedValue
+	}
+	return annotations
+}
@@ -0,0 +1,86 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretHygiene(t *testing.T) {
+	k := &mqlK8s{MqlRuntime: kindsRuntime(t, "./testdata/secrets.yaml")}
+
+	list, err := k.secrets()
+	require.NoError(t, err)
+	secrets := map[string]*mqlK8sSecret{}
+	for _, s := range list {
+		secrets[s.(*mqlK8sSecret).Name.Data] = s.(*mqlK8sSecret)
+	}
+	require.Len(t, secrets, 5)
+
+	// the database secret is exposed via env by a deployment without pods
+	db := secrets["db"]
+	findings, err := db.findings()
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	finding := findings[0].(*mqlK8sSecretfinding)
+	assert.Equal(t, "DATABASE_URL", finding.Key.Data)
+	assert.Equal(t, "connection-string-password", finding.Type.Data)
+	exposed, err := db.exposedViaEnv()
+	require.NoError(t, err)
+	assert.True(t, exposed)
+	envPods, err := db.envReferencedBy()
+	require.NoError(t, err)
+	assert.Empty(t, envPods)
+	unused, err := db.unused()
+	require.NoError(t, err)
+	assert.False(t, unused)
+
+	// values are redacted in the manifest and the last applied configuration
+	manifest, err := json.Marshal(db.Manifest.Data)
+	require.NoError(t, err)
+	assert.NotContains(t, string(manifest), "s3cr3t")
+	assert.Equal(t, redactedValue, db.Manifest.Data.(map[string]interface{})["stringData"].(map[string]interface{})["DATABASE_URL"])
+	annotations, err := db.annotations()
+	require.NoError(t, err)
+	assert.Equal(t, redactedValue, annotations[lastAppliedAnnotation])
+
+	tls := secrets["tls"]
+	mounted, err := tls.mountedBy()
+	require.NoError(t, err)
+	require.Len(t, mounted, 1)
+	assert.Equal(t, "web", mounted[0].(*mqlK8sPod).Name.Data)
+	exposed, err = tls.exposedViaEnv()
+	require.NoError(t, err)
+	assert.False(t, exposed)
+
+	cloud := secrets["cloud"]
+	findings, err = cloud.findings()
+	require.NoError(t, err)
+	require.Len(t, findings, 2)
+	assert.Equal(t, "cloud-access-key", findings[0].(*mqlK8sSecretfinding).Type.Data)
+	unused, err = cloud.unused()
+	require.NoError(t, err)
+	assert.True(t, unused)
+
+	// used by a service account
+	for _, name := range []string{"registry", "web-token"} {
+		unused, err = secrets[name].unused()
+		require.NoError(t, err)
+		assert.False(t, unused, name)
+	}
+
+	configmaps, err := k.configmaps()
+	require.NoError(t, err)
+	require.Len(t, configmaps, 1)
+	findings, err = configmaps[0].(*mqlK8sConfigmap).findings()
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	finding = findings[0].(*mqlK8sSecretfinding)
+	assert.Equal(t, "CACHE_URL", finding.Key.Data)
+	assert.Equal(t, "password in redis URL", finding.Description.Data)
+}
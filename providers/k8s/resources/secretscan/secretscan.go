@@ -0,0 +1,289 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package secretscan classifies sensitive values in secrets and configmaps and
+// finds the secrets that pod specs reference. Findings describe what a value
+// is, but never include the value itself.
+package secretscan
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Types of sensitive values
+const (
+	TypePrivateKey               = "private-key"
+	TypeCertificate              = "certificate"
+	TypeCloudAccessKey           = "cloud-access-key"
+	TypeJwt                      = "jwt"
+	TypeConnectionStringPassword = "connection-string-password"
+)
+
+// Finding is a sensitive value in a key of a secret or configmap
+type Finding struct {
+	// Key of the value in the data of the secret or configmap
+	Key string
+	// Type of the value, e.g. private-key
+	Type string
+	// Description of the value, e.g. "RSA private key"
+	Description string
+	// Expires is the expiry of certificates and JWTs, if set
+	Expires *time.Time
+}
+
+var (
+	awsAccessKeyID     = regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)
+	awsSecretAccessKey = regexp.MustCompile(`(?i)aws_?secret_?access_?key["']?\s*[:=]\s*["']?[A-Za-z0-9/+=]{40}\b`)
+	azureAccountKey    = regexp.MustCompile(`(?i)\bAccountKey=[A-Za-z0-9/+=]{40,}`)
+	jwt                = regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	urlPassword        = regexp.MustCompile(`\b([a-zA-Z][a-zA-Z0-9+.-]*)://[^:/\s@]+:([^@\s/]+)@`)
+	keyValuePassword   = regexp.MustCompile(`(?i)(?:^|;)\s*(?:password|pwd)\s*=\s*([^;\s]+)`)
+)
+
+// Scan returns the sensitive values in a value of a secret or configmap. Values
+// that are base64 encoded once more are decoded, since secrets are often
+// encoded twice by mistake.
+func Scan(key string, value []byte) []Finding {
+	findings := scan(key, value)
+	if len(findings) == 0 {
+		if decoded, ok := decodeBase64Text(value); ok {
+			findings = scan(key, decoded)
+		}
+	}
+	return findings
+}
+
+func scan(key string, value []byte) []Finding {
+	findings := scanPem(key, value)
+
+	if isServiceAccountKey(value) {
+		findings = append(findings, Finding{Key: key, Type: TypeCloudAccessKey, Description: "GCP service account key"})
+	}
+	if awsAccessKeyID.Match(value) {
+		findings = append(findings, Finding{Key: key, Type: TypeCloudAccessKey, Description: "AWS access key ID"})
+	}
+	if awsSecretAccessKey.Match(value) {
+		findings = append(findings, Finding{Key: key, Type: TypeCloudAccessKey, Description: "AWS secret access key"})
+	}
+	if azureAccountKey.Match(value) {
+		findings = append(findings, Finding{Key: key, Type: TypeCloudAccessKey, Description: "Azure storage account key"})
+	}
+
+	for _, token := range jwt.FindAll(value, -1) {
+		if f, ok := scanJwt(key, token); ok {
+			findings = append(findings, f)
+		}
+	}
+
+	for _, m := range urlPassword.FindAllSubmatch(value, -1) {
+		if isPassword(m[2]) {
+			findings = append(findings, Finding{
+				Key: key, Type: TypeConnectionStringPassword,
+				Description: "password in " + strings.ToLower(string(m[1])) + " URL",
+			})
+		}
+	}
+	for _, m := range keyValuePassword.FindAllSubmatch(value, -1) {
+		if isPassword(m[1]) {
+			findings = append(findings, Finding{Key: key, Type: TypeConnectionStringPassword, Description: "password in connection string"})
+		}
+	}
+
+	return findings
+}
+
+// scanPem returns the private keys and certificates of PEM blocks
+func scanPem(key string, value []byte) []Finding {
+	findings := []Finding{}
+	rest := value
+	for {
+		start := bytes.Index(rest, []byte("-----BEGIN "))
+		if start < 0 {
+			return findings
+		}
+		block, remaining := pem.Decode(rest[start:])
+		if block == nil {
+			// skip the broken block header
+			rest = rest[start+len("-----BEGIN "):]
+			continue
+		}
+		rest = remaining
+
+		switch {
+		case strings.HasSuffix(block.Type, "PRIVATE KEY"):
+			description := strings.TrimSpace(strings.TrimSuffix(block.Type, "PRIVATE KEY"))
+			switch description {
+			case "":
+				description = "private key"
+			case "ENCRYPTED":
+				description = "encrypted private key"
+			default:
+				description += " private key"
+			}
+			if _, ok := block.Headers["Proc-Type"]; ok && !strings.HasPrefix(description, "encrypted") {
+				description = "encrypted " + description
+			}
+			findings = append(findings, Finding{Key: key, Type: TypePrivateKey, Description: description})
+
+		case block.Type == "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				continue
+			}
+			expires := cert.NotAfter
+			description := "certificate for " + cert.Subject.CommonName
+			if cert.Subject.CommonName == "" {
+				description = "certificate"
+			}
+			if cert.IsCA {
+				description = "CA " + description
+			}
+			findings = append(findings, Finding{Key: key, Type: TypeCertificate, Description: description, Expires: &expires})
+		}
+	}
+}
+
+func isServiceAccountKey(value []byte) bool {
+	if !bytes.Contains(value, []byte("service_account")) {
+		return false
+	}
+	key := struct {
+		Type       string `json:"type"`
+		PrivateKey string `json:"private_key"`
+	}{}
+	if err := json.Unmarshal(value, &key); err != nil {
+		return false
+	}
+	return key.Type == "service_account" && key.PrivateKey != ""
+}
+
+// scanJwt verifies that a token looks like a JWT, with a header that sets the
+// algorithm, and reads the issuer and expiry
+func scanJwt(key string, token []byte) (Finding, bool) {
+	parts := strings.Split(string(token), ".")
+	header := struct {
+		Alg string `json:"alg"`
+	}{}
+	if data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[0], "=")); err != nil || json.Unmarshal(data, &header) != nil || header.Alg == "" {
+		return Finding{}, false
+	}
+
+	res := Finding{Key: key, Type: TypeJwt, Description: "JWT"}
+	claims := struct {
+		Issuer    string  `json:"iss"`
+		ExpiresAt float64 `json:"exp"`
+	}{}
+	if data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "=")); err == nil && json.Unmarshal(data, &claims) == nil {
+		if claims.Issuer != "" {
+			res.Description = "JWT issued by " + claims.Issuer
+		}
+		if claims.ExpiresAt > 0 {
+			expires := time.Unix(int64(claims.ExpiresAt), 0).UTC()
+			res.Expires = &expires
+		}
+	}
+	return res, true
+}
+
+// isPassword ignores empty passwords and placeholders like ${DB_PASSWORD}
+func isPassword(value []byte) bool {
+	s := strings.Trim(string(value), `"'`)
+	return s != "" && !strings.HasPrefix(s, "$") && !strings.HasPrefix(s, "{{") && !strings.HasPrefix(s, "%")
+}
+
+// decodeBase64Text decodes values that are base64 encoded printable text
+func decodeBase64Text(value []byte) ([]byte, bool) {
+	s := strings.TrimSpace(string(value))
+	if len(s) < 16 {
+		return nil, false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || !utf8.Valid(decoded) {
+		return nil, false
+	}
+	return decoded, true
+}
+
+// References are the names of the secrets that a pod spec uses
+type References struct {
+	// Volumes are secrets that are mounted as secret or projected volumes
+	Volumes []string
+	// Env are secrets that are exposed as environment variables of containers
+	Env []string
+	// ImagePull are the image pull secrets
+	ImagePull []string
+}
+
+// PodSpecReferences returns the secrets that a pod spec references. Every
+// secret is listed once per kind of reference.
+func PodSpecReferences(spec *corev1.PodSpec) References {
+	res := References{}
+	volumes := map[string]struct{}{}
+	env := map[string]struct{}{}
+	add := func(list *[]string, seen map[string]struct{}, name string) {
+		if _, ok := seen[name]; ok || name == "" {
+			return
+		}
+		seen[name] = struct{}{}
+		*list = append(*list, name)
+	}
+
+	for _, v := range spec.Volumes {
+		if v.Secret != nil {
+			add(&res.Volumes, volumes, v.Secret.SecretName)
+		}
+		if v.Projected != nil {
+			for _, source := range v.Projected.Sources {
+				if source.Secret != nil {
+					add(&res.Volumes, volumes, source.Secret.Name)
+				}
+			}
+		}
+	}
+
+	containerEnv := func(env []corev1.EnvVar, envFrom []corev1.EnvFromSource) []string {
+		names := []string{}
+		for _, e := range env {
+			if e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil {
+				names = append(names, e.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+		for _, e := range envFrom {
+			if e.SecretRef != nil {
+				names = append(names, e.SecretRef.Name)
+			}
+		}
+		return names
+	}
+	for _, c := range spec.InitContainers {
+		for _, name := range containerEnv(c.Env, c.EnvFrom) {
+			add(&res.Env, env, name)
+		}
+	}
+	for _, c := range spec.Containers {
+		for _, name := range containerEnv(c.Env, c.EnvFrom) {
+			add(&res.Env, env, name)
+		}
+	}
+	for _, c := range spec.EphemeralContainers {
+		for _, name := range containerEnv(c.Env, c.EnvFrom) {
+			add(&res.Env, env, name)
+		}
+	}
+
+	pull := map[string]struct{}{}
+	for _, s := range spec.ImagePullSecrets {
+		add(&res.ImagePull, pull, s.Name)
+	}
+	return res
+}
@@ -0,0 +1,87 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/v9/types"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// defaultStorageClassAnnotation marks the default storage class of a cluster
+const defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+
+type mqlK8sStorageclassInternal struct {
+	lock sync.Mutex
+	obj  *storagev1.StorageClass
+}
+
+func (k *mqlK8s) storageClasses() ([]interface{}, error) {
+	return k8sResourceToMql(k.MqlRuntime, "storageclasses.v1.storage.k8s.io", func(kind string, resource runtime.Object, obj metav1.Object, objT metav1.Type) (interface{}, error) {
+		ts := obj.GetCreationTimestamp()
+
+		manifest, err := convert.JsonToDict(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		sc, ok := resource.(*storagev1.StorageClass)
+		if !ok {
+			return nil, errors.New("not a k8s storageclass")
+		}
+
+		var reclaimPolicy, volumeBindingMode string
+		if sc.ReclaimPolicy != nil {
+			reclaimPolicy = string(*sc.ReclaimPolicy)
+		}
+		if sc.VolumeBindingMode != nil {
+			volumeBindingMode = string(*sc.VolumeBindingMode)
+		}
+
+		r, err := CreateResource(k.MqlRuntime, "k8s.storageclass", map[string]*llx.RawData{
+			"id":                   llx.StringData(objIdFromK8sObj(obj, objT)),
+			"uid":                  llx.StringData(string(obj.GetUID())),
+			"resourceVersion":      llx.StringData(obj.GetResourceVersion()),
+			"name":                 llx.StringData(obj.GetName()),
+			"kind":                 llx.StringData(objT.GetKind()),
+			"created":              llx.TimeData(ts.Time),
+			"manifest":             llx.DictData(manifest),
+			"provisioner":          llx.StringData(sc.Provisioner),
+			"parameters":           llx.MapData(convert.MapToInterfaceMap(sc.Parameters), types.String),
+			"reclaimPolicy":        llx.StringData(reclaimPolicy),
+			"volumeBindingMode":    llx.StringData(volumeBindingMode),
+			"allowVolumeExpansion": llx.BoolData(convert.ToBool(sc.AllowVolumeExpansion)),
+			"mountOptions":         llx.ArrayData(convert.SliceAnyToInterface(sc.MountOptions), types.String),
+			"isDefault":            llx.BoolData(sc.Annotations[defaultStorageClassAnnotation] == "true"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.(*mqlK8sStorageclass).obj = sc
+		return r, nil
+	})
+}
+
+func (k *mqlK8sStorageclass) id() (string, error) {
+	return k.Id.Data, nil
+}
+
+func initK8sStorageclass(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initResource[*mqlK8sStorageclass](runtime, args, func(k *mqlK8s) *plugin.TValue[[]interface{}] { return k.GetStorageClasses() })
+}
+
+func (k *mqlK8sStorageclass) annotations() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetAnnotations()), nil
+}
+
+func (k *mqlK8sStorageclass) labels() (map[string]interface{}, error) {
+	return convert.MapToInterfaceMap(k.obj.GetLabels()), nil
+}
@@ -6,6 +6,7 @@ package config
 import (
 	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/v9/providers/network/provider"
+	"go.mondoo.com/cnquery/v9/providers/network/resources"
 )
 
 var Config = plugin.Provider{
@@ -22,11 +23,22 @@ var Config = plugin.Provider{
 		{
 			Name:      "host",
 			Use:       "host HOST",
-			Short:     "a remote host",
+			Short:     "a remote host or a network range in CIDR notation",
 			MinArgs:   1,
 			MaxArgs:   1,
-			Discovery: []string{},
+			Discovery: []string{resources.DiscoveryPorts},
 			Flags: []plugin.Flag{
+				{
+					Long: "ports",
+					Type: plugin.FlagType_List,
+					Desc: "Ports to probe when discovering a network range (default: common service ports).",
+				},
+				{
+					Long:    "concurrency",
+					Type:    plugin.FlagType_Int,
+					Default: "64",
+					Desc:    "Maximum number of parallel connections when discovering a network range.",
+				},
 				{
 					Long:    "insecure",
 					Type:    plugin.FlagType_String,
@@ -4,6 +4,8 @@
 package connection
 
 import (
+	"net/netip"
+
 	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
 )
 
@@ -39,3 +41,13 @@ func (p *HostConnection) FQDN() string {
 	}
 	return p.Conf.Host
 }
+
+// IsRange returns true if the connection targets a network range in CIDR
+// notation, e.g. 10.0.0.0/24, instead of a single host
+func (p *HostConnection) IsRange() bool {
+	if p.Conf == nil {
+		return false
+	}
+	_, err := netip.ParsePrefix(p.Conf.Host)
+	return err == nil
+}
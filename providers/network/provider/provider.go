@@ -5,6 +5,7 @@ package provider
 
 import (
 	"errors"
+	"net/netip"
 	"net/url"
 	"strconv"
 	"strings"
@@ -51,6 +52,10 @@ func Init() *Service {
 
 func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error) {
 	target := req.Args[0]
+	if _, err := netip.ParsePrefix(target); err == nil {
+		return s.parseRange(target, req.Flags)
+	}
+
 	if i := strings.Index(target, "://"); i == -1 {
 		target = "http://" + target
 	}
@@ -84,6 +89,49 @@ func (s *Service) ParseCLI(req *plugin.ParseCLIReq) (*plugin.ParseCLIRes, error)
 	return &plugin.ParseCLIRes{Asset: &asset}, nil
 }
 
+// parseRange creates the asset for a network range in CIDR notation, whose
+// hosts are scanned during discovery
+func (s *Service) parseRange(target string, flags map[string]*llx.Primitive) (*plugin.ParseCLIRes, error) {
+	conf := &inventory.Config{
+		Type:    HostConnectionType,
+		Host:    target,
+		Options: map[string]string{},
+		Discover: &inventory.Discovery{
+			Targets: []string{},
+		},
+	}
+
+	if x, ok := flags["discover"]; ok && len(x.Array) != 0 {
+		for i := range x.Array {
+			conf.Discover.Targets = append(conf.Discover.Targets, string(x.Array[i].Value))
+		}
+	} else {
+		conf.Discover.Targets = []string{resources.DiscoveryAuto}
+	}
+
+	if x, ok := flags["ports"]; ok && len(x.Array) != 0 {
+		ports := make([]string, len(x.Array))
+		for i := range x.Array {
+			ports[i] = string(x.Array[i].Value)
+		}
+		list := strings.Join(ports, ",")
+		if _, err := resources.ParsePorts(list); err != nil {
+			return nil, err
+		}
+		conf.Options[resources.OptionPorts] = list
+	}
+
+	if x, ok := flags["concurrency"]; ok {
+		if concurrency, ok := x.RawData().Value.(int64); ok && concurrency > 0 {
+			conf.Options[resources.OptionConcurrency] = strconv.FormatInt(concurrency, 10)
+		}
+	}
+
+	return &plugin.ParseCLIRes{Asset: &inventory.Asset{
+		Connections: []*inventory.Config{conf},
+	}}, nil
+}
+
 // Shutdown is automatically called when the shell closes.
 // It is not necessary to implement this method.
 // If you want to do some cleanup, you can do it here.
@@ -112,13 +160,16 @@ func (s *Service) Connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 		}
 	}
 
-	// TODO: discovery of related assets and use them in the inventory below
+	in, err := s.discover(conn)
+	if err != nil {
+		return nil, err
+	}
 
 	return &plugin.ConnectRes{
 		Id:        uint32(conn.ID()),
 		Name:      conn.Name(),
 		Asset:     req.Asset,
-		Inventory: nil,
+		Inventory: in,
 	}, nil
 }
 
@@ -171,6 +222,18 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 }
 
 func (s *Service) detect(asset *inventory.Asset, conn *connection.HostConnection) error {
+	if conn.IsRange() {
+		asset.Name = conn.Conf.Host
+		asset.Platform = &inventory.Platform{
+			Name:   "network-range",
+			Family: []string{"network"},
+			Kind:   "network",
+			Title:  "Network Range",
+		}
+		asset.PlatformIds = []string{"//platformid.api.mondoo.app/runtime/network/range/" + conn.Conf.Host}
+		return nil
+	}
+
 	if conn.Conf.Port == 0 {
 		return errors.New("a port for the network connection is required")
 	}
@@ -188,6 +251,20 @@ func (s *Service) detect(asset *inventory.Asset, conn *connection.HostConnection
 	return nil
 }
 
+func (s *Service) discover(conn *connection.HostConnection) (*inventory.Inventory, error) {
+	if conn.Conf.Discover == nil {
+		return nil, nil
+	}
+
+	runtime, ok := s.runtimes[conn.ID()]
+	if !ok {
+		// no connection found, this should never happen
+		return nil, errors.New("connection " + strconv.FormatUint(uint64(conn.ID()), 10) + " not found")
+	}
+
+	return resources.Discover(runtime)
+}
+
 func (s *Service) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
 	runtime, ok := s.runtimes[req.Connection]
 	if !ok {
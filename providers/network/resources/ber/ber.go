@@ -0,0 +1,327 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package ber encodes and decodes the subset of the ASN.1 Basic Encoding
+// Rules (BER) that is used by SNMP and LDAP. Only tags with a number below
+// 31 are supported, which covers both protocols.
+package ber
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Universal tags
+const (
+	TagBoolean     byte = 0x01
+	TagInteger     byte = 0x02
+	TagOctetString byte = 0x04
+	TagNull        byte = 0x05
+	TagOID         byte = 0x06
+	TagEnumerated  byte = 0x0a
+	TagSequence    byte = 0x30
+	TagSet         byte = 0x31
+)
+
+// Classes and the constructed bit of tags
+const (
+	ClassApplication byte = 0x40
+	ClassContext     byte = 0x80
+	Constructed      byte = 0x20
+)
+
+// MaxLength limits the size of elements, which protects against allocating
+// huge buffers for malformed or hostile responses
+var MaxLength = 1 << 20
+
+// Element is a decoded BER element
+type Element struct {
+	Tag   byte
+	Value []byte
+}
+
+// Encode encodes an element with the given tag, its content is the
+// concatenation of all parts
+func Encode(tag byte, parts ...[]byte) []byte {
+	n := 0
+	for i := range parts {
+		n += len(parts[i])
+	}
+
+	res := append([]byte{tag}, encodeLength(n)...)
+	for i := range parts {
+		res = append(res, parts[i]...)
+	}
+	return res
+}
+
+func encodeLength(n int) []byte {
+	if n < 0x80 {
+		return []byte{byte(n)}
+	}
+
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], uint32(n))
+	i := 0
+	for i < 3 && buf[i] == 0 {
+		i++
+	}
+	return append([]byte{0x80 | byte(4-i)}, buf[i:]...)
+}
+
+// Sequence encodes a sequence of already encoded elements
+func Sequence(elems ...[]byte) []byte {
+	return Encode(TagSequence, elems...)
+}
+
+// Int encodes an integer with the given tag
+func Int(tag byte, v int64) []byte {
+	b := big.NewInt(v).Bytes()
+	if v < 0 {
+		// two's complement with the minimal number of bytes
+		b = make([]byte, 8)
+		binary.BigEndian.PutUint64(b, uint64(v))
+		for len(b) > 1 && b[0] == 0xff && b[1]&0x80 != 0 {
+			b = b[1:]
+		}
+	} else if len(b) == 0 || b[0]&0x80 != 0 {
+		b = append([]byte{0}, b...)
+	}
+	return Encode(tag, b)
+}
+
+// Integer encodes a universal integer
+func Integer(v int64) []byte {
+	return Int(TagInteger, v)
+}
+
+// Enumerated encodes a universal enumerated value
+func Enumerated(v int64) []byte {
+	return Int(TagEnumerated, v)
+}
+
+// Boolean encodes a universal boolean
+func Boolean(v bool) []byte {
+	if v {
+		return Encode(TagBoolean, []byte{0xff})
+	}
+	return Encode(TagBoolean, []byte{0})
+}
+
+// OctetString encodes a universal octet string
+func OctetString(v []byte) []byte {
+	return Encode(TagOctetString, v)
+}
+
+// String encodes a string as universal octet string
+func String(v string) []byte {
+	return Encode(TagOctetString, []byte(v))
+}
+
+// Null encodes a universal null
+func Null() []byte {
+	return []byte{TagNull, 0}
+}
+
+// OID encodes an object identifier in dotted notation, e.g. 1.3.6.1.2.1.1.1.0
+func OID(oid string) ([]byte, error) {
+	parts := strings.Split(strings.TrimPrefix(oid, "."), ".")
+	if len(parts) < 2 {
+		return nil, errors.New("invalid OID: " + oid)
+	}
+
+	ids := make([]uint64, len(parts))
+	for i := range parts {
+		id, err := strconv.ParseUint(parts[i], 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid OID: " + oid)
+		}
+		ids[i] = id
+	}
+	if ids[0] > 2 || (ids[0] < 2 && ids[1] > 39) {
+		return nil, errors.New("invalid OID: " + oid)
+	}
+
+	res := encodeBase128(ids[0]*40 + ids[1])
+	for _, id := range ids[2:] {
+		res = append(res, encodeBase128(id)...)
+	}
+	return Encode(TagOID, res), nil
+}
+
+func encodeBase128(v uint64) []byte {
+	res := []byte{byte(v & 0x7f)}
+	for v >>= 7; v != 0; v >>= 7 {
+		res = append([]byte{byte(v&0x7f) | 0x80}, res...)
+	}
+	return res
+}
+
+func readLength(r io.ByteReader) (int, error) {
+	l, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	if l&0x80 == 0 {
+		return int(l), nil
+	}
+
+	n := int(l & 0x7f)
+	if n == 0 || n > 4 {
+		return 0, errors.New("unsupported BER length")
+	}
+	length := 0
+	for i := 0; i < n; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		length = length<<8 | int(b)
+	}
+	if length > MaxLength {
+		return 0, errors.New("BER element is too large")
+	}
+	return length, nil
+}
+
+// Read reads the next element from a stream
+func Read(r *bufio.Reader) (Element, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return Element{}, err
+	}
+	if tag&0x1f == 0x1f {
+		return Element{}, errors.New("unsupported BER tag")
+	}
+
+	length, err := readLength(r)
+	if err != nil {
+		return Element{}, err
+	}
+	value := make([]byte, length)
+	if _, err := io.ReadFull(r, value); err != nil {
+		return Element{}, err
+	}
+	return Element{Tag: tag, Value: value}, nil
+}
+
+// Parse decodes the first element of the data and returns the remaining data
+func Parse(data []byte) (Element, []byte, error) {
+	if len(data) < 2 {
+		return Element{}, nil, errors.New("BER element is truncated")
+	}
+	tag := data[0]
+	if tag&0x1f == 0x1f {
+		return Element{}, nil, errors.New("unsupported BER tag")
+	}
+
+	r := &byteReader{data: data[1:]}
+	length, err := readLength(r)
+	if err != nil {
+		return Element{}, nil, err
+	}
+	rest := r.data
+	if len(rest) < length {
+		return Element{}, nil, errors.New("BER element is truncated")
+	}
+	return Element{Tag: tag, Value: rest[:length]}, rest[length:], nil
+}
+
+type byteReader struct {
+	data []byte
+}
+
+func (b *byteReader) ReadByte() (byte, error) {
+	if len(b.data) == 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	res := b.data[0]
+	b.data = b.data[1:]
+	return res, nil
+}
+
+// Children decodes the content of a constructed element
+func (e Element) Children() ([]Element, error) {
+	res := []Element{}
+	data := e.Value
+	for len(data) != 0 {
+		child, rest, err := Parse(data)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, child)
+		data = rest
+	}
+	return res, nil
+}
+
+// Int decodes the element as signed integer
+func (e Element) Int() (int64, error) {
+	if len(e.Value) == 0 || len(e.Value) > 8 {
+		return 0, errors.New("invalid BER integer")
+	}
+	var res int64
+	if e.Value[0]&0x80 != 0 {
+		res = -1
+	}
+	for _, b := range e.Value {
+		res = res<<8 | int64(b)
+	}
+	return res, nil
+}
+
+// Uint decodes the element as unsigned integer, e.g. SNMP counters
+func (e Element) Uint() (uint64, error) {
+	value := e.Value
+	if len(value) > 1 && value[0] == 0 {
+		value = value[1:]
+	}
+	if len(value) == 0 || len(value) > 8 {
+		return 0, errors.New("invalid BER integer")
+	}
+	var res uint64
+	for _, b := range value {
+		res = res<<8 | uint64(b)
+	}
+	return res, nil
+}
+
+// OID decodes the element as object identifier in dotted notation
+func (e Element) OID() (string, error) {
+	if len(e.Value) == 0 {
+		return "", errors.New("invalid BER object identifier")
+	}
+
+	ids := []uint64{}
+	var cur uint64
+	for i, b := range e.Value {
+		cur = cur<<7 | uint64(b&0x7f)
+		if b&0x80 != 0 {
+			if i == len(e.Value)-1 {
+				return "", errors.New("invalid BER object identifier")
+			}
+			continue
+		}
+		ids = append(ids, cur)
+		cur = 0
+	}
+
+	var res strings.Builder
+	switch {
+	case ids[0] < 40:
+		res.WriteString("0." + strconv.FormatUint(ids[0], 10))
+	case ids[0] < 80:
+		res.WriteString("1." + strconv.FormatUint(ids[0]-40, 10))
+	default:
+		res.WriteString("2." + strconv.FormatUint(ids[0]-80, 10))
+	}
+	for _, id := range ids[1:] {
+		res.WriteString("." + strconv.FormatUint(id, 10))
+	}
+	return res.String(), nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ber
+
+import (
+	"bufio"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInteger(t *testing.T) {
+	tests := map[int64][]byte{
+		0:          {0x02, 0x01, 0x00},
+		127:        {0x02, 0x01, 0x7f},
+		128:        {0x02, 0x02, 0x00, 0x80},
+		256:        {0x02, 0x02, 0x01, 0x00},
+		-1:         {0x02, 0x01, 0xff},
+		-128:       {0x02, 0x01, 0x80},
+		-129:       {0x02, 0x02, 0xff, 0x7f},
+		2147483647: {0x02, 0x04, 0x7f, 0xff, 0xff, 0xff},
+	}
+	for v, expected := range tests {
+		encoded := Integer(v)
+		assert.Equal(t, expected, encoded, v)
+
+		elem, rest, err := Parse(encoded)
+		require.NoError(t, err)
+		assert.Empty(t, rest)
+		decoded, err := elem.Int()
+		require.NoError(t, err)
+		assert.Equal(t, v, decoded)
+	}
+}
+
+func TestUint(t *testing.T) {
+	elem, _, err := Parse([]byte{0x46, 0x09, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+	require.NoError(t, err)
+	v, err := elem.Uint()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0xffffffffffffffff), v)
+}
+
+func TestOID(t *testing.T) {
+	for _, oid := range []string{"1.3.6.1.2.1.1.1.0", "1.3.6.1.4.1.1466.20037", "2.999.3", "0.9.2342"} {
+		encoded, err := OID(oid)
+		require.NoError(t, err)
+		elem, _, err := Parse(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, TagOID, elem.Tag)
+		decoded, err := elem.OID()
+		require.NoError(t, err)
+		assert.Equal(t, oid, decoded)
+	}
+
+	encoded, err := OID("1.3.6.1.2.1.1.1.0")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x06, 0x08, 0x2b, 0x06, 0x01, 0x02, 0x01, 0x01, 0x01, 0x00}, encoded)
+
+	_, err = OID("1")
+	assert.Error(t, err)
+	_, err = OID("1.3.x")
+	assert.Error(t, err)
+}
+
+func TestSequence(t *testing.T) {
+	long := bytes.Repeat([]byte{'a'}, 300)
+	encoded := Sequence(Integer(1), OctetString(long), Null(), Boolean(true))
+	assert.Equal(t, []byte{0x30, 0x82, 0x01, 0x38}, encoded[:4])
+
+	elem, err := Read(bufio.NewReader(bytes.NewReader(encoded)))
+	require.NoError(t, err)
+	assert.Equal(t, TagSequence, elem.Tag)
+
+	children, err := elem.Children()
+	require.NoError(t, err)
+	require.Len(t, children, 4)
+	assert.Equal(t, long, children[1].Value)
+	assert.Equal(t, TagNull, children[2].Tag)
+	assert.Equal(t, []byte{0xff}, children[3].Value)
+
+	_, _, err = Parse(encoded[:10])
+	assert.Error(t, err)
+}
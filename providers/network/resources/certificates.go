@@ -386,6 +386,30 @@ func (s *mqlCertificate) isVerified() (bool, error) {
 	return false, nil
 }
 
+func (s *mqlCertificate) publicKeyAlgorithm() (string, error) {
+	if err := s.getGoCert(); err != nil {
+		return "", err
+	}
+	algorithm, _ := certificates.PublicKeyInfo(s.cert.Data)
+	return algorithm, nil
+}
+
+func (s *mqlCertificate) publicKeyBits() (int64, error) {
+	if err := s.getGoCert(); err != nil {
+		return 0, err
+	}
+	_, bits := certificates.PublicKeyInfo(s.cert.Data)
+	return int64(bits), nil
+}
+
+func (s *mqlCertificate) chain() ([]interface{}, error) {
+	if err := s.getGoCert(); err != nil {
+		return nil, err
+	}
+	chain := certificates.BuildChain(s.cert.Data, nil, certificates.HttpFetchIssuer)
+	return CertificatesToMqlCertificates(s.MqlRuntime, chain)
+}
+
 func (r *mqlPkixName) id() (string, error) {
 	return r.Id.Data, nil
 }
@@ -0,0 +1,117 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package certificates
+
+import (
+	"bytes"
+	"crypto/dsa"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+// maxChainLength limits how many issuers are retrieved for a chain
+const maxChainLength = 8
+
+// maxIssuerSize limits the size of retrieved issuer certificates
+const maxIssuerSize = 1 << 20
+
+// PublicKeyInfo returns the algorithm and size in bits of the certificate's public key
+func PublicKeyInfo(cert *x509.Certificate) (string, int) {
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return "RSA", key.N.BitLen()
+	case *ecdsa.PublicKey:
+		return "ECDSA", key.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		return "Ed25519", 256
+	case *dsa.PublicKey:
+		return "DSA", key.P.BitLen()
+	default:
+		return cert.PublicKeyAlgorithm.String(), 0
+	}
+}
+
+// FetchIssuer retrieves a certificate from an issuing certificate URL
+type FetchIssuer func(url string) (*x509.Certificate, error)
+
+// HttpFetchIssuer retrieves DER- or PEM-encoded issuer certificates via HTTP
+func HttpFetchIssuer(url string) (*x509.Certificate, error) {
+	client := &http.Client{Timeout: time.Duration(TimeoutSeconds) * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("failed to fetch issuer certificate from " + url + ": " + resp.Status)
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxIssuerSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if certs, err := ParseCertsFromPEM(bytes.NewReader(raw)); err == nil && len(certs) != 0 {
+		return certs[0], nil
+	}
+	return x509.ParseCertificate(raw)
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignatureFrom(cert) == nil
+}
+
+// BuildChain builds the chain from the certificate up to one of the roots,
+// which defaults to the system roots. Intermediate certificates are
+// retrieved from the issuing certificate URLs (AIA). If no trusted chain
+// is found, the certificate and all retrieved issuers are returned.
+func BuildChain(cert *x509.Certificate, roots *x509.CertPool, fetch FetchIssuer) []*x509.Certificate {
+	pool := x509.NewCertPool()
+
+	// chains are built for expired certificates as well
+	now := time.Now()
+	if now.After(cert.NotAfter) {
+		now = cert.NotAfter
+	}
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: pool,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+
+	if chains, err := cert.Verify(opts); err == nil {
+		return chains[0]
+	}
+
+	res := []*x509.Certificate{cert}
+	current := cert
+	for len(res) < maxChainLength && !isSelfSigned(current) && fetch != nil {
+		var issuer *x509.Certificate
+		for _, url := range current.IssuingCertificateURL {
+			if c, err := fetch(url); err == nil && current.CheckSignatureFrom(c) == nil {
+				issuer = c
+				break
+			}
+		}
+		if issuer == nil {
+			break
+		}
+
+		pool.AddCert(issuer)
+		if chains, err := cert.Verify(opts); err == nil {
+			return chains[0]
+		}
+		res = append(res, issuer)
+		current = issuer
+	}
+	return res
+}
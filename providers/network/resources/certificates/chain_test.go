@@ -0,0 +1,97 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package certificates
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newChainCert(t *testing.T, name string, issuer *testCA, issuerURL string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	if issuerURL != "" {
+		tmpl.IssuingCertificateURL = []string{issuerURL}
+	}
+
+	parent, signer := tmpl, key
+	if issuer != nil {
+		parent, signer = issuer.cert, issuer.key
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, signer)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+func TestBuildChain(t *testing.T) {
+	root := newChainCert(t, "Root", nil, "")
+	intermediate := newChainCert(t, "Intermediate", root, "http://ca.example.com/root.crt")
+	leaf := newChainCert(t, "example.com", intermediate, "http://ca.example.com/intermediate.crt")
+
+	fetches := 0
+	fetch := func(url string) (*x509.Certificate, error) {
+		fetches++
+		switch url {
+		case "http://ca.example.com/intermediate.crt":
+			return intermediate.cert, nil
+		case "http://ca.example.com/root.crt":
+			return root.cert, nil
+		}
+		return nil, errors.New("not found")
+	}
+	names := func(chain []*x509.Certificate) []string {
+		res := make([]string, len(chain))
+		for i := range chain {
+			res[i] = chain[i].Subject.CommonName
+		}
+		return res
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root.cert)
+	chain := BuildChain(leaf.cert, roots, fetch)
+	assert.Equal(t, []string{"example.com", "Intermediate", "Root"}, names(chain))
+	assert.Equal(t, 1, fetches)
+
+	// untrusted chains end at the last issuer that was found
+	chain = BuildChain(leaf.cert, x509.NewCertPool(), fetch)
+	assert.Equal(t, []string{"example.com", "Intermediate", "Root"}, names(chain))
+
+	chain = BuildChain(leaf.cert, x509.NewCertPool(), nil)
+	assert.Equal(t, []string{"example.com"}, names(chain))
+}
+
+func TestPublicKeyInfo(t *testing.T) {
+	cert := newChainCert(t, "example.com", nil, "")
+	algorithm, bits := PublicKeyInfo(cert.cert)
+	assert.Equal(t, "ECDSA", algorithm)
+	assert.Equal(t, 256, bits)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/network/connection"
+	"go.mondoo.com/cnquery/v9/providers/network/resources/portscan"
+	"go.mondoo.com/cnquery/v9/utils/stringx"
+)
+
+// Discovery Flags
+const (
+	DiscoveryAll  = "all"
+	DiscoveryAuto = "auto"
+
+	// DiscoveryPorts scans all hosts of a network range for open ports
+	DiscoveryPorts = "ports"
+)
+
+// Connection options of network ranges
+const (
+	OptionPorts       = "ports"
+	OptionConcurrency = "concurrency"
+)
+
+// serviceFamilies are the platform families of discovered services, which
+// allows query packs to filter for them
+var serviceFamilies = map[string][]string{
+	portscan.ServiceHTTPS: {"network", "tls", "http"},
+	portscan.ServiceHTTP:  {"network", "http"},
+	portscan.ServiceSSH:   {"network", "ssh"},
+	portscan.ServiceTLS:   {"network", "tls"},
+	portscan.ServiceTCP:   {"network"},
+}
+
+// ParsePorts parses a comma-separated list of ports
+func ParsePorts(list string) ([]int, error) {
+	res := []int{}
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		port, err := strconv.ParseUint(entry, 10, 16)
+		if err != nil || port == 0 {
+			return nil, errors.New("invalid port: " + entry)
+		}
+		res = append(res, int(port))
+	}
+	return res, nil
+}
+
+func Discover(runtime *plugin.Runtime) (*inventory.Inventory, error) {
+	conn := runtime.Connection.(*connection.HostConnection)
+	if conn.Conf.Discover == nil || !conn.IsRange() {
+		return nil, nil
+	}
+
+	targets := conn.Conf.Discover.Targets
+	if len(targets) != 0 && !stringx.ContainsAnyOf(targets, DiscoveryAll, DiscoveryAuto, DiscoveryPorts) {
+		return nil, nil
+	}
+
+	prefix, err := netip.ParsePrefix(conn.Conf.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	ports, err := ParsePorts(conn.Conf.Options[OptionPorts])
+	if err != nil {
+		return nil, err
+	}
+	concurrency, _ := strconv.Atoi(conn.Conf.Options[OptionConcurrency])
+
+	scanner := portscan.New(ports, concurrency)
+	log.Debug().Str("range", prefix.String()).Ints("ports", scanner.Ports).Msg("network> scan for open ports")
+	services, err := scanner.Scan(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &inventory.Inventory{Spec: &inventory.InventorySpec{
+		Assets: make([]*inventory.Asset, len(services)),
+	}}
+	for i := range services {
+		in.Spec.Assets[i] = serviceAsset(conn, services[i])
+	}
+	return in, nil
+}
+
+func serviceAsset(conn *connection.HostConnection, service portscan.Service) *inventory.Asset {
+	host := service.Host.String()
+	port := strconv.Itoa(service.Port)
+
+	return &inventory.Asset{
+		Name: service.Address(),
+		Platform: &inventory.Platform{
+			Name:    "host",
+			Family:  serviceFamilies[service.Service],
+			Kind:    "network",
+			Runtime: service.Service,
+			Title:   "Network API",
+		},
+		PlatformIds: []string{"//platformid.api.mondoo.app/runtime/network/host/" + host + "/port/" + port},
+		Labels: map[string]string{
+			"network/range":   conn.Conf.Host,
+			"network/service": service.Service,
+			"network/port":    port,
+		},
+		Connections: []*inventory.Config{{
+			Type:        conn.Conf.Type,
+			Host:        host,
+			Port:        int32(service.Port),
+			Runtime:     service.Service,
+			Insecure:    conn.Conf.Insecure,
+			Credentials: conn.Conf.Credentials,
+		}},
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/network/connection"
+)
+
+func TestParsePorts(t *testing.T) {
+	ports, err := ParsePorts("22, 443,8443")
+	require.NoError(t, err)
+	assert.Equal(t, []int{22, 443, 8443}, ports)
+
+	ports, err = ParsePorts("")
+	require.NoError(t, err)
+	assert.Empty(t, ports)
+
+	_, err = ParsePorts("22,ssh")
+	assert.Error(t, err)
+	_, err = ParsePorts("70000")
+	assert.Error(t, err)
+}
+
+func TestDiscover(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conn.Write([]byte("SSH-2.0-OpenSSH_9.6\r\n"))
+			conn.Close()
+		}
+	}()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	conf := &inventory.Config{
+		Type:     "host",
+		Host:     "127.0.0.1/32",
+		Options:  map[string]string{OptionPorts: strconv.Itoa(port)},
+		Discover: &inventory.Discovery{Targets: []string{DiscoveryAuto}},
+	}
+	runtime := &plugin.Runtime{Connection: connection.NewHostConnection(1, &inventory.Asset{}, conf)}
+
+	in, err := Discover(runtime)
+	require.NoError(t, err)
+	require.Len(t, in.Spec.Assets, 1)
+	asset := in.Spec.Assets[0]
+	assert.Equal(t, "127.0.0.1:"+strconv.Itoa(port), asset.Name)
+	assert.Equal(t, []string{"network", "ssh"}, asset.Platform.Family)
+	assert.Equal(t, "ssh", asset.Platform.Runtime)
+	require.Len(t, asset.Connections, 1)
+	assert.Equal(t, "127.0.0.1", asset.Connections[0].Host)
+	assert.Equal(t, int32(port), asset.Connections[0].Port)
+	assert.Equal(t, "ssh", asset.Connections[0].Runtime)
+
+	// single hosts aren't discovered
+	conf.Host = "127.0.0.1"
+	in, err = Discover(runtime)
+	require.NoError(t, err)
+	assert.Nil(t, in)
+}
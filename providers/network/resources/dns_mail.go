@@ -0,0 +1,595 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/network/connection"
+	"go.mondoo.com/cnquery/v9/providers/network/resources/dnsshake"
+	"go.mondoo.com/cnquery/v9/providers/network/resources/domain"
+)
+
+const (
+	// mtaStsTimeout limits the time to fetch MTA-STS policies
+	mtaStsTimeout = 10 * time.Second
+	// maxMtaStsPolicySize limits the size of MTA-STS policy files
+	maxMtaStsPolicySize = 64 << 10
+)
+
+// initDnsDomain sets the domain of DNS policy resources to the FQDN of the
+// asset, unless it is given
+func initDnsDomain(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if raw, ok := args["domain"]; ok && raw.Value != nil {
+		args["domain"] = llx.StringData(strings.TrimSuffix(raw.Value.(string), "."))
+		return args, nil, nil
+	}
+
+	conn := runtime.Connection.(*connection.HostConnection)
+	fqdn := strings.TrimSuffix(conn.FQDN(), ".")
+	if net.ParseIP(fqdn) != nil {
+		fqdn = ""
+	}
+	args["domain"] = llx.StringData(fqdn)
+	return args, nil, nil
+}
+
+func secondsToTime(secs int64) *time.Time {
+	res := llx.DurationToTime(secs)
+	return &res
+}
+
+func newDnsClient(domain string) (*dnsshake.DnsClient, error) {
+	if domain == "" {
+		return nil, errors.New("no domain to look up, please provide a domain")
+	}
+	return dnsshake.New(domain)
+}
+
+func (d *mqlDns) newPolicy(resource string) (plugin.Resource, error) {
+	return NewResource(d.MqlRuntime, resource, map[string]*llx.RawData{
+		"domain": llx.StringData(d.Fqdn.Data),
+	})
+}
+
+func (d *mqlDns) spf() (*mqlDnsSpf, error) {
+	res, err := d.newPolicy("dns.spf")
+	if err != nil {
+		return nil, err
+	}
+	return res.(*mqlDnsSpf), nil
+}
+
+func (d *mqlDns) dmarc() (*mqlDnsDmarc, error) {
+	res, err := d.newPolicy("dns.dmarc")
+	if err != nil {
+		return nil, err
+	}
+	return res.(*mqlDnsDmarc), nil
+}
+
+func (d *mqlDns) mtaSts() (*mqlDnsMtaSts, error) {
+	res, err := d.newPolicy("dns.mtaSts")
+	if err != nil {
+		return nil, err
+	}
+	return res.(*mqlDnsMtaSts), nil
+}
+
+func (d *mqlDns) bimi() (*mqlDnsBimi, error) {
+	res, err := d.newPolicy("dns.bimi")
+	if err != nil {
+		return nil, err
+	}
+	return res.(*mqlDnsBimi), nil
+}
+
+func (d *mqlDns) caa() (*mqlDnsCaa, error) {
+	res, err := d.newPolicy("dns.caa")
+	if err != nil {
+		return nil, err
+	}
+	return res.(*mqlDnsCaa), nil
+}
+
+func (d *mqlDns) dnssec() (*mqlDnsDnssec, error) {
+	res, err := d.newPolicy("dns.dnssec")
+	if err != nil {
+		return nil, err
+	}
+	return res.(*mqlDnsDnssec), nil
+}
+
+// SPF
+
+type mqlDnsSpfInternal struct {
+	lock   sync.Mutex
+	policy plugin.TValue[*dnsshake.SpfPolicy]
+}
+
+func initDnsSpf(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initDnsDomain(runtime, args)
+}
+
+func (d *mqlDnsSpf) id() (string, error) {
+	return "dns.spf/" + d.Domain.Data, nil
+}
+
+func (d *mqlDnsSpf) fetch() (*dnsshake.SpfPolicy, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.policy.State&plugin.StateIsSet != 0 {
+		return d.policy.Data, d.policy.Error
+	}
+	d.policy.State = plugin.StateIsSet
+
+	client, err := newDnsClient(d.Domain.Data)
+	if err != nil {
+		d.policy.Error = err
+		return nil, err
+	}
+	d.policy.Data, d.policy.Error = client.LookupSpf(d.Domain.Data)
+	return d.policy.Data, d.policy.Error
+}
+
+func (d *mqlDnsSpf) record() (string, error) {
+	policy, err := d.fetch()
+	if err != nil {
+		return "", err
+	}
+	return policy.Record, nil
+}
+
+func (d *mqlDnsSpf) mechanisms() ([]interface{}, error) {
+	policy, err := d.fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []interface{}{}
+	if policy.Parsed == nil {
+		return res, nil
+	}
+	for _, directive := range policy.Parsed.Directives {
+		qualifier := directive.Qualifier
+		if qualifier == "" {
+			qualifier = "+"
+		}
+		res = append(res, map[string]interface{}{
+			"qualifier": qualifier,
+			"result":    dnsshake.SpfResult(qualifier),
+			"mechanism": directive.Mechanism,
+			"value":     directive.Value,
+			"cidr":      directive.CIDR,
+		})
+	}
+	return res, nil
+}
+
+func (d *mqlDnsSpf) modifiers() (map[string]interface{}, error) {
+	policy, err := d.fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]interface{}{}
+	if policy.Parsed == nil {
+		return res, nil
+	}
+	for _, modifier := range policy.Parsed.Modifiers {
+		res[modifier.Modifier] = modifier.Value
+	}
+	return res, nil
+}
+
+func (d *mqlDnsSpf) includes() ([]interface{}, error) {
+	policy, err := d.fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(policy.Includes))
+	for i, include := range policy.Includes {
+		o, err := CreateResource(d.MqlRuntime, "dns.spf", map[string]*llx.RawData{
+			"domain": llx.StringData(include.Domain),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		spf := o.(*mqlDnsSpf)
+		spf.lock.Lock()
+		if spf.policy.State&plugin.StateIsSet == 0 {
+			spf.policy = plugin.TValue[*dnsshake.SpfPolicy]{Data: include, State: plugin.StateIsSet}
+		}
+		spf.lock.Unlock()
+		res[i] = spf
+	}
+	return res, nil
+}
+
+func (d *mqlDnsSpf) lookups() (int64, error) {
+	policy, err := d.fetch()
+	if err != nil {
+		return 0, err
+	}
+	return int64(policy.Lookups), nil
+}
+
+func (d *mqlDnsSpf) defaultResult() (string, error) {
+	policy, err := d.fetch()
+	if err != nil {
+		return "", err
+	}
+	return policy.DefaultResult(), nil
+}
+
+func (d *mqlDnsSpf) valid() (bool, error) {
+	policy, err := d.fetch()
+	if err != nil {
+		return false, err
+	}
+	return policy.Valid(), nil
+}
+
+func (d *mqlDnsSpf) errors() ([]interface{}, error) {
+	policy, err := d.fetch()
+	if err != nil {
+		return nil, err
+	}
+	return llx.TArr2Raw(policy.Errors), nil
+}
+
+// DMARC
+
+type mqlDnsDmarcInternal struct {
+	lock    sync.Mutex
+	fetched bool
+	errs    []string
+}
+
+func initDnsDmarc(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initDnsDomain(runtime, args)
+}
+
+func (d *mqlDnsDmarc) id() (string, error) {
+	return "dns.dmarc/" + d.Domain.Data, nil
+}
+
+// fetch looks up the DMARC record and sets all fields of the resource
+func (d *mqlDnsDmarc) fetch() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.fetched {
+		return nil
+	}
+
+	client, err := newDnsClient(d.Domain.Data)
+	if err != nil {
+		return err
+	}
+	orgDomain := ""
+	if dn, err := domain.Parse(d.Domain.Data); err == nil {
+		orgDomain = dn.EffectiveTLDPlusOne
+	}
+
+	recordDomain, record, err := client.LookupDmarc(d.Domain.Data, orgDomain)
+	var parsed *dnsshake.DmarcRecord
+	d.errs = []string{}
+	switch {
+	case err != nil && recordDomain == "":
+		return err
+	case err != nil:
+		d.errs = append(d.errs, err.Error())
+	case record == "":
+		d.errs = append(d.errs, "no DMARC record found")
+	default:
+		parsed, err = dnsshake.NewDmarcRecord(record)
+		if err != nil {
+			d.errs = append(d.errs, "failed to parse DMARC record: "+err.Error())
+		} else {
+			_, errs := parsed.Valid()
+			d.errs = append(d.errs, errs...)
+		}
+	}
+	if parsed == nil {
+		parsed = &dnsshake.DmarcRecord{AggregateReports: []string{}, FailureReports: []string{}, FailureOptions: []string{}}
+	}
+
+	d.RecordDomain = plugin.TValue[string]{Data: recordDomain, State: plugin.StateIsSet}
+	d.Record = plugin.TValue[string]{Data: record, State: plugin.StateIsSet}
+	d.Version = plugin.TValue[string]{Data: parsed.Version, State: plugin.StateIsSet}
+	d.Policy = plugin.TValue[string]{Data: parsed.Policy, State: plugin.StateIsSet}
+	d.SubdomainPolicy = plugin.TValue[string]{Data: parsed.SubdomainPolicy, State: plugin.StateIsSet}
+	d.Percentage = plugin.TValue[int64]{Data: parsed.Percentage, State: plugin.StateIsSet}
+	d.AggregateReports = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(parsed.AggregateReports), State: plugin.StateIsSet}
+	d.FailureReports = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(parsed.FailureReports), State: plugin.StateIsSet}
+	d.DkimAlignment = plugin.TValue[string]{Data: parsed.DkimAlignment, State: plugin.StateIsSet}
+	d.SpfAlignment = plugin.TValue[string]{Data: parsed.SpfAlignment, State: plugin.StateIsSet}
+	d.FailureOptions = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(parsed.FailureOptions), State: plugin.StateIsSet}
+	d.ReportInterval = plugin.TValue[*time.Time]{Data: secondsToTime(parsed.ReportInterval), State: plugin.StateIsSet}
+	d.fetched = true
+	return nil
+}
+
+func (d *mqlDnsDmarc) recordDomain() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsDmarc) record() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsDmarc) version() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsDmarc) policy() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsDmarc) subdomainPolicy() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsDmarc) percentage() (int64, error) {
+	return 0, d.fetch()
+}
+
+func (d *mqlDnsDmarc) aggregateReports() ([]interface{}, error) {
+	return nil, d.fetch()
+}
+
+func (d *mqlDnsDmarc) failureReports() ([]interface{}, error) {
+	return nil, d.fetch()
+}
+
+func (d *mqlDnsDmarc) dkimAlignment() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsDmarc) spfAlignment() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsDmarc) failureOptions() ([]interface{}, error) {
+	return nil, d.fetch()
+}
+
+func (d *mqlDnsDmarc) reportInterval() (*time.Time, error) {
+	return nil, d.fetch()
+}
+
+func (d *mqlDnsDmarc) valid() (bool, error) {
+	if err := d.fetch(); err != nil {
+		return false, err
+	}
+	return len(d.errs) == 0, nil
+}
+
+func (d *mqlDnsDmarc) errors() ([]interface{}, error) {
+	if err := d.fetch(); err != nil {
+		return nil, err
+	}
+	return llx.TArr2Raw(d.errs), nil
+}
+
+// MTA-STS
+
+type mqlDnsMtaStsInternal struct {
+	lock    sync.Mutex
+	fetched bool
+	errs    []string
+}
+
+func initDnsMtaSts(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initDnsDomain(runtime, args)
+}
+
+func (d *mqlDnsMtaSts) id() (string, error) {
+	return "dns.mtaSts/" + d.Domain.Data, nil
+}
+
+// fetchMtaStsPolicy downloads the policy file. As required by RFC 8461, the
+// certificate must be valid and redirects are not followed.
+func fetchMtaStsPolicy(domain string) (string, error) {
+	client := &http.Client{
+		Timeout: mtaStsTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Get(dnsshake.MtaStsPolicyUrl(domain))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.New("policy request returned status " + resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxMtaStsPolicySize))
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// fetch looks up the MTA-STS record and policy and sets all fields of the
+// resource
+func (d *mqlDnsMtaSts) fetch() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.fetched {
+		return nil
+	}
+
+	client, err := newDnsClient(d.Domain.Data)
+	if err != nil {
+		return err
+	}
+	txts, err := client.LookupTXT("_mta-sts." + d.Domain.Data)
+	if err != nil {
+		return err
+	}
+
+	var record string
+	for i := range txts {
+		if strings.HasPrefix(txts[i], "v=STSv") {
+			record = txts[i]
+			break
+		}
+	}
+
+	d.errs = []string{}
+	var policyId, policy string
+	parsed := &dnsshake.MtaStsPolicy{Mx: []string{}}
+	if record == "" {
+		d.errs = append(d.errs, "no MTA-STS record found")
+	} else if r, err := dnsshake.NewMtaStsRecord(record); err != nil {
+		d.errs = append(d.errs, "failed to parse MTA-STS record: "+err.Error())
+	} else {
+		policyId = r.Id
+		if policy, err = fetchMtaStsPolicy(d.Domain.Data); err != nil {
+			d.errs = append(d.errs, "failed to fetch MTA-STS policy: "+err.Error())
+		} else if p, err := dnsshake.NewMtaStsPolicy(policy); err != nil {
+			d.errs = append(d.errs, "failed to parse MTA-STS policy: "+err.Error())
+		} else {
+			parsed = p
+		}
+	}
+
+	d.Record = plugin.TValue[string]{Data: record, State: plugin.StateIsSet}
+	d.PolicyId = plugin.TValue[string]{Data: policyId, State: plugin.StateIsSet}
+	d.Policy = plugin.TValue[string]{Data: policy, State: plugin.StateIsSet}
+	d.Mode = plugin.TValue[string]{Data: parsed.Mode, State: plugin.StateIsSet}
+	d.Mx = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(parsed.Mx), State: plugin.StateIsSet}
+	d.MaxAge = plugin.TValue[*time.Time]{Data: secondsToTime(parsed.MaxAge), State: plugin.StateIsSet}
+	d.fetched = true
+	return nil
+}
+
+func (d *mqlDnsMtaSts) record() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsMtaSts) policyId() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsMtaSts) policy() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsMtaSts) mode() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsMtaSts) mx() ([]interface{}, error) {
+	return nil, d.fetch()
+}
+
+func (d *mqlDnsMtaSts) maxAge() (*time.Time, error) {
+	return nil, d.fetch()
+}
+
+func (d *mqlDnsMtaSts) valid() (bool, error) {
+	if err := d.fetch(); err != nil {
+		return false, err
+	}
+	return len(d.errs) == 0, nil
+}
+
+func (d *mqlDnsMtaSts) errors() ([]interface{}, error) {
+	if err := d.fetch(); err != nil {
+		return nil, err
+	}
+	return llx.TArr2Raw(d.errs), nil
+}
+
+// BIMI
+
+type mqlDnsBimiInternal struct {
+	lock    sync.Mutex
+	fetched bool
+}
+
+func initDnsBimi(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initDnsDomain(runtime, args)
+}
+
+func (d *mqlDnsBimi) id() (string, error) {
+	return "dns.bimi/" + d.Domain.Data, nil
+}
+
+// fetch looks up the BIMI record of the default selector and sets all
+// fields of the resource
+func (d *mqlDnsBimi) fetch() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.fetched {
+		return nil
+	}
+
+	client, err := newDnsClient(d.Domain.Data)
+	if err != nil {
+		return err
+	}
+	txts, err := client.LookupTXT("default._bimi." + d.Domain.Data)
+	if err != nil {
+		return err
+	}
+
+	var record string
+	for i := range txts {
+		if strings.HasPrefix(txts[i], "v=BIMI") {
+			record = txts[i]
+			break
+		}
+	}
+
+	parsed := &dnsshake.BimiRecord{}
+	if record != "" {
+		if parsed, err = dnsshake.NewBimiRecord(record); err != nil {
+			return errors.New("failed to parse BIMI record: " + err.Error())
+		}
+	}
+
+	d.Record = plugin.TValue[string]{Data: record, State: plugin.StateIsSet}
+	d.Version = plugin.TValue[string]{Data: parsed.Version, State: plugin.StateIsSet}
+	d.Logo = plugin.TValue[string]{Data: parsed.Logo, State: plugin.StateIsSet}
+	d.Authority = plugin.TValue[string]{Data: parsed.Authority, State: plugin.StateIsSet}
+	d.fetched = true
+	return nil
+}
+
+func (d *mqlDnsBimi) record() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsBimi) version() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsBimi) logo() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsBimi) authority() (string, error) {
+	return "", d.fetch()
+}
@@ -0,0 +1,214 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/network/resources/dnsshake"
+)
+
+// CAA
+
+type mqlDnsCaaInternal struct {
+	lock    sync.Mutex
+	fetched bool
+}
+
+func initDnsCaa(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initDnsDomain(runtime, args)
+}
+
+func (d *mqlDnsCaa) id() (string, error) {
+	return "dns.caa/" + d.Domain.Data, nil
+}
+
+// caaIssuer returns the domain of the CA of issue and issuewild values,
+// see https://datatracker.ietf.org/doc/html/rfc8659#section-4.2
+func caaIssuer(value string) string {
+	issuer, _, _ := strings.Cut(value, ";")
+	return strings.TrimSpace(issuer)
+}
+
+// fetch looks up the CAA records and sets all fields of the resource
+func (d *mqlDnsCaa) fetch() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.fetched {
+		return nil
+	}
+
+	client, err := newDnsClient(d.Domain.Data)
+	if err != nil {
+		return err
+	}
+	name, records, err := client.LookupCaa(d.Domain.Data)
+	if err != nil {
+		return err
+	}
+
+	list := make([]interface{}, len(records))
+	issuers := []interface{}{}
+	iodef := []interface{}{}
+	var wildcardIssuers []interface{}
+	for i, record := range records {
+		o, err := CreateResource(d.MqlRuntime, "dns.caaRecord", map[string]*llx.RawData{
+			"__id":     llx.StringData(d.__id + "/" + strconv.Itoa(i)),
+			"name":     llx.StringData(record.Name),
+			"flag":     llx.IntData(int64(record.Flag)),
+			"tag":      llx.StringData(record.Tag),
+			"value":    llx.StringData(record.Value),
+			"critical": llx.BoolData(record.Critical()),
+		})
+		if err != nil {
+			return err
+		}
+		list[i] = o
+
+		switch record.Tag {
+		case "issue":
+			if issuer := caaIssuer(record.Value); issuer != "" {
+				issuers = append(issuers, issuer)
+			}
+		case "issuewild":
+			if wildcardIssuers == nil {
+				wildcardIssuers = []interface{}{}
+			}
+			if issuer := caaIssuer(record.Value); issuer != "" {
+				wildcardIssuers = append(wildcardIssuers, issuer)
+			}
+		case "iodef":
+			iodef = append(iodef, record.Value)
+		}
+	}
+	// without issuewild records, issue records also apply to wildcards
+	if wildcardIssuers == nil {
+		wildcardIssuers = issuers
+	}
+
+	d.RecordDomain = plugin.TValue[string]{Data: name, State: plugin.StateIsSet}
+	d.Issuers = plugin.TValue[[]interface{}]{Data: issuers, State: plugin.StateIsSet}
+	d.WildcardIssuers = plugin.TValue[[]interface{}]{Data: wildcardIssuers, State: plugin.StateIsSet}
+	d.Iodef = plugin.TValue[[]interface{}]{Data: iodef, State: plugin.StateIsSet}
+	d.List = plugin.TValue[[]interface{}]{Data: list, State: plugin.StateIsSet}
+	d.fetched = true
+	return nil
+}
+
+func (d *mqlDnsCaa) list() ([]interface{}, error) {
+	return nil, d.fetch()
+}
+
+func (d *mqlDnsCaa) recordDomain() (string, error) {
+	return "", d.fetch()
+}
+
+func (d *mqlDnsCaa) issuers() ([]interface{}, error) {
+	return nil, d.fetch()
+}
+
+func (d *mqlDnsCaa) wildcardIssuers() ([]interface{}, error) {
+	return nil, d.fetch()
+}
+
+func (d *mqlDnsCaa) iodef() ([]interface{}, error) {
+	return nil, d.fetch()
+}
+
+func (d *mqlDnsCaaRecord) id() (string, error) {
+	return "dns.caaRecord/" + d.Name.Data + "/" + d.Tag.Data + "/" + d.Value.Data, nil
+}
+
+// DNSSEC
+
+type mqlDnsDnssecInternal struct {
+	lock   sync.Mutex
+	result plugin.TValue[*dnsshake.DnssecResult]
+}
+
+func initDnsDnssec(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	return initDnsDomain(runtime, args)
+}
+
+func (d *mqlDnsDnssec) id() (string, error) {
+	return "dns.dnssec/" + d.Domain.Data, nil
+}
+
+func (d *mqlDnsDnssec) validate() (*dnsshake.DnssecResult, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.result.State&plugin.StateIsSet != 0 {
+		return d.result.Data, d.result.Error
+	}
+	d.result.State = plugin.StateIsSet
+
+	client, err := newDnsClient(d.Domain.Data)
+	if err != nil {
+		d.result.Error = err
+		return nil, err
+	}
+	d.result.Data, d.result.Error = client.ValidateDnssec(d.Domain.Data)
+	return d.result.Data, d.result.Error
+}
+
+func (d *mqlDnsDnssec) status() (string, error) {
+	res, err := d.validate()
+	if err != nil {
+		return "", err
+	}
+	return res.Status, nil
+}
+
+func (d *mqlDnsDnssec) signed() (bool, error) {
+	res, err := d.validate()
+	if err != nil {
+		return false, err
+	}
+	return res.Signed(), nil
+}
+
+func (d *mqlDnsDnssec) zones() ([]interface{}, error) {
+	res, err := d.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	zones := make([]interface{}, len(res.Zones))
+	for i, zone := range res.Zones {
+		zones[i] = map[string]interface{}{
+			"zone":       zone.Zone,
+			"signed":     zone.Signed,
+			"delegated":  zone.Delegated,
+			"status":     zone.Status,
+			"algorithms": llx.TArr2Raw(zone.Algorithms),
+			"errors":     llx.TArr2Raw(zone.Errors),
+		}
+	}
+	return zones, nil
+}
+
+func (d *mqlDnsDnssec) algorithms() ([]interface{}, error) {
+	res, err := d.validate()
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Zones) == 0 {
+		return []interface{}{}, nil
+	}
+	return llx.TArr2Raw(res.Zones[len(res.Zones)-1].Algorithms), nil
+}
+
+func (d *mqlDnsDnssec) errors() ([]interface{}, error) {
+	res, err := d.validate()
+	if err != nil {
+		return nil, err
+	}
+	return llx.TArr2Raw(res.Errors), nil
+}
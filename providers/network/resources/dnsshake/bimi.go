@@ -0,0 +1,43 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import "errors"
+
+// BimiRecord is a Brand Indicators for Message Identification record
+// see https://datatracker.ietf.org/doc/html/draft-brand-indicators-for-message-identification
+type BimiRecord struct {
+	Version string
+	// Logo is the location of the SVG logo (l)
+	Logo string
+	// Authority is the location of the Verified Mark Certificate (a)
+	Authority string
+}
+
+// NewBimiRecord parses a BIMI TXT record
+func NewBimiRecord(record string) (*BimiRecord, error) {
+	tags, err := splitTags(record)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 || tags[0][0] != "v" {
+		return nil, errors.New("BIMI record must start with the version (v)")
+	}
+
+	res := &BimiRecord{}
+	for _, tag := range tags {
+		switch tag[0] {
+		case "v":
+			res.Version = tag[1]
+		case "l":
+			res.Logo = tag[1]
+		case "a":
+			res.Authority = tag[1]
+		}
+	}
+	if res.Version != "BIMI1" {
+		return nil, errors.New("unsupported BIMI version '" + res.Version + "'")
+	}
+	return res, nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// CaaRecord is a Certification Authority Authorization record
+// see https://datatracker.ietf.org/doc/html/rfc8659#section-4.1
+type CaaRecord struct {
+	Name string
+	Flag uint8
+	// Tag is the property of the record, e.g. issue, issuewild or iodef
+	Tag   string
+	Value string
+}
+
+// Critical returns true if CAs must understand the tag of this record
+func (r CaaRecord) Critical() bool {
+	return r.Flag&128 != 0
+}
+
+// LookupCaa returns the CAA records that are relevant for the domain. These
+// are the records of the domain itself, or those of its closest parent if it
+// has none, see https://datatracker.ietf.org/doc/html/rfc8659#section-3.
+// It also returns the name the records were found at.
+func (d *DnsClient) LookupCaa(domain string) (string, []CaaRecord, error) {
+	labels := dns.SplitDomainName(domain)
+	for i := range labels {
+		name := strings.Join(labels[i:], ".")
+		rrs, err := d.lookup(name, dns.TypeCAA)
+		if err != nil {
+			return "", nil, err
+		}
+		if len(rrs) == 0 {
+			continue
+		}
+
+		res := make([]CaaRecord, len(rrs))
+		for j := range rrs {
+			caa := rrs[j].(*dns.CAA)
+			res[j] = CaaRecord{
+				Name:  strings.TrimSuffix(caa.Hdr.Name, "."),
+				Flag:  caa.Flag,
+				Tag:   strings.ToLower(caa.Tag),
+				Value: caa.Value,
+			}
+		}
+		return name, res, nil
+	}
+	return "", []CaaRecord{}, nil
+}
@@ -0,0 +1,39 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupCaa(t *testing.T) {
+	srv := &testDnsServer{}
+	srv.add(t,
+		`example.com. 300 IN CAA 0 issue "letsencrypt.org"`,
+		`example.com. 300 IN CAA 128 iodef "mailto:security@example.com"`,
+		`www.example.com. 300 IN A 192.0.2.1`,
+		`special.example.com. 300 IN CAA 0 issuewild ";"`,
+	)
+	client := srv.start(t)
+
+	name, records, err := client.LookupCaa("www.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", name)
+	require.Len(t, records, 2)
+	assert.Equal(t, CaaRecord{Name: "example.com", Tag: "issue", Value: "letsencrypt.org"}, records[0])
+	assert.True(t, records[1].Critical())
+
+	name, records, err = client.LookupCaa("special.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "special.example.com", name)
+	assert.Equal(t, []CaaRecord{{Name: "special.example.com", Tag: "issuewild", Value: ";"}}, records)
+
+	name, records, err = client.LookupCaa("other.test")
+	require.NoError(t, err)
+	assert.Equal(t, "", name)
+	assert.Empty(t, records)
+}
@@ -0,0 +1,197 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// DmarcRecord is a parsed DMARC policy record
+// see https://datatracker.ietf.org/doc/html/rfc7489#section-6.3
+type DmarcRecord struct {
+	Version string
+	// Policy for the domain: none, quarantine or reject
+	Policy string
+	// SubdomainPolicy for all subdomains, defaults to the policy
+	SubdomainPolicy string
+	// Percentage of messages the policy is applied to
+	Percentage int64
+	// AggregateReports are the URIs aggregate reports are sent to (rua)
+	AggregateReports []string
+	// FailureReports are the URIs failure reports are sent to (ruf)
+	FailureReports []string
+	// DkimAlignment is either relaxed or strict
+	DkimAlignment string
+	// SpfAlignment is either relaxed or strict
+	SpfAlignment string
+	// FailureOptions control when failure reports are generated (fo)
+	FailureOptions []string
+	// ReportInterval is the requested interval between aggregate reports
+	// in seconds
+	ReportInterval int64
+}
+
+// Valid verifies the record. It returns all errors found.
+func (r *DmarcRecord) Valid() (bool, []string) {
+	errs := []string{}
+	if r.Version != "DMARC1" {
+		errs = append(errs, "version must be DMARC1")
+	}
+
+	switch r.Policy {
+	case "none", "quarantine", "reject":
+	case "":
+		errs = append(errs, "missing required policy (p)")
+	default:
+		errs = append(errs, "invalid policy '"+r.Policy+"'")
+	}
+
+	switch r.SubdomainPolicy {
+	case "none", "quarantine", "reject", "":
+	default:
+		errs = append(errs, "invalid subdomain policy '"+r.SubdomainPolicy+"'")
+	}
+
+	if r.Percentage < 0 || r.Percentage > 100 {
+		errs = append(errs, "percentage (pct) must be between 0 and 100")
+	}
+	if r.DkimAlignment == "" {
+		errs = append(errs, "invalid DKIM alignment (adkim)")
+	}
+	if r.SpfAlignment == "" {
+		errs = append(errs, "invalid SPF alignment (aspf)")
+	}
+
+	return len(errs) == 0, errs
+}
+
+func dmarcAlignment(v string) string {
+	switch v {
+	case "r":
+		return "relaxed"
+	case "s":
+		return "strict"
+	default:
+		return ""
+	}
+}
+
+// splitTags splits records of the form `k1=v1; k2=v2`, which are used by
+// DMARC, MTA-STS and BIMI
+func splitTags(record string) ([][2]string, error) {
+	res := [][2]string{}
+	for _, tag := range strings.Split(record, ";") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		kv := strings.SplitN(tag, "=", 2)
+		if len(kv) != 2 {
+			return nil, errors.New("invalid tag '" + tag + "'")
+		}
+		res = append(res, [2]string{strings.ToLower(strings.TrimSpace(kv[0])), strings.TrimSpace(kv[1])})
+	}
+	return res, nil
+}
+
+func splitList(v string) []string {
+	res := []string{}
+	for _, entry := range strings.Split(v, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			res = append(res, entry)
+		}
+	}
+	return res
+}
+
+// NewDmarcRecord parses a DMARC TXT record. Missing tags are set to their
+// default values.
+func NewDmarcRecord(record string) (*DmarcRecord, error) {
+	tags, err := splitTags(record)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 || tags[0][0] != "v" {
+		return nil, errors.New("DMARC record must start with the version (v)")
+	}
+
+	res := &DmarcRecord{
+		Percentage:       100,
+		AggregateReports: []string{},
+		FailureReports:   []string{},
+		DkimAlignment:    "relaxed",
+		SpfAlignment:     "relaxed",
+		FailureOptions:   []string{"0"},
+		ReportInterval:   86400,
+	}
+	for _, tag := range tags {
+		switch tag[0] {
+		case "v":
+			res.Version = tag[1]
+		case "p":
+			res.Policy = strings.ToLower(tag[1])
+		case "sp":
+			res.SubdomainPolicy = strings.ToLower(tag[1])
+		case "pct":
+			if res.Percentage, err = strconv.ParseInt(tag[1], 10, 64); err != nil {
+				return nil, errors.New("invalid percentage (pct) '" + tag[1] + "'")
+			}
+		case "rua":
+			res.AggregateReports = splitList(tag[1])
+		case "ruf":
+			res.FailureReports = splitList(tag[1])
+		case "adkim":
+			res.DkimAlignment = dmarcAlignment(strings.ToLower(tag[1]))
+		case "aspf":
+			res.SpfAlignment = dmarcAlignment(strings.ToLower(tag[1]))
+		case "fo":
+			res.FailureOptions = strings.Split(tag[1], ":")
+		case "ri":
+			if res.ReportInterval, err = strconv.ParseInt(tag[1], 10, 64); err != nil {
+				return nil, errors.New("invalid report interval (ri) '" + tag[1] + "'")
+			}
+		}
+	}
+
+	if res.SubdomainPolicy == "" {
+		res.SubdomainPolicy = res.Policy
+	}
+	return res, nil
+}
+
+// LookupDmarc returns the DMARC record of the domain. If the domain has no
+// record, the record of the organizational domain applies, if one is given.
+// It returns the domain the record was found at.
+func (d *DnsClient) LookupDmarc(domain string, organizationalDomain string) (string, string, error) {
+	candidates := []string{domain}
+	if organizationalDomain != "" && organizationalDomain != domain {
+		candidates = append(candidates, organizationalDomain)
+	}
+
+	for _, candidate := range candidates {
+		txts, err := d.LookupTXT("_dmarc." + candidate)
+		if err != nil {
+			return "", "", err
+		}
+
+		var records []string
+		for i := range txts {
+			if strings.HasPrefix(strings.ToUpper(txts[i]), "V=DMARC1") {
+				records = append(records, txts[i])
+			}
+		}
+		switch len(records) {
+		case 0:
+			continue
+		case 1:
+			return candidate, records[0], nil
+		default:
+			// https://datatracker.ietf.org/doc/html/rfc7489#section-6.6.3
+			return candidate, "", errors.New("found " + strconv.Itoa(len(records)) + " DMARC records for " + candidate + ", only one is allowed")
+		}
+	}
+	return "", "", nil
+}
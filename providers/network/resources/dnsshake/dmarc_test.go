@@ -0,0 +1,79 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDmarcRecord(t *testing.T) {
+	r, err := NewDmarcRecord("v=DMARC1; p=reject; sp=quarantine; pct=50; rua=mailto:dmarc@example.com,mailto:agg@example.net; ruf=mailto:forensic@example.com; adkim=s; fo=1:d")
+	require.NoError(t, err)
+	assert.Equal(t, &DmarcRecord{
+		Version:          "DMARC1",
+		Policy:           "reject",
+		SubdomainPolicy:  "quarantine",
+		Percentage:       50,
+		AggregateReports: []string{"mailto:dmarc@example.com", "mailto:agg@example.net"},
+		FailureReports:   []string{"mailto:forensic@example.com"},
+		DkimAlignment:    "strict",
+		SpfAlignment:     "relaxed",
+		FailureOptions:   []string{"1", "d"},
+		ReportInterval:   86400,
+	}, r)
+	ok, errs := r.Valid()
+	assert.True(t, ok)
+	assert.Empty(t, errs)
+
+	r, err = NewDmarcRecord("v=DMARC1; p=none")
+	require.NoError(t, err)
+	assert.Equal(t, "none", r.SubdomainPolicy)
+	assert.Equal(t, int64(100), r.Percentage)
+
+	r, err = NewDmarcRecord("v=DMARC1; pct=120; aspf=x")
+	require.NoError(t, err)
+	ok, errs = r.Valid()
+	assert.False(t, ok)
+	assert.Equal(t, []string{
+		"missing required policy (p)",
+		"percentage (pct) must be between 0 and 100",
+		"invalid SPF alignment (aspf)",
+	}, errs)
+
+	_, err = NewDmarcRecord("p=reject; v=DMARC1")
+	assert.Error(t, err)
+}
+
+func TestLookupDmarc(t *testing.T) {
+	srv := &testDnsServer{}
+	srv.add(t,
+		`_dmarc.example.com. 300 IN TXT "v=DMARC1; p=reject"`,
+		`_dmarc.example.com. 300 IN TXT "some other record"`,
+		`_dmarc.twice.test. 300 IN TXT "v=DMARC1; p=reject"`,
+		`_dmarc.twice.test. 300 IN TXT "v=DMARC1; p=none"`,
+	)
+	client := srv.start(t)
+
+	domain, record, err := client.LookupDmarc("example.com", "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", domain)
+	assert.Equal(t, "v=DMARC1; p=reject", record)
+
+	// subdomains fall back to the organizational domain
+	domain, record, err = client.LookupDmarc("mail.example.com", "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", domain)
+	assert.Equal(t, "v=DMARC1; p=reject", record)
+
+	domain, record, err = client.LookupDmarc("none.test", "none.test")
+	require.NoError(t, err)
+	assert.Equal(t, "", domain)
+	assert.Equal(t, "", record)
+
+	_, _, err = client.LookupDmarc("twice.test", "")
+	assert.EqualError(t, err, "found 2 DMARC records for twice.test, only one is allowed")
+}
@@ -0,0 +1,317 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// DNSSEC validation states as defined in https://datatracker.ietf.org/doc/html/rfc4035#section-4.3
+const (
+	DnssecSecure        = "secure"
+	DnssecInsecure      = "insecure"
+	DnssecBogus         = "bogus"
+	DnssecIndeterminate = "indeterminate"
+)
+
+// rootTrustAnchors are the DS records of the root zone key signing keys
+// (KSK-2017 and KSK-2024), see https://data.iana.org/root-anchors/root-anchors.xml
+var rootTrustAnchors = []string{
+	". 172800 IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D",
+	". 172800 IN DS 38696 8 2 683D2D0ACB8C9B712A1948B27F741219298D0A450D612C483AF444A4C0FB2B16",
+}
+
+// defaultTrustAnchors parses the root trust anchors
+func defaultTrustAnchors() ([]*dns.DS, error) {
+	res := make([]*dns.DS, len(rootTrustAnchors))
+	for i := range rootTrustAnchors {
+		rr, err := dns.NewRR(rootTrustAnchors[i])
+		if err != nil {
+			return nil, err
+		}
+		res[i] = rr.(*dns.DS)
+	}
+	return res, nil
+}
+
+// DnssecZone is the validation result of one zone in the chain of trust
+type DnssecZone struct {
+	Zone string
+	// Signed is set if the zone publishes DNSKEY records
+	Signed bool
+	// Delegated is set if the parent zone publishes DS records for the zone
+	Delegated  bool
+	Algorithms []string
+	Status     string
+	Errors     []string
+}
+
+// DnssecResult is the validation of the chain of trust from the root zone
+// down to the zone of a domain
+type DnssecResult struct {
+	Domain string
+	Status string
+	// Zones of the chain of trust, starting at the root
+	Zones  []*DnssecZone
+	Errors []string
+}
+
+// Signed returns true if the zone of the domain is signed
+func (r *DnssecResult) Signed() bool {
+	if len(r.Zones) == 0 {
+		return false
+	}
+	return r.Zones[len(r.Zones)-1].Signed
+}
+
+// ValidateDnssec validates the chain of trust for the zone of the domain,
+// starting at the root trust anchor. Signatures are validated by the client,
+// not by the resolver.
+//
+// The absence of DS records is not proven via NSEC records. A zone without
+// DS records in a validated parent is treated as insecure.
+func (d *DnsClient) ValidateDnssec(domain string) (*DnssecResult, error) {
+	res := &DnssecResult{Domain: strings.TrimSuffix(domain, ".")}
+
+	zones, err := d.zoneChain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	anchors := d.trustAnchors
+	if anchors == nil {
+		anchors, err = defaultTrustAnchors()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	status := DnssecSecure
+	dsSet := anchors
+	for i, zone := range zones {
+		cur := &DnssecZone{Zone: zone, Delegated: len(dsSet) > 0, Algorithms: []string{}}
+		res.Zones = append(res.Zones, cur)
+
+		keyRRs, keySigs, err := d.signedRRset(zone, dns.TypeDNSKEY)
+		if err != nil {
+			cur.Status = DnssecIndeterminate
+			cur.Errors = append(cur.Errors, "failed to get DNSKEY records: "+err.Error())
+			status = worseDnssecStatus(status, DnssecIndeterminate)
+			continue
+		}
+		keys := make([]*dns.DNSKEY, len(keyRRs))
+		algorithms := map[string]struct{}{}
+		for j := range keyRRs {
+			keys[j] = keyRRs[j].(*dns.DNSKEY)
+			algorithm := dns.AlgorithmToString[keys[j].Algorithm]
+			if _, ok := algorithms[algorithm]; !ok {
+				algorithms[algorithm] = struct{}{}
+				cur.Algorithms = append(cur.Algorithms, algorithm)
+			}
+		}
+		cur.Signed = len(keys) > 0
+
+		if status != DnssecSecure {
+			// nothing below an insecure or bogus zone can be secure
+			cur.Status = status
+			continue
+		}
+
+		if !cur.Delegated {
+			cur.Status = DnssecInsecure
+			status = DnssecInsecure
+			continue
+		}
+
+		cur.Status = DnssecSecure
+		if err := verifyKeys(keyRRs, keySigs, keys, dsSet); err != nil {
+			cur.Status = DnssecBogus
+			cur.Errors = append(cur.Errors, err.Error())
+			status = DnssecBogus
+			continue
+		}
+
+		if i == len(zones)-1 {
+			break
+		}
+
+		// the DS records of the next zone are published and signed by this zone
+		dsRRs, dsSigs, err := d.signedRRset(zones[i+1], dns.TypeDS)
+		if err != nil {
+			cur.Errors = append(cur.Errors, "failed to get DS records of "+zones[i+1]+": "+err.Error())
+			status = DnssecIndeterminate
+			continue
+		}
+		if len(dsRRs) == 0 {
+			dsSet = nil
+			continue
+		}
+		if err := verifyRRset(dsRRs, dsSigs, keys); err != nil {
+			cur.Errors = append(cur.Errors, "DS records of "+zones[i+1]+": "+err.Error())
+			cur.Status = DnssecBogus
+			status = DnssecBogus
+			continue
+		}
+		dsSet = make([]*dns.DS, len(dsRRs))
+		for j := range dsRRs {
+			dsSet[j] = dsRRs[j].(*dns.DS)
+		}
+	}
+
+	res.Status = status
+	for _, zone := range res.Zones {
+		for _, e := range zone.Errors {
+			res.Errors = append(res.Errors, zone.Zone+": "+e)
+		}
+	}
+	return res, nil
+}
+
+// worseDnssecStatus returns the status with the lower level of trust
+func worseDnssecStatus(a, b string) string {
+	rank := map[string]int{DnssecSecure: 0, DnssecInsecure: 1, DnssecIndeterminate: 2, DnssecBogus: 3}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
+
+// zoneChain returns all zones from the root down to the zone of the name
+func (d *DnsClient) zoneChain(name string) ([]string, error) {
+	res := []string{}
+	cur := dns.Fqdn(name)
+	for {
+		zone, err := d.zoneOf(cur)
+		if err != nil {
+			return nil, err
+		}
+		res = append([]string{zone}, res...)
+		if zone == "." {
+			return res, nil
+		}
+
+		parts := dns.SplitDomainName(zone)
+		if len(parts) <= 1 {
+			cur = "."
+		} else {
+			cur = dns.Fqdn(strings.Join(parts[1:], "."))
+		}
+	}
+}
+
+// zoneOf finds the zone a name belongs to, which is the owner of the SOA
+// record in the answer or authority section
+func (d *DnsClient) zoneOf(name string) (string, error) {
+	r, err := d.exchange(name, dns.TypeSOA, false)
+	if err != nil {
+		return "", err
+	}
+	if r.Rcode != dns.RcodeSuccess && r.Rcode != dns.RcodeNameError {
+		return "", errors.New("dns query for " + name + " failed: " + dns.RcodeToString[r.Rcode])
+	}
+
+	for _, rrs := range [][]dns.RR{r.Answer, r.Ns} {
+		for i := range rrs {
+			if soa, ok := rrs[i].(*dns.SOA); ok {
+				return strings.ToLower(soa.Hdr.Name), nil
+			}
+		}
+	}
+	return "", errors.New("cannot find the zone of " + name)
+}
+
+// signedRRset returns the records of the name and type, with their signatures
+func (d *DnsClient) signedRRset(name string, dnsType uint16) ([]dns.RR, []*dns.RRSIG, error) {
+	r, err := d.exchange(name, dnsType, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	if r.Rcode != dns.RcodeSuccess && r.Rcode != dns.RcodeNameError {
+		return nil, nil, errors.New("dns query for " + name + " failed: " + dns.RcodeToString[r.Rcode])
+	}
+
+	rrs := []dns.RR{}
+	sigs := []*dns.RRSIG{}
+	for _, rr := range r.Answer {
+		switch v := rr.(type) {
+		case *dns.RRSIG:
+			if v.TypeCovered == dnsType {
+				sigs = append(sigs, v)
+			}
+		default:
+			if rr.Header().Rrtype == dnsType {
+				rrs = append(rrs, rr)
+			}
+		}
+	}
+	return rrs, sigs, nil
+}
+
+// verifyKeys checks that one of the keys matches the DS records of the
+// parent and that this key signed the set of keys
+func verifyKeys(keyRRs []dns.RR, sigs []*dns.RRSIG, keys []*dns.DNSKEY, dsSet []*dns.DS) error {
+	if len(keys) == 0 {
+		return errors.New("zone is delegated with DS records, but has no DNSKEY records")
+	}
+
+	trusted := trustedKeys(keys, dsSet)
+	if len(trusted) == 0 {
+		return errors.New("no DNSKEY matches the DS records of the parent zone")
+	}
+
+	return verifyRRset(keyRRs, sigs, trusted)
+}
+
+// trustedKeys returns all keys that match one of the DS records
+func trustedKeys(keys []*dns.DNSKEY, dsSet []*dns.DS) []*dns.DNSKEY {
+	var res []*dns.DNSKEY
+	for _, key := range keys {
+		for _, ds := range dsSet {
+			if key.KeyTag() != ds.KeyTag || key.Algorithm != ds.Algorithm {
+				continue
+			}
+			if digest := key.ToDS(ds.DigestType); digest != nil && strings.EqualFold(digest.Digest, ds.Digest) {
+				res = append(res, key)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// verifyRRset checks that the records have a valid signature of one of the
+// keys
+func verifyRRset(rrs []dns.RR, sigs []*dns.RRSIG, keys []*dns.DNSKEY) error {
+	if len(sigs) == 0 {
+		return errors.New("records are not signed")
+	}
+
+	now := time.Now()
+	expired := false
+	for _, sig := range sigs {
+		for _, key := range keys {
+			if key.KeyTag() != sig.KeyTag || key.Algorithm != sig.Algorithm ||
+				!strings.EqualFold(key.Hdr.Name, sig.SignerName) {
+				continue
+			}
+			if err := sig.Verify(key, rrs); err != nil {
+				continue
+			}
+			if !sig.ValidityPeriod(now) {
+				expired = true
+				continue
+			}
+			return nil
+		}
+	}
+
+	if expired {
+		return errors.New("signature is expired or not yet valid")
+	}
+	return errors.New("no valid signature found")
+}
@@ -0,0 +1,160 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"crypto"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testZoneKey struct {
+	key  *dns.DNSKEY
+	priv crypto.Signer
+}
+
+func newTestZoneKey(t *testing.T, zone string) *testZoneKey {
+	key := &dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: zone, Rrtype: dns.TypeDNSKEY, Class: dns.ClassINET, Ttl: 3600},
+		Flags:     257,
+		Protocol:  3,
+		Algorithm: dns.ECDSAP256SHA256,
+	}
+	priv, err := key.Generate(256)
+	require.NoError(t, err)
+	return &testZoneKey{key: key, priv: priv.(crypto.Signer)}
+}
+
+func (k *testZoneKey) sign(t *testing.T, inception time.Time, rrs ...dns.RR) dns.RR {
+	sig := &dns.RRSIG{
+		Hdr:        dns.RR_Header{Name: rrs[0].Header().Name, Rrtype: dns.TypeRRSIG, Class: dns.ClassINET, Ttl: 3600},
+		Algorithm:  k.key.Algorithm,
+		SignerName: k.key.Hdr.Name,
+		KeyTag:     k.key.KeyTag(),
+		Inception:  uint32(inception.Unix()),
+		Expiration: uint32(inception.Add(2 * time.Hour).Unix()),
+	}
+	require.NoError(t, sig.Sign(k.priv, rrs))
+	return sig
+}
+
+// addZone adds a signed zone with the given key
+func (s *testDnsServer) addZone(t *testing.T, key *testZoneKey) {
+	zone := key.key.Hdr.Name
+	s.add(t, zone+" 3600 IN SOA ns.test. admin.test. 1 7200 3600 1209600 3600")
+	s.records = append(s.records, key.key, key.sign(t, time.Now().Add(-time.Hour), key.key))
+}
+
+// addDelegation adds the signed DS record of the child to the parent zone
+func (s *testDnsServer) addDelegation(t *testing.T, parent *testZoneKey, child *testZoneKey) {
+	ds := child.key.ToDS(dns.SHA256)
+	ds.Hdr.Ttl = 3600
+	s.records = append(s.records, ds, parent.sign(t, time.Now().Add(-time.Hour), ds))
+}
+
+func TestValidateDnssec(t *testing.T) {
+	root := newTestZoneKey(t, ".")
+	tld := newTestZoneKey(t, "test.")
+	secure := newTestZoneKey(t, "secure.test.")
+	bogus := newTestZoneKey(t, "bogus.test.")
+	other := newTestZoneKey(t, "bogus.test.")
+	undelegated := newTestZoneKey(t, "undelegated.test.")
+
+	srv := &testDnsServer{}
+	srv.addZone(t, root)
+	srv.addZone(t, tld)
+	srv.addDelegation(t, root, tld)
+	srv.addZone(t, secure)
+	srv.addDelegation(t, tld, secure)
+	// the parent has the DS of a different key
+	srv.addZone(t, bogus)
+	srv.addDelegation(t, tld, other)
+	srv.add(t, "unsigned.test. 3600 IN SOA ns.unsigned.test. admin.unsigned.test. 1 7200 3600 1209600 3600")
+	// signed, but the parent has no DS record
+	srv.addZone(t, undelegated)
+	srv.add(t, "www.secure.test. 300 IN A 192.0.2.1")
+
+	client := srv.start(t)
+	client.trustAnchors = []*dns.DS{root.key.ToDS(dns.SHA256)}
+
+	t.Run("secure", func(t *testing.T) {
+		res, err := client.ValidateDnssec("www.secure.test")
+		require.NoError(t, err)
+		assert.Equal(t, DnssecSecure, res.Status)
+		assert.True(t, res.Signed())
+		assert.Empty(t, res.Errors)
+		require.Len(t, res.Zones, 3)
+		assert.Equal(t, ".", res.Zones[0].Zone)
+		assert.Equal(t, "test.", res.Zones[1].Zone)
+		assert.Equal(t, "secure.test.", res.Zones[2].Zone)
+		assert.Equal(t, []string{"ECDSAP256SHA256"}, res.Zones[2].Algorithms)
+	})
+
+	t.Run("insecure", func(t *testing.T) {
+		res, err := client.ValidateDnssec("unsigned.test")
+		require.NoError(t, err)
+		assert.Equal(t, DnssecInsecure, res.Status)
+		assert.False(t, res.Signed())
+		assert.False(t, res.Zones[2].Delegated)
+
+		res, err = client.ValidateDnssec("undelegated.test")
+		require.NoError(t, err)
+		assert.Equal(t, DnssecInsecure, res.Status)
+		assert.True(t, res.Signed())
+	})
+
+	t.Run("bogus", func(t *testing.T) {
+		res, err := client.ValidateDnssec("bogus.test")
+		require.NoError(t, err)
+		assert.Equal(t, DnssecBogus, res.Status)
+		assert.True(t, res.Signed())
+		assert.Equal(t, []string{"bogus.test.: no DNSKEY matches the DS records of the parent zone"}, res.Errors)
+	})
+
+	t.Run("untrusted root", func(t *testing.T) {
+		client.trustAnchors = []*dns.DS{other.key.ToDS(dns.SHA256)}
+		defer func() { client.trustAnchors = []*dns.DS{root.key.ToDS(dns.SHA256)} }()
+
+		res, err := client.ValidateDnssec("secure.test")
+		require.NoError(t, err)
+		assert.Equal(t, DnssecBogus, res.Status)
+	})
+
+	t.Run("any trusted root key", func(t *testing.T) {
+		// during a key rollover the old and the new key are trust anchors
+		client.trustAnchors = []*dns.DS{other.key.ToDS(dns.SHA256), root.key.ToDS(dns.SHA256)}
+		defer func() { client.trustAnchors = []*dns.DS{root.key.ToDS(dns.SHA256)} }()
+
+		res, err := client.ValidateDnssec("secure.test")
+		require.NoError(t, err)
+		assert.Equal(t, DnssecSecure, res.Status)
+	})
+}
+
+func TestRootTrustAnchors(t *testing.T) {
+	anchors, err := defaultTrustAnchors()
+	require.NoError(t, err)
+
+	// key signing keys of the current root DNSKEY set
+	var keys []*dns.DNSKEY
+	for _, s := range []string{
+		// KSK-2017
+		". 172800 IN DNSKEY 257 3 8 AwEAAaz/tAm8yTn4Mfeh5eyI96WSVexTBAvkMgJzkKTOiW1vkIbzxeF3+/4RgWOq7HrxRixHlFlExOLAJr5emLvN7SWXgnLh4+B5xQlNVz8Og8kvArMtNROxVQuCaSnIDdD5LKyWbRd2n9WGe2R8PzgCmr3EgVLrjyBxWezF0jLHwVN8efS3rCj/EWgvIWgb9tarpVUDK/b58Da+sqqls3eNbuv7pr+eoZG+SrDK6nWeL3c6H5Apxz7LjVc1uTIdsIXxuOLYA4/ilBmSVIzuDWfdRUfhHdY6+cn8HFRm+2hM8AnXGXws9555KrUB5qihylGa8subX2Nn6UwNR1AkUTV74bU=",
+		// KSK-2024
+		". 172800 IN DNSKEY 257 3 8 AwEAAa96jeuknZlaeSrvyAJj6ZHv28hhOKkx3rLGXVaC6rXTsDc449/cidltpkyGwCJNnOAlFNKF2jBosZBU5eeHspaQWOmOElZsjICMQMC3aeHbGiShvZsx4wMYSjH8e7Vrhbu6irwCzVBApESjbUdpWWmEnhathWu1jo+siFUiRAAxm9qyJNg/wOZqqzL/dL/q8PkcRU5oUKEpUge71M3ej2/7CPqpdVwuMoTvoB+ZOT4YeGyxMvHmbrxlFzGOHOijtzN+u1TQNatX2XBuzZNQ1K+s2CXkPIZo7s6JgZyvaBevYtxPvYLw4z9mR7K2vaF18UYH9Z9GNUUeayffKC73PYc=",
+	} {
+		rr, err := dns.NewRR(s)
+		require.NoError(t, err)
+		keys = append(keys, rr.(*dns.DNSKEY))
+	}
+
+	trusted := trustedKeys(keys, anchors)
+	require.Len(t, trusted, 2)
+	assert.Equal(t, uint16(20326), trusted[0].KeyTag())
+	assert.Equal(t, uint16(38696), trusted[1].KeyTag())
+}
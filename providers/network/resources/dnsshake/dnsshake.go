@@ -19,6 +19,8 @@ type DnsClient struct {
 	config *dns.ClientConfig
 	fqdn   string
 	sync   sync.Mutex
+	// trustAnchors for DNSSEC validation, defaults to the root zone key
+	trustAnchors []*dns.DS
 }
 
 type DnsRecord struct {
@@ -59,6 +61,14 @@ func New(fqdn string) (*DnsClient, error) {
 		}
 	}
 
+	return NewWithConfig(fqdn, config)
+}
+
+// NewWithConfig creates a client that uses the servers of the given config
+func NewWithConfig(fqdn string, config *dns.ClientConfig) (*DnsClient, error) {
+	if config == nil || len(config.Servers) == 0 {
+		return nil, errors.New("no dns server configured")
+	}
 	return &DnsClient{
 		fqdn:   fqdn,
 		config: config,
@@ -200,13 +210,7 @@ func (d *DnsClient) queryDnsType(fqdn string, t string) (map[string]DnsRecord, e
 
 	res := map[string]DnsRecord{}
 
-	c := &dns.Client{}
-	m := &dns.Msg{}
-	m.SetEdns0(4096, false)
-	m.SetQuestion(dns.Fqdn(fqdn), dnsType)
-	m.RecursionDesired = true
-
-	r, _, err := c.Exchange(m, net.JoinHostPort(d.config.Servers[0], d.config.Port))
+	r, err := d.exchange(fqdn, dnsType, false)
 	if err != nil {
 		res[dnsTypText] = DnsRecord{
 			Type:  dnsTypText,
@@ -292,3 +296,59 @@ func (d *DnsClient) queryDnsType(fqdn string, t string) (map[string]DnsRecord, e
 	}
 	return res, nil
 }
+
+// exchange sends a query for the name to the first configured server. With
+// dnssec set, signatures are requested and the server is asked to not
+// validate them, so that they can be validated by the caller.
+func (d *DnsClient) exchange(name string, dnsType uint16, dnssec bool) (*dns.Msg, error) {
+	m := &dns.Msg{}
+	m.SetEdns0(4096, dnssec)
+	m.SetQuestion(dns.Fqdn(name), dnsType)
+	m.RecursionDesired = true
+	m.CheckingDisabled = dnssec
+
+	addr := net.JoinHostPort(d.config.Servers[0], d.config.Port)
+	c := &dns.Client{}
+	r, _, err := c.Exchange(m, addr)
+	if err == nil && r.Truncated {
+		c.Net = "tcp"
+		r, _, err = c.Exchange(m, addr)
+	}
+	return r, err
+}
+
+// lookup returns all answers of the given type for the name. Names that
+// don't exist or have no records of the type return no answers.
+func (d *DnsClient) lookup(name string, dnsType uint16) ([]dns.RR, error) {
+	r, err := d.exchange(name, dnsType, false)
+	if err != nil {
+		return nil, err
+	}
+	switch r.Rcode {
+	case dns.RcodeSuccess, dns.RcodeNameError:
+	default:
+		return nil, errors.New("dns query for " + name + " failed: " + dns.RcodeToString[r.Rcode])
+	}
+
+	res := []dns.RR{}
+	for i := range r.Answer {
+		if r.Answer[i].Header().Rrtype == dnsType {
+			res = append(res, r.Answer[i])
+		}
+	}
+	return res, nil
+}
+
+// LookupTXT returns the TXT records of the name, with their strings joined
+func (d *DnsClient) LookupTXT(name string) ([]string, error) {
+	rrs, err := d.lookup(name, dns.TypeTXT)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, len(rrs))
+	for i := range rrs {
+		res = append(res, strings.Join(rrs[i].(*dns.TXT).Txt, ""))
+	}
+	return res, nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// MtaStsRecord is the TXT record that announces an MTA-STS policy
+// see https://datatracker.ietf.org/doc/html/rfc8461#section-3.1
+type MtaStsRecord struct {
+	Version string
+	// Id changes whenever the policy changes
+	Id string
+}
+
+// NewMtaStsRecord parses the _mta-sts TXT record
+func NewMtaStsRecord(record string) (*MtaStsRecord, error) {
+	tags, err := splitTags(record)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 || tags[0][0] != "v" {
+		return nil, errors.New("MTA-STS record must start with the version (v)")
+	}
+
+	res := &MtaStsRecord{}
+	for _, tag := range tags {
+		switch tag[0] {
+		case "v":
+			res.Version = tag[1]
+		case "id":
+			res.Id = tag[1]
+		}
+	}
+
+	if res.Version != "STSv1" {
+		return nil, errors.New("unsupported MTA-STS version '" + res.Version + "'")
+	}
+	if res.Id == "" {
+		return nil, errors.New("MTA-STS record has no id")
+	}
+	return res, nil
+}
+
+// MtaStsPolicy is the policy that is served via HTTPS
+// see https://datatracker.ietf.org/doc/html/rfc8461#section-3.2
+type MtaStsPolicy struct {
+	Version string
+	// Mode is either enforce, testing or none
+	Mode string
+	// Mx are the patterns of MX hosts that are allowed to receive mails
+	Mx []string
+	// MaxAge is how long senders may cache the policy, in seconds
+	MaxAge int64
+}
+
+// MaxMtaStsAge is the maximum value of max_age
+const MaxMtaStsAge = 31557600
+
+// MtaStsPolicyUrl returns the location of the policy of a domain
+func MtaStsPolicyUrl(domain string) string {
+	return "https://mta-sts." + strings.TrimSuffix(domain, ".") + "/.well-known/mta-sts.txt"
+}
+
+// NewMtaStsPolicy parses a policy file
+func NewMtaStsPolicy(policy string) (*MtaStsPolicy, error) {
+	res := &MtaStsPolicy{Mx: []string{}}
+	hasMaxAge := false
+
+	for _, line := range strings.Split(policy, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		kv := strings.SplitN(line, ":", 2)
+		if len(kv) != 2 {
+			return nil, errors.New("invalid line in MTA-STS policy: " + line)
+		}
+		value := strings.TrimSpace(kv[1])
+
+		switch strings.TrimSpace(kv[0]) {
+		case "version":
+			res.Version = value
+		case "mode":
+			res.Mode = value
+		case "mx":
+			res.Mx = append(res.Mx, value)
+		case "max_age":
+			maxAge, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, errors.New("invalid max_age in MTA-STS policy: " + value)
+			}
+			res.MaxAge = maxAge
+			hasMaxAge = true
+		}
+	}
+
+	if res.Version != "STSv1" {
+		return nil, errors.New("unsupported MTA-STS policy version '" + res.Version + "'")
+	}
+	switch res.Mode {
+	case "enforce", "testing":
+		if len(res.Mx) == 0 {
+			return nil, errors.New("MTA-STS policy in mode " + res.Mode + " requires mx entries")
+		}
+	case "none":
+	default:
+		return nil, errors.New("invalid mode in MTA-STS policy '" + res.Mode + "'")
+	}
+	if !hasMaxAge {
+		return nil, errors.New("MTA-STS policy has no max_age")
+	}
+	if res.MaxAge < 0 || res.MaxAge > MaxMtaStsAge {
+		return nil, errors.New("max_age in MTA-STS policy must be between 0 and " + strconv.Itoa(MaxMtaStsAge))
+	}
+
+	return res, nil
+}
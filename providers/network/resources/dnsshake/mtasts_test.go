@@ -0,0 +1,50 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMtaSts(t *testing.T) {
+	r, err := NewMtaStsRecord("v=STSv1; id=20160831085700Z;")
+	require.NoError(t, err)
+	assert.Equal(t, &MtaStsRecord{Version: "STSv1", Id: "20160831085700Z"}, r)
+
+	_, err = NewMtaStsRecord("v=STSv1;")
+	assert.EqualError(t, err, "MTA-STS record has no id")
+
+	assert.Equal(t, "https://mta-sts.example.com/.well-known/mta-sts.txt", MtaStsPolicyUrl("example.com."))
+
+	p, err := NewMtaStsPolicy("version: STSv1\r\nmode: enforce\r\nmx: mail.example.com\r\nmx: *.example.net\r\nmax_age: 86400\r\n")
+	require.NoError(t, err)
+	assert.Equal(t, &MtaStsPolicy{
+		Version: "STSv1",
+		Mode:    "enforce",
+		Mx:      []string{"mail.example.com", "*.example.net"},
+		MaxAge:  86400,
+	}, p)
+
+	_, err = NewMtaStsPolicy("version: STSv1\nmode: testing\nmax_age: 86400\n")
+	assert.EqualError(t, err, "MTA-STS policy in mode testing requires mx entries")
+
+	_, err = NewMtaStsPolicy("version: STSv1\nmode: none\n")
+	assert.EqualError(t, err, "MTA-STS policy has no max_age")
+}
+
+func TestBimiRecord(t *testing.T) {
+	r, err := NewBimiRecord("v=BIMI1; l=https://example.com/logo.svg; a=https://example.com/vmc.pem")
+	require.NoError(t, err)
+	assert.Equal(t, &BimiRecord{
+		Version:   "BIMI1",
+		Logo:      "https://example.com/logo.svg",
+		Authority: "https://example.com/vmc.pem",
+	}, r)
+
+	_, err = NewBimiRecord("v=BIMI2; l=")
+	assert.Error(t, err)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package dnsshake
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+)
+
+// testDnsServer is an in-process DNS server that answers queries from a
+// fixed set of records
+type testDnsServer struct {
+	records []dns.RR
+}
+
+func (s *testDnsServer) add(t *testing.T, records ...string) {
+	for i := range records {
+		rr, err := dns.NewRR(records[i])
+		require.NoError(t, err)
+		s.records = append(s.records, rr)
+	}
+}
+
+// zoneOf returns the SOA of the closest zone that contains the name
+func (s *testDnsServer) zoneOf(name string) dns.RR {
+	var res dns.RR
+	for _, rr := range s.records {
+		if rr.Header().Rrtype != dns.TypeSOA || !dns.IsSubDomain(rr.Header().Name, name) {
+			continue
+		}
+		if res == nil || dns.CountLabel(rr.Header().Name) > dns.CountLabel(res.Header().Name) {
+			res = rr
+		}
+	}
+	return res
+}
+
+func (s *testDnsServer) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	q := r.Question[0]
+	m := &dns.Msg{}
+	m.SetReply(r)
+	dnssec := r.IsEdns0() != nil && r.IsEdns0().Do()
+
+	exists := false
+	for _, rr := range s.records {
+		if !strings.EqualFold(rr.Header().Name, q.Name) {
+			continue
+		}
+		exists = true
+		if rr.Header().Rrtype == q.Qtype {
+			m.Answer = append(m.Answer, rr)
+		}
+		if sig, ok := rr.(*dns.RRSIG); ok && dnssec && sig.TypeCovered == q.Qtype {
+			m.Answer = append(m.Answer, rr)
+		}
+	}
+
+	if len(m.Answer) == 0 {
+		if !exists {
+			m.Rcode = dns.RcodeNameError
+		}
+		if soa := s.zoneOf(q.Name); soa != nil {
+			m.Ns = append(m.Ns, soa)
+		}
+	}
+	w.WriteMsg(m)
+}
+
+// start runs the server and returns a client for it
+func (s *testDnsServer) start(t *testing.T) *DnsClient {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	srv := &dns.Server{PacketConn: pc, Handler: s, NotifyStartedFunc: func() { close(started) }}
+	go srv.ActivateAndServe()
+	<-started
+	t.Cleanup(func() { srv.Shutdown() })
+
+	_, port, err := net.SplitHostPort(pc.LocalAddr().String())
+	require.NoError(t, err)
+	client, err := NewWithConfig("", &dns.ClientConfig{Servers: []string{"127.0.0.1"}, Port: port})
+	require.NoError(t, err)
+	return client
+}
@@ -4,10 +4,13 @@
 package dnsshake
 
 import (
+	"errors"
+	"strconv"
 	"strings"
 
 	"github.com/alecthomas/participle/v2"
 	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/miekg/dns"
 )
 
 // Sender Policy Framework (SPF) for Authorizing Use of Domains in Email, Version 1
@@ -27,7 +30,7 @@ var spfLexer = lexer.MustSimple([]lexer.SimpleRule{
 	{`Equal`, `[=]`},
 	{`Mechanism`, `\b(all|include|a|mx|ptr|ip4|ip6|exists)\b`},
 	{`Modifier`, `\b(redirect|exp)\b`},
-	{`String`, `[^+\-:\s=\/][\w.%\-+{}]+`},
+	{`String`, `[^+\-~?:\s=\/][\w.%\-+{}]+`},
 	{`Qualifier`, `[\+\-~?]`},
 	{`Number`, `\d+`},
 })
@@ -68,3 +71,283 @@ func (s *spf) Parse(txt string) (*SpfRecord, error) {
 
 	return spfParser.Parse("", strings.NewReader(strings.Join(lines, "\n")))
 }
+
+// MaxSpfLookups is the maximum number of DNS lookups that may be needed to
+// evaluate an SPF policy, see https://datatracker.ietf.org/doc/html/rfc7208#section-4.6.4
+const MaxSpfLookups = 10
+
+// MaxSpfVoidLookups is the maximum number of DNS lookups of an SPF policy
+// that may return no records, see https://datatracker.ietf.org/doc/html/rfc7208#section-4.6.4
+const MaxSpfVoidLookups = 2
+
+// SpfPermError is the result of a policy that exceeds a lookup limit
+const SpfPermError = "permerror"
+
+// SpfPolicy is the SPF policy of a domain, together with all policies that
+// it includes or redirects to
+type SpfPolicy struct {
+	Domain string
+	// Record is the SPF TXT record of the domain, empty if it has none
+	Record string
+	Parsed *SpfRecord
+	// Includes are the policies of all include mechanisms and the redirect
+	// modifier, in order
+	Includes []*SpfPolicy
+	// Lookups is the number of DNS lookups needed to evaluate this policy,
+	// including the lookups of all includes
+	Lookups int
+	// Errors found in this policy or any of its includes
+	Errors []string
+	// PermError is set if the evaluation of this policy was stopped, because
+	// it exceeded a lookup limit
+	PermError bool
+}
+
+// Valid returns true if the policy exists, can be parsed and stays within
+// the DNS lookup limit
+func (p *SpfPolicy) Valid() bool {
+	return p.Parsed != nil && len(p.Errors) == 0
+}
+
+// DefaultResult is the result for senders that match no mechanism of the
+// policy: pass, fail, softfail or neutral. Policies that exceed a lookup
+// limit result in a permerror.
+func (p *SpfPolicy) DefaultResult() string {
+	if p.Parsed == nil {
+		return ""
+	}
+	if p.PermError {
+		return SpfPermError
+	}
+	for i := range p.Parsed.Directives {
+		if p.Parsed.Directives[i].Mechanism == "all" {
+			return SpfResult(p.Parsed.Directives[i].Qualifier)
+		}
+	}
+	if redirect := p.redirect(); redirect != nil {
+		return redirect.DefaultResult()
+	}
+	// https://datatracker.ietf.org/doc/html/rfc7208#section-4.7
+	return "neutral"
+}
+
+func (p *SpfPolicy) redirect() *SpfPolicy {
+	if p.Parsed == nil {
+		return nil
+	}
+	for i := range p.Parsed.Modifiers {
+		if p.Parsed.Modifiers[i].Modifier != "redirect" {
+			continue
+		}
+		for j := range p.Includes {
+			if p.Includes[j].Domain == p.Parsed.Modifiers[i].Value {
+				return p.Includes[j]
+			}
+		}
+	}
+	return nil
+}
+
+// SpfResult converts a qualifier into the result it stands for
+func SpfResult(qualifier string) string {
+	switch qualifier {
+	case "-":
+		return "fail"
+	case "~":
+		return "softfail"
+	case "?":
+		return "neutral"
+	default:
+		return "pass"
+	}
+}
+
+// spfLookupMechanisms are the mechanisms that require DNS lookups
+var spfLookupMechanisms = map[string]struct{}{
+	"include": {},
+	"a":       {},
+	"mx":      {},
+	"ptr":     {},
+	"exists":  {},
+}
+
+// spfEvaluation is the state that all policies of one evaluation share,
+// since the lookup limits apply to the evaluation as a whole
+type spfEvaluation struct {
+	lookups int
+	voids   int
+	parents map[string]struct{}
+	// permerror is set once a lookup limit is exceeded, which stops the
+	// evaluation
+	permerror string
+}
+
+// lookup counts a DNS lookup and returns false once the limit is exceeded
+func (e *spfEvaluation) lookup() bool {
+	if e.permerror != "" {
+		return false
+	}
+	e.lookups++
+	if e.lookups > MaxSpfLookups {
+		e.permerror = "policy requires more than " + strconv.Itoa(MaxSpfLookups) + " DNS lookups"
+		return false
+	}
+	return true
+}
+
+// void counts a DNS lookup that returned no records
+func (e *spfEvaluation) void(name string) {
+	e.voids++
+	if e.voids > MaxSpfVoidLookups && e.permerror == "" {
+		e.permerror = "policy has more than " + strconv.Itoa(MaxSpfVoidLookups) + " DNS lookups without records, the last one for " + name
+	}
+}
+
+// LookupSpf finds the SPF record of the domain and resolves all policies
+// that it includes or redirects to. The evaluation stops with a permerror
+// as soon as it exceeds the limit of DNS lookups or void lookups.
+func (d *DnsClient) LookupSpf(domain string) (*SpfPolicy, error) {
+	e := &spfEvaluation{parents: map[string]struct{}{}}
+	res, err := d.lookupSpf(strings.TrimSuffix(domain, "."), e)
+	if err != nil {
+		return nil, err
+	}
+	if e.permerror != "" {
+		res.Errors = append(res.Errors, e.permerror)
+	}
+	return res, nil
+}
+
+func (d *DnsClient) lookupSpf(domain string, e *spfEvaluation) (*SpfPolicy, error) {
+	res := &SpfPolicy{Domain: domain}
+	start := e.lookups
+	defer func() {
+		res.Lookups = e.lookups - start
+		res.PermError = e.permerror != ""
+	}()
+
+	txts, err := d.LookupTXT(domain)
+	if err != nil {
+		return nil, err
+	}
+	// only the lookups of includes and redirects count, not the lookup of
+	// the domain that is evaluated
+	if len(txts) == 0 && len(e.parents) > 0 {
+		e.void(domain)
+	}
+	var records []string
+	for i := range txts {
+		if txts[i] == "v=spf1" || strings.HasPrefix(txts[i], "v=spf1 ") {
+			records = append(records, txts[i])
+		}
+	}
+	switch len(records) {
+	case 0:
+		res.Errors = append(res.Errors, domain+": no SPF record found")
+		return res, nil
+	case 1:
+		res.Record = records[0]
+	default:
+		res.Errors = append(res.Errors, domain+": found "+strconv.Itoa(len(records))+" SPF records, only one is allowed")
+		return res, nil
+	}
+
+	res.Parsed, err = NewSpf().Parse(res.Record)
+	if err != nil {
+		res.Errors = append(res.Errors, domain+": failed to parse SPF record: "+err.Error())
+		return res, nil
+	}
+
+	e.parents[domain] = struct{}{}
+	defer delete(e.parents, domain)
+
+	for _, directive := range res.Parsed.Directives {
+		if _, ok := spfLookupMechanisms[directive.Mechanism]; !ok {
+			continue
+		}
+		if !e.lookup() {
+			return res, nil
+		}
+
+		switch directive.Mechanism {
+		case "include":
+			err = d.includeSpf(res, directive.Value, e)
+		case "a", "mx", "exists":
+			target := directive.Value
+			if target == "" {
+				target = domain
+			}
+			err = d.resolveSpfMechanism(directive.Mechanism, target, e)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if e.permerror != "" {
+			return res, nil
+		}
+	}
+
+	for _, modifier := range res.Parsed.Modifiers {
+		if modifier.Modifier != "redirect" {
+			continue
+		}
+		if !e.lookup() {
+			return res, nil
+		}
+		if err := d.includeSpf(res, modifier.Value, e); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// includeSpf resolves the policy of an include mechanism or redirect modifier
+func (d *DnsClient) includeSpf(res *SpfPolicy, target string, e *spfEvaluation) error {
+	if strings.Contains(target, "%") {
+		// macros can only be expanded for a specific sender
+		return nil
+	}
+	if _, ok := e.parents[target]; ok {
+		res.Errors = append(res.Errors, res.Domain+": include loop via "+target)
+		return nil
+	}
+
+	include, err := d.lookupSpf(target, e)
+	if err != nil {
+		return errors.New("failed to resolve SPF include " + target + ": " + err.Error())
+	}
+	res.Includes = append(res.Includes, include)
+	res.Errors = append(res.Errors, include.Errors...)
+	return nil
+}
+
+// resolveSpfMechanism looks up the records of an a, mx or exists mechanism
+// to count void lookups
+func (d *DnsClient) resolveSpfMechanism(mechanism string, target string, e *spfEvaluation) error {
+	if strings.Contains(target, "%") {
+		// macros can only be expanded for a specific sender
+		return nil
+	}
+
+	dnsTypes := []uint16{dns.TypeA}
+	switch mechanism {
+	case "a":
+		// the sender address decides between A and AAAA, which is unknown here
+		dnsTypes = append(dnsTypes, dns.TypeAAAA)
+	case "mx":
+		dnsTypes = []uint16{dns.TypeMX}
+	}
+
+	for _, dnsType := range dnsTypes {
+		rrs, err := d.lookup(target, dnsType)
+		if err != nil {
+			return errors.New("failed to resolve SPF mechanism " + mechanism + ":" + target + ": " + err.Error())
+		}
+		if len(rrs) > 0 {
+			return nil
+		}
+	}
+	e.void(target)
+	return nil
+}
@@ -227,6 +227,28 @@ func TestRfcRecords(t *testing.T) {
 				},
 			},
 		},
+		{
+			Title:        "Softfail",
+			DnsTxtRecord: "v=spf1 ip4:192.0.2.0/24 ?mx ~all",
+			Expected: &SpfRecord{
+				Version: "spf1",
+				Directives: []Directive{
+					{
+						Mechanism: "ip4",
+						Value:     "192.0.2.0",
+						CIDR:      "24",
+					},
+					{
+						Qualifier: "?",
+						Mechanism: "mx",
+					},
+					{
+						Qualifier: "~",
+						Mechanism: "all",
+					},
+				},
+			},
+		},
 		{
 			Title:        "Explain",
 			DnsTxtRecord: "v=spf1 mx -all exp=explain._spf.%{d}",
@@ -263,3 +285,105 @@ func TestRfcRecords(t *testing.T) {
 		})
 	}
 }
+
+func TestLookupSpf(t *testing.T) {
+	srv := &testDnsServer{}
+	srv.add(t,
+		`example.com. 300 IN TXT "v=spf1 include:_spf.mail.test include:_spf.crm.test mx -all"`,
+		`example.com. 300 IN TXT "google-site-verification=abc"`,
+		`example.com. 300 IN MX 10 mail.example.com.`,
+		`_spf.mail.test. 300 IN TXT "v=spf1 ip4:192.0.2.0/24 a:relay.mail.test ~all"`,
+		`relay.mail.test. 300 IN A 192.0.2.25`,
+		`_spf.crm.test. 300 IN TXT "v=spf1 ip4:198.51.100.10 ?all"`,
+		`redirect.test. 300 IN TXT "v=spf1 redirect=_spf.mail.test"`,
+		`loop.test. 300 IN TXT "v=spf1 include:loop2.test -all"`,
+		`loop2.test. 300 IN TXT "v=spf1 include:loop.test -all"`,
+		`missing.test. 300 IN TXT "v=spf1 include:nothing.test -all"`,
+		`twice.test. 300 IN TXT "v=spf1 -all"`,
+		`twice.test. 300 IN TXT "v=spf1 mx -all"`,
+		`big.test. 300 IN TXT "v=spf1 include:l1.test include:l2.test include:l3.test include:l4.test -all"`,
+		`l1.test. 300 IN TXT "v=spf1 a mx -all"`,
+		`l2.test. 300 IN TXT "v=spf1 a mx -all"`,
+		`l3.test. 300 IN TXT "v=spf1 a mx -all"`,
+		`l4.test. 300 IN TXT "v=spf1 a mx -all"`,
+		`l1.test. 300 IN A 192.0.2.1`, `l1.test. 300 IN MX 10 l1.test.`,
+		`l2.test. 300 IN A 192.0.2.2`, `l2.test. 300 IN MX 10 l2.test.`,
+		`l3.test. 300 IN A 192.0.2.3`, `l3.test. 300 IN MX 10 l3.test.`,
+		`l4.test. 300 IN AAAA 2001:db8::4`, `l4.test. 300 IN MX 10 l4.test.`,
+		`void.test. 300 IN TXT "v=spf1 a:n1.test mx:n2.test include:n3.test exists:n4.test -all"`,
+		`twovoids.test. 300 IN TXT "v=spf1 a:n1.test mx:n2.test -all"`,
+	)
+	client := srv.start(t)
+
+	t.Run("includes", func(t *testing.T) {
+		policy, err := client.LookupSpf("example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "v=spf1 include:_spf.mail.test include:_spf.crm.test mx -all", policy.Record)
+		require.Len(t, policy.Includes, 2)
+		assert.Equal(t, "_spf.mail.test", policy.Includes[0].Domain)
+		assert.Equal(t, "_spf.crm.test", policy.Includes[1].Domain)
+		assert.Equal(t, 4, policy.Lookups)
+		assert.Equal(t, "fail", policy.DefaultResult())
+		assert.Empty(t, policy.Errors)
+		assert.True(t, policy.Valid())
+	})
+
+	t.Run("redirect", func(t *testing.T) {
+		policy, err := client.LookupSpf("redirect.test")
+		require.NoError(t, err)
+		assert.Equal(t, 2, policy.Lookups)
+		assert.Equal(t, "softfail", policy.DefaultResult())
+	})
+
+	t.Run("lookup limit", func(t *testing.T) {
+		policy, err := client.LookupSpf("big.test")
+		require.NoError(t, err)
+		// the evaluation stops at the first lookup over the limit
+		assert.Equal(t, 11, policy.Lookups)
+		assert.False(t, policy.Valid())
+		assert.Equal(t, SpfPermError, policy.DefaultResult())
+		assert.Equal(t, []string{"policy requires more than 10 DNS lookups"}, policy.Errors)
+		require.Len(t, policy.Includes, 4)
+		assert.False(t, policy.Includes[0].PermError)
+		assert.Equal(t, "fail", policy.Includes[0].DefaultResult())
+		assert.True(t, policy.Includes[3].PermError)
+		assert.Equal(t, 1, policy.Includes[3].Lookups)
+	})
+
+	t.Run("void lookup limit", func(t *testing.T) {
+		policy, err := client.LookupSpf("twovoids.test")
+		require.NoError(t, err)
+		assert.True(t, policy.Valid())
+		assert.Equal(t, "fail", policy.DefaultResult())
+
+		policy, err = client.LookupSpf("void.test")
+		require.NoError(t, err)
+		assert.False(t, policy.Valid())
+		assert.Equal(t, SpfPermError, policy.DefaultResult())
+		assert.Equal(t, 3, policy.Lookups)
+		assert.Equal(t, []string{
+			"n3.test: no SPF record found",
+			"policy has more than 2 DNS lookups without records, the last one for n3.test",
+		}, policy.Errors)
+	})
+
+	t.Run("loop", func(t *testing.T) {
+		policy, err := client.LookupSpf("loop.test")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"loop2.test: include loop via loop.test"}, policy.Errors)
+	})
+
+	t.Run("missing include", func(t *testing.T) {
+		policy, err := client.LookupSpf("missing.test")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"nothing.test: no SPF record found"}, policy.Errors)
+	})
+
+	t.Run("multiple records", func(t *testing.T) {
+		policy, err := client.LookupSpf("twice.test")
+		require.NoError(t, err)
+		assert.Nil(t, policy.Parsed)
+		assert.False(t, policy.Valid())
+		assert.Equal(t, []string{"twice.test: found 2 SPF records, only one is allowed"}, policy.Errors)
+	})
+}
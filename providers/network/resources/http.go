@@ -27,6 +27,15 @@ type mqlHttpGetInternal struct {
 }
 
 func initHttpGet(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if err := initHttpUrl(runtime, args, "http.get"); err != nil {
+		return nil, nil, err
+	}
+	return args, nil, nil
+}
+
+// initHttpUrl turns the rawUrl argument into a url resource. If it's missing,
+// the URL is taken from the connection instead.
+func initHttpUrl(runtime *plugin.Runtime, args map[string]*llx.RawData, resource string) error {
 	if rawUrl, ok := args["rawUrl"]; ok {
 		// We add a default prefix if it is missing. Otherwise the URL parser
 		// will return unintuitive results. For example: "mondoo.com" would
@@ -42,7 +51,7 @@ func initHttpGet(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[str
 			"scheme": llx.StringData("http"),
 		})
 		if err != nil {
-			return nil, nil, err
+			return err
 		}
 
 		delete(args, "rawUrl")
@@ -52,7 +61,7 @@ func initHttpGet(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[str
 	if _, ok := args["url"]; !ok {
 		conn := runtime.Connection.(*connection.HostConnection)
 		if conn.Conf == nil {
-			return nil, nil, errors.New("missing URL for http.get")
+			return errors.New("missing URL for " + resource)
 		}
 
 		scheme := conn.Conf.Runtime
@@ -73,12 +82,12 @@ func initHttpGet(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[str
 			"scheme": llx.StringData(scheme),
 		})
 		if err != nil {
-			return nil, nil, err
+			return err
 		}
 		args["url"] = llx.ResourceData(url, "url")
 	}
 
-	return args, nil, nil
+	return nil
 }
 
 func (x *mqlHttpGet) id() (string, error) {
@@ -118,7 +127,10 @@ func (x *mqlHttpGet) header() (*mqlHttpHeader, error) {
 		return nil, err
 	}
 
-	header := x.resp.Data.Header
+	return newHttpHeader(x.MqlRuntime, x.__id, x.resp.Data.Header)
+}
+
+func newHttpHeader(runtime *plugin.Runtime, id string, header http.Header) (*mqlHttpHeader, error) {
 	params := make(map[string]interface{}, len(header))
 	for key := range header {
 		mkey := textproto.CanonicalMIMEHeaderKey(key)
@@ -130,8 +142,8 @@ func (x *mqlHttpGet) header() (*mqlHttpHeader, error) {
 		params[normalizeHeaderKey(mkey)] = ivals
 	}
 
-	o, err := CreateResource(x.MqlRuntime, "http.header", map[string]*llx.RawData{
-		"__id":   llx.StringData(x.__id),
+	o, err := CreateResource(runtime, "http.header", map[string]*llx.RawData{
+		"__id":   llx.StringData(id),
 		"params": llx.MapData(params, types.Array(types.String)),
 	})
 	if err != nil {
@@ -319,12 +331,27 @@ func (x *mqlHttpHeaderXssProtection) id() (string, error) {
 
 func (x *mqlHttpHeader) xContentTypeOptions() (string, error) {
 	params, ok := x.Params.Data["X-Content-Type-Options"]
-	return parseSingleHeaderValue(params, ok, &x.XFrameOptions)
+	return parseSingleHeaderValue(params, ok, &x.XContentTypeOptions)
 }
 
 func (x *mqlHttpHeader) referrerPolicy() (string, error) {
 	params, ok := x.Params.Data["Referrer-Policy"]
-	return parseSingleHeaderValue(params, ok, &x.XFrameOptions)
+	return parseSingleHeaderValue(params, ok, &x.ReferrerPolicy)
+}
+
+func (x *mqlHttpHeader) crossOriginOpenerPolicy() (string, error) {
+	params, ok := x.Params.Data["Cross-Origin-Opener-Policy"]
+	return parseSingleHeaderValue(params, ok, &x.CrossOriginOpenerPolicy)
+}
+
+func (x *mqlHttpHeader) crossOriginEmbedderPolicy() (string, error) {
+	params, ok := x.Params.Data["Cross-Origin-Embedder-Policy"]
+	return parseSingleHeaderValue(params, ok, &x.CrossOriginEmbedderPolicy)
+}
+
+func (x *mqlHttpHeader) crossOriginResourcePolicy() (string, error) {
+	params, ok := x.Params.Data["Cross-Origin-Resource-Policy"]
+	return parseSingleHeaderValue(params, ok, &x.CrossOriginResourcePolicy)
 }
 
 func (x *mqlHttpHeader) contentType() (*mqlHttpHeaderContentType, error) {
@@ -370,15 +397,37 @@ func (x *mqlHttpHeaderContentType) id() (string, error) {
 
 func (x *mqlHttpHeader) setCookie() (*mqlHttpHeaderSetCookie, error) {
 	raw, ok := x.Params.Data["Set-Cookie"]
-	if !ok {
+	if !ok || len(raw.([]interface{})) == 0 {
 		x.SetCookie.State = plugin.StateIsSet | plugin.StateIsNull
 		return nil, nil
 	}
 
+	return newHttpHeaderSetCookie(x.MqlRuntime, raw.([]interface{})[0].(string))
+}
+
+func (x *mqlHttpHeader) cookies() ([]interface{}, error) {
+	raw, ok := x.Params.Data["Set-Cookie"]
+	if !ok {
+		return []interface{}{}, nil
+	}
+
+	arr := raw.([]interface{})
+	res := make([]interface{}, len(arr))
+	for i := range arr {
+		cookie, err := newHttpHeaderSetCookie(x.MqlRuntime, arr[i].(string))
+		if err != nil {
+			return nil, err
+		}
+		res[i] = cookie
+	}
+	return res, nil
+}
+
+func newHttpHeaderSetCookie(runtime *plugin.Runtime, raw string) (*mqlHttpHeaderSetCookie, error) {
 	cname := llx.NilData
 	cval := llx.NilData
 	params := llx.NilData
-	parseHeaderFields(raw.([]interface{}), func(key string, value string) {
+	parseHeaderFields([]interface{}{raw}, func(key string, value string) {
 		if cname.Value == nil && value != "" {
 			cname = llx.StringData(key)
 			cval = llx.StringData(value)
@@ -390,7 +439,7 @@ func (x *mqlHttpHeader) setCookie() (*mqlHttpHeaderSetCookie, error) {
 		params.Value.(map[string]interface{})[key] = value
 	})
 
-	o, err := CreateResource(x.MqlRuntime, "http.header.setCookie", map[string]*llx.RawData{
+	o, err := CreateResource(runtime, "http.header.setCookie", map[string]*llx.RawData{
 		"name":   cname,
 		"value":  cval,
 		"params": params,
@@ -416,6 +465,229 @@ func (x *mqlHttpHeader) csp() (map[string]interface{}, error) {
 	return m, nil
 }
 
+func (x *mqlHttpHeader) contentSecurityPolicy() (*mqlHttpHeaderCsp, error) {
+	raw, ok := x.Params.Data["Content-Security-Policy"]
+	if !ok {
+		x.ContentSecurityPolicy.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+	return newHttpHeaderCsp(x.MqlRuntime, x.__id+"/csp", raw.([]interface{}), false)
+}
+
+func (x *mqlHttpHeader) contentSecurityPolicyReportOnly() (*mqlHttpHeaderCsp, error) {
+	raw, ok := x.Params.Data["Content-Security-Policy-Report-Only"]
+	if !ok {
+		x.ContentSecurityPolicyReportOnly.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+	return newHttpHeaderCsp(x.MqlRuntime, x.__id+"/cspReportOnly", raw.([]interface{}), true)
+}
+
+// parseCsp returns the directives of all policies. Multiple policies may be
+// sent in separate headers or separated by commas. Like browsers do within
+// a policy, only the first occurrence of a directive is used.
+func parseCsp(raw []interface{}) map[string][]string {
+	res := map[string][]string{}
+	for i := range raw {
+		for _, policy := range strings.Split(raw[i].(string), ",") {
+			for _, directive := range strings.Split(policy, ";") {
+				fields := strings.Fields(directive)
+				if len(fields) == 0 {
+					continue
+				}
+				name := strings.ToLower(fields[0])
+				if _, ok := res[name]; ok {
+					continue
+				}
+				res[name] = fields[1:]
+			}
+		}
+	}
+	return res
+}
+
+// scriptSources returns the sources that apply to scripts and whether any were set
+func scriptSources(directives map[string][]string) ([]string, bool) {
+	if sources, ok := directives["script-src"]; ok {
+		return sources, true
+	}
+	sources, ok := directives["default-src"]
+	return sources, ok
+}
+
+func hasCspSource(sources []string, source string) bool {
+	for i := range sources {
+		if strings.EqualFold(sources[i], source) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasCspNonceOrHash checks for nonces and hashes, which make browsers ignore 'unsafe-inline'
+func hasCspNonceOrHash(sources []string) bool {
+	for i := range sources {
+		source := strings.ToLower(sources[i])
+		if strings.HasPrefix(source, "'nonce-") || strings.HasPrefix(source, "'sha256-") ||
+			strings.HasPrefix(source, "'sha384-") || strings.HasPrefix(source, "'sha512-") {
+			return true
+		}
+	}
+	return false
+}
+
+func newHttpHeaderCsp(runtime *plugin.Runtime, id string, raw []interface{}, reportOnly bool) (*mqlHttpHeaderCsp, error) {
+	directives := parseCsp(raw)
+	sources, hasSources := scriptSources(directives)
+
+	unsafeInline := hasCspSource(sources, "'unsafe-inline'") && !hasCspNonceOrHash(sources)
+	unsafeEval := hasCspSource(sources, "'unsafe-eval'")
+	unsafeScriptSources := !hasSources || hasCspSource(sources, "*") ||
+		hasCspSource(sources, "http:") || hasCspSource(sources, "https:")
+
+	reportTo := ""
+	if to := directives["report-to"]; len(to) != 0 {
+		reportTo = to[0]
+	}
+
+	rawDirectives := make(map[string]interface{}, len(directives))
+	for name, sources := range directives {
+		rawDirectives[name] = llx.TArr2Raw(sources)
+	}
+
+	o, err := CreateResource(runtime, "http.header.csp", map[string]*llx.RawData{
+		"__id":                llx.StringData(id),
+		"directives":          llx.MapData(rawDirectives, types.Array(types.String)),
+		"reportOnly":          llx.BoolData(reportOnly),
+		"unsafeInline":        llx.BoolData(unsafeInline),
+		"unsafeEval":          llx.BoolData(unsafeEval),
+		"unsafeScriptSources": llx.BoolData(unsafeScriptSources),
+		"reportUri":           llx.ArrayData(llx.TArr2Raw(directives["report-uri"]), types.String),
+		"reportTo":            llx.StringData(reportTo),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o.(*mqlHttpHeaderCsp), nil
+}
+
+// permissionsPolicy parses the structured field dictionary of this header,
+// e.g. geolocation=(), camera=(self "https://example.com"), fullscreen=*
+func (x *mqlHttpHeader) permissionsPolicy() (map[string]interface{}, error) {
+	raw, ok := x.Params.Data["Permissions-Policy"]
+	if !ok {
+		x.PermissionsPolicy.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	res := map[string]interface{}{}
+	parseHeaderFieldsD(raw.([]interface{}), func(key string, value string) {
+		if key == "" {
+			return
+		}
+		// drop parameters of the member, e.g. ;report-to=endpoint
+		if idx := strings.Index(value, ";"); idx != -1 {
+			value = value[:idx]
+		}
+		value = strings.TrimSpace(value)
+		value = strings.TrimSuffix(strings.TrimPrefix(value, "("), ")")
+
+		origins := []interface{}{}
+		for _, origin := range strings.Fields(value) {
+			origins = append(origins, strings.Trim(origin, `"`))
+		}
+		res[key] = origins
+	}, ",", "=")
+
+	return res, nil
+}
+
+func (x *mqlHttpHeaderSts) preloadReady() (bool, error) {
+	// see https://hstspreload.org/#submission-requirements
+	const minPreloadMaxAge = 365 * 24 * 60 * 60
+	if x.MaxAge.Data == nil || llx.TimeToDuration(x.MaxAge.Data) < minPreloadMaxAge {
+		return false, nil
+	}
+	return x.IncludeSubDomains.Data && x.Preload.Data, nil
+}
+
+// param returns a cookie attribute, whose names are case-insensitive
+func (x *mqlHttpHeaderSetCookie) param(name string) (string, bool) {
+	for key, value := range x.Params.Data {
+		if strings.EqualFold(key, name) {
+			return value.(string), true
+		}
+	}
+	return "", false
+}
+
+func (x *mqlHttpHeaderSetCookie) domain() (string, error) {
+	domain, _ := x.param("Domain")
+	return domain, nil
+}
+
+func (x *mqlHttpHeaderSetCookie) path() (string, error) {
+	path, _ := x.param("Path")
+	return path, nil
+}
+
+// cookieTimeFormats are the formats of the Expires attribute that are used in practice
+var cookieTimeFormats = []string{
+	time.RFC1123,
+	"Mon, 02-Jan-2006 15:04:05 MST",
+	time.RFC850,
+	time.ANSIC,
+}
+
+func (x *mqlHttpHeaderSetCookie) expires() (*time.Time, error) {
+	raw, ok := x.param("Expires")
+	if !ok {
+		x.Expires.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	for _, format := range cookieTimeFormats {
+		if t, err := time.Parse(format, raw); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, errors.New("invalid Expires attribute: " + raw)
+}
+
+func (x *mqlHttpHeaderSetCookie) maxAge() (int64, error) {
+	raw, ok := x.param("Max-Age")
+	if !ok {
+		x.MaxAge.State = plugin.StateIsSet | plugin.StateIsNull
+		return 0, nil
+	}
+
+	age, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, errors.New("invalid Max-Age attribute: " + raw)
+	}
+	return age, nil
+}
+
+func (x *mqlHttpHeaderSetCookie) secure() (bool, error) {
+	_, ok := x.param("Secure")
+	return ok, nil
+}
+
+func (x *mqlHttpHeaderSetCookie) httpOnly() (bool, error) {
+	_, ok := x.param("HttpOnly")
+	return ok, nil
+}
+
+func (x *mqlHttpHeaderSetCookie) sameSite() (string, error) {
+	sameSite, _ := x.param("SameSite")
+	return sameSite, nil
+}
+
+func (x *mqlHttpHeaderSetCookie) partitioned() (bool, error) {
+	_, ok := x.param("Partitioned")
+	return ok, nil
+}
+
 func (x *mqlHttpHeaderSetCookie) id() (string, error) {
 	// cookies may be very long, so it's more efficient to checksum them
 	res := checksums.New.Add(x.Name.Data).Add(x.Value.Data)
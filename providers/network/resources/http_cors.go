@@ -0,0 +1,160 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+)
+
+// defaultCorsOrigin is an origin that no server should trust
+const defaultCorsOrigin = "https://example.com"
+
+type mqlHttpCorsInternal struct {
+	lock sync.Mutex
+	resp plugin.TValue[*http.Response]
+}
+
+func initHttpCors(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if err := initHttpUrl(runtime, args, "http.cors"); err != nil {
+		return nil, nil, err
+	}
+
+	if origin, ok := args["origin"]; !ok || origin.Value == nil || origin.Value.(string) == "" {
+		args["origin"] = llx.StringData(defaultCorsOrigin)
+	}
+	if method, ok := args["method"]; ok && method.Value != nil && method.Value.(string) != "" {
+		args["method"] = llx.StringData(strings.ToUpper(method.Value.(string)))
+	} else {
+		args["method"] = llx.StringData(http.MethodGet)
+	}
+	return args, nil, nil
+}
+
+func (x *mqlHttpCors) id() (string, error) {
+	return x.Url.Data.__id + " origin=" + x.Origin.Data + " method=" + x.Method.Data, nil
+}
+
+// do sends the preflight request
+func (x *mqlHttpCors) do() error {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	if x.resp.State&plugin.StateIsSet != 0 {
+		return x.resp.Error
+	}
+	x.resp.State = plugin.StateIsSet
+
+	if x.Url.Data == nil {
+		x.resp.Error = errors.New("missing URL for http.cors")
+		return x.resp.Error
+	}
+
+	req, err := http.NewRequest(http.MethodOptions, x.Url.Data.String.Data, nil)
+	if err != nil {
+		x.resp.Error = err
+		return err
+	}
+	req.Header.Set("Origin", x.Origin.Data)
+	req.Header.Set("Access-Control-Request-Method", x.Method.Data)
+
+	client := newHttpClient(x.MqlRuntime, defaultHttpTimeout*time.Second)
+	// the policy of the requested URL matters, not the one of a redirect target
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		x.resp.Error = err
+		return err
+	}
+	resp.Body.Close()
+	x.resp.Data = resp
+
+	allowOrigin := resp.Header.Get("Access-Control-Allow-Origin")
+	reflectsOrigin := allowOrigin != "" && allowOrigin == x.Origin.Data
+
+	var maxAge plugin.TValue[*time.Time]
+	if age, err := strconv.ParseInt(resp.Header.Get("Access-Control-Max-Age"), 10, 64); err == nil {
+		t := llx.DurationToTime(age)
+		maxAge = plugin.TValue[*time.Time]{Data: &t, State: plugin.StateIsSet}
+	} else {
+		maxAge = plugin.TValue[*time.Time]{State: plugin.StateIsSet | plugin.StateIsNull}
+	}
+
+	x.StatusCode = plugin.TValue[int64]{Data: int64(resp.StatusCode), State: plugin.StateIsSet}
+	x.AllowOrigin = plugin.TValue[string]{Data: allowOrigin, State: plugin.StateIsSet}
+	x.AllowCredentials = plugin.TValue[bool]{Data: strings.EqualFold(resp.Header.Get("Access-Control-Allow-Credentials"), "true"), State: plugin.StateIsSet}
+	x.AllowMethods = plugin.TValue[[]interface{}]{Data: corsList(resp.Header.Values("Access-Control-Allow-Methods")), State: plugin.StateIsSet}
+	x.AllowHeaders = plugin.TValue[[]interface{}]{Data: corsList(resp.Header.Values("Access-Control-Allow-Headers")), State: plugin.StateIsSet}
+	x.ExposeHeaders = plugin.TValue[[]interface{}]{Data: corsList(resp.Header.Values("Access-Control-Expose-Headers")), State: plugin.StateIsSet}
+	x.MaxAge = maxAge
+	x.ReflectsOrigin = plugin.TValue[bool]{Data: reflectsOrigin, State: plugin.StateIsSet}
+	x.AllowsAnyOrigin = plugin.TValue[bool]{Data: allowOrigin == "*" || reflectsOrigin, State: plugin.StateIsSet}
+	return nil
+}
+
+// corsList splits comma-separated header values
+func corsList(values []string) []interface{} {
+	res := []interface{}{}
+	for i := range values {
+		for _, value := range strings.Split(values[i], ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				res = append(res, value)
+			}
+		}
+	}
+	return res
+}
+
+func (x *mqlHttpCors) statusCode() (int64, error) {
+	return 0, x.do()
+}
+
+func (x *mqlHttpCors) header() (*mqlHttpHeader, error) {
+	if err := x.do(); err != nil {
+		return nil, err
+	}
+	return newHttpHeader(x.MqlRuntime, x.__id, x.resp.Data.Header)
+}
+
+func (x *mqlHttpCors) allowOrigin() (string, error) {
+	return "", x.do()
+}
+
+func (x *mqlHttpCors) allowCredentials() (bool, error) {
+	return false, x.do()
+}
+
+func (x *mqlHttpCors) allowMethods() ([]interface{}, error) {
+	return nil, x.do()
+}
+
+func (x *mqlHttpCors) allowHeaders() ([]interface{}, error) {
+	return nil, x.do()
+}
+
+func (x *mqlHttpCors) exposeHeaders() ([]interface{}, error) {
+	return nil, x.do()
+}
+
+func (x *mqlHttpCors) maxAge() (*time.Time, error) {
+	return nil, x.do()
+}
+
+func (x *mqlHttpCors) reflectsOrigin() (bool, error) {
+	return false, x.do()
+}
+
+func (x *mqlHttpCors) allowsAnyOrigin() (bool, error) {
+	return false, x.do()
+}
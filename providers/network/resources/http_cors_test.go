@@ -0,0 +1,77 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/network/connection"
+)
+
+func TestResource_HttpCors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodOptions {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		switch r.URL.Path {
+		case "/reflect":
+			w.Header().Set("Access-Control-Allow-Origin", r.Header.Get("Origin"))
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+			w.Header().Set("Access-Control-Allow-Methods", r.Header.Get("Access-Control-Request-Method")+", OPTIONS")
+			w.Header().Set("Access-Control-Max-Age", "600")
+		case "/strict":
+			w.Header().Set("Access-Control-Allow-Origin", "https://app.example.org")
+			w.Header().Add("Access-Control-Allow-Headers", "Authorization")
+			w.Header().Add("Access-Control-Allow-Headers", "Content-Type")
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	newCors := func(args map[string]*llx.RawData) *mqlHttpCors {
+		runtime := &plugin.Runtime{
+			Connection: connection.NewHostConnection(1, nil, &inventory.Config{}),
+		}
+		res, err := NewResource(runtime, "http.cors", args)
+		require.NoError(t, err)
+		return res.(*mqlHttpCors)
+	}
+
+	t.Run("reflected origin", func(t *testing.T) {
+		cors := newCors(map[string]*llx.RawData{
+			"rawUrl": llx.StringData(srv.URL + "/reflect"),
+			"method": llx.StringData("delete"),
+		})
+		allowOrigin := cors.GetAllowOrigin()
+		require.NoError(t, allowOrigin.Error)
+		assert.Equal(t, defaultCorsOrigin, allowOrigin.Data)
+		assert.Equal(t, int64(204), cors.GetStatusCode().Data)
+		assert.True(t, cors.GetReflectsOrigin().Data)
+		assert.True(t, cors.GetAllowsAnyOrigin().Data)
+		assert.True(t, cors.GetAllowCredentials().Data)
+		assert.Equal(t, []interface{}{"DELETE", "OPTIONS"}, cors.GetAllowMethods().Data)
+		assert.Equal(t, int64(600), llx.TimeToDuration(cors.GetMaxAge().Data))
+	})
+
+	t.Run("fixed origin", func(t *testing.T) {
+		cors := newCors(map[string]*llx.RawData{
+			"rawUrl": llx.StringData(srv.URL + "/strict"),
+			"origin": llx.StringData("https://attacker.example.net"),
+		})
+		assert.Equal(t, "https://app.example.org", cors.GetAllowOrigin().Data)
+		assert.False(t, cors.GetReflectsOrigin().Data)
+		assert.False(t, cors.GetAllowsAnyOrigin().Data)
+		assert.False(t, cors.GetAllowCredentials().Data)
+		assert.Equal(t, []interface{}{"Authorization", "Content-Type"}, cors.GetAllowHeaders().Data)
+		assert.True(t, cors.GetMaxAge().State&plugin.StateIsNull != 0)
+	})
+}
@@ -0,0 +1,311 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mondoo.com/cnquery/v9/checksums"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/vault"
+	"go.mondoo.com/cnquery/v9/providers/network/connection"
+	"go.mondoo.com/cnquery/v9/types"
+	"go.mondoo.com/cnquery/v9/utils/sortx"
+)
+
+const (
+	defaultHttpTimeout  = 30
+	maxHttpRedirects    = 10
+	httpAuthBasic       = "basic"
+	httpAuthBearer      = "bearer"
+	maxHttpResponseBody = 10 << 20
+)
+
+// newHttpClient returns the client for all HTTP requests of the provider.
+// Certificates are not verified if the connection is configured as insecure.
+func newHttpClient(runtime *plugin.Runtime, timeout time.Duration) *http.Client {
+	var insecure bool
+	if conn, ok := runtime.Connection.(*connection.HostConnection); ok && conn.Conf != nil {
+		insecure = conn.Conf.Insecure
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure},
+		},
+	}
+}
+
+type mqlHttpRequestInternal struct {
+	lock     sync.Mutex
+	resp     plugin.TValue[*http.Response]
+	respBody []byte
+	hops     []*http.Response
+	elapsed  time.Duration
+}
+
+func initHttpRequest(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if err := initHttpUrl(runtime, args, "http.request"); err != nil {
+		return nil, nil, err
+	}
+
+	if method, ok := args["method"]; ok && method.Value != nil {
+		args["method"] = llx.StringData(strings.ToUpper(method.Value.(string)))
+	} else {
+		args["method"] = llx.StringData(http.MethodGet)
+	}
+	if _, ok := args["requestHeaders"]; !ok {
+		args["requestHeaders"] = llx.MapData(map[string]interface{}{}, types.String)
+	}
+	if _, ok := args["requestBody"]; !ok {
+		args["requestBody"] = llx.StringData("")
+	}
+	if _, ok := args["followRedirects"]; !ok {
+		args["followRedirects"] = llx.BoolTrue
+	}
+	if _, ok := args["timeout"]; !ok {
+		args["timeout"] = llx.IntData(defaultHttpTimeout)
+	}
+	if auth, ok := args["auth"]; ok && auth.Value != nil {
+		switch strings.ToLower(auth.Value.(string)) {
+		case "", httpAuthBasic, httpAuthBearer:
+			args["auth"] = llx.StringData(strings.ToLower(auth.Value.(string)))
+		default:
+			return nil, nil, errors.New("unsupported auth '" + auth.Value.(string) + "' for http.request, supported are: basic, bearer")
+		}
+	} else {
+		args["auth"] = llx.StringData("")
+	}
+
+	return args, nil, nil
+}
+
+func (x *mqlHttpRequest) id() (string, error) {
+	res := checksums.New.
+		Add(x.Method.Data).
+		Add(x.Url.Data.__id).
+		Add(x.RequestBody.Data).
+		Add(strconv.FormatBool(x.FollowRedirects.Data)).
+		Add(strconv.FormatInt(x.Timeout.Data, 10)).
+		Add(x.Auth.Data)
+	for _, key := range sortx.Keys(x.RequestHeaders.Data) {
+		res = res.Add(key).Add(x.RequestHeaders.Data[key].(string))
+	}
+	return x.Method.Data + " " + x.Url.Data.__id + " " + res.String(), nil
+}
+
+// httpCredential returns the first credential of the asset with one of
+// the given types. Credentials may have been retrieved from a vault.
+func httpCredential(runtime *plugin.Runtime, typ vault.CredentialType) (*vault.Credential, error) {
+	conn := runtime.Connection.(*connection.HostConnection)
+	if conn.Conf != nil {
+		for _, cred := range conn.Conf.Credentials {
+			if cred.Type == typ {
+				return cred, nil
+			}
+		}
+	}
+	return nil, errors.New("no " + typ.String() + " credentials configured for this asset")
+}
+
+func (x *mqlHttpRequest) newRequest() (*http.Request, error) {
+	var body io.Reader
+	if x.RequestBody.Data != "" {
+		body = strings.NewReader(x.RequestBody.Data)
+	}
+
+	req, err := http.NewRequest(x.Method.Data, x.Url.Data.String.Data, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range x.RequestHeaders.Data {
+		req.Header.Set(key, value.(string))
+	}
+
+	switch x.Auth.Data {
+	case httpAuthBasic:
+		cred, err := httpCredential(x.MqlRuntime, vault.CredentialType_password)
+		if err != nil {
+			return nil, err
+		}
+		req.SetBasicAuth(cred.User, string(cred.Secret))
+	case httpAuthBearer:
+		cred, err := httpCredential(x.MqlRuntime, vault.CredentialType_bearer)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+string(cred.Secret))
+	}
+
+	return req, nil
+}
+
+func (x *mqlHttpRequest) do() error {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	if x.resp.State&plugin.StateIsSet != 0 {
+		return x.resp.Error
+	}
+	x.resp.State = plugin.StateIsSet
+
+	if x.Url.Data == nil {
+		x.resp.Error = errors.New("missing URL for http.request")
+		return x.resp.Error
+	}
+
+	req, err := x.newRequest()
+	if err != nil {
+		x.resp.Error = err
+		return err
+	}
+
+	client := newHttpClient(x.MqlRuntime, time.Duration(x.Timeout.Data)*time.Second)
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if !x.FollowRedirects.Data {
+			return http.ErrUseLastResponse
+		}
+		if len(via) >= maxHttpRedirects {
+			return errors.New("stopped after " + strconv.Itoa(maxHttpRedirects) + " redirects")
+		}
+		x.hops = append(x.hops, req.Response)
+		return nil
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	x.elapsed = time.Since(start)
+	if err != nil {
+		x.resp.Error = err
+		return err
+	}
+	defer resp.Body.Close()
+
+	x.respBody, err = io.ReadAll(io.LimitReader(resp.Body, maxHttpResponseBody))
+	if err != nil {
+		x.resp.Error = err
+		return err
+	}
+	x.resp.Data = resp
+
+	x.StatusCode = plugin.TValue[int64]{Data: int64(resp.StatusCode), State: plugin.StateIsSet}
+	x.Version = plugin.TValue[string]{Data: strconv.Itoa(resp.ProtoMajor) + "." + strconv.Itoa(resp.ProtoMinor), State: plugin.StateIsSet}
+	return nil
+}
+
+func (x *mqlHttpRequest) header() (*mqlHttpHeader, error) {
+	if err := x.do(); err != nil {
+		return nil, err
+	}
+	return newHttpHeader(x.MqlRuntime, x.__id, x.resp.Data.Header)
+}
+
+func (x *mqlHttpRequest) statusCode() (int64, error) {
+	return 0, x.do()
+}
+
+func (x *mqlHttpRequest) version() (string, error) {
+	return "", x.do()
+}
+
+func (x *mqlHttpRequest) body() (string, error) {
+	if err := x.do(); err != nil {
+		return "", err
+	}
+	return string(x.respBody), nil
+}
+
+func (x *mqlHttpRequest) json() (interface{}, error) {
+	if err := x.do(); err != nil {
+		return nil, err
+	}
+
+	var res interface{}
+	if err := json.Unmarshal(x.respBody, &res); err != nil {
+		return nil, errors.New("failed to parse response body as JSON: " + err.Error())
+	}
+	return res, nil
+}
+
+func (x *mqlHttpRequest) responseTime() (*time.Time, error) {
+	if err := x.do(); err != nil {
+		return nil, err
+	}
+	res := llx.DurationToTime(0).Add(x.elapsed)
+	return &res, nil
+}
+
+func (x *mqlHttpRequest) redirects() ([]interface{}, error) {
+	if err := x.do(); err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(x.hops))
+	for i, hop := range x.hops {
+		id := x.__id + "/redirect/" + strconv.Itoa(i)
+		header, err := newHttpHeader(x.MqlRuntime, id, hop.Header)
+		if err != nil {
+			return nil, err
+		}
+
+		var url string
+		if hop.Request != nil {
+			url = hop.Request.URL.String()
+		}
+
+		o, err := CreateResource(x.MqlRuntime, "http.redirect", map[string]*llx.RawData{
+			"__id":       llx.StringData(id),
+			"url":        llx.StringData(url),
+			"statusCode": llx.IntData(int64(hop.StatusCode)),
+			"header":     llx.ResourceData(header, "http.header"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = o
+	}
+	return res, nil
+}
+
+func (x *mqlHttpRequest) tlsVersion() (string, error) {
+	if err := x.do(); err != nil {
+		return "", err
+	}
+	if x.resp.Data.TLS == nil {
+		return "", nil
+	}
+	return tls.VersionName(x.resp.Data.TLS.Version), nil
+}
+
+func (x *mqlHttpRequest) certificate() (*mqlCertificate, error) {
+	if err := x.do(); err != nil {
+		return nil, err
+	}
+	if x.resp.Data.TLS == nil || len(x.resp.Data.TLS.PeerCertificates) == 0 {
+		x.Certificate.State = plugin.StateIsSet | plugin.StateIsNull
+		return nil, nil
+	}
+
+	certs, err := CertificatesToMqlCertificates(x.MqlRuntime, x.resp.Data.TLS.PeerCertificates[:1])
+	if err != nil || len(certs) == 0 {
+		return nil, err
+	}
+	return certs[0].(*mqlCertificate), nil
+}
+
+func (x *mqlHttpRedirect) id() (string, error) {
+	return "", errors.New("http redirect not initialized")
+}
@@ -0,0 +1,126 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/vault"
+	"go.mondoo.com/cnquery/v9/providers/network/connection"
+	"go.mondoo.com/cnquery/v9/types"
+)
+
+func newHttpTestServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"admin":true}`))
+	})
+	mux.HandleFunc("/old", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/admin", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Method", r.Method)
+		w.Header().Set("X-Custom", r.Header.Get("X-Custom"))
+	})
+	return httptest.NewServer(mux)
+}
+
+func newHttpRequest(t *testing.T, conf *inventory.Config, args map[string]*llx.RawData) *mqlHttpRequest {
+	runtime := &plugin.Runtime{
+		Connection: connection.NewHostConnection(1, nil, conf),
+	}
+	res, err := NewResource(runtime, "http.request", args)
+	require.NoError(t, err)
+	return res.(*mqlHttpRequest)
+}
+
+func TestResource_HttpRequest(t *testing.T) {
+	srv := newHttpTestServer()
+	defer srv.Close()
+
+	t.Run("unauthorized without a token", func(t *testing.T) {
+		req := newHttpRequest(t, &inventory.Config{}, map[string]*llx.RawData{
+			"rawUrl": llx.StringData(srv.URL + "/admin"),
+		})
+		code := req.GetStatusCode()
+		require.NoError(t, code.Error)
+		assert.Equal(t, int64(401), code.Data)
+	})
+
+	t.Run("bearer auth from credentials", func(t *testing.T) {
+		conf := &inventory.Config{
+			Credentials: []*vault.Credential{{Type: vault.CredentialType_bearer, Secret: []byte("s3cret")}},
+		}
+		req := newHttpRequest(t, conf, map[string]*llx.RawData{
+			"rawUrl": llx.StringData(srv.URL + "/admin"),
+			"auth":   llx.StringData("bearer"),
+		})
+		assert.Equal(t, int64(200), req.GetStatusCode().Data)
+		json := req.GetJson()
+		require.NoError(t, json.Error)
+		assert.Equal(t, map[string]interface{}{"admin": true}, json.Data)
+	})
+
+	t.Run("missing credentials", func(t *testing.T) {
+		req := newHttpRequest(t, &inventory.Config{}, map[string]*llx.RawData{
+			"rawUrl": llx.StringData(srv.URL + "/admin"),
+			"auth":   llx.StringData("basic"),
+		})
+		assert.Error(t, req.GetStatusCode().Error)
+	})
+
+	t.Run("redirects", func(t *testing.T) {
+		req := newHttpRequest(t, &inventory.Config{}, map[string]*llx.RawData{
+			"rawUrl": llx.StringData(srv.URL + "/old"),
+		})
+		assert.Equal(t, int64(401), req.GetStatusCode().Data)
+		redirects := req.GetRedirects()
+		require.NoError(t, redirects.Error)
+		require.Len(t, redirects.Data, 1)
+		assert.Equal(t, int64(301), redirects.Data[0].(*mqlHttpRedirect).StatusCode.Data)
+
+		req = newHttpRequest(t, &inventory.Config{}, map[string]*llx.RawData{
+			"rawUrl":          llx.StringData(srv.URL + "/old"),
+			"followRedirects": llx.BoolFalse,
+		})
+		assert.Equal(t, int64(301), req.GetStatusCode().Data)
+		assert.Empty(t, req.GetRedirects().Data)
+	})
+
+	t.Run("method and headers", func(t *testing.T) {
+		req := newHttpRequest(t, &inventory.Config{}, map[string]*llx.RawData{
+			"rawUrl":         llx.StringData(srv.URL + "/echo"),
+			"method":         llx.StringData("post"),
+			"requestHeaders": llx.MapData(map[string]interface{}{"X-Custom": "yes"}, types.String),
+		})
+		header := req.GetHeader()
+		require.NoError(t, header.Error)
+		params := header.Data.GetParams()
+		require.NoError(t, params.Error)
+		assert.Equal(t, []interface{}{"POST"}, params.Data["X-Method"])
+		assert.Equal(t, []interface{}{"yes"}, params.Data["X-Custom"])
+		assert.Equal(t, "", req.GetTlsVersion().Data)
+	})
+
+	t.Run("unsupported auth", func(t *testing.T) {
+		runtime := &plugin.Runtime{Connection: connection.NewHostConnection(1, nil, &inventory.Config{})}
+		_, err := NewResource(runtime, "http.request", map[string]*llx.RawData{
+			"rawUrl": llx.StringData(srv.URL),
+			"auth":   llx.StringData("digest"),
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+)
+
+func newTestHttpHeader(t *testing.T, header http.Header) *mqlHttpHeader {
+	res, err := newHttpHeader(&plugin.Runtime{}, "test", header)
+	require.NoError(t, err)
+	return res
+}
+
+func TestHttpHeader_Csp(t *testing.T) {
+	header := newTestHttpHeader(t, http.Header{
+		"Content-Security-Policy": {
+			"default-src 'self'; script-src 'self' 'unsafe-inline' 'unsafe-eval' https://cdn.example.com; report-uri /csp",
+			"frame-ancestors 'none'",
+		},
+		"Content-Security-Policy-Report-Only": {"script-src 'unsafe-inline' 'nonce-abc'; report-to csp-endpoint"},
+	})
+
+	csp := header.GetContentSecurityPolicy()
+	require.NoError(t, csp.Error)
+	assert.Equal(t, map[string]interface{}{
+		"default-src":     []interface{}{"'self'"},
+		"script-src":      []interface{}{"'self'", "'unsafe-inline'", "'unsafe-eval'", "https://cdn.example.com"},
+		"report-uri":      []interface{}{"/csp"},
+		"frame-ancestors": []interface{}{"'none'"},
+	}, csp.Data.Directives.Data)
+	assert.False(t, csp.Data.ReportOnly.Data)
+	assert.True(t, csp.Data.UnsafeInline.Data)
+	assert.True(t, csp.Data.UnsafeEval.Data)
+	assert.False(t, csp.Data.UnsafeScriptSources.Data)
+	assert.Equal(t, []interface{}{"/csp"}, csp.Data.ReportUri.Data)
+
+	reportOnly := header.GetContentSecurityPolicyReportOnly()
+	require.NoError(t, reportOnly.Error)
+	assert.True(t, reportOnly.Data.ReportOnly.Data)
+	// nonces disable 'unsafe-inline'
+	assert.False(t, reportOnly.Data.UnsafeInline.Data)
+	assert.Equal(t, "csp-endpoint", reportOnly.Data.ReportTo.Data)
+
+	empty := newTestHttpHeader(t, http.Header{"Content-Security-Policy": {"img-src *"}})
+	assert.True(t, empty.GetContentSecurityPolicy().Data.UnsafeScriptSources.Data)
+	missing := newTestHttpHeader(t, http.Header{})
+	assert.True(t, missing.GetContentSecurityPolicy().State&plugin.StateIsNull != 0)
+}
+
+func TestHttpHeader_Policies(t *testing.T) {
+	header := newTestHttpHeader(t, http.Header{
+		"Permissions-Policy":           {`geolocation=(), camera=(self "https://example.com"), fullscreen=*`},
+		"Referrer-Policy":              {"strict-origin-when-cross-origin"},
+		"Cross-Origin-Opener-Policy":   {"same-origin"},
+		"Cross-Origin-Embedder-Policy": {"require-corp"},
+		"Strict-Transport-Security":    {"max-age=63072000; includeSubDomains; preload"},
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"geolocation": []interface{}{},
+		"camera":      []interface{}{"self", "https://example.com"},
+		"fullscreen":  []interface{}{"*"},
+	}, header.GetPermissionsPolicy().Data)
+	assert.Equal(t, "strict-origin-when-cross-origin", header.GetReferrerPolicy().Data)
+	assert.Equal(t, "same-origin", header.GetCrossOriginOpenerPolicy().Data)
+	assert.Equal(t, "require-corp", header.GetCrossOriginEmbedderPolicy().Data)
+
+	corp := header.GetCrossOriginResourcePolicy()
+	require.NoError(t, corp.Error)
+	assert.True(t, corp.State&plugin.StateIsNull != 0)
+	// the null state must only be set for the missing header
+	assert.True(t, header.GetXContentTypeOptions().State&plugin.StateIsNull != 0)
+	assert.Equal(t, "strict-origin-when-cross-origin", header.GetReferrerPolicy().Data)
+
+	sts := header.GetSts()
+	require.NoError(t, sts.Error)
+	assert.True(t, sts.Data.GetPreloadReady().Data)
+
+	short := newTestHttpHeader(t, http.Header{"Strict-Transport-Security": {"max-age=86400; includeSubDomains; preload"}})
+	assert.False(t, short.GetSts().Data.GetPreloadReady().Data)
+}
+
+func TestHttpHeader_Cookies(t *testing.T) {
+	header := newTestHttpHeader(t, http.Header{
+		"Set-Cookie": {
+			"session=abc; Path=/; Domain=example.com; Secure; HttpOnly; SameSite=Strict; Max-Age=3600",
+			"tracking=xyz; Expires=Wed, 21 Oct 2037 07:28:00 GMT; SameSite=None; Secure; Partitioned",
+		},
+	})
+
+	cookies := header.GetCookies()
+	require.NoError(t, cookies.Error)
+	require.Len(t, cookies.Data, 2)
+
+	session := cookies.Data[0].(*mqlHttpHeaderSetCookie)
+	assert.Equal(t, "session", session.Name.Data)
+	assert.Equal(t, "example.com", session.GetDomain().Data)
+	assert.Equal(t, "/", session.GetPath().Data)
+	assert.True(t, session.GetSecure().Data)
+	assert.True(t, session.GetHttpOnly().Data)
+	assert.Equal(t, "Strict", session.GetSameSite().Data)
+	assert.Equal(t, int64(3600), session.GetMaxAge().Data)
+	assert.False(t, session.GetPartitioned().Data)
+	assert.True(t, session.GetExpires().State&plugin.StateIsNull != 0)
+
+	tracking := cookies.Data[1].(*mqlHttpHeaderSetCookie)
+	assert.Equal(t, "tracking", tracking.Name.Data)
+	assert.False(t, tracking.GetHttpOnly().Data)
+	assert.True(t, tracking.GetPartitioned().Data)
+	assert.Equal(t, "None", tracking.GetSameSite().Data)
+	assert.Equal(t, time.Date(2037, 10, 21, 7, 28, 0, 0, time.UTC), tracking.GetExpires().Data.UTC())
+	assert.True(t, tracking.GetMaxAge().State&plugin.StateIsNull != 0)
+
+	first := header.GetSetCookie()
+	require.NoError(t, first.Error)
+	assert.Equal(t, "session", first.Data.Name.Data)
+}
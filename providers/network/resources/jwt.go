@@ -0,0 +1,119 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.mondoo.com/cnquery/v9/checksums"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/core/resources/jwt"
+)
+
+func initJwks(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	url, hasUrl := args["url"]
+	if !hasUrl || url.Value == nil {
+		args["url"] = llx.StringData("")
+	}
+	if content, ok := args["content"]; ok && content.Value != nil {
+		return args, nil, nil
+	}
+	if !hasUrl || url.Value == nil || url.Value.(string) == "" {
+		return nil, nil, errors.New("jwks needs either a url or its content")
+	}
+	return args, nil, nil
+}
+
+func (j *mqlJwks) id() (string, error) {
+	if j.Url.Data != "" {
+		return "jwks/" + j.Url.Data, nil
+	}
+	return "jwks/" + checksums.New.Add(j.Content.Data).String(), nil
+}
+
+func (j *mqlJwks) content() (string, error) {
+	client := newHttpClient(j.MqlRuntime, defaultHttpTimeout*time.Second)
+
+	resp, err := client.Get(j.Url.Data)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.New("failed to fetch JSON Web Key Set from " + j.Url.Data + ": " + resp.Status)
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxHttpResponseBody))
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+func (j *mqlJwks) keys(content string) ([]interface{}, error) {
+	keys, err := jwt.ParseKeys(content)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(keys))
+	for i := range keys {
+		key := keys[i]
+
+		var thumbprint string
+		if raw, err := key.Thumbprint(crypto.SHA256); err == nil {
+			thumbprint = base64.RawURLEncoding.EncodeToString(raw)
+		}
+		typ, bits, curve := jwkProperties(key.Key)
+
+		o, err := CreateResource(j.MqlRuntime, "jwk", map[string]*llx.RawData{
+			"__id":       llx.StringData(j.__id + "/" + strconv.Itoa(i) + "/" + thumbprint),
+			"keyId":      llx.StringData(key.KeyID),
+			"type":       llx.StringData(typ),
+			"algorithm":  llx.StringData(key.Algorithm),
+			"use":        llx.StringData(key.Use),
+			"bits":       llx.IntData(int64(bits)),
+			"curve":      llx.StringData(curve),
+			"public":     llx.BoolData(key.IsPublic()),
+			"thumbprint": llx.StringData(thumbprint),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = o
+	}
+	return res, nil
+}
+
+// jwkProperties returns the key type as used in JWKs, the key size in bits,
+// and the curve of elliptic curve keys
+func jwkProperties(key interface{}) (string, int, string) {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return "RSA", k.N.BitLen(), ""
+	case *rsa.PrivateKey:
+		return "RSA", k.N.BitLen(), ""
+	case *ecdsa.PublicKey:
+		return "EC", k.Curve.Params().BitSize, k.Curve.Params().Name
+	case *ecdsa.PrivateKey:
+		return "EC", k.Curve.Params().BitSize, k.Curve.Params().Name
+	case ed25519.PublicKey, ed25519.PrivateKey:
+		return "OKP", 256, "Ed25519"
+	case []byte:
+		return "oct", len(k) * 8, ""
+	default:
+		return "", 0, ""
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/network/connection"
+	"gopkg.in/square/go-jose.v2"
+)
+
+func newJwtTestRuntime() *plugin.Runtime {
+	return &plugin.Runtime{
+		Connection: connection.NewHostConnection(1, nil, &inventory.Config{}),
+	}
+}
+
+func marshalJwks(t *testing.T, keys ...jose.JSONWebKey) string {
+	raw, err := json.Marshal(jose.JSONWebKeySet{Keys: keys})
+	require.NoError(t, err)
+	return string(raw)
+}
+
+func TestResource_Jwks(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	content := marshalJwks(t,
+		jose.JSONWebKey{Key: rsaKey.Public(), KeyID: "key-1", Algorithm: "RS256", Use: "sig"},
+		jose.JSONWebKey{Key: []byte("secret"), KeyID: "key-2"},
+	)
+	// keys with unsupported types are skipped
+	content = content[:len(content)-2] + `,{"kty":"unknown","kid":"key-3"}]}`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(content))
+	}))
+	defer srv.Close()
+
+	res, err := NewResource(newJwtTestRuntime(), "jwks", map[string]*llx.RawData{
+		"url": llx.StringData(srv.URL),
+	})
+	require.NoError(t, err)
+	jwks := res.(*mqlJwks)
+
+	keys := jwks.GetKeys()
+	require.NoError(t, keys.Error)
+	require.Len(t, keys.Data, 2)
+
+	key := keys.Data[0].(*mqlJwk)
+	assert.Equal(t, "key-1", key.KeyId.Data)
+	assert.Equal(t, "RSA", key.Type.Data)
+	assert.Equal(t, "RS256", key.Algorithm.Data)
+	assert.Equal(t, "sig", key.Use.Data)
+	assert.Equal(t, int64(2048), key.Bits.Data)
+	assert.True(t, key.Public.Data)
+	assert.NotEmpty(t, key.Thumbprint.Data)
+
+	key = keys.Data[1].(*mqlJwk)
+	assert.Equal(t, "oct", key.Type.Data)
+	assert.Equal(t, int64(48), key.Bits.Data)
+	assert.False(t, key.Public.Data)
+
+	_, err = NewResource(newJwtTestRuntime(), "jwks", map[string]*llx.RawData{})
+	assert.EqualError(t, err, "jwks needs either a url or its content")
+}
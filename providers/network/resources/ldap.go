@@ -0,0 +1,152 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/network/resources/ldapshake"
+)
+
+const (
+	defaultLdapPort  = 389
+	defaultLdapsPort = 636
+)
+
+func initLdap(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	var ldaps bool
+	if target, ok := args["target"]; ok && target.Value != nil {
+		ldaps = strings.HasPrefix(target.Value.(string), "ldaps://")
+	}
+	port := int64(defaultLdapPort)
+	if ldaps {
+		port = defaultLdapsPort
+	}
+
+	if err := initServiceTarget(runtime, args, port, "ldap", "ldaps", "tcp"); err != nil {
+		return nil, nil, err
+	}
+	if _, ok := args["tls"]; !ok {
+		args["tls"] = llx.BoolData(ldaps || args["port"].Value.(int64) == defaultLdapsPort)
+	}
+	return args, nil, nil
+}
+
+type mqlLdapInternal struct {
+	lock    sync.Mutex
+	fetched bool
+}
+
+func (s *mqlLdap) id() (string, error) {
+	return "ldap/" + net.JoinHostPort(s.Host.Data, strconv.Itoa(int(s.Port.Data))), nil
+}
+
+// fetch probes the server and sets all fields of the resource
+func (s *mqlLdap) fetch() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.fetched {
+		return nil
+	}
+
+	tester := ldapshake.New(s.Host.Data, int(s.Port.Data), s.Tls.Data)
+	if err := tester.Test(); err != nil {
+		return err
+	}
+	findings := tester.Findings
+
+	rootDSE := make(map[string]interface{}, len(findings.RootDSE))
+	for k, v := range findings.RootDSE {
+		rootDSE[k] = llx.TArr2Raw(v)
+	}
+
+	versions := []interface{}{}
+	for _, v := range findings.SupportedLdapVersions {
+		if version, err := strconv.ParseInt(v, 10, 64); err == nil {
+			versions = append(versions, version)
+		}
+	}
+
+	var startTls plugin.TValue[bool]
+	if s.Tls.Data {
+		// StartTLS doesn't apply to connections that use TLS already
+		startTls = plugin.TValue[bool]{State: plugin.StateIsSet | plugin.StateIsNull}
+	} else {
+		startTls = plugin.TValue[bool]{Data: findings.StartTLS, State: plugin.StateIsSet}
+	}
+
+	s.RootDSE = plugin.TValue[map[string]interface{}]{Data: rootDSE, State: plugin.StateIsSet}
+	s.NamingContexts = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.NamingContexts), State: plugin.StateIsSet}
+	s.DefaultNamingContext = plugin.TValue[string]{Data: findings.DefaultNamingContext, State: plugin.StateIsSet}
+	s.SupportedLdapVersions = plugin.TValue[[]interface{}]{Data: versions, State: plugin.StateIsSet}
+	s.SupportedSaslMechanisms = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.SupportedSaslMechanisms), State: plugin.StateIsSet}
+	s.SupportedExtensions = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.SupportedExtensions), State: plugin.StateIsSet}
+	s.SupportedControls = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.SupportedControls), State: plugin.StateIsSet}
+	s.VendorName = plugin.TValue[string]{Data: findings.VendorName, State: plugin.StateIsSet}
+	s.VendorVersion = plugin.TValue[string]{Data: findings.VendorVersion, State: plugin.StateIsSet}
+	s.AnonymousBind = plugin.TValue[bool]{Data: findings.AnonymousBind, State: plugin.StateIsSet}
+	s.AnonymousSearch = plugin.TValue[bool]{Data: findings.AnonymousSearch, State: plugin.StateIsSet}
+	s.StartTls = startTls
+	s.Errors = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.Errors), State: plugin.StateIsSet}
+	s.fetched = true
+	return nil
+}
+
+func (s *mqlLdap) rootDSE() (map[string]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlLdap) namingContexts() ([]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlLdap) defaultNamingContext() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlLdap) supportedLdapVersions() ([]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlLdap) supportedSaslMechanisms() ([]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlLdap) supportedExtensions() ([]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlLdap) supportedControls() ([]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlLdap) vendorName() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlLdap) vendorVersion() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlLdap) anonymousBind() (bool, error) {
+	return false, s.fetch()
+}
+
+func (s *mqlLdap) anonymousSearch() (bool, error) {
+	return false, s.fetch()
+}
+
+func (s *mqlLdap) startTls() (bool, error) {
+	return false, s.fetch()
+}
+
+func (s *mqlLdap) errors() ([]interface{}, error) {
+	return nil, s.fetch()
+}
@@ -0,0 +1,44 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/network/connection"
+)
+
+func TestInitLdap(t *testing.T) {
+	tests := []struct {
+		target string
+		host   string
+		port   int64
+		tls    bool
+	}{
+		{"ldap.example.com", "ldap.example.com", 389, false},
+		{"ldap://ldap.example.com:3389", "ldap.example.com", 3389, false},
+		{"ldaps://ldap.example.com", "ldap.example.com", 636, true},
+		{"ldap.example.com:636", "ldap.example.com", 636, true},
+	}
+	for _, test := range tests {
+		t.Run(test.target, func(t *testing.T) {
+			runtime := &plugin.Runtime{
+				Connection: connection.NewHostConnection(1, nil, &inventory.Config{}),
+			}
+			res, err := NewResource(runtime, "ldap", map[string]*llx.RawData{
+				"target": llx.StringData(test.target),
+			})
+			require.NoError(t, err)
+			ldap := res.(*mqlLdap)
+			assert.Equal(t, test.host, ldap.Host.Data)
+			assert.Equal(t, test.port, ldap.Port.Data)
+			assert.Equal(t, test.tls, ldap.Tls.Data)
+		})
+	}
+}
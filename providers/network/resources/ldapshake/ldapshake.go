@@ -0,0 +1,390 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package ldapshake probes LDAP servers without credentials. It reads the
+// root DSE and checks which operations are possible anonymously.
+package ldapshake
+
+import (
+	"bufio"
+	"crypto/tls"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mondoo.com/cnquery/v9/providers/network/resources/ber"
+	"go.mondoo.com/cnquery/v9/providers/network/resources/tlsshake"
+)
+
+// Protocol operations
+// see https://datatracker.ietf.org/doc/html/rfc4511#section-4.2
+const (
+	opBindRequest       byte = 0x60
+	opBindResponse      byte = 0x61
+	opUnbindRequest     byte = 0x42
+	opSearchRequest     byte = 0x63
+	opSearchResultEntry byte = 0x64
+	opSearchResultDone  byte = 0x65
+	opSearchResultRef   byte = 0x73
+)
+
+// OidStartTLS is the extended operation of StartTLS
+const OidStartTLS = "1.3.6.1.4.1.1466.20037"
+
+const (
+	scopeBaseObject   = 0
+	derefNever        = 0
+	resultSuccess     = 0
+	filterPresent     = 0x87
+	authSimple        = 0x80
+	maxSearchMessages = 1000
+)
+
+// DefaultTimeout limits each connection to the server
+var DefaultTimeout = 10 * time.Second
+
+type Findings struct {
+	// RootDSE contains all attributes of the root DSE
+	RootDSE                 map[string][]string
+	NamingContexts          []string
+	DefaultNamingContext    string
+	SupportedLdapVersions   []string
+	SupportedSaslMechanisms []string
+	SupportedExtensions     []string
+	SupportedControls       []string
+	VendorName              string
+	VendorVersion           string
+
+	// AnonymousBind is true if the server accepts anonymous simple binds
+	AnonymousBind bool
+	// AnonymousSearch is true if the naming context can be read anonymously
+	AnonymousSearch bool
+	// StartTLS is true if the server upgrades connections via StartTLS
+	StartTLS bool
+
+	Errors []string
+}
+
+type Tester struct {
+	Findings  Findings
+	target    string
+	host      string
+	useTLS    bool
+	messageID int64
+}
+
+// New creates a new tester for the given host and port. Servers that use
+// LDAP over TLS (ldaps) are probed via TLS.
+func New(host string, port int, useTLS bool) *Tester {
+	return &Tester{
+		target: net.JoinHostPort(host, strconv.Itoa(port)),
+		host:   host,
+		useTLS: useTLS,
+	}
+}
+
+func (s *Tester) addError(msg string) {
+	s.Findings.Errors = append(s.Findings.Errors, msg)
+}
+
+// Test runs all probes against the server. It returns an error if the
+// server doesn't speak LDAP. Errors of individual probes are collected
+// in the findings.
+func (s *Tester) Test() error {
+	if err := s.testRootDSE(); err != nil {
+		return err
+	}
+
+	s.testAnonymous()
+	if !s.useTLS {
+		s.testStartTLS()
+	}
+	return nil
+}
+
+type connection struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (s *Tester) dial() (*connection, error) {
+	conn, err := net.DialTimeout("tcp", s.target, DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Now().Add(DefaultTimeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if s.useTLS {
+		// the certificate is checked by the tls resource
+		conn = tls.Client(conn, &tls.Config{ServerName: s.host, InsecureSkipVerify: true})
+	}
+	return &connection{Conn: conn, reader: bufio.NewReader(conn)}, nil
+}
+
+func (s *Tester) send(conn *connection, op []byte) (int64, error) {
+	s.messageID++
+	_, err := conn.Write(ber.Sequence(ber.Integer(s.messageID), op))
+	return s.messageID, err
+}
+
+// receive reads the next message with the ID
+func (s *Tester) receive(conn *connection, messageID int64) (ber.Element, error) {
+	for {
+		msg, err := ber.Read(conn.reader)
+		if err != nil {
+			return ber.Element{}, err
+		}
+		if msg.Tag != ber.TagSequence {
+			return ber.Element{}, errors.New("unexpected LDAP message")
+		}
+		fields, err := msg.Children()
+		if err != nil {
+			return ber.Element{}, err
+		}
+		if len(fields) < 2 {
+			return ber.Element{}, errors.New("invalid LDAP message")
+		}
+		id, err := fields[0].Int()
+		if err != nil {
+			return ber.Element{}, err
+		}
+		// e.g. notices of disconnection use the ID 0
+		if id != messageID {
+			if id == 0 {
+				return ber.Element{}, errors.New("server closed the connection")
+			}
+			continue
+		}
+		return fields[1], nil
+	}
+}
+
+// result returns the result code and diagnostic message of a response
+func result(op ber.Element) (int64, string, error) {
+	fields, err := op.Children()
+	if err != nil {
+		return 0, "", err
+	}
+	if len(fields) < 3 {
+		return 0, "", errors.New("invalid LDAP result")
+	}
+	code, err := fields[0].Int()
+	return code, string(fields[2].Value), err
+}
+
+func resultError(code int64, msg string) error {
+	res := "LDAP result code " + strconv.Itoa(int(code))
+	if msg != "" {
+		res += ": " + msg
+	}
+	return errors.New(res)
+}
+
+// search runs a search with base scope and returns the attributes of the entry
+func (s *Tester) search(conn *connection, base string, attributes ...string) (map[string][]string, error) {
+	attrs := make([][]byte, len(attributes))
+	for i := range attributes {
+		attrs[i] = ber.String(attributes[i])
+	}
+	messageID, err := s.send(conn, ber.Encode(opSearchRequest,
+		ber.String(base),
+		ber.Enumerated(scopeBaseObject),
+		ber.Enumerated(derefNever),
+		ber.Integer(0),
+		ber.Integer(0),
+		ber.Boolean(false),
+		ber.Encode(filterPresent, []byte("objectClass")),
+		ber.Sequence(attrs...),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	var res map[string][]string
+	for i := 0; i < maxSearchMessages; i++ {
+		op, err := s.receive(conn, messageID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch op.Tag {
+		case opSearchResultEntry:
+			if res, err = parseEntry(op); err != nil {
+				return nil, err
+			}
+		case opSearchResultRef:
+		case opSearchResultDone:
+			code, msg, err := result(op)
+			if err != nil {
+				return nil, err
+			}
+			if code != resultSuccess {
+				return nil, resultError(code, msg)
+			}
+			if res == nil {
+				return nil, errors.New("no entry returned for " + base)
+			}
+			return res, nil
+		default:
+			return nil, errors.New("unexpected LDAP response")
+		}
+	}
+	return nil, errors.New("too many LDAP search results")
+}
+
+func parseEntry(op ber.Element) (map[string][]string, error) {
+	fields, err := op.Children()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) != 2 {
+		return nil, errors.New("invalid LDAP search result")
+	}
+	attributes, err := fields[1].Children()
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string][]string{}
+	for i := range attributes {
+		parts, err := attributes[i].Children()
+		if err != nil {
+			return nil, err
+		}
+		if len(parts) != 2 {
+			return nil, errors.New("invalid LDAP attribute")
+		}
+		values, err := parts[1].Children()
+		if err != nil {
+			return nil, err
+		}
+		name := string(parts[0].Value)
+		res[name] = make([]string, len(values))
+		for j := range values {
+			res[name][j] = string(values[j].Value)
+		}
+	}
+	return res, nil
+}
+
+// bind sends an anonymous simple bind
+// see https://datatracker.ietf.org/doc/html/rfc4513#section-5.1.1
+func (s *Tester) bind(conn *connection) error {
+	messageID, err := s.send(conn, ber.Encode(opBindRequest,
+		ber.Integer(3),
+		ber.String(""),
+		ber.Encode(authSimple, nil),
+	))
+	if err != nil {
+		return err
+	}
+	op, err := s.receive(conn, messageID)
+	if err != nil {
+		return err
+	}
+	if op.Tag != opBindResponse {
+		return errors.New("unexpected LDAP response")
+	}
+	code, msg, err := result(op)
+	if err != nil {
+		return err
+	}
+	if code != resultSuccess {
+		return resultError(code, msg)
+	}
+	return nil
+}
+
+func (s *Tester) unbind(conn *connection) {
+	s.send(conn, []byte{opUnbindRequest, 0})
+}
+
+// attribute returns the values of an attribute, whose names are case-insensitive
+func (f *Findings) attribute(name string) []string {
+	for k, v := range f.RootDSE {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return []string{}
+}
+
+// testRootDSE reads the root DSE, which describes the server
+// see https://datatracker.ietf.org/doc/html/rfc4512#section-5.1
+func (s *Tester) testRootDSE() error {
+	conn, err := s.dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// operational attributes are only returned if they are requested
+	rootDSE, err := s.search(conn, "", "*", "+")
+	if err != nil {
+		return err
+	}
+	s.unbind(conn)
+
+	f := &s.Findings
+	f.RootDSE = rootDSE
+	f.NamingContexts = f.attribute("namingContexts")
+	f.SupportedLdapVersions = f.attribute("supportedLDAPVersion")
+	f.SupportedSaslMechanisms = f.attribute("supportedSASLMechanisms")
+	f.SupportedExtensions = f.attribute("supportedExtension")
+	f.SupportedControls = f.attribute("supportedControl")
+	if v := f.attribute("defaultNamingContext"); len(v) != 0 {
+		f.DefaultNamingContext = v[0]
+	} else if len(f.NamingContexts) != 0 {
+		f.DefaultNamingContext = f.NamingContexts[0]
+	}
+	if v := f.attribute("vendorName"); len(v) != 0 {
+		f.VendorName = v[0]
+	}
+	if v := f.attribute("vendorVersion"); len(v) != 0 {
+		f.VendorVersion = v[0]
+	}
+	return nil
+}
+
+// testAnonymous checks if anonymous clients can bind and read the naming context
+func (s *Tester) testAnonymous() {
+	conn, err := s.dial()
+	if err != nil {
+		s.addError("anonymous bind: " + err.Error())
+		return
+	}
+	defer conn.Close()
+
+	if err := s.bind(conn); err != nil {
+		s.addError("anonymous bind: " + err.Error())
+		return
+	}
+	s.Findings.AnonymousBind = true
+
+	if s.Findings.DefaultNamingContext != "" {
+		if _, err := s.search(conn, s.Findings.DefaultNamingContext); err == nil {
+			s.Findings.AnonymousSearch = true
+		}
+	}
+	s.unbind(conn)
+}
+
+func (s *Tester) testStartTLS() {
+	conn, err := s.dial()
+	if err != nil {
+		s.addError("starttls: " + err.Error())
+		return
+	}
+	defer conn.Close()
+
+	if err := tlsshake.StartTLS(conn, tlsshake.STARTTLS_LDAP, ""); err != nil {
+		s.addError("starttls: " + err.Error())
+		return
+	}
+	s.Findings.StartTLS = true
+}
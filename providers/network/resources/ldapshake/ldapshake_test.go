@@ -0,0 +1,193 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ldapshake
+
+import (
+	"bufio"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/providers/network/resources/ber"
+)
+
+const (
+	opExtendedRequest  byte = 0x77
+	opExtendedResponse byte = 0x78
+)
+
+// testServer is a minimal LDAP server
+type testServer struct {
+	rootDSE        map[string][]string
+	allowAnonymous bool
+	allowSearch    bool
+	startTLS       bool
+}
+
+func ldapResult(op byte, code int64) []byte {
+	return ber.Encode(op, ber.Enumerated(code), ber.String(""), ber.String(""))
+}
+
+func entry(dn string, attrs map[string][]string) []byte {
+	list := [][]byte{}
+	for name, values := range attrs {
+		encoded := make([][]byte, len(values))
+		for i := range values {
+			encoded[i] = ber.String(values[i])
+		}
+		list = append(list, ber.Sequence(ber.String(name), ber.Encode(ber.TagSet, encoded...)))
+	}
+	return ber.Encode(opSearchResultEntry, ber.String(dn), ber.Sequence(list...))
+}
+
+func (s *testServer) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	bound := false
+
+	for {
+		msg, err := ber.Read(reader)
+		if err != nil {
+			return
+		}
+		fields, _ := msg.Children()
+		id, _ := fields[0].Int()
+		reply := func(ops ...[]byte) {
+			for _, op := range ops {
+				conn.Write(ber.Sequence(ber.Integer(id), op))
+			}
+		}
+
+		op := fields[1]
+		switch op.Tag {
+		case opBindRequest:
+			if s.allowAnonymous {
+				bound = true
+				reply(ldapResult(opBindResponse, 0))
+			} else {
+				// inappropriateAuthentication
+				reply(ldapResult(opBindResponse, 48))
+			}
+		case opSearchRequest:
+			params, _ := op.Children()
+			base := string(params[0].Value)
+			switch {
+			case base == "":
+				reply(entry("", s.rootDSE), ldapResult(opSearchResultDone, 0))
+			case bound && s.allowSearch:
+				reply(entry(base, map[string][]string{"objectClass": {"domain"}}), ldapResult(opSearchResultDone, 0))
+			default:
+				// insufficientAccessRights
+				reply(ldapResult(opSearchResultDone, 50))
+			}
+		case opExtendedRequest:
+			if s.startTLS {
+				reply(ldapResult(opExtendedResponse, 0))
+			} else {
+				// protocolError
+				reply(ldapResult(opExtendedResponse, 2))
+			}
+		case opUnbindRequest:
+			return
+		}
+	}
+}
+
+func startTestServer(t *testing.T, server *testServer) (string, int) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.handle(conn)
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return host, portNum
+}
+
+func TestTester(t *testing.T) {
+	host, port := startTestServer(t, &testServer{
+		rootDSE: map[string][]string{
+			"namingContexts":          {"dc=example,dc=com"},
+			"supportedLDAPVersion":    {"3"},
+			"supportedSASLMechanisms": {"DIGEST-MD5", "GSSAPI"},
+			"supportedExtension":      {OidStartTLS, "1.3.6.1.4.1.4203.1.11.1"},
+			"supportedControl":        {"1.2.840.113556.1.4.319"},
+			"vendorName":              {"Example Directory"},
+			"vendorVersion":           {"2.6.7"},
+		},
+		allowAnonymous: true,
+		allowSearch:    true,
+		startTLS:       true,
+	})
+
+	tester := New(host, port, false)
+	require.NoError(t, tester.Test())
+	findings := tester.Findings
+
+	assert.Equal(t, []string{"dc=example,dc=com"}, findings.NamingContexts)
+	assert.Equal(t, "dc=example,dc=com", findings.DefaultNamingContext)
+	assert.Equal(t, []string{"3"}, findings.SupportedLdapVersions)
+	assert.Equal(t, []string{"DIGEST-MD5", "GSSAPI"}, findings.SupportedSaslMechanisms)
+	assert.Contains(t, findings.SupportedExtensions, OidStartTLS)
+	assert.Equal(t, []string{"1.2.840.113556.1.4.319"}, findings.SupportedControls)
+	assert.Equal(t, "Example Directory", findings.VendorName)
+	assert.Equal(t, "2.6.7", findings.VendorVersion)
+	assert.Len(t, findings.RootDSE, 7)
+	assert.True(t, findings.AnonymousBind)
+	assert.True(t, findings.AnonymousSearch)
+	assert.True(t, findings.StartTLS)
+	assert.Empty(t, findings.Errors)
+}
+
+func TestRestricted(t *testing.T) {
+	host, port := startTestServer(t, &testServer{
+		rootDSE: map[string][]string{
+			"namingcontexts":       {"dc=corp,dc=example,dc=com", "cn=config"},
+			"defaultNamingContext": {"dc=corp,dc=example,dc=com"},
+		},
+	})
+
+	tester := New(host, port, false)
+	require.NoError(t, tester.Test())
+	findings := tester.Findings
+
+	assert.Equal(t, []string{"dc=corp,dc=example,dc=com", "cn=config"}, findings.NamingContexts)
+	assert.Equal(t, "dc=corp,dc=example,dc=com", findings.DefaultNamingContext)
+	assert.Equal(t, []string{}, findings.SupportedSaslMechanisms)
+	assert.False(t, findings.AnonymousBind)
+	assert.False(t, findings.AnonymousSearch)
+	assert.False(t, findings.StartTLS)
+	assert.Len(t, findings.Errors, 2)
+}
+
+func TestNoLdap(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		conn.Write([]byte("SSH-2.0-OpenSSH_9.6\r\n"))
+		conn.Close()
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	tester := New(addr.IP.String(), addr.Port, false)
+	assert.Error(t, tester.Test())
+}
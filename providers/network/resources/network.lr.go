@@ -30,6 +30,18 @@ func init() {
 			Init: initHttpGet,
 			Create: createHttpGet,
 		},
+		"http.request": {
+			Init: initHttpRequest,
+			Create: createHttpRequest,
+		},
+		"http.cors": {
+			Init: initHttpCors,
+			Create: createHttpCors,
+		},
+		"http.redirect": {
+			// to override args, implement: initHttpRedirect(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createHttpRedirect,
+		},
 		"http.header": {
 			// to override args, implement: initHttpHeader(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createHttpHeader,
@@ -38,6 +50,10 @@ func init() {
 			// to override args, implement: initHttpHeaderSts(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createHttpHeaderSts,
 		},
+		"http.header.csp": {
+			// to override args, implement: initHttpHeaderCsp(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createHttpHeaderCsp,
+		},
 		"http.header.xssProtection": {
 			// to override args, implement: initHttpHeaderXssProtection(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createHttpHeaderXssProtection,
@@ -58,6 +74,30 @@ func init() {
 			Init: initTls,
 			Create: createTls,
 		},
+		"tls.cipher": {
+			// to override args, implement: initTlsCipher(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createTlsCipher,
+		},
+		"ssh.server": {
+			Init: initSshServer,
+			Create: createSshServer,
+		},
+		"ssh.server.hostKey": {
+			// to override args, implement: initSshServerHostKey(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createSshServerHostKey,
+		},
+		"snmp": {
+			Init: initSnmp,
+			Create: createSnmp,
+		},
+		"ldap": {
+			Init: initLdap,
+			Create: createLdap,
+		},
+		"redis": {
+			Init: initRedis,
+			Create: createRedis,
+		},
 		"certificates": {
 			// to override args, implement: initCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createCertificates,
@@ -94,6 +134,14 @@ func init() {
 			// to override args, implement: initOpenpgpSignature(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createOpenpgpSignature,
 		},
+		"jwks": {
+			Init: initJwks,
+			Create: createJwks,
+		},
+		"jwk": {
+			// to override args, implement: initJwk(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createJwk,
+		},
 		"domainName": {
 			Init: initDomainName,
 			Create: createDomainName,
@@ -114,6 +162,34 @@ func init() {
 			// to override args, implement: initDnsDkimRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createDnsDkimRecord,
 		},
+		"dns.spf": {
+			Init: initDnsSpf,
+			Create: createDnsSpf,
+		},
+		"dns.dmarc": {
+			Init: initDnsDmarc,
+			Create: createDnsDmarc,
+		},
+		"dns.mtaSts": {
+			Init: initDnsMtaSts,
+			Create: createDnsMtaSts,
+		},
+		"dns.bimi": {
+			Init: initDnsBimi,
+			Create: createDnsBimi,
+		},
+		"dns.caa": {
+			Init: initDnsCaa,
+			Create: createDnsCaa,
+		},
+		"dns.caaRecord": {
+			// to override args, implement: initDnsCaaRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createDnsCaaRecord,
+		},
+		"dns.dnssec": {
+			Init: initDnsDnssec,
+			Create: createDnsDnssec,
+		},
 	}
 }
 
@@ -206,6 +282,102 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"http.get.body": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlHttpGet).GetBody()).ToDataRes(types.String)
 	},
+	"http.request.url": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetUrl()).ToDataRes(types.Resource("url"))
+	},
+	"http.request.method": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetMethod()).ToDataRes(types.String)
+	},
+	"http.request.requestHeaders": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetRequestHeaders()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"http.request.requestBody": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetRequestBody()).ToDataRes(types.String)
+	},
+	"http.request.followRedirects": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetFollowRedirects()).ToDataRes(types.Bool)
+	},
+	"http.request.timeout": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetTimeout()).ToDataRes(types.Int)
+	},
+	"http.request.auth": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetAuth()).ToDataRes(types.String)
+	},
+	"http.request.header": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetHeader()).ToDataRes(types.Resource("http.header"))
+	},
+	"http.request.statusCode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetStatusCode()).ToDataRes(types.Int)
+	},
+	"http.request.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetVersion()).ToDataRes(types.String)
+	},
+	"http.request.body": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetBody()).ToDataRes(types.String)
+	},
+	"http.request.json": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetJson()).ToDataRes(types.Dict)
+	},
+	"http.request.responseTime": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetResponseTime()).ToDataRes(types.Time)
+	},
+	"http.request.redirects": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetRedirects()).ToDataRes(types.Array(types.Resource("http.redirect")))
+	},
+	"http.request.tlsVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetTlsVersion()).ToDataRes(types.String)
+	},
+	"http.request.certificate": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRequest).GetCertificate()).ToDataRes(types.Resource("certificate"))
+	},
+	"http.cors.url": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpCors).GetUrl()).ToDataRes(types.Resource("url"))
+	},
+	"http.cors.origin": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpCors).GetOrigin()).ToDataRes(types.String)
+	},
+	"http.cors.method": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpCors).GetMethod()).ToDataRes(types.String)
+	},
+	"http.cors.statusCode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpCors).GetStatusCode()).ToDataRes(types.Int)
+	},
+	"http.cors.header": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpCors).GetHeader()).ToDataRes(types.Resource("http.header"))
+	},
+	"http.cors.allowOrigin": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpCors).GetAllowOrigin()).ToDataRes(types.String)
+	},
+	"http.cors.allowCredentials": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpCors).GetAllowCredentials()).ToDataRes(types.Bool)
+	},
+	"http.cors.allowMethods": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpCors).GetAllowMethods()).ToDataRes(types.Array(types.String))
+	},
+	"http.cors.allowHeaders": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpCors).GetAllowHeaders()).ToDataRes(types.Array(types.String))
+	},
+	"http.cors.exposeHeaders": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpCors).GetExposeHeaders()).ToDataRes(types.Array(types.String))
+	},
+	"http.cors.maxAge": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpCors).GetMaxAge()).ToDataRes(types.Time)
+	},
+	"http.cors.reflectsOrigin": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpCors).GetReflectsOrigin()).ToDataRes(types.Bool)
+	},
+	"http.cors.allowsAnyOrigin": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpCors).GetAllowsAnyOrigin()).ToDataRes(types.Bool)
+	},
+	"http.redirect.url": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRedirect).GetUrl()).ToDataRes(types.String)
+	},
+	"http.redirect.statusCode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRedirect).GetStatusCode()).ToDataRes(types.Int)
+	},
+	"http.redirect.header": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpRedirect).GetHeader()).ToDataRes(types.Resource("http.header"))
+	},
 	"http.header.params": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlHttpHeader).GetParams()).ToDataRes(types.Map(types.String, types.Array(types.String)))
 	},
@@ -230,9 +402,30 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"http.header.setCookie": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlHttpHeader).GetSetCookie()).ToDataRes(types.Resource("http.header.setCookie"))
 	},
+	"http.header.cookies": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeader).GetCookies()).ToDataRes(types.Array(types.Resource("http.header.setCookie")))
+	},
 	"http.header.csp": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlHttpHeader).GetCsp()).ToDataRes(types.Map(types.String, types.String))
 	},
+	"http.header.contentSecurityPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeader).GetContentSecurityPolicy()).ToDataRes(types.Resource("http.header.csp"))
+	},
+	"http.header.contentSecurityPolicyReportOnly": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeader).GetContentSecurityPolicyReportOnly()).ToDataRes(types.Resource("http.header.csp"))
+	},
+	"http.header.permissionsPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeader).GetPermissionsPolicy()).ToDataRes(types.Map(types.String, types.Array(types.String)))
+	},
+	"http.header.crossOriginOpenerPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeader).GetCrossOriginOpenerPolicy()).ToDataRes(types.String)
+	},
+	"http.header.crossOriginEmbedderPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeader).GetCrossOriginEmbedderPolicy()).ToDataRes(types.String)
+	},
+	"http.header.crossOriginResourcePolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeader).GetCrossOriginResourcePolicy()).ToDataRes(types.String)
+	},
 	"http.header.sts.maxAge": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlHttpHeaderSts).GetMaxAge()).ToDataRes(types.Time)
 	},
@@ -242,6 +435,30 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"http.header.sts.preload": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlHttpHeaderSts).GetPreload()).ToDataRes(types.Bool)
 	},
+	"http.header.sts.preloadReady": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderSts).GetPreloadReady()).ToDataRes(types.Bool)
+	},
+	"http.header.csp.directives": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderCsp).GetDirectives()).ToDataRes(types.Map(types.String, types.Array(types.String)))
+	},
+	"http.header.csp.reportOnly": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderCsp).GetReportOnly()).ToDataRes(types.Bool)
+	},
+	"http.header.csp.unsafeInline": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderCsp).GetUnsafeInline()).ToDataRes(types.Bool)
+	},
+	"http.header.csp.unsafeEval": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderCsp).GetUnsafeEval()).ToDataRes(types.Bool)
+	},
+	"http.header.csp.unsafeScriptSources": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderCsp).GetUnsafeScriptSources()).ToDataRes(types.Bool)
+	},
+	"http.header.csp.reportUri": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderCsp).GetReportUri()).ToDataRes(types.Array(types.String))
+	},
+	"http.header.csp.reportTo": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderCsp).GetReportTo()).ToDataRes(types.String)
+	},
 	"http.header.xssProtection.enabled": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlHttpHeaderXssProtection).GetEnabled()).ToDataRes(types.Bool)
 	},
@@ -266,6 +483,30 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"http.header.setCookie.params": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlHttpHeaderSetCookie).GetParams()).ToDataRes(types.Map(types.String, types.String))
 	},
+	"http.header.setCookie.domain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderSetCookie).GetDomain()).ToDataRes(types.String)
+	},
+	"http.header.setCookie.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderSetCookie).GetPath()).ToDataRes(types.String)
+	},
+	"http.header.setCookie.expires": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderSetCookie).GetExpires()).ToDataRes(types.Time)
+	},
+	"http.header.setCookie.maxAge": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderSetCookie).GetMaxAge()).ToDataRes(types.Int)
+	},
+	"http.header.setCookie.secure": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderSetCookie).GetSecure()).ToDataRes(types.Bool)
+	},
+	"http.header.setCookie.httpOnly": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderSetCookie).GetHttpOnly()).ToDataRes(types.Bool)
+	},
+	"http.header.setCookie.sameSite": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderSetCookie).GetSameSite()).ToDataRes(types.String)
+	},
+	"http.header.setCookie.partitioned": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlHttpHeaderSetCookie).GetPartitioned()).ToDataRes(types.Bool)
+	},
 	"url.string": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlUrl).GetString()).ToDataRes(types.String)
 	},
@@ -302,6 +543,12 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"tls.domainName": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlTls).GetDomainName()).ToDataRes(types.String)
 	},
+	"tls.caBundle": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTls).GetCaBundle()).ToDataRes(types.String)
+	},
+	"tls.starttls": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTls).GetStarttls()).ToDataRes(types.String)
+	},
 	"tls.params": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlTls).GetParams()).ToDataRes(types.Dict)
 	},
@@ -320,6 +567,243 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"tls.nonSniCertificates": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlTls).GetNonSniCertificates()).ToDataRes(types.Array(types.Resource("certificate")))
 	},
+	"tls.cipherSuites": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTls).GetCipherSuites()).ToDataRes(types.Array(types.Resource("tls.cipher")))
+	},
+	"tls.fallbackScsv": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTls).GetFallbackScsv()).ToDataRes(types.Bool)
+	},
+	"tls.secureRenegotiation": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTls).GetSecureRenegotiation()).ToDataRes(types.Bool)
+	},
+	"tls.ocspStapling": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTls).GetOcspStapling()).ToDataRes(types.Bool)
+	},
+	"tls.ocspStatus": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTls).GetOcspStatus()).ToDataRes(types.String)
+	},
+	"tls.alpnProtocols": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTls).GetAlpnProtocols()).ToDataRes(types.Array(types.String))
+	},
+	"tls.chainValid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTls).GetChainValid()).ToDataRes(types.Bool)
+	},
+	"tls.chainError": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTls).GetChainError()).ToDataRes(types.String)
+	},
+	"tls.grade": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTls).GetGrade()).ToDataRes(types.String)
+	},
+	"tls.gradeReasons": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTls).GetGradeReasons()).ToDataRes(types.Array(types.String))
+	},
+	"tls.cipher.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTlsCipher).GetName()).ToDataRes(types.String)
+	},
+	"tls.cipher.keyExchange": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTlsCipher).GetKeyExchange()).ToDataRes(types.String)
+	},
+	"tls.cipher.authentication": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTlsCipher).GetAuthentication()).ToDataRes(types.String)
+	},
+	"tls.cipher.encryption": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTlsCipher).GetEncryption()).ToDataRes(types.String)
+	},
+	"tls.cipher.mac": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTlsCipher).GetMac()).ToDataRes(types.String)
+	},
+	"tls.cipher.bits": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTlsCipher).GetBits()).ToDataRes(types.Int)
+	},
+	"tls.cipher.forwardSecrecy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTlsCipher).GetForwardSecrecy()).ToDataRes(types.Bool)
+	},
+	"tls.cipher.aead": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTlsCipher).GetAead()).ToDataRes(types.Bool)
+	},
+	"tls.cipher.grade": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlTlsCipher).GetGrade()).ToDataRes(types.String)
+	},
+	"ssh.server.host": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetHost()).ToDataRes(types.String)
+	},
+	"ssh.server.port": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetPort()).ToDataRes(types.Int)
+	},
+	"ssh.server.banner": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetBanner()).ToDataRes(types.String)
+	},
+	"ssh.server.protocolVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetProtocolVersion()).ToDataRes(types.String)
+	},
+	"ssh.server.softwareVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetSoftwareVersion()).ToDataRes(types.String)
+	},
+	"ssh.server.comments": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetComments()).ToDataRes(types.String)
+	},
+	"ssh.server.kexAlgorithms": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetKexAlgorithms()).ToDataRes(types.Array(types.String))
+	},
+	"ssh.server.hostKeyAlgorithms": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetHostKeyAlgorithms()).ToDataRes(types.Array(types.String))
+	},
+	"ssh.server.ciphers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetCiphers()).ToDataRes(types.Array(types.String))
+	},
+	"ssh.server.macs": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetMacs()).ToDataRes(types.Array(types.String))
+	},
+	"ssh.server.compressionAlgorithms": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetCompressionAlgorithms()).ToDataRes(types.Array(types.String))
+	},
+	"ssh.server.hostKeys": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetHostKeys()).ToDataRes(types.Array(types.Resource("ssh.server.hostKey")))
+	},
+	"ssh.server.authMethods": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetAuthMethods()).ToDataRes(types.Array(types.String))
+	},
+	"ssh.server.errors": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServer).GetErrors()).ToDataRes(types.Array(types.String))
+	},
+	"ssh.server.hostKey.type": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServerHostKey).GetType()).ToDataRes(types.String)
+	},
+	"ssh.server.hostKey.bits": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServerHostKey).GetBits()).ToDataRes(types.Int)
+	},
+	"ssh.server.hostKey.fingerprint": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServerHostKey).GetFingerprint()).ToDataRes(types.String)
+	},
+	"ssh.server.hostKey.md5Fingerprint": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServerHostKey).GetMd5Fingerprint()).ToDataRes(types.String)
+	},
+	"ssh.server.hostKey.publicKey": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSshServerHostKey).GetPublicKey()).ToDataRes(types.String)
+	},
+	"snmp.host": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetHost()).ToDataRes(types.String)
+	},
+	"snmp.port": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetPort()).ToDataRes(types.Int)
+	},
+	"snmp.oids": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetOids()).ToDataRes(types.Array(types.String))
+	},
+	"snmp.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetVersion()).ToDataRes(types.String)
+	},
+	"snmp.v1Accepted": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetV1Accepted()).ToDataRes(types.Bool)
+	},
+	"snmp.v2cAccepted": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetV2cAccepted()).ToDataRes(types.Bool)
+	},
+	"snmp.v3Supported": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetV3Supported()).ToDataRes(types.Bool)
+	},
+	"snmp.engineId": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetEngineId()).ToDataRes(types.String)
+	},
+	"snmp.sysDescr": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetSysDescr()).ToDataRes(types.String)
+	},
+	"snmp.sysObjectID": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetSysObjectID()).ToDataRes(types.String)
+	},
+	"snmp.sysName": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetSysName()).ToDataRes(types.String)
+	},
+	"snmp.sysContact": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetSysContact()).ToDataRes(types.String)
+	},
+	"snmp.sysLocation": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetSysLocation()).ToDataRes(types.String)
+	},
+	"snmp.walk": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetWalk()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"snmp.errors": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlSnmp).GetErrors()).ToDataRes(types.Array(types.String))
+	},
+	"ldap.host": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetHost()).ToDataRes(types.String)
+	},
+	"ldap.port": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetPort()).ToDataRes(types.Int)
+	},
+	"ldap.tls": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetTls()).ToDataRes(types.Bool)
+	},
+	"ldap.rootDSE": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetRootDSE()).ToDataRes(types.Map(types.String, types.Array(types.String)))
+	},
+	"ldap.namingContexts": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetNamingContexts()).ToDataRes(types.Array(types.String))
+	},
+	"ldap.defaultNamingContext": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetDefaultNamingContext()).ToDataRes(types.String)
+	},
+	"ldap.supportedLdapVersions": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetSupportedLdapVersions()).ToDataRes(types.Array(types.Int))
+	},
+	"ldap.supportedSaslMechanisms": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetSupportedSaslMechanisms()).ToDataRes(types.Array(types.String))
+	},
+	"ldap.supportedExtensions": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetSupportedExtensions()).ToDataRes(types.Array(types.String))
+	},
+	"ldap.supportedControls": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetSupportedControls()).ToDataRes(types.Array(types.String))
+	},
+	"ldap.vendorName": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetVendorName()).ToDataRes(types.String)
+	},
+	"ldap.vendorVersion": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetVendorVersion()).ToDataRes(types.String)
+	},
+	"ldap.anonymousBind": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetAnonymousBind()).ToDataRes(types.Bool)
+	},
+	"ldap.anonymousSearch": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetAnonymousSearch()).ToDataRes(types.Bool)
+	},
+	"ldap.startTls": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetStartTls()).ToDataRes(types.Bool)
+	},
+	"ldap.errors": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlLdap).GetErrors()).ToDataRes(types.Array(types.String))
+	},
+	"redis.host": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRedis).GetHost()).ToDataRes(types.String)
+	},
+	"redis.port": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRedis).GetPort()).ToDataRes(types.Int)
+	},
+	"redis.authRequired": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRedis).GetAuthRequired()).ToDataRes(types.Bool)
+	},
+	"redis.protectedMode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRedis).GetProtectedMode()).ToDataRes(types.Bool)
+	},
+	"redis.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRedis).GetVersion()).ToDataRes(types.String)
+	},
+	"redis.mode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRedis).GetMode()).ToDataRes(types.String)
+	},
+	"redis.os": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRedis).GetOs()).ToDataRes(types.String)
+	},
+	"redis.dangerousCommands": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRedis).GetDangerousCommands()).ToDataRes(types.Array(types.String))
+	},
+	"redis.renamedCommands": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRedis).GetRenamedCommands()).ToDataRes(types.Array(types.String))
+	},
+	"redis.errors": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlRedis).GetErrors()).ToDataRes(types.Array(types.String))
+	},
 	"certificates.pem": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlCertificates).GetPem()).ToDataRes(types.String)
 	},
@@ -398,6 +882,15 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"certificate.isVerified": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlCertificate).GetIsVerified()).ToDataRes(types.Bool)
 	},
+	"certificate.publicKeyAlgorithm": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCertificate).GetPublicKeyAlgorithm()).ToDataRes(types.String)
+	},
+	"certificate.publicKeyBits": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCertificate).GetPublicKeyBits()).ToDataRes(types.Int)
+	},
+	"certificate.chain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlCertificate).GetChain()).ToDataRes(types.Array(types.Resource("certificate")))
+	},
 	"pkix.name.id": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlPkixName).GetId()).ToDataRes(types.String)
 	},
@@ -527,6 +1020,39 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"openpgp.signature.keyExpiresIn": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlOpenpgpSignature).GetKeyExpiresIn()).ToDataRes(types.Time)
 	},
+	"jwks.url": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJwks).GetUrl()).ToDataRes(types.String)
+	},
+	"jwks.content": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJwks).GetContent()).ToDataRes(types.String)
+	},
+	"jwks.keys": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJwks).GetKeys()).ToDataRes(types.Array(types.Resource("jwk")))
+	},
+	"jwk.keyId": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJwk).GetKeyId()).ToDataRes(types.String)
+	},
+	"jwk.type": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJwk).GetType()).ToDataRes(types.String)
+	},
+	"jwk.algorithm": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJwk).GetAlgorithm()).ToDataRes(types.String)
+	},
+	"jwk.use": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJwk).GetUse()).ToDataRes(types.String)
+	},
+	"jwk.bits": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJwk).GetBits()).ToDataRes(types.Int)
+	},
+	"jwk.curve": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJwk).GetCurve()).ToDataRes(types.String)
+	},
+	"jwk.public": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJwk).GetPublic()).ToDataRes(types.Bool)
+	},
+	"jwk.thumbprint": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlJwk).GetThumbprint()).ToDataRes(types.String)
+	},
 	"domainName.fqdn": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlDomainName).GetFqdn()).ToDataRes(types.String)
 	},
@@ -557,6 +1083,24 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"dns.dkim": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlDns).GetDkim()).ToDataRes(types.Array(types.Resource("dns.dkimRecord")))
 	},
+	"dns.spf": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetSpf()).ToDataRes(types.Resource("dns.spf"))
+	},
+	"dns.dmarc": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetDmarc()).ToDataRes(types.Resource("dns.dmarc"))
+	},
+	"dns.mtaSts": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetMtaSts()).ToDataRes(types.Resource("dns.mtaSts"))
+	},
+	"dns.bimi": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetBimi()).ToDataRes(types.Resource("dns.bimi"))
+	},
+	"dns.caa": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetCaa()).ToDataRes(types.Resource("dns.caa"))
+	},
+	"dns.dnssec": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDns).GetDnssec()).ToDataRes(types.Resource("dns.dnssec"))
+	},
 	"dns.record.name": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlDnsRecord).GetName()).ToDataRes(types.String)
 	},
@@ -611,6 +1155,171 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"dns.dkimRecord.valid": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlDnsDkimRecord).GetValid()).ToDataRes(types.Bool)
 	},
+	"dns.spf.domain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpf).GetDomain()).ToDataRes(types.String)
+	},
+	"dns.spf.record": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpf).GetRecord()).ToDataRes(types.String)
+	},
+	"dns.spf.mechanisms": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpf).GetMechanisms()).ToDataRes(types.Array(types.Dict))
+	},
+	"dns.spf.modifiers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpf).GetModifiers()).ToDataRes(types.Map(types.String, types.String))
+	},
+	"dns.spf.includes": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpf).GetIncludes()).ToDataRes(types.Array(types.Resource("dns.spf")))
+	},
+	"dns.spf.lookups": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpf).GetLookups()).ToDataRes(types.Int)
+	},
+	"dns.spf.defaultResult": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpf).GetDefaultResult()).ToDataRes(types.String)
+	},
+	"dns.spf.valid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpf).GetValid()).ToDataRes(types.Bool)
+	},
+	"dns.spf.errors": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsSpf).GetErrors()).ToDataRes(types.Array(types.String))
+	},
+	"dns.dmarc.domain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetDomain()).ToDataRes(types.String)
+	},
+	"dns.dmarc.recordDomain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetRecordDomain()).ToDataRes(types.String)
+	},
+	"dns.dmarc.record": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetRecord()).ToDataRes(types.String)
+	},
+	"dns.dmarc.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetVersion()).ToDataRes(types.String)
+	},
+	"dns.dmarc.policy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetPolicy()).ToDataRes(types.String)
+	},
+	"dns.dmarc.subdomainPolicy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetSubdomainPolicy()).ToDataRes(types.String)
+	},
+	"dns.dmarc.percentage": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetPercentage()).ToDataRes(types.Int)
+	},
+	"dns.dmarc.aggregateReports": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetAggregateReports()).ToDataRes(types.Array(types.String))
+	},
+	"dns.dmarc.failureReports": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetFailureReports()).ToDataRes(types.Array(types.String))
+	},
+	"dns.dmarc.dkimAlignment": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetDkimAlignment()).ToDataRes(types.String)
+	},
+	"dns.dmarc.spfAlignment": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetSpfAlignment()).ToDataRes(types.String)
+	},
+	"dns.dmarc.failureOptions": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetFailureOptions()).ToDataRes(types.Array(types.String))
+	},
+	"dns.dmarc.reportInterval": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetReportInterval()).ToDataRes(types.Time)
+	},
+	"dns.dmarc.valid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetValid()).ToDataRes(types.Bool)
+	},
+	"dns.dmarc.errors": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDmarc).GetErrors()).ToDataRes(types.Array(types.String))
+	},
+	"dns.mtaSts.domain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaSts).GetDomain()).ToDataRes(types.String)
+	},
+	"dns.mtaSts.record": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaSts).GetRecord()).ToDataRes(types.String)
+	},
+	"dns.mtaSts.policyId": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaSts).GetPolicyId()).ToDataRes(types.String)
+	},
+	"dns.mtaSts.policy": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaSts).GetPolicy()).ToDataRes(types.String)
+	},
+	"dns.mtaSts.mode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaSts).GetMode()).ToDataRes(types.String)
+	},
+	"dns.mtaSts.mx": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaSts).GetMx()).ToDataRes(types.Array(types.String))
+	},
+	"dns.mtaSts.maxAge": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaSts).GetMaxAge()).ToDataRes(types.Time)
+	},
+	"dns.mtaSts.valid": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaSts).GetValid()).ToDataRes(types.Bool)
+	},
+	"dns.mtaSts.errors": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsMtaSts).GetErrors()).ToDataRes(types.Array(types.String))
+	},
+	"dns.bimi.domain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsBimi).GetDomain()).ToDataRes(types.String)
+	},
+	"dns.bimi.record": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsBimi).GetRecord()).ToDataRes(types.String)
+	},
+	"dns.bimi.version": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsBimi).GetVersion()).ToDataRes(types.String)
+	},
+	"dns.bimi.logo": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsBimi).GetLogo()).ToDataRes(types.String)
+	},
+	"dns.bimi.authority": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsBimi).GetAuthority()).ToDataRes(types.String)
+	},
+	"dns.caa.domain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaa).GetDomain()).ToDataRes(types.String)
+	},
+	"dns.caa.recordDomain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaa).GetRecordDomain()).ToDataRes(types.String)
+	},
+	"dns.caa.issuers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaa).GetIssuers()).ToDataRes(types.Array(types.String))
+	},
+	"dns.caa.wildcardIssuers": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaa).GetWildcardIssuers()).ToDataRes(types.Array(types.String))
+	},
+	"dns.caa.iodef": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaa).GetIodef()).ToDataRes(types.Array(types.String))
+	},
+	"dns.caa.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaa).GetList()).ToDataRes(types.Array(types.Resource("dns.caaRecord")))
+	},
+	"dns.caaRecord.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetName()).ToDataRes(types.String)
+	},
+	"dns.caaRecord.flag": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetFlag()).ToDataRes(types.Int)
+	},
+	"dns.caaRecord.tag": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetTag()).ToDataRes(types.String)
+	},
+	"dns.caaRecord.value": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetValue()).ToDataRes(types.String)
+	},
+	"dns.caaRecord.critical": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsCaaRecord).GetCritical()).ToDataRes(types.Bool)
+	},
+	"dns.dnssec.domain": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetDomain()).ToDataRes(types.String)
+	},
+	"dns.dnssec.status": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetStatus()).ToDataRes(types.String)
+	},
+	"dns.dnssec.signed": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetSigned()).ToDataRes(types.Bool)
+	},
+	"dns.dnssec.zones": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetZones()).ToDataRes(types.Array(types.Dict))
+	},
+	"dns.dnssec.algorithms": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetAlgorithms()).ToDataRes(types.Array(types.String))
+	},
+	"dns.dnssec.errors": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlDnsDnssec).GetErrors()).ToDataRes(types.Array(types.String))
+	},
 }
 
 func GetData(resource plugin.Resource, field string, args map[string]*llx.RawData) *plugin.DataRes {
@@ -667,52 +1376,220 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlHttpGet).Body, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"http.header.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlHttpHeader).__id, ok = v.Value.(string)
+	"http.request.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlHttpRequest).__id, ok = v.Value.(string)
 			return
 		},
-	"http.header.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlHttpHeader).Params, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"http.request.url": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Url, ok = plugin.RawToTValue[*mqlUrl](v.Value, v.Error)
 		return
 	},
-	"http.header.sts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlHttpHeader).Sts, ok = plugin.RawToTValue[*mqlHttpHeaderSts](v.Value, v.Error)
+	"http.request.method": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Method, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"http.header.xFrameOptions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlHttpHeader).XFrameOptions, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"http.request.requestHeaders": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).RequestHeaders, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"http.header.xXssProtection": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlHttpHeader).XXssProtection, ok = plugin.RawToTValue[*mqlHttpHeaderXssProtection](v.Value, v.Error)
+	"http.request.requestBody": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).RequestBody, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"http.header.xContentTypeOptions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlHttpHeader).XContentTypeOptions, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"http.request.followRedirects": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).FollowRedirects, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"http.header.referrerPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlHttpHeader).ReferrerPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"http.request.timeout": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Timeout, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"http.header.contentType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlHttpHeader).ContentType, ok = plugin.RawToTValue[*mqlHttpHeaderContentType](v.Value, v.Error)
+	"http.request.auth": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Auth, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"http.header.setCookie": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlHttpHeader).SetCookie, ok = plugin.RawToTValue[*mqlHttpHeaderSetCookie](v.Value, v.Error)
+	"http.request.header": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Header, ok = plugin.RawToTValue[*mqlHttpHeader](v.Value, v.Error)
 		return
 	},
-	"http.header.csp": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlHttpHeader).Csp, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"http.request.statusCode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).StatusCode, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"http.header.sts.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlHttpHeaderSts).__id, ok = v.Value.(string)
-			return
-		},
-	"http.header.sts.maxAge": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlHttpHeaderSts).MaxAge, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"http.request.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.request.body": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Body, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.request.json": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Json, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"http.request.responseTime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).ResponseTime, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"http.request.redirects": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Redirects, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"http.request.tlsVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).TlsVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.request.certificate": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRequest).Certificate, ok = plugin.RawToTValue[*mqlCertificate](v.Value, v.Error)
+		return
+	},
+	"http.cors.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlHttpCors).__id, ok = v.Value.(string)
+			return
+		},
+	"http.cors.url": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpCors).Url, ok = plugin.RawToTValue[*mqlUrl](v.Value, v.Error)
+		return
+	},
+	"http.cors.origin": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpCors).Origin, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.cors.method": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpCors).Method, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.cors.statusCode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpCors).StatusCode, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"http.cors.header": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpCors).Header, ok = plugin.RawToTValue[*mqlHttpHeader](v.Value, v.Error)
+		return
+	},
+	"http.cors.allowOrigin": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpCors).AllowOrigin, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.cors.allowCredentials": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpCors).AllowCredentials, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"http.cors.allowMethods": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpCors).AllowMethods, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"http.cors.allowHeaders": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpCors).AllowHeaders, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"http.cors.exposeHeaders": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpCors).ExposeHeaders, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"http.cors.maxAge": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpCors).MaxAge, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"http.cors.reflectsOrigin": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpCors).ReflectsOrigin, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"http.cors.allowsAnyOrigin": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpCors).AllowsAnyOrigin, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"http.redirect.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlHttpRedirect).__id, ok = v.Value.(string)
+			return
+		},
+	"http.redirect.url": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRedirect).Url, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.redirect.statusCode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRedirect).StatusCode, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"http.redirect.header": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpRedirect).Header, ok = plugin.RawToTValue[*mqlHttpHeader](v.Value, v.Error)
+		return
+	},
+	"http.header.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlHttpHeader).__id, ok = v.Value.(string)
+			return
+		},
+	"http.header.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).Params, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"http.header.sts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).Sts, ok = plugin.RawToTValue[*mqlHttpHeaderSts](v.Value, v.Error)
+		return
+	},
+	"http.header.xFrameOptions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).XFrameOptions, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.header.xXssProtection": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).XXssProtection, ok = plugin.RawToTValue[*mqlHttpHeaderXssProtection](v.Value, v.Error)
+		return
+	},
+	"http.header.xContentTypeOptions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).XContentTypeOptions, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.header.referrerPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).ReferrerPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.header.contentType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).ContentType, ok = plugin.RawToTValue[*mqlHttpHeaderContentType](v.Value, v.Error)
+		return
+	},
+	"http.header.setCookie": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).SetCookie, ok = plugin.RawToTValue[*mqlHttpHeaderSetCookie](v.Value, v.Error)
+		return
+	},
+	"http.header.cookies": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).Cookies, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"http.header.csp": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).Csp, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"http.header.contentSecurityPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).ContentSecurityPolicy, ok = plugin.RawToTValue[*mqlHttpHeaderCsp](v.Value, v.Error)
+		return
+	},
+	"http.header.contentSecurityPolicyReportOnly": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).ContentSecurityPolicyReportOnly, ok = plugin.RawToTValue[*mqlHttpHeaderCsp](v.Value, v.Error)
+		return
+	},
+	"http.header.permissionsPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).PermissionsPolicy, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"http.header.crossOriginOpenerPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).CrossOriginOpenerPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.header.crossOriginEmbedderPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).CrossOriginEmbedderPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.header.crossOriginResourcePolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeader).CrossOriginResourcePolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.header.sts.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlHttpHeaderSts).__id, ok = v.Value.(string)
+			return
+		},
+	"http.header.sts.maxAge": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderSts).MaxAge, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
 	"http.header.sts.includeSubDomains": func(r plugin.Resource, v *llx.RawData) (ok bool) {
@@ -723,6 +1600,42 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlHttpHeaderSts).Preload, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
+	"http.header.sts.preloadReady": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderSts).PreloadReady, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"http.header.csp.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlHttpHeaderCsp).__id, ok = v.Value.(string)
+			return
+		},
+	"http.header.csp.directives": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderCsp).Directives, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"http.header.csp.reportOnly": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderCsp).ReportOnly, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"http.header.csp.unsafeInline": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderCsp).UnsafeInline, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"http.header.csp.unsafeEval": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderCsp).UnsafeEval, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"http.header.csp.unsafeScriptSources": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderCsp).UnsafeScriptSources, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"http.header.csp.reportUri": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderCsp).ReportUri, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"http.header.csp.reportTo": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderCsp).ReportTo, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
 	"http.header.xssProtection.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 			r.(*mqlHttpHeaderXssProtection).__id, ok = v.Value.(string)
 			return
@@ -767,6 +1680,38 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlHttpHeaderSetCookie).Params, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
+	"http.header.setCookie.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderSetCookie).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.header.setCookie.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderSetCookie).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.header.setCookie.expires": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderSetCookie).Expires, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"http.header.setCookie.maxAge": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderSetCookie).MaxAge, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"http.header.setCookie.secure": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderSetCookie).Secure, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"http.header.setCookie.httpOnly": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderSetCookie).HttpOnly, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"http.header.setCookie.sameSite": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderSetCookie).SameSite, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"http.header.setCookie.partitioned": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlHttpHeaderSetCookie).Partitioned, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
 	"url.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 			r.(*mqlUrl).__id, ok = v.Value.(string)
 			return
@@ -823,6 +1768,14 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlTls).DomainName, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
+	"tls.caBundle": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTls).CaBundle, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"tls.starttls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTls).Starttls, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
 	"tls.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 		r.(*mqlTls).Params, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
@@ -847,487 +1800,3338 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlTls).NonSniCertificates, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"certificates.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlCertificates).__id, ok = v.Value.(string)
-			return
-		},
-	"certificates.pem": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificates).Pem, ok = plugin.RawToTValue[string](v.Value, v.Error)
-		return
-	},
-	"certificates.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificates).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"tls.cipherSuites": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTls).CipherSuites, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"certificate.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlCertificate).__id, ok = v.Value.(string)
-			return
-		},
-	"certificate.pem": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).Pem, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"tls.fallbackScsv": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTls).FallbackScsv, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"certificate.fingerprints": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).Fingerprints, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"tls.secureRenegotiation": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTls).SecureRenegotiation, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"certificate.serial": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).Serial, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"tls.ocspStapling": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTls).OcspStapling, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"certificate.subjectKeyID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).SubjectKeyID, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"tls.ocspStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTls).OcspStatus, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"certificate.authorityKeyID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).AuthorityKeyID, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"tls.alpnProtocols": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTls).AlpnProtocols, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"certificate.subject": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).Subject, ok = plugin.RawToTValue[*mqlPkixName](v.Value, v.Error)
+	"tls.chainValid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTls).ChainValid, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"certificate.issuer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).Issuer, ok = plugin.RawToTValue[*mqlPkixName](v.Value, v.Error)
+	"tls.chainError": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTls).ChainError, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"certificate.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).Version, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"tls.grade": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTls).Grade, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"certificate.notBefore": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).NotBefore, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"tls.gradeReasons": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTls).GradeReasons, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"certificate.notAfter": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).NotAfter, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"tls.cipher.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlTlsCipher).__id, ok = v.Value.(string)
+			return
+		},
+	"tls.cipher.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTlsCipher).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"certificate.expiresIn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).ExpiresIn, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"tls.cipher.keyExchange": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTlsCipher).KeyExchange, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"certificate.signature": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).Signature, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"tls.cipher.authentication": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTlsCipher).Authentication, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"certificate.signingAlgorithm": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).SigningAlgorithm, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"tls.cipher.encryption": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTlsCipher).Encryption, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"certificate.isCA": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).IsCA, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"tls.cipher.mac": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTlsCipher).Mac, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"certificate.keyUsage": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).KeyUsage, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"tls.cipher.bits": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTlsCipher).Bits, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"certificate.extendedKeyUsage": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).ExtendedKeyUsage, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"tls.cipher.forwardSecrecy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTlsCipher).ForwardSecrecy, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"certificate.extensions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).Extensions, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"tls.cipher.aead": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTlsCipher).Aead, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"certificate.policyIdentifier": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).PolicyIdentifier, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"tls.cipher.grade": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlTlsCipher).Grade, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"certificate.crlDistributionPoints": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).CrlDistributionPoints, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"ssh.server.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSshServer).__id, ok = v.Value.(string)
+			return
+		},
+	"ssh.server.host": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Host, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"certificate.ocspServer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).OcspServer, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"ssh.server.port": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Port, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"certificate.issuingCertificateUrl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).IssuingCertificateUrl, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"ssh.server.banner": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Banner, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"certificate.isRevoked": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).IsRevoked, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"ssh.server.protocolVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).ProtocolVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"certificate.revokedAt": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).RevokedAt, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"ssh.server.softwareVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).SoftwareVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"certificate.isVerified": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlCertificate).IsVerified, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"ssh.server.comments": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Comments, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"pkix.name.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlPkixName).__id, ok = v.Value.(string)
-			return
-		},
-	"pkix.name.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixName).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ssh.server.kexAlgorithms": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).KexAlgorithms, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"pkix.name.dn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixName).Dn, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ssh.server.hostKeyAlgorithms": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).HostKeyAlgorithms, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"pkix.name.serialNumber": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixName).SerialNumber, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ssh.server.ciphers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Ciphers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"pkix.name.commonName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixName).CommonName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ssh.server.macs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Macs, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"pkix.name.country": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixName).Country, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"ssh.server.compressionAlgorithms": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).CompressionAlgorithms, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"pkix.name.organization": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixName).Organization, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"ssh.server.hostKeys": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).HostKeys, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"pkix.name.organizationalUnit": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixName).OrganizationalUnit, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"ssh.server.authMethods": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).AuthMethods, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"pkix.name.locality": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixName).Locality, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"ssh.server.errors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServer).Errors, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"pkix.name.province": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixName).Province, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"ssh.server.hostKey.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSshServerHostKey).__id, ok = v.Value.(string)
+			return
+		},
+	"ssh.server.hostKey.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServerHostKey).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"pkix.name.streetAddress": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixName).StreetAddress, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"ssh.server.hostKey.bits": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServerHostKey).Bits, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"pkix.name.postalCode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixName).PostalCode, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"ssh.server.hostKey.fingerprint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServerHostKey).Fingerprint, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"pkix.name.names": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixName).Names, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"ssh.server.hostKey.md5Fingerprint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServerHostKey).Md5Fingerprint, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"pkix.name.extraNames": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixName).ExtraNames, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+	"ssh.server.hostKey.publicKey": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSshServerHostKey).PublicKey, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"pkix.extension.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlPkixExtension).__id, ok = v.Value.(string)
+	"snmp.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlSnmp).__id, ok = v.Value.(string)
 			return
 		},
-	"pkix.extension.identifier": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixExtension).Identifier, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"snmp.host": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).Host, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"pkix.extension.critical": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixExtension).Critical, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"snmp.port": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).Port, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"pkix.extension.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlPkixExtension).Value, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"snmp.oids": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).Oids, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"openpgp.entities.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlOpenpgpEntities).__id, ok = v.Value.(string)
-			return
-		},
-	"openpgp.entities.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpEntities).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"snmp.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"openpgp.entities.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpEntities).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"snmp.v1Accepted": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).V1Accepted, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"openpgp.entity.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlOpenpgpEntity).__id, ok = v.Value.(string)
-			return
-		},
-	"openpgp.entity.primaryPublicKey": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpEntity).PrimaryPublicKey, ok = plugin.RawToTValue[*mqlOpenpgpPublicKey](v.Value, v.Error)
+	"snmp.v2cAccepted": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).V2cAccepted, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"openpgp.entity.identities": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpEntity).Identities, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"snmp.v3Supported": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).V3Supported, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"openpgp.publicKey.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlOpenpgpPublicKey).__id, ok = v.Value.(string)
-			return
-		},
-	"openpgp.publicKey.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpPublicKey).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"snmp.engineId": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).EngineId, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"openpgp.publicKey.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpPublicKey).Version, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"snmp.sysDescr": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).SysDescr, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"openpgp.publicKey.fingerprint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpPublicKey).Fingerprint, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"snmp.sysObjectID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).SysObjectID, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"openpgp.publicKey.keyAlgorithm": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpPublicKey).KeyAlgorithm, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"snmp.sysName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).SysName, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"openpgp.publicKey.bitLength": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpPublicKey).BitLength, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"snmp.sysContact": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).SysContact, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"openpgp.publicKey.creationTime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpPublicKey).CreationTime, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"snmp.sysLocation": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).SysLocation, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"openpgp.identity.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlOpenpgpIdentity).__id, ok = v.Value.(string)
+	"snmp.walk": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).Walk, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"snmp.errors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlSnmp).Errors, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ldap.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlLdap).__id, ok = v.Value.(string)
 			return
 		},
-	"openpgp.identity.fingerprint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpIdentity).Fingerprint, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ldap.host": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).Host, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"openpgp.identity.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpIdentity).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ldap.port": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).Port, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"openpgp.identity.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpIdentity).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ldap.tls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).Tls, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"openpgp.identity.email": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpIdentity).Email, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ldap.rootDSE": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).RootDSE, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"openpgp.identity.comment": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpIdentity).Comment, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"ldap.namingContexts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).NamingContexts, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"openpgp.identity.signatures": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpIdentity).Signatures, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"ldap.defaultNamingContext": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).DefaultNamingContext, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"openpgp.signature.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlOpenpgpSignature).__id, ok = v.Value.(string)
+	"ldap.supportedLdapVersions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).SupportedLdapVersions, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ldap.supportedSaslMechanisms": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).SupportedSaslMechanisms, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ldap.supportedExtensions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).SupportedExtensions, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ldap.supportedControls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).SupportedControls, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"ldap.vendorName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).VendorName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ldap.vendorVersion": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).VendorVersion, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"ldap.anonymousBind": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).AnonymousBind, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"ldap.anonymousSearch": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).AnonymousSearch, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"ldap.startTls": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).StartTls, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"ldap.errors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlLdap).Errors, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"redis.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlRedis).__id, ok = v.Value.(string)
 			return
 		},
-	"openpgp.signature.fingerprint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpSignature).Fingerprint, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"redis.host": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRedis).Host, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"openpgp.signature.identityName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpSignature).IdentityName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"redis.port": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRedis).Port, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"openpgp.signature.hash": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpSignature).Hash, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"redis.authRequired": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRedis).AuthRequired, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"openpgp.signature.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpSignature).Version, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"redis.protectedMode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRedis).ProtectedMode, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"openpgp.signature.signatureType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpSignature).SignatureType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"redis.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRedis).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"openpgp.signature.keyAlgorithm": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpSignature).KeyAlgorithm, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"redis.mode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRedis).Mode, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"openpgp.signature.creationTime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpSignature).CreationTime, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"redis.os": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRedis).Os, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"openpgp.signature.lifetimeSecs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpSignature).LifetimeSecs, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"redis.dangerousCommands": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRedis).DangerousCommands, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"openpgp.signature.expiresIn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpSignature).ExpiresIn, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"redis.renamedCommands": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRedis).RenamedCommands, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"openpgp.signature.keyLifetimeSecs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpSignature).KeyLifetimeSecs, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"redis.errors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlRedis).Errors, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"openpgp.signature.keyExpiresIn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlOpenpgpSignature).KeyExpiresIn, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+	"certificates.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlCertificates).__id, ok = v.Value.(string)
+			return
+		},
+	"certificates.pem": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificates).Pem, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"domainName.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDomainName).__id, ok = v.Value.(string)
+	"certificates.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificates).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"certificate.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlCertificate).__id, ok = v.Value.(string)
 			return
 		},
-	"domainName.fqdn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDomainName).Fqdn, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.pem": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).Pem, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"domainName.effectiveTLDPlusOne": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDomainName).EffectiveTLDPlusOne, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.fingerprints": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).Fingerprints, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
 		return
 	},
-	"domainName.tld": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDomainName).Tld, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.serial": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).Serial, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"domainName.tldIcannManaged": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDomainName).TldIcannManaged, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"certificate.subjectKeyID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).SubjectKeyID, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"domainName.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDomainName).Labels, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"certificate.authorityKeyID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).AuthorityKeyID, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"dns.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDns).__id, ok = v.Value.(string)
-			return
-		},
-	"dns.fqdn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDns).Fqdn, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.subject": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).Subject, ok = plugin.RawToTValue[*mqlPkixName](v.Value, v.Error)
 		return
 	},
-	"dns.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDns).Params, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+	"certificate.issuer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).Issuer, ok = plugin.RawToTValue[*mqlPkixName](v.Value, v.Error)
 		return
 	},
-	"dns.records": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDns).Records, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"certificate.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).Version, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"dns.mx": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDns).Mx, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"certificate.notBefore": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).NotBefore, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
-	"dns.dkim": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDns).Dkim, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"certificate.notAfter": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).NotAfter, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
-	"dns.record.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDnsRecord).__id, ok = v.Value.(string)
-			return
-		},
-	"dns.record.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsRecord).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.expiresIn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).ExpiresIn, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
-	"dns.record.ttl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsRecord).Ttl, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"certificate.signature": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).Signature, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"dns.record.class": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsRecord).Class, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.signingAlgorithm": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).SigningAlgorithm, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"dns.record.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsRecord).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.isCA": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).IsCA, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"dns.record.rdata": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsRecord).Rdata, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"certificate.keyUsage": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).KeyUsage, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"dns.mxRecord.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDnsMxRecord).__id, ok = v.Value.(string)
-			return
-		},
-	"dns.mxRecord.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsMxRecord).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.extendedKeyUsage": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).ExtendedKeyUsage, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"dns.mxRecord.preference": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsMxRecord).Preference, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+	"certificate.extensions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).Extensions, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"dns.mxRecord.domainName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsMxRecord).DomainName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.policyIdentifier": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).PolicyIdentifier, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"dns.dkimRecord.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-			r.(*mqlDnsDkimRecord).__id, ok = v.Value.(string)
-			return
-		},
-	"dns.dkimRecord.dnsTxt": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsDkimRecord).DnsTxt, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.crlDistributionPoints": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).CrlDistributionPoints, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"dns.dkimRecord.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsDkimRecord).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.ocspServer": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).OcspServer, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"dns.dkimRecord.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsDkimRecord).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.issuingCertificateUrl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).IssuingCertificateUrl, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"dns.dkimRecord.hashAlgorithms": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsDkimRecord).HashAlgorithms, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"certificate.isRevoked": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).IsRevoked, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"dns.dkimRecord.keyType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsDkimRecord).KeyType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.revokedAt": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).RevokedAt, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
 		return
 	},
-	"dns.dkimRecord.notes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsDkimRecord).Notes, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.isVerified": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).IsVerified, ok = plugin.RawToTValue[bool](v.Value, v.Error)
 		return
 	},
-	"dns.dkimRecord.publicKeyData": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsDkimRecord).PublicKeyData, ok = plugin.RawToTValue[string](v.Value, v.Error)
+	"certificate.publicKeyAlgorithm": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).PublicKeyAlgorithm, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-	"dns.dkimRecord.serviceTypes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsDkimRecord).ServiceTypes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"certificate.publicKeyBits": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).PublicKeyBits, ok = plugin.RawToTValue[int64](v.Value, v.Error)
 		return
 	},
-	"dns.dkimRecord.flags": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsDkimRecord).Flags, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+	"certificate.chain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlCertificate).Chain, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
-	"dns.dkimRecord.valid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
-		r.(*mqlDnsDkimRecord).Valid, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+	"pkix.name.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlPkixName).__id, ok = v.Value.(string)
+			return
+		},
+	"pkix.name.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixName).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
 		return
 	},
-}
-
-func SetData(resource plugin.Resource, field string, val *llx.RawData) error {
-	f, ok := setDataFields[resource.MqlName() + "." + field]
-	if !ok {
-		return errors.New("[network] cannot set '"+field+"' in resource '"+resource.MqlName()+"', field not found")
-	}
-
-	if ok := f(resource, val); !ok {
-		return errors.New("[network] cannot set '"+field+"' in resource '"+resource.MqlName()+"', type does not match")
-	}
-	return nil
-}
-
-func SetAllData(resource plugin.Resource, args map[string]*llx.RawData) error {
-	var err error
-	for k, v := range args {
-		if err = SetData(resource, k, v); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
+	"pkix.name.dn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixName).Dn, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"pkix.name.serialNumber": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixName).SerialNumber, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"pkix.name.commonName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixName).CommonName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"pkix.name.country": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixName).Country, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"pkix.name.organization": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixName).Organization, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"pkix.name.organizationalUnit": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixName).OrganizationalUnit, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"pkix.name.locality": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixName).Locality, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"pkix.name.province": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixName).Province, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"pkix.name.streetAddress": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixName).StreetAddress, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"pkix.name.postalCode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixName).PostalCode, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"pkix.name.names": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixName).Names, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"pkix.name.extraNames": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixName).ExtraNames, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"pkix.extension.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlPkixExtension).__id, ok = v.Value.(string)
+			return
+		},
+	"pkix.extension.identifier": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixExtension).Identifier, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"pkix.extension.critical": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixExtension).Critical, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"pkix.extension.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlPkixExtension).Value, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.entities.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlOpenpgpEntities).__id, ok = v.Value.(string)
+			return
+		},
+	"openpgp.entities.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpEntities).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.entities.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpEntities).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"openpgp.entity.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlOpenpgpEntity).__id, ok = v.Value.(string)
+			return
+		},
+	"openpgp.entity.primaryPublicKey": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpEntity).PrimaryPublicKey, ok = plugin.RawToTValue[*mqlOpenpgpPublicKey](v.Value, v.Error)
+		return
+	},
+	"openpgp.entity.identities": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpEntity).Identities, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"openpgp.publicKey.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlOpenpgpPublicKey).__id, ok = v.Value.(string)
+			return
+		},
+	"openpgp.publicKey.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpPublicKey).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.publicKey.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpPublicKey).Version, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"openpgp.publicKey.fingerprint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpPublicKey).Fingerprint, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.publicKey.keyAlgorithm": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpPublicKey).KeyAlgorithm, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.publicKey.bitLength": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpPublicKey).BitLength, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"openpgp.publicKey.creationTime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpPublicKey).CreationTime, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"openpgp.identity.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlOpenpgpIdentity).__id, ok = v.Value.(string)
+			return
+		},
+	"openpgp.identity.fingerprint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpIdentity).Fingerprint, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.identity.id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpIdentity).Id, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.identity.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpIdentity).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.identity.email": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpIdentity).Email, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.identity.comment": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpIdentity).Comment, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.identity.signatures": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpIdentity).Signatures, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"openpgp.signature.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlOpenpgpSignature).__id, ok = v.Value.(string)
+			return
+		},
+	"openpgp.signature.fingerprint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpSignature).Fingerprint, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.signature.identityName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpSignature).IdentityName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.signature.hash": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpSignature).Hash, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.signature.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpSignature).Version, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"openpgp.signature.signatureType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpSignature).SignatureType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.signature.keyAlgorithm": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpSignature).KeyAlgorithm, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"openpgp.signature.creationTime": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpSignature).CreationTime, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"openpgp.signature.lifetimeSecs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpSignature).LifetimeSecs, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"openpgp.signature.expiresIn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpSignature).ExpiresIn, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"openpgp.signature.keyLifetimeSecs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpSignature).KeyLifetimeSecs, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"openpgp.signature.keyExpiresIn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOpenpgpSignature).KeyExpiresIn, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"jwks.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlJwks).__id, ok = v.Value.(string)
+			return
+		},
+	"jwks.url": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJwks).Url, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"jwks.content": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJwks).Content, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"jwks.keys": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJwks).Keys, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"jwk.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlJwk).__id, ok = v.Value.(string)
+			return
+		},
+	"jwk.keyId": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJwk).KeyId, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"jwk.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJwk).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"jwk.algorithm": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJwk).Algorithm, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"jwk.use": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJwk).Use, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"jwk.bits": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJwk).Bits, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"jwk.curve": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJwk).Curve, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"jwk.public": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJwk).Public, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"jwk.thumbprint": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlJwk).Thumbprint, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"domainName.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDomainName).__id, ok = v.Value.(string)
+			return
+		},
+	"domainName.fqdn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDomainName).Fqdn, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"domainName.effectiveTLDPlusOne": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDomainName).EffectiveTLDPlusOne, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"domainName.tld": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDomainName).Tld, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"domainName.tldIcannManaged": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDomainName).TldIcannManaged, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"domainName.labels": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDomainName).Labels, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDns).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.fqdn": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Fqdn, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.params": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Params, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.records": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Records, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.mx": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Mx, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.dkim": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Dkim, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.spf": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Spf, ok = plugin.RawToTValue[*mqlDnsSpf](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Dmarc, ok = plugin.RawToTValue[*mqlDnsDmarc](v.Value, v.Error)
+		return
+	},
+	"dns.mtaSts": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).MtaSts, ok = plugin.RawToTValue[*mqlDnsMtaSts](v.Value, v.Error)
+		return
+	},
+	"dns.bimi": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Bimi, ok = plugin.RawToTValue[*mqlDnsBimi](v.Value, v.Error)
+		return
+	},
+	"dns.caa": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Caa, ok = plugin.RawToTValue[*mqlDnsCaa](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDns).Dnssec, ok = plugin.RawToTValue[*mqlDnsDnssec](v.Value, v.Error)
+		return
+	},
+	"dns.record.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsRecord).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.record.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsRecord).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.record.ttl": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsRecord).Ttl, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.record.class": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsRecord).Class, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.record.type": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsRecord).Type, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.record.rdata": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsRecord).Rdata, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.mxRecord.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsMxRecord).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.mxRecord.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMxRecord).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.mxRecord.preference": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMxRecord).Preference, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.mxRecord.domainName": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMxRecord).DomainName, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dkimRecord.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsDkimRecord).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.dkimRecord.dnsTxt": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDkimRecord).DnsTxt, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dkimRecord.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDkimRecord).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dkimRecord.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDkimRecord).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dkimRecord.hashAlgorithms": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDkimRecord).HashAlgorithms, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.dkimRecord.keyType": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDkimRecord).KeyType, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dkimRecord.notes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDkimRecord).Notes, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dkimRecord.publicKeyData": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDkimRecord).PublicKeyData, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dkimRecord.serviceTypes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDkimRecord).ServiceTypes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.dkimRecord.flags": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDkimRecord).Flags, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.dkimRecord.valid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDkimRecord).Valid, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.spf.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsSpf).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.spf.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpf).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.spf.record": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpf).Record, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.spf.mechanisms": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpf).Mechanisms, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.spf.modifiers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpf).Modifiers, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.spf.includes": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpf).Includes, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.spf.lookups": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpf).Lookups, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.spf.defaultResult": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpf).DefaultResult, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.spf.valid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpf).Valid, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.spf.errors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsSpf).Errors, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsDmarc).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.dmarc.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.recordDomain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).RecordDomain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.record": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).Record, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.policy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).Policy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.subdomainPolicy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).SubdomainPolicy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.percentage": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).Percentage, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.aggregateReports": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).AggregateReports, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.failureReports": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).FailureReports, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.dkimAlignment": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).DkimAlignment, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.spfAlignment": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).SpfAlignment, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.failureOptions": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).FailureOptions, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.reportInterval": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).ReportInterval, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.valid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).Valid, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.dmarc.errors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDmarc).Errors, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.mtaSts.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsMtaSts).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.mtaSts.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaSts).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.mtaSts.record": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaSts).Record, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.mtaSts.policyId": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaSts).PolicyId, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.mtaSts.policy": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaSts).Policy, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.mtaSts.mode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaSts).Mode, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.mtaSts.mx": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaSts).Mx, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.mtaSts.maxAge": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaSts).MaxAge, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"dns.mtaSts.valid": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaSts).Valid, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.mtaSts.errors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsMtaSts).Errors, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.bimi.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsBimi).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.bimi.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsBimi).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.bimi.record": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsBimi).Record, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.bimi.version": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsBimi).Version, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.bimi.logo": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsBimi).Logo, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.bimi.authority": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsBimi).Authority, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.caa.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsCaa).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.caa.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaa).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.caa.recordDomain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaa).RecordDomain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.caa.issuers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaa).Issuers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.caa.wildcardIssuers": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaa).WildcardIssuers, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.caa.iodef": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaa).Iodef, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.caa.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaa).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsCaaRecord).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.caaRecord.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.flag": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Flag, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.tag": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Tag, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.value": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Value, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.caaRecord.critical": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsCaaRecord).Critical, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlDnsDnssec).__id, ok = v.Value.(string)
+			return
+		},
+	"dns.dnssec.domain": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).Domain, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.status": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).Status, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.signed": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).Signed, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.zones": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).Zones, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.algorithms": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).Algorithms, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"dns.dnssec.errors": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlDnsDnssec).Errors, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+}
+
+func SetData(resource plugin.Resource, field string, val *llx.RawData) error {
+	f, ok := setDataFields[resource.MqlName() + "." + field]
+	if !ok {
+		return errors.New("[network] cannot set '"+field+"' in resource '"+resource.MqlName()+"', field not found")
+	}
+
+	if ok := f(resource, val); !ok {
+		return errors.New("[network] cannot set '"+field+"' in resource '"+resource.MqlName()+"', type does not match")
+	}
+	return nil
+}
+
+func SetAllData(resource plugin.Resource, args map[string]*llx.RawData) error {
+	var err error
+	for k, v := range args {
+		if err = SetData(resource, k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // mqlSocket for the socket resource
 type mqlSocket struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlSocketInternal it will be used here
-	Protocol plugin.TValue[string]
+	// optional: if you define mqlSocketInternal it will be used here
+	Protocol plugin.TValue[string]
+	Port plugin.TValue[int64]
+	Address plugin.TValue[string]
+}
+
+// createSocket creates a new instance of this resource
+func createSocket(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSocket{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("socket", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlSocket) MqlName() string {
+	return "socket"
+}
+
+func (c *mqlSocket) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlSocket) GetProtocol() *plugin.TValue[string] {
+	return &c.Protocol
+}
+
+func (c *mqlSocket) GetPort() *plugin.TValue[int64] {
+	return &c.Port
+}
+
+func (c *mqlSocket) GetAddress() *plugin.TValue[string] {
+	return &c.Address
+}
+
+// mqlHttp for the http resource
+type mqlHttp struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlHttpInternal it will be used here
+}
+
+// createHttp creates a new instance of this resource
+func createHttp(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttp{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttp) MqlName() string {
+	return "http"
+}
+
+func (c *mqlHttp) MqlID() string {
+	return c.__id
+}
+
+// mqlHttpGet for the http.get resource
+type mqlHttpGet struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlHttpGetInternal
+	Url plugin.TValue[*mqlUrl]
+	Header plugin.TValue[*mqlHttpHeader]
+	StatusCode plugin.TValue[int64]
+	Version plugin.TValue[string]
+	Body plugin.TValue[string]
+}
+
+// createHttpGet creates a new instance of this resource
+func createHttpGet(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpGet{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http.get", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttpGet) MqlName() string {
+	return "http.get"
+}
+
+func (c *mqlHttpGet) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlHttpGet) GetUrl() *plugin.TValue[*mqlUrl] {
+	return &c.Url
+}
+
+func (c *mqlHttpGet) GetHeader() *plugin.TValue[*mqlHttpHeader] {
+	return plugin.GetOrCompute[*mqlHttpHeader](&c.Header, func() (*mqlHttpHeader, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.get", c.__id, "header")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlHttpHeader), nil
+			}
+		}
+
+		return c.header()
+	})
+}
+
+func (c *mqlHttpGet) GetStatusCode() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.StatusCode, func() (int64, error) {
+		return c.statusCode()
+	})
+}
+
+func (c *mqlHttpGet) GetVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
+		return c.version()
+	})
+}
+
+func (c *mqlHttpGet) GetBody() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Body, func() (string, error) {
+		return c.body()
+	})
+}
+
+// mqlHttpRequest for the http.request resource
+type mqlHttpRequest struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlHttpRequestInternal
+	Url plugin.TValue[*mqlUrl]
+	Method plugin.TValue[string]
+	RequestHeaders plugin.TValue[map[string]interface{}]
+	RequestBody plugin.TValue[string]
+	FollowRedirects plugin.TValue[bool]
+	Timeout plugin.TValue[int64]
+	Auth plugin.TValue[string]
+	Header plugin.TValue[*mqlHttpHeader]
+	StatusCode plugin.TValue[int64]
+	Version plugin.TValue[string]
+	Body plugin.TValue[string]
+	Json plugin.TValue[interface{}]
+	ResponseTime plugin.TValue[*time.Time]
+	Redirects plugin.TValue[[]interface{}]
+	TlsVersion plugin.TValue[string]
+	Certificate plugin.TValue[*mqlCertificate]
+}
+
+// createHttpRequest creates a new instance of this resource
+func createHttpRequest(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpRequest{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http.request", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttpRequest) MqlName() string {
+	return "http.request"
+}
+
+func (c *mqlHttpRequest) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlHttpRequest) GetUrl() *plugin.TValue[*mqlUrl] {
+	return &c.Url
+}
+
+func (c *mqlHttpRequest) GetMethod() *plugin.TValue[string] {
+	return &c.Method
+}
+
+func (c *mqlHttpRequest) GetRequestHeaders() *plugin.TValue[map[string]interface{}] {
+	return &c.RequestHeaders
+}
+
+func (c *mqlHttpRequest) GetRequestBody() *plugin.TValue[string] {
+	return &c.RequestBody
+}
+
+func (c *mqlHttpRequest) GetFollowRedirects() *plugin.TValue[bool] {
+	return &c.FollowRedirects
+}
+
+func (c *mqlHttpRequest) GetTimeout() *plugin.TValue[int64] {
+	return &c.Timeout
+}
+
+func (c *mqlHttpRequest) GetAuth() *plugin.TValue[string] {
+	return &c.Auth
+}
+
+func (c *mqlHttpRequest) GetHeader() *plugin.TValue[*mqlHttpHeader] {
+	return plugin.GetOrCompute[*mqlHttpHeader](&c.Header, func() (*mqlHttpHeader, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.request", c.__id, "header")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlHttpHeader), nil
+			}
+		}
+
+		return c.header()
+	})
+}
+
+func (c *mqlHttpRequest) GetStatusCode() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.StatusCode, func() (int64, error) {
+		return c.statusCode()
+	})
+}
+
+func (c *mqlHttpRequest) GetVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
+		return c.version()
+	})
+}
+
+func (c *mqlHttpRequest) GetBody() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Body, func() (string, error) {
+		return c.body()
+	})
+}
+
+func (c *mqlHttpRequest) GetJson() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Json, func() (interface{}, error) {
+		return c.json()
+	})
+}
+
+func (c *mqlHttpRequest) GetResponseTime() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.ResponseTime, func() (*time.Time, error) {
+		return c.responseTime()
+	})
+}
+
+func (c *mqlHttpRequest) GetRedirects() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Redirects, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.request", c.__id, "redirects")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.redirects()
+	})
+}
+
+func (c *mqlHttpRequest) GetTlsVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.TlsVersion, func() (string, error) {
+		return c.tlsVersion()
+	})
+}
+
+func (c *mqlHttpRequest) GetCertificate() *plugin.TValue[*mqlCertificate] {
+	return plugin.GetOrCompute[*mqlCertificate](&c.Certificate, func() (*mqlCertificate, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.request", c.__id, "certificate")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlCertificate), nil
+			}
+		}
+
+		return c.certificate()
+	})
+}
+
+// mqlHttpCors for the http.cors resource
+type mqlHttpCors struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlHttpCorsInternal
+	Url plugin.TValue[*mqlUrl]
+	Origin plugin.TValue[string]
+	Method plugin.TValue[string]
+	StatusCode plugin.TValue[int64]
+	Header plugin.TValue[*mqlHttpHeader]
+	AllowOrigin plugin.TValue[string]
+	AllowCredentials plugin.TValue[bool]
+	AllowMethods plugin.TValue[[]interface{}]
+	AllowHeaders plugin.TValue[[]interface{}]
+	ExposeHeaders plugin.TValue[[]interface{}]
+	MaxAge plugin.TValue[*time.Time]
+	ReflectsOrigin plugin.TValue[bool]
+	AllowsAnyOrigin plugin.TValue[bool]
+}
+
+// createHttpCors creates a new instance of this resource
+func createHttpCors(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpCors{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http.cors", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttpCors) MqlName() string {
+	return "http.cors"
+}
+
+func (c *mqlHttpCors) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlHttpCors) GetUrl() *plugin.TValue[*mqlUrl] {
+	return &c.Url
+}
+
+func (c *mqlHttpCors) GetOrigin() *plugin.TValue[string] {
+	return &c.Origin
+}
+
+func (c *mqlHttpCors) GetMethod() *plugin.TValue[string] {
+	return &c.Method
+}
+
+func (c *mqlHttpCors) GetStatusCode() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.StatusCode, func() (int64, error) {
+		return c.statusCode()
+	})
+}
+
+func (c *mqlHttpCors) GetHeader() *plugin.TValue[*mqlHttpHeader] {
+	return plugin.GetOrCompute[*mqlHttpHeader](&c.Header, func() (*mqlHttpHeader, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.cors", c.__id, "header")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlHttpHeader), nil
+			}
+		}
+
+		return c.header()
+	})
+}
+
+func (c *mqlHttpCors) GetAllowOrigin() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.AllowOrigin, func() (string, error) {
+		return c.allowOrigin()
+	})
+}
+
+func (c *mqlHttpCors) GetAllowCredentials() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.AllowCredentials, func() (bool, error) {
+		return c.allowCredentials()
+	})
+}
+
+func (c *mqlHttpCors) GetAllowMethods() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.AllowMethods, func() ([]interface{}, error) {
+		return c.allowMethods()
+	})
+}
+
+func (c *mqlHttpCors) GetAllowHeaders() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.AllowHeaders, func() ([]interface{}, error) {
+		return c.allowHeaders()
+	})
+}
+
+func (c *mqlHttpCors) GetExposeHeaders() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.ExposeHeaders, func() ([]interface{}, error) {
+		return c.exposeHeaders()
+	})
+}
+
+func (c *mqlHttpCors) GetMaxAge() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.MaxAge, func() (*time.Time, error) {
+		return c.maxAge()
+	})
+}
+
+func (c *mqlHttpCors) GetReflectsOrigin() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.ReflectsOrigin, func() (bool, error) {
+		return c.reflectsOrigin()
+	})
+}
+
+func (c *mqlHttpCors) GetAllowsAnyOrigin() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.AllowsAnyOrigin, func() (bool, error) {
+		return c.allowsAnyOrigin()
+	})
+}
+
+// mqlHttpRedirect for the http.redirect resource
+type mqlHttpRedirect struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlHttpRedirectInternal it will be used here
+	Url plugin.TValue[string]
+	StatusCode plugin.TValue[int64]
+	Header plugin.TValue[*mqlHttpHeader]
+}
+
+// createHttpRedirect creates a new instance of this resource
+func createHttpRedirect(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpRedirect{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http.redirect", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttpRedirect) MqlName() string {
+	return "http.redirect"
+}
+
+func (c *mqlHttpRedirect) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlHttpRedirect) GetUrl() *plugin.TValue[string] {
+	return &c.Url
+}
+
+func (c *mqlHttpRedirect) GetStatusCode() *plugin.TValue[int64] {
+	return &c.StatusCode
+}
+
+func (c *mqlHttpRedirect) GetHeader() *plugin.TValue[*mqlHttpHeader] {
+	return &c.Header
+}
+
+// mqlHttpHeader for the http.header resource
+type mqlHttpHeader struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlHttpHeaderInternal it will be used here
+	Params plugin.TValue[map[string]interface{}]
+	Sts plugin.TValue[*mqlHttpHeaderSts]
+	XFrameOptions plugin.TValue[string]
+	XXssProtection plugin.TValue[*mqlHttpHeaderXssProtection]
+	XContentTypeOptions plugin.TValue[string]
+	ReferrerPolicy plugin.TValue[string]
+	ContentType plugin.TValue[*mqlHttpHeaderContentType]
+	SetCookie plugin.TValue[*mqlHttpHeaderSetCookie]
+	Cookies plugin.TValue[[]interface{}]
+	Csp plugin.TValue[map[string]interface{}]
+	ContentSecurityPolicy plugin.TValue[*mqlHttpHeaderCsp]
+	ContentSecurityPolicyReportOnly plugin.TValue[*mqlHttpHeaderCsp]
+	PermissionsPolicy plugin.TValue[map[string]interface{}]
+	CrossOriginOpenerPolicy plugin.TValue[string]
+	CrossOriginEmbedderPolicy plugin.TValue[string]
+	CrossOriginResourcePolicy plugin.TValue[string]
+}
+
+// createHttpHeader creates a new instance of this resource
+func createHttpHeader(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpHeader{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http.header", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttpHeader) MqlName() string {
+	return "http.header"
+}
+
+func (c *mqlHttpHeader) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlHttpHeader) GetParams() *plugin.TValue[map[string]interface{}] {
+	return &c.Params
+}
+
+func (c *mqlHttpHeader) GetSts() *plugin.TValue[*mqlHttpHeaderSts] {
+	return plugin.GetOrCompute[*mqlHttpHeaderSts](&c.Sts, func() (*mqlHttpHeaderSts, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "sts")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlHttpHeaderSts), nil
+			}
+		}
+
+		return c.sts()
+	})
+}
+
+func (c *mqlHttpHeader) GetXFrameOptions() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.XFrameOptions, func() (string, error) {
+		return c.xFrameOptions()
+	})
+}
+
+func (c *mqlHttpHeader) GetXXssProtection() *plugin.TValue[*mqlHttpHeaderXssProtection] {
+	return plugin.GetOrCompute[*mqlHttpHeaderXssProtection](&c.XXssProtection, func() (*mqlHttpHeaderXssProtection, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "xXssProtection")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlHttpHeaderXssProtection), nil
+			}
+		}
+
+		return c.xXssProtection()
+	})
+}
+
+func (c *mqlHttpHeader) GetXContentTypeOptions() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.XContentTypeOptions, func() (string, error) {
+		return c.xContentTypeOptions()
+	})
+}
+
+func (c *mqlHttpHeader) GetReferrerPolicy() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.ReferrerPolicy, func() (string, error) {
+		return c.referrerPolicy()
+	})
+}
+
+func (c *mqlHttpHeader) GetContentType() *plugin.TValue[*mqlHttpHeaderContentType] {
+	return plugin.GetOrCompute[*mqlHttpHeaderContentType](&c.ContentType, func() (*mqlHttpHeaderContentType, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "contentType")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlHttpHeaderContentType), nil
+			}
+		}
+
+		return c.contentType()
+	})
+}
+
+func (c *mqlHttpHeader) GetSetCookie() *plugin.TValue[*mqlHttpHeaderSetCookie] {
+	return plugin.GetOrCompute[*mqlHttpHeaderSetCookie](&c.SetCookie, func() (*mqlHttpHeaderSetCookie, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "setCookie")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlHttpHeaderSetCookie), nil
+			}
+		}
+
+		return c.setCookie()
+	})
+}
+
+func (c *mqlHttpHeader) GetCookies() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Cookies, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "cookies")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.cookies()
+	})
+}
+
+func (c *mqlHttpHeader) GetCsp() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Csp, func() (map[string]interface{}, error) {
+		return c.csp()
+	})
+}
+
+func (c *mqlHttpHeader) GetContentSecurityPolicy() *plugin.TValue[*mqlHttpHeaderCsp] {
+	return plugin.GetOrCompute[*mqlHttpHeaderCsp](&c.ContentSecurityPolicy, func() (*mqlHttpHeaderCsp, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "contentSecurityPolicy")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlHttpHeaderCsp), nil
+			}
+		}
+
+		return c.contentSecurityPolicy()
+	})
+}
+
+func (c *mqlHttpHeader) GetContentSecurityPolicyReportOnly() *plugin.TValue[*mqlHttpHeaderCsp] {
+	return plugin.GetOrCompute[*mqlHttpHeaderCsp](&c.ContentSecurityPolicyReportOnly, func() (*mqlHttpHeaderCsp, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "contentSecurityPolicyReportOnly")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlHttpHeaderCsp), nil
+			}
+		}
+
+		return c.contentSecurityPolicyReportOnly()
+	})
+}
+
+func (c *mqlHttpHeader) GetPermissionsPolicy() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.PermissionsPolicy, func() (map[string]interface{}, error) {
+		return c.permissionsPolicy()
+	})
+}
+
+func (c *mqlHttpHeader) GetCrossOriginOpenerPolicy() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.CrossOriginOpenerPolicy, func() (string, error) {
+		return c.crossOriginOpenerPolicy()
+	})
+}
+
+func (c *mqlHttpHeader) GetCrossOriginEmbedderPolicy() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.CrossOriginEmbedderPolicy, func() (string, error) {
+		return c.crossOriginEmbedderPolicy()
+	})
+}
+
+func (c *mqlHttpHeader) GetCrossOriginResourcePolicy() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.CrossOriginResourcePolicy, func() (string, error) {
+		return c.crossOriginResourcePolicy()
+	})
+}
+
+// mqlHttpHeaderSts for the http.header.sts resource
+type mqlHttpHeaderSts struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlHttpHeaderStsInternal it will be used here
+	MaxAge plugin.TValue[*time.Time]
+	IncludeSubDomains plugin.TValue[bool]
+	Preload plugin.TValue[bool]
+	PreloadReady plugin.TValue[bool]
+}
+
+// createHttpHeaderSts creates a new instance of this resource
+func createHttpHeaderSts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpHeaderSts{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http.header.sts", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttpHeaderSts) MqlName() string {
+	return "http.header.sts"
+}
+
+func (c *mqlHttpHeaderSts) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlHttpHeaderSts) GetMaxAge() *plugin.TValue[*time.Time] {
+	return &c.MaxAge
+}
+
+func (c *mqlHttpHeaderSts) GetIncludeSubDomains() *plugin.TValue[bool] {
+	return &c.IncludeSubDomains
+}
+
+func (c *mqlHttpHeaderSts) GetPreload() *plugin.TValue[bool] {
+	return &c.Preload
+}
+
+func (c *mqlHttpHeaderSts) GetPreloadReady() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.PreloadReady, func() (bool, error) {
+		return c.preloadReady()
+	})
+}
+
+// mqlHttpHeaderCsp for the http.header.csp resource
+type mqlHttpHeaderCsp struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlHttpHeaderCspInternal it will be used here
+	Directives plugin.TValue[map[string]interface{}]
+	ReportOnly plugin.TValue[bool]
+	UnsafeInline plugin.TValue[bool]
+	UnsafeEval plugin.TValue[bool]
+	UnsafeScriptSources plugin.TValue[bool]
+	ReportUri plugin.TValue[[]interface{}]
+	ReportTo plugin.TValue[string]
+}
+
+// createHttpHeaderCsp creates a new instance of this resource
+func createHttpHeaderCsp(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpHeaderCsp{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http.header.csp", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttpHeaderCsp) MqlName() string {
+	return "http.header.csp"
+}
+
+func (c *mqlHttpHeaderCsp) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlHttpHeaderCsp) GetDirectives() *plugin.TValue[map[string]interface{}] {
+	return &c.Directives
+}
+
+func (c *mqlHttpHeaderCsp) GetReportOnly() *plugin.TValue[bool] {
+	return &c.ReportOnly
+}
+
+func (c *mqlHttpHeaderCsp) GetUnsafeInline() *plugin.TValue[bool] {
+	return &c.UnsafeInline
+}
+
+func (c *mqlHttpHeaderCsp) GetUnsafeEval() *plugin.TValue[bool] {
+	return &c.UnsafeEval
+}
+
+func (c *mqlHttpHeaderCsp) GetUnsafeScriptSources() *plugin.TValue[bool] {
+	return &c.UnsafeScriptSources
+}
+
+func (c *mqlHttpHeaderCsp) GetReportUri() *plugin.TValue[[]interface{}] {
+	return &c.ReportUri
+}
+
+func (c *mqlHttpHeaderCsp) GetReportTo() *plugin.TValue[string] {
+	return &c.ReportTo
+}
+
+// mqlHttpHeaderXssProtection for the http.header.xssProtection resource
+type mqlHttpHeaderXssProtection struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlHttpHeaderXssProtectionInternal it will be used here
+	Enabled plugin.TValue[bool]
+	Mode plugin.TValue[string]
+	Report plugin.TValue[string]
+}
+
+// createHttpHeaderXssProtection creates a new instance of this resource
+func createHttpHeaderXssProtection(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpHeaderXssProtection{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http.header.xssProtection", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttpHeaderXssProtection) MqlName() string {
+	return "http.header.xssProtection"
+}
+
+func (c *mqlHttpHeaderXssProtection) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlHttpHeaderXssProtection) GetEnabled() *plugin.TValue[bool] {
+	return &c.Enabled
+}
+
+func (c *mqlHttpHeaderXssProtection) GetMode() *plugin.TValue[string] {
+	return &c.Mode
+}
+
+func (c *mqlHttpHeaderXssProtection) GetReport() *plugin.TValue[string] {
+	return &c.Report
+}
+
+// mqlHttpHeaderContentType for the http.header.contentType resource
+type mqlHttpHeaderContentType struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlHttpHeaderContentTypeInternal it will be used here
+	Type plugin.TValue[string]
+	Params plugin.TValue[map[string]interface{}]
+}
+
+// createHttpHeaderContentType creates a new instance of this resource
+func createHttpHeaderContentType(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpHeaderContentType{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http.header.contentType", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttpHeaderContentType) MqlName() string {
+	return "http.header.contentType"
+}
+
+func (c *mqlHttpHeaderContentType) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlHttpHeaderContentType) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
+
+func (c *mqlHttpHeaderContentType) GetParams() *plugin.TValue[map[string]interface{}] {
+	return &c.Params
+}
+
+// mqlHttpHeaderSetCookie for the http.header.setCookie resource
+type mqlHttpHeaderSetCookie struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlHttpHeaderSetCookieInternal it will be used here
+	Name plugin.TValue[string]
+	Value plugin.TValue[string]
+	Params plugin.TValue[map[string]interface{}]
+	Domain plugin.TValue[string]
+	Path plugin.TValue[string]
+	Expires plugin.TValue[*time.Time]
+	MaxAge plugin.TValue[int64]
+	Secure plugin.TValue[bool]
+	HttpOnly plugin.TValue[bool]
+	SameSite plugin.TValue[string]
+	Partitioned plugin.TValue[bool]
+}
+
+// createHttpHeaderSetCookie creates a new instance of this resource
+func createHttpHeaderSetCookie(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlHttpHeaderSetCookie{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("http.header.setCookie", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlHttpHeaderSetCookie) MqlName() string {
+	return "http.header.setCookie"
+}
+
+func (c *mqlHttpHeaderSetCookie) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlHttpHeaderSetCookie) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlHttpHeaderSetCookie) GetValue() *plugin.TValue[string] {
+	return &c.Value
+}
+
+func (c *mqlHttpHeaderSetCookie) GetParams() *plugin.TValue[map[string]interface{}] {
+	return &c.Params
+}
+
+func (c *mqlHttpHeaderSetCookie) GetDomain() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Domain, func() (string, error) {
+		return c.domain()
+	})
+}
+
+func (c *mqlHttpHeaderSetCookie) GetPath() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Path, func() (string, error) {
+		return c.path()
+	})
+}
+
+func (c *mqlHttpHeaderSetCookie) GetExpires() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.Expires, func() (*time.Time, error) {
+		return c.expires()
+	})
+}
+
+func (c *mqlHttpHeaderSetCookie) GetMaxAge() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.MaxAge, func() (int64, error) {
+		return c.maxAge()
+	})
+}
+
+func (c *mqlHttpHeaderSetCookie) GetSecure() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Secure, func() (bool, error) {
+		return c.secure()
+	})
+}
+
+func (c *mqlHttpHeaderSetCookie) GetHttpOnly() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.HttpOnly, func() (bool, error) {
+		return c.httpOnly()
+	})
+}
+
+func (c *mqlHttpHeaderSetCookie) GetSameSite() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.SameSite, func() (string, error) {
+		return c.sameSite()
+	})
+}
+
+func (c *mqlHttpHeaderSetCookie) GetPartitioned() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Partitioned, func() (bool, error) {
+		return c.partitioned()
+	})
+}
+
+// mqlUrl for the url resource
+type mqlUrl struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlUrlInternal it will be used here
+	String plugin.TValue[string]
+	Scheme plugin.TValue[string]
+	User plugin.TValue[string]
+	Password plugin.TValue[string]
+	Host plugin.TValue[string]
+	Port plugin.TValue[int64]
+	Path plugin.TValue[string]
+	Query plugin.TValue[map[string]interface{}]
+	RawQuery plugin.TValue[string]
+	RawFragment plugin.TValue[string]
+}
+
+// createUrl creates a new instance of this resource
+func createUrl(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlUrl{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("url", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlUrl) MqlName() string {
+	return "url"
+}
+
+func (c *mqlUrl) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlUrl) GetString() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.String, func() (string, error) {
+		return c.string()
+	})
+}
+
+func (c *mqlUrl) GetScheme() *plugin.TValue[string] {
+	return &c.Scheme
+}
+
+func (c *mqlUrl) GetUser() *plugin.TValue[string] {
+	return &c.User
+}
+
+func (c *mqlUrl) GetPassword() *plugin.TValue[string] {
+	return &c.Password
+}
+
+func (c *mqlUrl) GetHost() *plugin.TValue[string] {
+	return &c.Host
+}
+
+func (c *mqlUrl) GetPort() *plugin.TValue[int64] {
+	return &c.Port
+}
+
+func (c *mqlUrl) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlUrl) GetQuery() *plugin.TValue[map[string]interface{}] {
+	return &c.Query
+}
+
+func (c *mqlUrl) GetRawQuery() *plugin.TValue[string] {
+	return &c.RawQuery
+}
+
+func (c *mqlUrl) GetRawFragment() *plugin.TValue[string] {
+	return &c.RawFragment
+}
+
+// mqlTls for the tls resource
+type mqlTls struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlTlsInternal
+	Socket plugin.TValue[*mqlSocket]
+	DomainName plugin.TValue[string]
+	CaBundle plugin.TValue[string]
+	Starttls plugin.TValue[string]
+	Params plugin.TValue[interface{}]
+	Versions plugin.TValue[[]interface{}]
+	Ciphers plugin.TValue[[]interface{}]
+	Extensions plugin.TValue[[]interface{}]
+	Certificates plugin.TValue[[]interface{}]
+	NonSniCertificates plugin.TValue[[]interface{}]
+	CipherSuites plugin.TValue[[]interface{}]
+	FallbackScsv plugin.TValue[bool]
+	SecureRenegotiation plugin.TValue[bool]
+	OcspStapling plugin.TValue[bool]
+	OcspStatus plugin.TValue[string]
+	AlpnProtocols plugin.TValue[[]interface{}]
+	ChainValid plugin.TValue[bool]
+	ChainError plugin.TValue[string]
+	Grade plugin.TValue[string]
+	GradeReasons plugin.TValue[[]interface{}]
+}
+
+// createTls creates a new instance of this resource
+func createTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlTls{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("tls", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlTls) MqlName() string {
+	return "tls"
+}
+
+func (c *mqlTls) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlTls) GetSocket() *plugin.TValue[*mqlSocket] {
+	return &c.Socket
+}
+
+func (c *mqlTls) GetDomainName() *plugin.TValue[string] {
+	return &c.DomainName
+}
+
+func (c *mqlTls) GetCaBundle() *plugin.TValue[string] {
+	return &c.CaBundle
+}
+
+func (c *mqlTls) GetStarttls() *plugin.TValue[string] {
+	return &c.Starttls
+}
+
+func (c *mqlTls) GetParams() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
+		vargSocket := c.GetSocket()
+		if vargSocket.Error != nil {
+			return nil, vargSocket.Error
+		}
+
+		vargDomainName := c.GetDomainName()
+		if vargDomainName.Error != nil {
+			return nil, vargDomainName.Error
+		}
+
+		vargCaBundle := c.GetCaBundle()
+		if vargCaBundle.Error != nil {
+			return nil, vargCaBundle.Error
+		}
+
+		vargStarttls := c.GetStarttls()
+		if vargStarttls.Error != nil {
+			return nil, vargStarttls.Error
+		}
+
+		return c.params(vargSocket.Data, vargDomainName.Data, vargCaBundle.Data, vargStarttls.Data)
+	})
+}
+
+func (c *mqlTls) GetVersions() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Versions, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.versions(vargParams.Data)
+	})
+}
+
+func (c *mqlTls) GetCiphers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Ciphers, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.ciphers(vargParams.Data)
+	})
+}
+
+func (c *mqlTls) GetExtensions() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Extensions, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.extensions(vargParams.Data)
+	})
+}
+
+func (c *mqlTls) GetCertificates() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Certificates, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("tls", c.__id, "certificates")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.certificates(vargParams.Data)
+	})
+}
+
+func (c *mqlTls) GetNonSniCertificates() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.NonSniCertificates, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("tls", c.__id, "nonSniCertificates")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.nonSniCertificates(vargParams.Data)
+	})
+}
+
+func (c *mqlTls) GetCipherSuites() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.CipherSuites, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("tls", c.__id, "cipherSuites")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.cipherSuites(vargParams.Data)
+	})
+}
+
+func (c *mqlTls) GetFallbackScsv() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.FallbackScsv, func() (bool, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return false, vargParams.Error
+		}
+
+		return c.fallbackScsv(vargParams.Data)
+	})
+}
+
+func (c *mqlTls) GetSecureRenegotiation() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.SecureRenegotiation, func() (bool, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return false, vargParams.Error
+		}
+
+		return c.secureRenegotiation(vargParams.Data)
+	})
+}
+
+func (c *mqlTls) GetOcspStapling() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.OcspStapling, func() (bool, error) {
+		vargSocket := c.GetSocket()
+		if vargSocket.Error != nil {
+			return false, vargSocket.Error
+		}
+
+		vargDomainName := c.GetDomainName()
+		if vargDomainName.Error != nil {
+			return false, vargDomainName.Error
+		}
+
+		vargStarttls := c.GetStarttls()
+		if vargStarttls.Error != nil {
+			return false, vargStarttls.Error
+		}
+
+		return c.ocspStapling(vargSocket.Data, vargDomainName.Data, vargStarttls.Data)
+	})
+}
+
+func (c *mqlTls) GetOcspStatus() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.OcspStatus, func() (string, error) {
+		vargSocket := c.GetSocket()
+		if vargSocket.Error != nil {
+			return "", vargSocket.Error
+		}
+
+		vargDomainName := c.GetDomainName()
+		if vargDomainName.Error != nil {
+			return "", vargDomainName.Error
+		}
+
+		vargStarttls := c.GetStarttls()
+		if vargStarttls.Error != nil {
+			return "", vargStarttls.Error
+		}
+
+		return c.ocspStatus(vargSocket.Data, vargDomainName.Data, vargStarttls.Data)
+	})
+}
+
+func (c *mqlTls) GetAlpnProtocols() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.AlpnProtocols, func() ([]interface{}, error) {
+		vargSocket := c.GetSocket()
+		if vargSocket.Error != nil {
+			return nil, vargSocket.Error
+		}
+
+		vargDomainName := c.GetDomainName()
+		if vargDomainName.Error != nil {
+			return nil, vargDomainName.Error
+		}
+
+		vargStarttls := c.GetStarttls()
+		if vargStarttls.Error != nil {
+			return nil, vargStarttls.Error
+		}
+
+		return c.alpnProtocols(vargSocket.Data, vargDomainName.Data, vargStarttls.Data)
+	})
+}
+
+func (c *mqlTls) GetChainValid() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.ChainValid, func() (bool, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return false, vargParams.Error
+		}
+
+		return c.chainValid(vargParams.Data)
+	})
+}
+
+func (c *mqlTls) GetChainError() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.ChainError, func() (string, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return "", vargParams.Error
+		}
+
+		return c.chainError(vargParams.Data)
+	})
+}
+
+func (c *mqlTls) GetGrade() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Grade, func() (string, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return "", vargParams.Error
+		}
+
+		return c.grade(vargParams.Data)
+	})
+}
+
+func (c *mqlTls) GetGradeReasons() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.GradeReasons, func() ([]interface{}, error) {
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
+
+		return c.gradeReasons(vargParams.Data)
+	})
+}
+
+// mqlTlsCipher for the tls.cipher resource
+type mqlTlsCipher struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlTlsCipherInternal it will be used here
+	Name plugin.TValue[string]
+	KeyExchange plugin.TValue[string]
+	Authentication plugin.TValue[string]
+	Encryption plugin.TValue[string]
+	Mac plugin.TValue[string]
+	Bits plugin.TValue[int64]
+	ForwardSecrecy plugin.TValue[bool]
+	Aead plugin.TValue[bool]
+	Grade plugin.TValue[string]
+}
+
+// createTlsCipher creates a new instance of this resource
+func createTlsCipher(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlTlsCipher{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("tls.cipher", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlTlsCipher) MqlName() string {
+	return "tls.cipher"
+}
+
+func (c *mqlTlsCipher) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlTlsCipher) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlTlsCipher) GetKeyExchange() *plugin.TValue[string] {
+	return &c.KeyExchange
+}
+
+func (c *mqlTlsCipher) GetAuthentication() *plugin.TValue[string] {
+	return &c.Authentication
+}
+
+func (c *mqlTlsCipher) GetEncryption() *plugin.TValue[string] {
+	return &c.Encryption
+}
+
+func (c *mqlTlsCipher) GetMac() *plugin.TValue[string] {
+	return &c.Mac
+}
+
+func (c *mqlTlsCipher) GetBits() *plugin.TValue[int64] {
+	return &c.Bits
+}
+
+func (c *mqlTlsCipher) GetForwardSecrecy() *plugin.TValue[bool] {
+	return &c.ForwardSecrecy
+}
+
+func (c *mqlTlsCipher) GetAead() *plugin.TValue[bool] {
+	return &c.Aead
+}
+
+func (c *mqlTlsCipher) GetGrade() *plugin.TValue[string] {
+	return &c.Grade
+}
+
+// mqlSshServer for the ssh.server resource
+type mqlSshServer struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlSshServerInternal
+	Host plugin.TValue[string]
+	Port plugin.TValue[int64]
+	Banner plugin.TValue[string]
+	ProtocolVersion plugin.TValue[string]
+	SoftwareVersion plugin.TValue[string]
+	Comments plugin.TValue[string]
+	KexAlgorithms plugin.TValue[[]interface{}]
+	HostKeyAlgorithms plugin.TValue[[]interface{}]
+	Ciphers plugin.TValue[[]interface{}]
+	Macs plugin.TValue[[]interface{}]
+	CompressionAlgorithms plugin.TValue[[]interface{}]
+	HostKeys plugin.TValue[[]interface{}]
+	AuthMethods plugin.TValue[[]interface{}]
+	Errors plugin.TValue[[]interface{}]
+}
+
+// createSshServer creates a new instance of this resource
+func createSshServer(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSshServer{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("ssh.server", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlSshServer) MqlName() string {
+	return "ssh.server"
+}
+
+func (c *mqlSshServer) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlSshServer) GetHost() *plugin.TValue[string] {
+	return &c.Host
+}
+
+func (c *mqlSshServer) GetPort() *plugin.TValue[int64] {
+	return &c.Port
+}
+
+func (c *mqlSshServer) GetBanner() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Banner, func() (string, error) {
+		return c.banner()
+	})
+}
+
+func (c *mqlSshServer) GetProtocolVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.ProtocolVersion, func() (string, error) {
+		return c.protocolVersion()
+	})
+}
+
+func (c *mqlSshServer) GetSoftwareVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.SoftwareVersion, func() (string, error) {
+		return c.softwareVersion()
+	})
+}
+
+func (c *mqlSshServer) GetComments() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Comments, func() (string, error) {
+		return c.comments()
+	})
+}
+
+func (c *mqlSshServer) GetKexAlgorithms() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.KexAlgorithms, func() ([]interface{}, error) {
+		return c.kexAlgorithms()
+	})
+}
+
+func (c *mqlSshServer) GetHostKeyAlgorithms() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.HostKeyAlgorithms, func() ([]interface{}, error) {
+		return c.hostKeyAlgorithms()
+	})
+}
+
+func (c *mqlSshServer) GetCiphers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Ciphers, func() ([]interface{}, error) {
+		return c.ciphers()
+	})
+}
+
+func (c *mqlSshServer) GetMacs() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Macs, func() ([]interface{}, error) {
+		return c.macs()
+	})
+}
+
+func (c *mqlSshServer) GetCompressionAlgorithms() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.CompressionAlgorithms, func() ([]interface{}, error) {
+		return c.compressionAlgorithms()
+	})
+}
+
+func (c *mqlSshServer) GetHostKeys() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.HostKeys, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("ssh.server", c.__id, "hostKeys")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.hostKeys()
+	})
+}
+
+func (c *mqlSshServer) GetAuthMethods() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.AuthMethods, func() ([]interface{}, error) {
+		return c.authMethods()
+	})
+}
+
+func (c *mqlSshServer) GetErrors() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Errors, func() ([]interface{}, error) {
+		return c.errors()
+	})
+}
+
+// mqlSshServerHostKey for the ssh.server.hostKey resource
+type mqlSshServerHostKey struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlSshServerHostKeyInternal it will be used here
+	Type plugin.TValue[string]
+	Bits plugin.TValue[int64]
+	Fingerprint plugin.TValue[string]
+	Md5Fingerprint plugin.TValue[string]
+	PublicKey plugin.TValue[string]
+}
+
+// createSshServerHostKey creates a new instance of this resource
+func createSshServerHostKey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSshServerHostKey{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("ssh.server.hostKey", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlSshServerHostKey) MqlName() string {
+	return "ssh.server.hostKey"
+}
+
+func (c *mqlSshServerHostKey) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlSshServerHostKey) GetType() *plugin.TValue[string] {
+	return &c.Type
+}
+
+func (c *mqlSshServerHostKey) GetBits() *plugin.TValue[int64] {
+	return &c.Bits
+}
+
+func (c *mqlSshServerHostKey) GetFingerprint() *plugin.TValue[string] {
+	return &c.Fingerprint
+}
+
+func (c *mqlSshServerHostKey) GetMd5Fingerprint() *plugin.TValue[string] {
+	return &c.Md5Fingerprint
+}
+
+func (c *mqlSshServerHostKey) GetPublicKey() *plugin.TValue[string] {
+	return &c.PublicKey
+}
+
+// mqlSnmp for the snmp resource
+type mqlSnmp struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlSnmpInternal
+	Host plugin.TValue[string]
+	Port plugin.TValue[int64]
+	Oids plugin.TValue[[]interface{}]
+	Version plugin.TValue[string]
+	V1Accepted plugin.TValue[bool]
+	V2cAccepted plugin.TValue[bool]
+	V3Supported plugin.TValue[bool]
+	EngineId plugin.TValue[string]
+	SysDescr plugin.TValue[string]
+	SysObjectID plugin.TValue[string]
+	SysName plugin.TValue[string]
+	SysContact plugin.TValue[string]
+	SysLocation plugin.TValue[string]
+	Walk plugin.TValue[map[string]interface{}]
+	Errors plugin.TValue[[]interface{}]
+}
+
+// createSnmp creates a new instance of this resource
+func createSnmp(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlSnmp{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("snmp", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlSnmp) MqlName() string {
+	return "snmp"
+}
+
+func (c *mqlSnmp) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlSnmp) GetHost() *plugin.TValue[string] {
+	return &c.Host
+}
+
+func (c *mqlSnmp) GetPort() *plugin.TValue[int64] {
+	return &c.Port
+}
+
+func (c *mqlSnmp) GetOids() *plugin.TValue[[]interface{}] {
+	return &c.Oids
+}
+
+func (c *mqlSnmp) GetVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
+		return c.version()
+	})
+}
+
+func (c *mqlSnmp) GetV1Accepted() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.V1Accepted, func() (bool, error) {
+		return c.v1Accepted()
+	})
+}
+
+func (c *mqlSnmp) GetV2cAccepted() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.V2cAccepted, func() (bool, error) {
+		return c.v2cAccepted()
+	})
+}
+
+func (c *mqlSnmp) GetV3Supported() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.V3Supported, func() (bool, error) {
+		return c.v3Supported()
+	})
+}
+
+func (c *mqlSnmp) GetEngineId() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.EngineId, func() (string, error) {
+		return c.engineId()
+	})
+}
+
+func (c *mqlSnmp) GetSysDescr() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.SysDescr, func() (string, error) {
+		return c.sysDescr()
+	})
+}
+
+func (c *mqlSnmp) GetSysObjectID() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.SysObjectID, func() (string, error) {
+		return c.sysObjectID()
+	})
+}
+
+func (c *mqlSnmp) GetSysName() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.SysName, func() (string, error) {
+		return c.sysName()
+	})
+}
+
+func (c *mqlSnmp) GetSysContact() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.SysContact, func() (string, error) {
+		return c.sysContact()
+	})
+}
+
+func (c *mqlSnmp) GetSysLocation() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.SysLocation, func() (string, error) {
+		return c.sysLocation()
+	})
+}
+
+func (c *mqlSnmp) GetWalk() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Walk, func() (map[string]interface{}, error) {
+		return c.walk()
+	})
+}
+
+func (c *mqlSnmp) GetErrors() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Errors, func() ([]interface{}, error) {
+		return c.errors()
+	})
+}
+
+// mqlLdap for the ldap resource
+type mqlLdap struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlLdapInternal
+	Host plugin.TValue[string]
 	Port plugin.TValue[int64]
-	Address plugin.TValue[string]
+	Tls plugin.TValue[bool]
+	RootDSE plugin.TValue[map[string]interface{}]
+	NamingContexts plugin.TValue[[]interface{}]
+	DefaultNamingContext plugin.TValue[string]
+	SupportedLdapVersions plugin.TValue[[]interface{}]
+	SupportedSaslMechanisms plugin.TValue[[]interface{}]
+	SupportedExtensions plugin.TValue[[]interface{}]
+	SupportedControls plugin.TValue[[]interface{}]
+	VendorName plugin.TValue[string]
+	VendorVersion plugin.TValue[string]
+	AnonymousBind plugin.TValue[bool]
+	AnonymousSearch plugin.TValue[bool]
+	StartTls plugin.TValue[bool]
+	Errors plugin.TValue[[]interface{}]
+}
+
+// createLdap creates a new instance of this resource
+func createLdap(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlLdap{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("ldap", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-// createSocket creates a new instance of this resource
-func createSocket(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlSocket{
+func (c *mqlLdap) MqlName() string {
+	return "ldap"
+}
+
+func (c *mqlLdap) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlLdap) GetHost() *plugin.TValue[string] {
+	return &c.Host
+}
+
+func (c *mqlLdap) GetPort() *plugin.TValue[int64] {
+	return &c.Port
+}
+
+func (c *mqlLdap) GetTls() *plugin.TValue[bool] {
+	return &c.Tls
+}
+
+func (c *mqlLdap) GetRootDSE() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.RootDSE, func() (map[string]interface{}, error) {
+		return c.rootDSE()
+	})
+}
+
+func (c *mqlLdap) GetNamingContexts() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.NamingContexts, func() ([]interface{}, error) {
+		return c.namingContexts()
+	})
+}
+
+func (c *mqlLdap) GetDefaultNamingContext() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.DefaultNamingContext, func() (string, error) {
+		return c.defaultNamingContext()
+	})
+}
+
+func (c *mqlLdap) GetSupportedLdapVersions() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.SupportedLdapVersions, func() ([]interface{}, error) {
+		return c.supportedLdapVersions()
+	})
+}
+
+func (c *mqlLdap) GetSupportedSaslMechanisms() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.SupportedSaslMechanisms, func() ([]interface{}, error) {
+		return c.supportedSaslMechanisms()
+	})
+}
+
+func (c *mqlLdap) GetSupportedExtensions() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.SupportedExtensions, func() ([]interface{}, error) {
+		return c.supportedExtensions()
+	})
+}
+
+func (c *mqlLdap) GetSupportedControls() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.SupportedControls, func() ([]interface{}, error) {
+		return c.supportedControls()
+	})
+}
+
+func (c *mqlLdap) GetVendorName() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.VendorName, func() (string, error) {
+		return c.vendorName()
+	})
+}
+
+func (c *mqlLdap) GetVendorVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.VendorVersion, func() (string, error) {
+		return c.vendorVersion()
+	})
+}
+
+func (c *mqlLdap) GetAnonymousBind() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.AnonymousBind, func() (bool, error) {
+		return c.anonymousBind()
+	})
+}
+
+func (c *mqlLdap) GetAnonymousSearch() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.AnonymousSearch, func() (bool, error) {
+		return c.anonymousSearch()
+	})
+}
+
+func (c *mqlLdap) GetStartTls() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.StartTls, func() (bool, error) {
+		return c.startTls()
+	})
+}
+
+func (c *mqlLdap) GetErrors() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Errors, func() ([]interface{}, error) {
+		return c.errors()
+	})
+}
+
+// mqlRedis for the redis resource
+type mqlRedis struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlRedisInternal
+	Host plugin.TValue[string]
+	Port plugin.TValue[int64]
+	AuthRequired plugin.TValue[bool]
+	ProtectedMode plugin.TValue[bool]
+	Version plugin.TValue[string]
+	Mode plugin.TValue[string]
+	Os plugin.TValue[string]
+	DangerousCommands plugin.TValue[[]interface{}]
+	RenamedCommands plugin.TValue[[]interface{}]
+	Errors plugin.TValue[[]interface{}]
+}
+
+// createRedis creates a new instance of this resource
+func createRedis(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlRedis{
 		MqlRuntime: runtime,
 	}
 
@@ -1344,7 +5148,7 @@ func createSocket(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("socket", res.__id)
+		args, err = runtime.ResourceFromRecording("redis", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -1354,36 +5158,82 @@ func createSocket(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin
 	return res, nil
 }
 
-func (c *mqlSocket) MqlName() string {
-	return "socket"
+func (c *mqlRedis) MqlName() string {
+	return "redis"
 }
 
-func (c *mqlSocket) MqlID() string {
+func (c *mqlRedis) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlSocket) GetProtocol() *plugin.TValue[string] {
-	return &c.Protocol
+func (c *mqlRedis) GetHost() *plugin.TValue[string] {
+	return &c.Host
 }
 
-func (c *mqlSocket) GetPort() *plugin.TValue[int64] {
+func (c *mqlRedis) GetPort() *plugin.TValue[int64] {
 	return &c.Port
 }
 
-func (c *mqlSocket) GetAddress() *plugin.TValue[string] {
-	return &c.Address
+func (c *mqlRedis) GetAuthRequired() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.AuthRequired, func() (bool, error) {
+		return c.authRequired()
+	})
 }
 
-// mqlHttp for the http resource
-type mqlHttp struct {
+func (c *mqlRedis) GetProtectedMode() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.ProtectedMode, func() (bool, error) {
+		return c.protectedMode()
+	})
+}
+
+func (c *mqlRedis) GetVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
+		return c.version()
+	})
+}
+
+func (c *mqlRedis) GetMode() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Mode, func() (string, error) {
+		return c.mode()
+	})
+}
+
+func (c *mqlRedis) GetOs() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Os, func() (string, error) {
+		return c.os()
+	})
+}
+
+func (c *mqlRedis) GetDangerousCommands() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.DangerousCommands, func() ([]interface{}, error) {
+		return c.dangerousCommands()
+	})
+}
+
+func (c *mqlRedis) GetRenamedCommands() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.RenamedCommands, func() ([]interface{}, error) {
+		return c.renamedCommands()
+	})
+}
+
+func (c *mqlRedis) GetErrors() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Errors, func() ([]interface{}, error) {
+		return c.errors()
+	})
+}
+
+// mqlCertificates for the certificates resource
+type mqlCertificates struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlHttpInternal it will be used here
+	// optional: if you define mqlCertificatesInternal it will be used here
+	Pem plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
 }
 
-// createHttp creates a new instance of this resource
-func createHttp(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlHttp{
+// createCertificates creates a new instance of this resource
+func createCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlCertificates{
 		MqlRuntime: runtime,
 	}
 
@@ -1392,10 +5242,15 @@ func createHttp(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("http", res.__id)
+		args, err = runtime.ResourceFromRecording("certificates", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -1405,29 +5260,71 @@ func createHttp(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.R
 	return res, nil
 }
 
-func (c *mqlHttp) MqlName() string {
-	return "http"
+func (c *mqlCertificates) MqlName() string {
+	return "certificates"
 }
 
-func (c *mqlHttp) MqlID() string {
+func (c *mqlCertificates) MqlID() string {
 	return c.__id
 }
 
-// mqlHttpGet for the http.get resource
-type mqlHttpGet struct {
+func (c *mqlCertificates) GetPem() *plugin.TValue[string] {
+	return &c.Pem
+}
+
+func (c *mqlCertificates) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("certificates", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlCertificate for the certificate resource
+type mqlCertificate struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlHttpGetInternal
-	Url plugin.TValue[*mqlUrl]
-	Header plugin.TValue[*mqlHttpHeader]
-	StatusCode plugin.TValue[int64]
-	Version plugin.TValue[string]
-	Body plugin.TValue[string]
+	mqlCertificateInternal
+	Pem plugin.TValue[string]
+	Fingerprints plugin.TValue[map[string]interface{}]
+	Serial plugin.TValue[string]
+	SubjectKeyID plugin.TValue[string]
+	AuthorityKeyID plugin.TValue[string]
+	Subject plugin.TValue[*mqlPkixName]
+	Issuer plugin.TValue[*mqlPkixName]
+	Version plugin.TValue[int64]
+	NotBefore plugin.TValue[*time.Time]
+	NotAfter plugin.TValue[*time.Time]
+	ExpiresIn plugin.TValue[*time.Time]
+	Signature plugin.TValue[string]
+	SigningAlgorithm plugin.TValue[string]
+	IsCA plugin.TValue[bool]
+	KeyUsage plugin.TValue[[]interface{}]
+	ExtendedKeyUsage plugin.TValue[[]interface{}]
+	Extensions plugin.TValue[[]interface{}]
+	PolicyIdentifier plugin.TValue[[]interface{}]
+	CrlDistributionPoints plugin.TValue[[]interface{}]
+	OcspServer plugin.TValue[[]interface{}]
+	IssuingCertificateUrl plugin.TValue[[]interface{}]
+	IsRevoked plugin.TValue[bool]
+	RevokedAt plugin.TValue[*time.Time]
+	IsVerified plugin.TValue[bool]
+	PublicKeyAlgorithm plugin.TValue[string]
+	PublicKeyBits plugin.TValue[int64]
+	Chain plugin.TValue[[]interface{}]
 }
 
-// createHttpGet creates a new instance of this resource
-func createHttpGet(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlHttpGet{
+// createCertificate creates a new instance of this resource
+func createCertificate(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlCertificate{
 		MqlRuntime: runtime,
 	}
 
@@ -1444,7 +5341,7 @@ func createHttpGet(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("http.get", res.__id)
+		args, err = runtime.ResourceFromRecording("certificate", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -1454,210 +5351,237 @@ func createHttpGet(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugi
 	return res, nil
 }
 
-func (c *mqlHttpGet) MqlName() string {
-	return "http.get"
+func (c *mqlCertificate) MqlName() string {
+	return "certificate"
 }
 
-func (c *mqlHttpGet) MqlID() string {
+func (c *mqlCertificate) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlHttpGet) GetUrl() *plugin.TValue[*mqlUrl] {
-	return &c.Url
+func (c *mqlCertificate) GetPem() *plugin.TValue[string] {
+	return &c.Pem
 }
 
-func (c *mqlHttpGet) GetHeader() *plugin.TValue[*mqlHttpHeader] {
-	return plugin.GetOrCompute[*mqlHttpHeader](&c.Header, func() (*mqlHttpHeader, error) {
+func (c *mqlCertificate) GetFingerprints() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Fingerprints, func() (map[string]interface{}, error) {
+		return c.fingerprints()
+	})
+}
+
+func (c *mqlCertificate) GetSerial() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Serial, func() (string, error) {
+		return c.serial()
+	})
+}
+
+func (c *mqlCertificate) GetSubjectKeyID() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.SubjectKeyID, func() (string, error) {
+		return c.subjectKeyID()
+	})
+}
+
+func (c *mqlCertificate) GetAuthorityKeyID() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.AuthorityKeyID, func() (string, error) {
+		return c.authorityKeyID()
+	})
+}
+
+func (c *mqlCertificate) GetSubject() *plugin.TValue[*mqlPkixName] {
+	return plugin.GetOrCompute[*mqlPkixName](&c.Subject, func() (*mqlPkixName, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("http.get", c.__id, "header")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("certificate", c.__id, "subject")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlHttpHeader), nil
+				return d.Value.(*mqlPkixName), nil
 			}
 		}
 
-		return c.header()
+		return c.subject()
 	})
 }
 
-func (c *mqlHttpGet) GetStatusCode() *plugin.TValue[int64] {
-	return plugin.GetOrCompute[int64](&c.StatusCode, func() (int64, error) {
-		return c.statusCode()
+func (c *mqlCertificate) GetIssuer() *plugin.TValue[*mqlPkixName] {
+	return plugin.GetOrCompute[*mqlPkixName](&c.Issuer, func() (*mqlPkixName, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("certificate", c.__id, "issuer")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlPkixName), nil
+			}
+		}
+
+		return c.issuer()
 	})
 }
 
-func (c *mqlHttpGet) GetVersion() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
+func (c *mqlCertificate) GetVersion() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Version, func() (int64, error) {
 		return c.version()
 	})
 }
 
-func (c *mqlHttpGet) GetBody() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Body, func() (string, error) {
-		return c.body()
+func (c *mqlCertificate) GetNotBefore() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.NotBefore, func() (*time.Time, error) {
+		return c.notBefore()
 	})
 }
 
-// mqlHttpHeader for the http.header resource
-type mqlHttpHeader struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlHttpHeaderInternal it will be used here
-	Params plugin.TValue[map[string]interface{}]
-	Sts plugin.TValue[*mqlHttpHeaderSts]
-	XFrameOptions plugin.TValue[string]
-	XXssProtection plugin.TValue[*mqlHttpHeaderXssProtection]
-	XContentTypeOptions plugin.TValue[string]
-	ReferrerPolicy plugin.TValue[string]
-	ContentType plugin.TValue[*mqlHttpHeaderContentType]
-	SetCookie plugin.TValue[*mqlHttpHeaderSetCookie]
-	Csp plugin.TValue[map[string]interface{}]
+func (c *mqlCertificate) GetNotAfter() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.NotAfter, func() (*time.Time, error) {
+		return c.notAfter()
+	})
 }
 
-// createHttpHeader creates a new instance of this resource
-func createHttpHeader(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlHttpHeader{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+func (c *mqlCertificate) GetExpiresIn() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.ExpiresIn, func() (*time.Time, error) {
+		return c.expiresIn()
+	})
+}
 
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("http.header", res.__id)
-		if err != nil || args == nil {
-			return res, err
-		}
-		return res, SetAllData(res, args)
-	}
+func (c *mqlCertificate) GetSignature() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Signature, func() (string, error) {
+		return c.signature()
+	})
+}
 
-	return res, nil
+func (c *mqlCertificate) GetSigningAlgorithm() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.SigningAlgorithm, func() (string, error) {
+		return c.signingAlgorithm()
+	})
 }
 
-func (c *mqlHttpHeader) MqlName() string {
-	return "http.header"
+func (c *mqlCertificate) GetIsCA() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.IsCA, func() (bool, error) {
+		return c.isCA()
+	})
 }
 
-func (c *mqlHttpHeader) MqlID() string {
-	return c.__id
+func (c *mqlCertificate) GetKeyUsage() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.KeyUsage, func() ([]interface{}, error) {
+		return c.keyUsage()
+	})
 }
 
-func (c *mqlHttpHeader) GetParams() *plugin.TValue[map[string]interface{}] {
-	return &c.Params
+func (c *mqlCertificate) GetExtendedKeyUsage() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.ExtendedKeyUsage, func() ([]interface{}, error) {
+		return c.extendedKeyUsage()
+	})
 }
 
-func (c *mqlHttpHeader) GetSts() *plugin.TValue[*mqlHttpHeaderSts] {
-	return plugin.GetOrCompute[*mqlHttpHeaderSts](&c.Sts, func() (*mqlHttpHeaderSts, error) {
+func (c *mqlCertificate) GetExtensions() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Extensions, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "sts")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("certificate", c.__id, "extensions")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlHttpHeaderSts), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		return c.sts()
+		return c.extensions()
 	})
 }
 
-func (c *mqlHttpHeader) GetXFrameOptions() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.XFrameOptions, func() (string, error) {
-		return c.xFrameOptions()
+func (c *mqlCertificate) GetPolicyIdentifier() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.PolicyIdentifier, func() ([]interface{}, error) {
+		return c.policyIdentifier()
 	})
 }
 
-func (c *mqlHttpHeader) GetXXssProtection() *plugin.TValue[*mqlHttpHeaderXssProtection] {
-	return plugin.GetOrCompute[*mqlHttpHeaderXssProtection](&c.XXssProtection, func() (*mqlHttpHeaderXssProtection, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "xXssProtection")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlHttpHeaderXssProtection), nil
-			}
-		}
+func (c *mqlCertificate) GetCrlDistributionPoints() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.CrlDistributionPoints, func() ([]interface{}, error) {
+		return c.crlDistributionPoints()
+	})
+}
 
-		return c.xXssProtection()
+func (c *mqlCertificate) GetOcspServer() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.OcspServer, func() ([]interface{}, error) {
+		return c.ocspServer()
 	})
 }
 
-func (c *mqlHttpHeader) GetXContentTypeOptions() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.XContentTypeOptions, func() (string, error) {
-		return c.xContentTypeOptions()
+func (c *mqlCertificate) GetIssuingCertificateUrl() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.IssuingCertificateUrl, func() ([]interface{}, error) {
+		return c.issuingCertificateUrl()
 	})
 }
 
-func (c *mqlHttpHeader) GetReferrerPolicy() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.ReferrerPolicy, func() (string, error) {
-		return c.referrerPolicy()
+func (c *mqlCertificate) GetIsRevoked() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.IsRevoked, func() (bool, error) {
+		return c.isRevoked()
 	})
 }
 
-func (c *mqlHttpHeader) GetContentType() *plugin.TValue[*mqlHttpHeaderContentType] {
-	return plugin.GetOrCompute[*mqlHttpHeaderContentType](&c.ContentType, func() (*mqlHttpHeaderContentType, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "contentType")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlHttpHeaderContentType), nil
-			}
-		}
+func (c *mqlCertificate) GetRevokedAt() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.RevokedAt, func() (*time.Time, error) {
+		return c.revokedAt()
+	})
+}
 
-		return c.contentType()
+func (c *mqlCertificate) GetIsVerified() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.IsVerified, func() (bool, error) {
+		return c.isVerified()
 	})
 }
 
-func (c *mqlHttpHeader) GetSetCookie() *plugin.TValue[*mqlHttpHeaderSetCookie] {
-	return plugin.GetOrCompute[*mqlHttpHeaderSetCookie](&c.SetCookie, func() (*mqlHttpHeaderSetCookie, error) {
+func (c *mqlCertificate) GetPublicKeyAlgorithm() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PublicKeyAlgorithm, func() (string, error) {
+		return c.publicKeyAlgorithm()
+	})
+}
+
+func (c *mqlCertificate) GetPublicKeyBits() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.PublicKeyBits, func() (int64, error) {
+		return c.publicKeyBits()
+	})
+}
+
+func (c *mqlCertificate) GetChain() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Chain, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("http.header", c.__id, "setCookie")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("certificate", c.__id, "chain")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlHttpHeaderSetCookie), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		return c.setCookie()
-	})
-}
-
-func (c *mqlHttpHeader) GetCsp() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Csp, func() (map[string]interface{}, error) {
-		return c.csp()
+		return c.chain()
 	})
 }
 
-// mqlHttpHeaderSts for the http.header.sts resource
-type mqlHttpHeaderSts struct {
+// mqlPkixName for the pkix.name resource
+type mqlPkixName struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlHttpHeaderStsInternal it will be used here
-	MaxAge plugin.TValue[*time.Time]
-	IncludeSubDomains plugin.TValue[bool]
-	Preload plugin.TValue[bool]
+	// optional: if you define mqlPkixNameInternal it will be used here
+	Id plugin.TValue[string]
+	Dn plugin.TValue[string]
+	SerialNumber plugin.TValue[string]
+	CommonName plugin.TValue[string]
+	Country plugin.TValue[[]interface{}]
+	Organization plugin.TValue[[]interface{}]
+	OrganizationalUnit plugin.TValue[[]interface{}]
+	Locality plugin.TValue[[]interface{}]
+	Province plugin.TValue[[]interface{}]
+	StreetAddress plugin.TValue[[]interface{}]
+	PostalCode plugin.TValue[[]interface{}]
+	Names plugin.TValue[map[string]interface{}]
+	ExtraNames plugin.TValue[map[string]interface{}]
 }
 
-// createHttpHeaderSts creates a new instance of this resource
-func createHttpHeaderSts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlHttpHeaderSts{
+// createPkixName creates a new instance of this resource
+func createPkixName(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPkixName{
 		MqlRuntime: runtime,
 	}
 
@@ -1674,7 +5598,7 @@ func createHttpHeaderSts(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("http.header.sts", res.__id)
+		args, err = runtime.ResourceFromRecording("pkix.name", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -1684,39 +5608,79 @@ func createHttpHeaderSts(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlHttpHeaderSts) MqlName() string {
-	return "http.header.sts"
+func (c *mqlPkixName) MqlName() string {
+	return "pkix.name"
 }
 
-func (c *mqlHttpHeaderSts) MqlID() string {
+func (c *mqlPkixName) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlHttpHeaderSts) GetMaxAge() *plugin.TValue[*time.Time] {
-	return &c.MaxAge
+func (c *mqlPkixName) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlHttpHeaderSts) GetIncludeSubDomains() *plugin.TValue[bool] {
-	return &c.IncludeSubDomains
+func (c *mqlPkixName) GetDn() *plugin.TValue[string] {
+	return &c.Dn
 }
 
-func (c *mqlHttpHeaderSts) GetPreload() *plugin.TValue[bool] {
-	return &c.Preload
+func (c *mqlPkixName) GetSerialNumber() *plugin.TValue[string] {
+	return &c.SerialNumber
 }
 
-// mqlHttpHeaderXssProtection for the http.header.xssProtection resource
-type mqlHttpHeaderXssProtection struct {
+func (c *mqlPkixName) GetCommonName() *plugin.TValue[string] {
+	return &c.CommonName
+}
+
+func (c *mqlPkixName) GetCountry() *plugin.TValue[[]interface{}] {
+	return &c.Country
+}
+
+func (c *mqlPkixName) GetOrganization() *plugin.TValue[[]interface{}] {
+	return &c.Organization
+}
+
+func (c *mqlPkixName) GetOrganizationalUnit() *plugin.TValue[[]interface{}] {
+	return &c.OrganizationalUnit
+}
+
+func (c *mqlPkixName) GetLocality() *plugin.TValue[[]interface{}] {
+	return &c.Locality
+}
+
+func (c *mqlPkixName) GetProvince() *plugin.TValue[[]interface{}] {
+	return &c.Province
+}
+
+func (c *mqlPkixName) GetStreetAddress() *plugin.TValue[[]interface{}] {
+	return &c.StreetAddress
+}
+
+func (c *mqlPkixName) GetPostalCode() *plugin.TValue[[]interface{}] {
+	return &c.PostalCode
+}
+
+func (c *mqlPkixName) GetNames() *plugin.TValue[map[string]interface{}] {
+	return &c.Names
+}
+
+func (c *mqlPkixName) GetExtraNames() *plugin.TValue[map[string]interface{}] {
+	return &c.ExtraNames
+}
+
+// mqlPkixExtension for the pkix.extension resource
+type mqlPkixExtension struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlHttpHeaderXssProtectionInternal it will be used here
-	Enabled plugin.TValue[bool]
-	Mode plugin.TValue[string]
-	Report plugin.TValue[string]
+	// optional: if you define mqlPkixExtensionInternal it will be used here
+	Identifier plugin.TValue[string]
+	Critical plugin.TValue[bool]
+	Value plugin.TValue[string]
 }
 
-// createHttpHeaderXssProtection creates a new instance of this resource
-func createHttpHeaderXssProtection(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlHttpHeaderXssProtection{
+// createPkixExtension creates a new instance of this resource
+func createPkixExtension(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlPkixExtension{
 		MqlRuntime: runtime,
 	}
 
@@ -1733,7 +5697,7 @@ func createHttpHeaderXssProtection(runtime *plugin.Runtime, args map[string]*llx
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("http.header.xssProtection", res.__id)
+		args, err = runtime.ResourceFromRecording("pkix.extension", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -1743,38 +5707,38 @@ func createHttpHeaderXssProtection(runtime *plugin.Runtime, args map[string]*llx
 	return res, nil
 }
 
-func (c *mqlHttpHeaderXssProtection) MqlName() string {
-	return "http.header.xssProtection"
+func (c *mqlPkixExtension) MqlName() string {
+	return "pkix.extension"
 }
 
-func (c *mqlHttpHeaderXssProtection) MqlID() string {
+func (c *mqlPkixExtension) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlHttpHeaderXssProtection) GetEnabled() *plugin.TValue[bool] {
-	return &c.Enabled
+func (c *mqlPkixExtension) GetIdentifier() *plugin.TValue[string] {
+	return &c.Identifier
 }
 
-func (c *mqlHttpHeaderXssProtection) GetMode() *plugin.TValue[string] {
-	return &c.Mode
+func (c *mqlPkixExtension) GetCritical() *plugin.TValue[bool] {
+	return &c.Critical
 }
 
-func (c *mqlHttpHeaderXssProtection) GetReport() *plugin.TValue[string] {
-	return &c.Report
+func (c *mqlPkixExtension) GetValue() *plugin.TValue[string] {
+	return &c.Value
 }
 
-// mqlHttpHeaderContentType for the http.header.contentType resource
-type mqlHttpHeaderContentType struct {
+// mqlOpenpgpEntities for the openpgp.entities resource
+type mqlOpenpgpEntities struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlHttpHeaderContentTypeInternal it will be used here
-	Type plugin.TValue[string]
-	Params plugin.TValue[map[string]interface{}]
+	// optional: if you define mqlOpenpgpEntitiesInternal it will be used here
+	Content plugin.TValue[string]
+	List plugin.TValue[[]interface{}]
 }
 
-// createHttpHeaderContentType creates a new instance of this resource
-func createHttpHeaderContentType(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlHttpHeaderContentType{
+// createOpenpgpEntities creates a new instance of this resource
+func createOpenpgpEntities(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOpenpgpEntities{
 		MqlRuntime: runtime,
 	}
 
@@ -1783,15 +5747,10 @@ func createHttpHeaderContentType(runtime *plugin.Runtime, args map[string]*llx.R
 		return res, err
 	}
 
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
-		}
-	}
+	// to override __id implement: id() (string, error)
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("http.header.contentType", res.__id)
+		args, err = runtime.ResourceFromRecording("openpgp.entities", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -1801,35 +5760,51 @@ func createHttpHeaderContentType(runtime *plugin.Runtime, args map[string]*llx.R
 	return res, nil
 }
 
-func (c *mqlHttpHeaderContentType) MqlName() string {
-	return "http.header.contentType"
+func (c *mqlOpenpgpEntities) MqlName() string {
+	return "openpgp.entities"
 }
 
-func (c *mqlHttpHeaderContentType) MqlID() string {
+func (c *mqlOpenpgpEntities) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlHttpHeaderContentType) GetType() *plugin.TValue[string] {
-	return &c.Type
+func (c *mqlOpenpgpEntities) GetContent() *plugin.TValue[string] {
+	return &c.Content
 }
 
-func (c *mqlHttpHeaderContentType) GetParams() *plugin.TValue[map[string]interface{}] {
-	return &c.Params
+func (c *mqlOpenpgpEntities) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("openpgp.entities", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.list(vargContent.Data)
+	})
 }
 
-// mqlHttpHeaderSetCookie for the http.header.setCookie resource
-type mqlHttpHeaderSetCookie struct {
+// mqlOpenpgpEntity for the openpgp.entity resource
+type mqlOpenpgpEntity struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlHttpHeaderSetCookieInternal it will be used here
-	Name plugin.TValue[string]
-	Value plugin.TValue[string]
-	Params plugin.TValue[map[string]interface{}]
+	mqlOpenpgpEntityInternal
+	PrimaryPublicKey plugin.TValue[*mqlOpenpgpPublicKey]
+	Identities plugin.TValue[[]interface{}]
 }
 
-// createHttpHeaderSetCookie creates a new instance of this resource
-func createHttpHeaderSetCookie(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlHttpHeaderSetCookie{
+// createOpenpgpEntity creates a new instance of this resource
+func createOpenpgpEntity(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOpenpgpEntity{
 		MqlRuntime: runtime,
 	}
 
@@ -1846,7 +5821,7 @@ func createHttpHeaderSetCookie(runtime *plugin.Runtime, args map[string]*llx.Raw
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("http.header.setCookie", res.__id)
+		args, err = runtime.ResourceFromRecording("openpgp.entity", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -1856,46 +5831,50 @@ func createHttpHeaderSetCookie(runtime *plugin.Runtime, args map[string]*llx.Raw
 	return res, nil
 }
 
-func (c *mqlHttpHeaderSetCookie) MqlName() string {
-	return "http.header.setCookie"
+func (c *mqlOpenpgpEntity) MqlName() string {
+	return "openpgp.entity"
 }
 
-func (c *mqlHttpHeaderSetCookie) MqlID() string {
+func (c *mqlOpenpgpEntity) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlHttpHeaderSetCookie) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlOpenpgpEntity) GetPrimaryPublicKey() *plugin.TValue[*mqlOpenpgpPublicKey] {
+	return &c.PrimaryPublicKey
 }
 
-func (c *mqlHttpHeaderSetCookie) GetValue() *plugin.TValue[string] {
-	return &c.Value
-}
+func (c *mqlOpenpgpEntity) GetIdentities() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Identities, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("openpgp.entity", c.__id, "identities")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlHttpHeaderSetCookie) GetParams() *plugin.TValue[map[string]interface{}] {
-	return &c.Params
+		return c.identities()
+	})
 }
 
-// mqlUrl for the url resource
-type mqlUrl struct {
+// mqlOpenpgpPublicKey for the openpgp.publicKey resource
+type mqlOpenpgpPublicKey struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlUrlInternal it will be used here
-	String plugin.TValue[string]
-	Scheme plugin.TValue[string]
-	User plugin.TValue[string]
-	Password plugin.TValue[string]
-	Host plugin.TValue[string]
-	Port plugin.TValue[int64]
-	Path plugin.TValue[string]
-	Query plugin.TValue[map[string]interface{}]
-	RawQuery plugin.TValue[string]
-	RawFragment plugin.TValue[string]
+	// optional: if you define mqlOpenpgpPublicKeyInternal it will be used here
+	Id plugin.TValue[string]
+	Version plugin.TValue[int64]
+	Fingerprint plugin.TValue[string]
+	KeyAlgorithm plugin.TValue[string]
+	BitLength plugin.TValue[int64]
+	CreationTime plugin.TValue[*time.Time]
 }
 
-// createUrl creates a new instance of this resource
-func createUrl(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlUrl{
+// createOpenpgpPublicKey creates a new instance of this resource
+func createOpenpgpPublicKey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOpenpgpPublicKey{
 		MqlRuntime: runtime,
 	}
 
@@ -1912,84 +5891,64 @@ func createUrl(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Re
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("url", res.__id)
+		args, err = runtime.ResourceFromRecording("openpgp.publicKey", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
 		return res, SetAllData(res, args)
 	}
 
-	return res, nil
-}
-
-func (c *mqlUrl) MqlName() string {
-	return "url"
-}
-
-func (c *mqlUrl) MqlID() string {
-	return c.__id
-}
-
-func (c *mqlUrl) GetString() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.String, func() (string, error) {
-		return c.string()
-	})
-}
-
-func (c *mqlUrl) GetScheme() *plugin.TValue[string] {
-	return &c.Scheme
+	return res, nil
 }
 
-func (c *mqlUrl) GetUser() *plugin.TValue[string] {
-	return &c.User
+func (c *mqlOpenpgpPublicKey) MqlName() string {
+	return "openpgp.publicKey"
 }
 
-func (c *mqlUrl) GetPassword() *plugin.TValue[string] {
-	return &c.Password
+func (c *mqlOpenpgpPublicKey) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlUrl) GetHost() *plugin.TValue[string] {
-	return &c.Host
+func (c *mqlOpenpgpPublicKey) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlUrl) GetPort() *plugin.TValue[int64] {
-	return &c.Port
+func (c *mqlOpenpgpPublicKey) GetVersion() *plugin.TValue[int64] {
+	return &c.Version
 }
 
-func (c *mqlUrl) GetPath() *plugin.TValue[string] {
-	return &c.Path
+func (c *mqlOpenpgpPublicKey) GetFingerprint() *plugin.TValue[string] {
+	return &c.Fingerprint
 }
 
-func (c *mqlUrl) GetQuery() *plugin.TValue[map[string]interface{}] {
-	return &c.Query
+func (c *mqlOpenpgpPublicKey) GetKeyAlgorithm() *plugin.TValue[string] {
+	return &c.KeyAlgorithm
 }
 
-func (c *mqlUrl) GetRawQuery() *plugin.TValue[string] {
-	return &c.RawQuery
+func (c *mqlOpenpgpPublicKey) GetBitLength() *plugin.TValue[int64] {
+	return &c.BitLength
 }
 
-func (c *mqlUrl) GetRawFragment() *plugin.TValue[string] {
-	return &c.RawFragment
+func (c *mqlOpenpgpPublicKey) GetCreationTime() *plugin.TValue[*time.Time] {
+	return &c.CreationTime
 }
 
-// mqlTls for the tls resource
-type mqlTls struct {
+// mqlOpenpgpIdentity for the openpgp.identity resource
+type mqlOpenpgpIdentity struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlTlsInternal
-	Socket plugin.TValue[*mqlSocket]
-	DomainName plugin.TValue[string]
-	Params plugin.TValue[interface{}]
-	Versions plugin.TValue[[]interface{}]
-	Ciphers plugin.TValue[[]interface{}]
-	Extensions plugin.TValue[[]interface{}]
-	Certificates plugin.TValue[[]interface{}]
-	NonSniCertificates plugin.TValue[[]interface{}]
+	mqlOpenpgpIdentityInternal
+	Fingerprint plugin.TValue[string]
+	Id plugin.TValue[string]
+	Name plugin.TValue[string]
+	Email plugin.TValue[string]
+	Comment plugin.TValue[string]
+	Signatures plugin.TValue[[]interface{}]
 }
 
-// createTls creates a new instance of this resource
-func createTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlTls{
+// createOpenpgpIdentity creates a new instance of this resource
+func createOpenpgpIdentity(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOpenpgpIdentity{
 		MqlRuntime: runtime,
 	}
 
@@ -2006,7 +5965,7 @@ func createTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Re
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("tls", res.__id)
+		args, err = runtime.ResourceFromRecording("openpgp.identity", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -2016,96 +5975,38 @@ func createTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Re
 	return res, nil
 }
 
-func (c *mqlTls) MqlName() string {
-	return "tls"
+func (c *mqlOpenpgpIdentity) MqlName() string {
+	return "openpgp.identity"
 }
 
-func (c *mqlTls) MqlID() string {
+func (c *mqlOpenpgpIdentity) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlTls) GetSocket() *plugin.TValue[*mqlSocket] {
-	return &c.Socket
-}
-
-func (c *mqlTls) GetDomainName() *plugin.TValue[string] {
-	return &c.DomainName
-}
-
-func (c *mqlTls) GetParams() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
-		vargSocket := c.GetSocket()
-		if vargSocket.Error != nil {
-			return nil, vargSocket.Error
-		}
-
-		vargDomainName := c.GetDomainName()
-		if vargDomainName.Error != nil {
-			return nil, vargDomainName.Error
-		}
-
-		return c.params(vargSocket.Data, vargDomainName.Data)
-	})
+func (c *mqlOpenpgpIdentity) GetFingerprint() *plugin.TValue[string] {
+	return &c.Fingerprint
 }
 
-func (c *mqlTls) GetVersions() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Versions, func() ([]interface{}, error) {
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
-
-		return c.versions(vargParams.Data)
-	})
+func (c *mqlOpenpgpIdentity) GetId() *plugin.TValue[string] {
+	return &c.Id
 }
 
-func (c *mqlTls) GetCiphers() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Ciphers, func() ([]interface{}, error) {
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
-
-		return c.ciphers(vargParams.Data)
-	})
+func (c *mqlOpenpgpIdentity) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlTls) GetExtensions() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Extensions, func() ([]interface{}, error) {
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
-
-		return c.extensions(vargParams.Data)
-	})
+func (c *mqlOpenpgpIdentity) GetEmail() *plugin.TValue[string] {
+	return &c.Email
 }
 
-func (c *mqlTls) GetCertificates() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Certificates, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("tls", c.__id, "certificates")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
-
-		return c.certificates(vargParams.Data)
-	})
+func (c *mqlOpenpgpIdentity) GetComment() *plugin.TValue[string] {
+	return &c.Comment
 }
 
-func (c *mqlTls) GetNonSniCertificates() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.NonSniCertificates, func() ([]interface{}, error) {
+func (c *mqlOpenpgpIdentity) GetSignatures() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Signatures, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("tls", c.__id, "nonSniCertificates")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("openpgp.identity", c.__id, "signatures")
 			if err != nil {
 				return nil, err
 			}
@@ -2114,27 +6015,31 @@ func (c *mqlTls) GetNonSniCertificates() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
-
-		return c.nonSniCertificates(vargParams.Data)
+		return c.signatures()
 	})
 }
 
-// mqlCertificates for the certificates resource
-type mqlCertificates struct {
+// mqlOpenpgpSignature for the openpgp.signature resource
+type mqlOpenpgpSignature struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlCertificatesInternal it will be used here
-	Pem plugin.TValue[string]
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlOpenpgpSignatureInternal it will be used here
+	Fingerprint plugin.TValue[string]
+	IdentityName plugin.TValue[string]
+	Hash plugin.TValue[string]
+	Version plugin.TValue[int64]
+	SignatureType plugin.TValue[string]
+	KeyAlgorithm plugin.TValue[string]
+	CreationTime plugin.TValue[*time.Time]
+	LifetimeSecs plugin.TValue[int64]
+	ExpiresIn plugin.TValue[*time.Time]
+	KeyLifetimeSecs plugin.TValue[int64]
+	KeyExpiresIn plugin.TValue[*time.Time]
 }
 
-// createCertificates creates a new instance of this resource
-func createCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlCertificates{
+// createOpenpgpSignature creates a new instance of this resource
+func createOpenpgpSignature(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOpenpgpSignature{
 		MqlRuntime: runtime,
 	}
 
@@ -2151,7 +6056,7 @@ func createCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("certificates", res.__id)
+		args, err = runtime.ResourceFromRecording("openpgp.signature", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -2161,68 +6066,71 @@ func createCertificates(runtime *plugin.Runtime, args map[string]*llx.RawData) (
 	return res, nil
 }
 
-func (c *mqlCertificates) MqlName() string {
-	return "certificates"
+func (c *mqlOpenpgpSignature) MqlName() string {
+	return "openpgp.signature"
 }
 
-func (c *mqlCertificates) MqlID() string {
+func (c *mqlOpenpgpSignature) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlCertificates) GetPem() *plugin.TValue[string] {
-	return &c.Pem
+func (c *mqlOpenpgpSignature) GetFingerprint() *plugin.TValue[string] {
+	return &c.Fingerprint
 }
 
-func (c *mqlCertificates) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("certificates", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlOpenpgpSignature) GetIdentityName() *plugin.TValue[string] {
+	return &c.IdentityName
+}
 
-		return c.list()
-	})
+func (c *mqlOpenpgpSignature) GetHash() *plugin.TValue[string] {
+	return &c.Hash
 }
 
-// mqlCertificate for the certificate resource
-type mqlCertificate struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	mqlCertificateInternal
-	Pem plugin.TValue[string]
-	Fingerprints plugin.TValue[map[string]interface{}]
-	Serial plugin.TValue[string]
-	SubjectKeyID plugin.TValue[string]
-	AuthorityKeyID plugin.TValue[string]
-	Subject plugin.TValue[*mqlPkixName]
-	Issuer plugin.TValue[*mqlPkixName]
-	Version plugin.TValue[int64]
-	NotBefore plugin.TValue[*time.Time]
-	NotAfter plugin.TValue[*time.Time]
-	ExpiresIn plugin.TValue[*time.Time]
-	Signature plugin.TValue[string]
-	SigningAlgorithm plugin.TValue[string]
-	IsCA plugin.TValue[bool]
-	KeyUsage plugin.TValue[[]interface{}]
-	ExtendedKeyUsage plugin.TValue[[]interface{}]
-	Extensions plugin.TValue[[]interface{}]
-	PolicyIdentifier plugin.TValue[[]interface{}]
-	CrlDistributionPoints plugin.TValue[[]interface{}]
-	OcspServer plugin.TValue[[]interface{}]
-	IssuingCertificateUrl plugin.TValue[[]interface{}]
-	IsRevoked plugin.TValue[bool]
-	RevokedAt plugin.TValue[*time.Time]
-	IsVerified plugin.TValue[bool]
+func (c *mqlOpenpgpSignature) GetVersion() *plugin.TValue[int64] {
+	return &c.Version
+}
+
+func (c *mqlOpenpgpSignature) GetSignatureType() *plugin.TValue[string] {
+	return &c.SignatureType
+}
+
+func (c *mqlOpenpgpSignature) GetKeyAlgorithm() *plugin.TValue[string] {
+	return &c.KeyAlgorithm
+}
+
+func (c *mqlOpenpgpSignature) GetCreationTime() *plugin.TValue[*time.Time] {
+	return &c.CreationTime
 }
 
-// createCertificate creates a new instance of this resource
-func createCertificate(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlCertificate{
+func (c *mqlOpenpgpSignature) GetLifetimeSecs() *plugin.TValue[int64] {
+	return &c.LifetimeSecs
+}
+
+func (c *mqlOpenpgpSignature) GetExpiresIn() *plugin.TValue[*time.Time] {
+	return &c.ExpiresIn
+}
+
+func (c *mqlOpenpgpSignature) GetKeyLifetimeSecs() *plugin.TValue[int64] {
+	return &c.KeyLifetimeSecs
+}
+
+func (c *mqlOpenpgpSignature) GetKeyExpiresIn() *plugin.TValue[*time.Time] {
+	return &c.KeyExpiresIn
+}
+
+// mqlJwks for the jwks resource
+type mqlJwks struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlJwksInternal it will be used here
+	Url plugin.TValue[string]
+	Content plugin.TValue[string]
+	Keys plugin.TValue[[]interface{}]
+}
+
+// createJwks creates a new instance of this resource
+func createJwks(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlJwks{
 		MqlRuntime: runtime,
 	}
 
@@ -2239,7 +6147,7 @@ func createCertificate(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("certificate", res.__id)
+		args, err = runtime.ResourceFromRecording("jwks", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -2249,209 +6157,214 @@ func createCertificate(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlCertificate) MqlName() string {
-	return "certificate"
+func (c *mqlJwks) MqlName() string {
+	return "jwks"
 }
 
-func (c *mqlCertificate) MqlID() string {
+func (c *mqlJwks) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlCertificate) GetPem() *plugin.TValue[string] {
-	return &c.Pem
-}
-
-func (c *mqlCertificate) GetFingerprints() *plugin.TValue[map[string]interface{}] {
-	return plugin.GetOrCompute[map[string]interface{}](&c.Fingerprints, func() (map[string]interface{}, error) {
-		return c.fingerprints()
-	})
-}
-
-func (c *mqlCertificate) GetSerial() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Serial, func() (string, error) {
-		return c.serial()
-	})
-}
-
-func (c *mqlCertificate) GetSubjectKeyID() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.SubjectKeyID, func() (string, error) {
-		return c.subjectKeyID()
-	})
+func (c *mqlJwks) GetUrl() *plugin.TValue[string] {
+	return &c.Url
 }
 
-func (c *mqlCertificate) GetAuthorityKeyID() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.AuthorityKeyID, func() (string, error) {
-		return c.authorityKeyID()
+func (c *mqlJwks) GetContent() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Content, func() (string, error) {
+		return c.content()
 	})
 }
 
-func (c *mqlCertificate) GetSubject() *plugin.TValue[*mqlPkixName] {
-	return plugin.GetOrCompute[*mqlPkixName](&c.Subject, func() (*mqlPkixName, error) {
+func (c *mqlJwks) GetKeys() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Keys, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("certificate", c.__id, "subject")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("jwks", c.__id, "keys")
 			if err != nil {
 				return nil, err
 			}
 			if d != nil {
-				return d.Value.(*mqlPkixName), nil
+				return d.Value.([]interface{}), nil
 			}
 		}
 
-		return c.subject()
+		vargContent := c.GetContent()
+		if vargContent.Error != nil {
+			return nil, vargContent.Error
+		}
+
+		return c.keys(vargContent.Data)
 	})
 }
 
-func (c *mqlCertificate) GetIssuer() *plugin.TValue[*mqlPkixName] {
-	return plugin.GetOrCompute[*mqlPkixName](&c.Issuer, func() (*mqlPkixName, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("certificate", c.__id, "issuer")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.(*mqlPkixName), nil
-			}
+// mqlJwk for the jwk resource
+type mqlJwk struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlJwkInternal it will be used here
+	KeyId plugin.TValue[string]
+	Type plugin.TValue[string]
+	Algorithm plugin.TValue[string]
+	Use plugin.TValue[string]
+	Bits plugin.TValue[int64]
+	Curve plugin.TValue[string]
+	Public plugin.TValue[bool]
+	Thumbprint plugin.TValue[string]
+}
+
+// createJwk creates a new instance of this resource
+func createJwk(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlJwk{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("jwk", res.__id)
+		if err != nil || args == nil {
+			return res, err
 		}
+		return res, SetAllData(res, args)
+	}
 
-		return c.issuer()
-	})
+	return res, nil
 }
 
-func (c *mqlCertificate) GetVersion() *plugin.TValue[int64] {
-	return plugin.GetOrCompute[int64](&c.Version, func() (int64, error) {
-		return c.version()
-	})
+func (c *mqlJwk) MqlName() string {
+	return "jwk"
 }
 
-func (c *mqlCertificate) GetNotBefore() *plugin.TValue[*time.Time] {
-	return plugin.GetOrCompute[*time.Time](&c.NotBefore, func() (*time.Time, error) {
-		return c.notBefore()
-	})
+func (c *mqlJwk) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlCertificate) GetNotAfter() *plugin.TValue[*time.Time] {
-	return plugin.GetOrCompute[*time.Time](&c.NotAfter, func() (*time.Time, error) {
-		return c.notAfter()
-	})
+func (c *mqlJwk) GetKeyId() *plugin.TValue[string] {
+	return &c.KeyId
 }
 
-func (c *mqlCertificate) GetExpiresIn() *plugin.TValue[*time.Time] {
-	return plugin.GetOrCompute[*time.Time](&c.ExpiresIn, func() (*time.Time, error) {
-		return c.expiresIn()
-	})
+func (c *mqlJwk) GetType() *plugin.TValue[string] {
+	return &c.Type
 }
 
-func (c *mqlCertificate) GetSignature() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.Signature, func() (string, error) {
-		return c.signature()
-	})
+func (c *mqlJwk) GetAlgorithm() *plugin.TValue[string] {
+	return &c.Algorithm
 }
 
-func (c *mqlCertificate) GetSigningAlgorithm() *plugin.TValue[string] {
-	return plugin.GetOrCompute[string](&c.SigningAlgorithm, func() (string, error) {
-		return c.signingAlgorithm()
-	})
+func (c *mqlJwk) GetUse() *plugin.TValue[string] {
+	return &c.Use
 }
 
-func (c *mqlCertificate) GetIsCA() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.IsCA, func() (bool, error) {
-		return c.isCA()
-	})
+func (c *mqlJwk) GetBits() *plugin.TValue[int64] {
+	return &c.Bits
 }
 
-func (c *mqlCertificate) GetKeyUsage() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.KeyUsage, func() ([]interface{}, error) {
-		return c.keyUsage()
-	})
+func (c *mqlJwk) GetCurve() *plugin.TValue[string] {
+	return &c.Curve
 }
 
-func (c *mqlCertificate) GetExtendedKeyUsage() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.ExtendedKeyUsage, func() ([]interface{}, error) {
-		return c.extendedKeyUsage()
-	})
+func (c *mqlJwk) GetPublic() *plugin.TValue[bool] {
+	return &c.Public
 }
 
-func (c *mqlCertificate) GetExtensions() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Extensions, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("certificate", c.__id, "extensions")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
+func (c *mqlJwk) GetThumbprint() *plugin.TValue[string] {
+	return &c.Thumbprint
+}
+
+// mqlDomainName for the domainName resource
+type mqlDomainName struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlDomainNameInternal it will be used here
+	Fqdn plugin.TValue[string]
+	EffectiveTLDPlusOne plugin.TValue[string]
+	Tld plugin.TValue[string]
+	TldIcannManaged plugin.TValue[bool]
+	Labels plugin.TValue[[]interface{}]
+}
+
+// createDomainName creates a new instance of this resource
+func createDomainName(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDomainName{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		return c.extensions()
-	})
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("domainName", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
 }
 
-func (c *mqlCertificate) GetPolicyIdentifier() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.PolicyIdentifier, func() ([]interface{}, error) {
-		return c.policyIdentifier()
-	})
+func (c *mqlDomainName) MqlName() string {
+	return "domainName"
 }
 
-func (c *mqlCertificate) GetCrlDistributionPoints() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.CrlDistributionPoints, func() ([]interface{}, error) {
-		return c.crlDistributionPoints()
-	})
+func (c *mqlDomainName) MqlID() string {
+	return c.__id
 }
 
-func (c *mqlCertificate) GetOcspServer() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.OcspServer, func() ([]interface{}, error) {
-		return c.ocspServer()
-	})
+func (c *mqlDomainName) GetFqdn() *plugin.TValue[string] {
+	return &c.Fqdn
 }
 
-func (c *mqlCertificate) GetIssuingCertificateUrl() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.IssuingCertificateUrl, func() ([]interface{}, error) {
-		return c.issuingCertificateUrl()
-	})
+func (c *mqlDomainName) GetEffectiveTLDPlusOne() *plugin.TValue[string] {
+	return &c.EffectiveTLDPlusOne
 }
 
-func (c *mqlCertificate) GetIsRevoked() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.IsRevoked, func() (bool, error) {
-		return c.isRevoked()
-	})
+func (c *mqlDomainName) GetTld() *plugin.TValue[string] {
+	return &c.Tld
 }
 
-func (c *mqlCertificate) GetRevokedAt() *plugin.TValue[*time.Time] {
-	return plugin.GetOrCompute[*time.Time](&c.RevokedAt, func() (*time.Time, error) {
-		return c.revokedAt()
-	})
+func (c *mqlDomainName) GetTldIcannManaged() *plugin.TValue[bool] {
+	return &c.TldIcannManaged
 }
 
-func (c *mqlCertificate) GetIsVerified() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.IsVerified, func() (bool, error) {
-		return c.isVerified()
-	})
+func (c *mqlDomainName) GetLabels() *plugin.TValue[[]interface{}] {
+	return &c.Labels
 }
 
-// mqlPkixName for the pkix.name resource
-type mqlPkixName struct {
+// mqlDns for the dns resource
+type mqlDns struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlPkixNameInternal it will be used here
-	Id plugin.TValue[string]
-	Dn plugin.TValue[string]
-	SerialNumber plugin.TValue[string]
-	CommonName plugin.TValue[string]
-	Country plugin.TValue[[]interface{}]
-	Organization plugin.TValue[[]interface{}]
-	OrganizationalUnit plugin.TValue[[]interface{}]
-	Locality plugin.TValue[[]interface{}]
-	Province plugin.TValue[[]interface{}]
-	StreetAddress plugin.TValue[[]interface{}]
-	PostalCode plugin.TValue[[]interface{}]
-	Names plugin.TValue[map[string]interface{}]
-	ExtraNames plugin.TValue[map[string]interface{}]
+	// optional: if you define mqlDnsInternal it will be used here
+	Fqdn plugin.TValue[string]
+	Params plugin.TValue[interface{}]
+	Records plugin.TValue[[]interface{}]
+	Mx plugin.TValue[[]interface{}]
+	Dkim plugin.TValue[[]interface{}]
+	Spf plugin.TValue[*mqlDnsSpf]
+	Dmarc plugin.TValue[*mqlDnsDmarc]
+	MtaSts plugin.TValue[*mqlDnsMtaSts]
+	Bimi plugin.TValue[*mqlDnsBimi]
+	Caa plugin.TValue[*mqlDnsCaa]
+	Dnssec plugin.TValue[*mqlDnsDnssec]
 }
 
-// createPkixName creates a new instance of this resource
-func createPkixName(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPkixName{
+// createDns creates a new instance of this resource
+func createDns(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDns{
 		MqlRuntime: runtime,
 	}
 
@@ -2468,7 +6381,7 @@ func createPkixName(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("pkix.name", res.__id)
+		args, err = runtime.ResourceFromRecording("dns", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -2478,137 +6391,203 @@ func createPkixName(runtime *plugin.Runtime, args map[string]*llx.RawData) (plug
 	return res, nil
 }
 
-func (c *mqlPkixName) MqlName() string {
-	return "pkix.name"
+func (c *mqlDns) MqlName() string {
+	return "dns"
 }
 
-func (c *mqlPkixName) MqlID() string {
+func (c *mqlDns) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlPkixName) GetId() *plugin.TValue[string] {
-	return &c.Id
+func (c *mqlDns) GetFqdn() *plugin.TValue[string] {
+	return &c.Fqdn
 }
 
-func (c *mqlPkixName) GetDn() *plugin.TValue[string] {
-	return &c.Dn
-}
+func (c *mqlDns) GetParams() *plugin.TValue[interface{}] {
+	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
+		vargFqdn := c.GetFqdn()
+		if vargFqdn.Error != nil {
+			return nil, vargFqdn.Error
+		}
 
-func (c *mqlPkixName) GetSerialNumber() *plugin.TValue[string] {
-	return &c.SerialNumber
+		return c.params(vargFqdn.Data)
+	})
 }
 
-func (c *mqlPkixName) GetCommonName() *plugin.TValue[string] {
-	return &c.CommonName
-}
+func (c *mqlDns) GetRecords() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Records, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "records")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlPkixName) GetCountry() *plugin.TValue[[]interface{}] {
-	return &c.Country
-}
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
 
-func (c *mqlPkixName) GetOrganization() *plugin.TValue[[]interface{}] {
-	return &c.Organization
+		return c.records(vargParams.Data)
+	})
 }
 
-func (c *mqlPkixName) GetOrganizationalUnit() *plugin.TValue[[]interface{}] {
-	return &c.OrganizationalUnit
-}
+func (c *mqlDns) GetMx() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Mx, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "mx")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlPkixName) GetLocality() *plugin.TValue[[]interface{}] {
-	return &c.Locality
-}
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
 
-func (c *mqlPkixName) GetProvince() *plugin.TValue[[]interface{}] {
-	return &c.Province
+		return c.mx(vargParams.Data)
+	})
 }
 
-func (c *mqlPkixName) GetStreetAddress() *plugin.TValue[[]interface{}] {
-	return &c.StreetAddress
-}
+func (c *mqlDns) GetDkim() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Dkim, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "dkim")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
 
-func (c *mqlPkixName) GetPostalCode() *plugin.TValue[[]interface{}] {
-	return &c.PostalCode
-}
+		vargParams := c.GetParams()
+		if vargParams.Error != nil {
+			return nil, vargParams.Error
+		}
 
-func (c *mqlPkixName) GetNames() *plugin.TValue[map[string]interface{}] {
-	return &c.Names
+		return c.dkim(vargParams.Data)
+	})
 }
 
-func (c *mqlPkixName) GetExtraNames() *plugin.TValue[map[string]interface{}] {
-	return &c.ExtraNames
-}
+func (c *mqlDns) GetSpf() *plugin.TValue[*mqlDnsSpf] {
+	return plugin.GetOrCompute[*mqlDnsSpf](&c.Spf, func() (*mqlDnsSpf, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "spf")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlDnsSpf), nil
+			}
+		}
 
-// mqlPkixExtension for the pkix.extension resource
-type mqlPkixExtension struct {
-	MqlRuntime *plugin.Runtime
-	__id string
-	// optional: if you define mqlPkixExtensionInternal it will be used here
-	Identifier plugin.TValue[string]
-	Critical plugin.TValue[bool]
-	Value plugin.TValue[string]
+		return c.spf()
+	})
 }
 
-// createPkixExtension creates a new instance of this resource
-func createPkixExtension(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlPkixExtension{
-		MqlRuntime: runtime,
-	}
-
-	err := SetAllData(res, args)
-	if err != nil {
-		return res, err
-	}
-
-	if res.__id == "" {
-	res.__id, err = res.id()
-		if err != nil {
-			return nil, err
+func (c *mqlDns) GetDmarc() *plugin.TValue[*mqlDnsDmarc] {
+	return plugin.GetOrCompute[*mqlDnsDmarc](&c.Dmarc, func() (*mqlDnsDmarc, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "dmarc")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlDnsDmarc), nil
+			}
 		}
-	}
 
-	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("pkix.extension", res.__id)
-		if err != nil || args == nil {
-			return res, err
+		return c.dmarc()
+	})
+}
+
+func (c *mqlDns) GetMtaSts() *plugin.TValue[*mqlDnsMtaSts] {
+	return plugin.GetOrCompute[*mqlDnsMtaSts](&c.MtaSts, func() (*mqlDnsMtaSts, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "mtaSts")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlDnsMtaSts), nil
+			}
 		}
-		return res, SetAllData(res, args)
-	}
 
-	return res, nil
+		return c.mtaSts()
+	})
 }
 
-func (c *mqlPkixExtension) MqlName() string {
-	return "pkix.extension"
-}
+func (c *mqlDns) GetBimi() *plugin.TValue[*mqlDnsBimi] {
+	return plugin.GetOrCompute[*mqlDnsBimi](&c.Bimi, func() (*mqlDnsBimi, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "bimi")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlDnsBimi), nil
+			}
+		}
 
-func (c *mqlPkixExtension) MqlID() string {
-	return c.__id
+		return c.bimi()
+	})
 }
 
-func (c *mqlPkixExtension) GetIdentifier() *plugin.TValue[string] {
-	return &c.Identifier
-}
+func (c *mqlDns) GetCaa() *plugin.TValue[*mqlDnsCaa] {
+	return plugin.GetOrCompute[*mqlDnsCaa](&c.Caa, func() (*mqlDnsCaa, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "caa")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlDnsCaa), nil
+			}
+		}
 
-func (c *mqlPkixExtension) GetCritical() *plugin.TValue[bool] {
-	return &c.Critical
+		return c.caa()
+	})
 }
 
-func (c *mqlPkixExtension) GetValue() *plugin.TValue[string] {
-	return &c.Value
+func (c *mqlDns) GetDnssec() *plugin.TValue[*mqlDnsDnssec] {
+	return plugin.GetOrCompute[*mqlDnsDnssec](&c.Dnssec, func() (*mqlDnsDnssec, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "dnssec")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlDnsDnssec), nil
+			}
+		}
+
+		return c.dnssec()
+	})
 }
 
-// mqlOpenpgpEntities for the openpgp.entities resource
-type mqlOpenpgpEntities struct {
+// mqlDnsRecord for the dns.record resource
+type mqlDnsRecord struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlOpenpgpEntitiesInternal it will be used here
-	Content plugin.TValue[string]
-	List plugin.TValue[[]interface{}]
+	// optional: if you define mqlDnsRecordInternal it will be used here
+	Name plugin.TValue[string]
+	Ttl plugin.TValue[int64]
+	Class plugin.TValue[string]
+	Type plugin.TValue[string]
+	Rdata plugin.TValue[[]interface{}]
 }
 
-// createOpenpgpEntities creates a new instance of this resource
-func createOpenpgpEntities(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOpenpgpEntities{
+// createDnsRecord creates a new instance of this resource
+func createDnsRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsRecord{
 		MqlRuntime: runtime,
 	}
 
@@ -2617,10 +6596,15 @@ func createOpenpgpEntities(runtime *plugin.Runtime, args map[string]*llx.RawData
 		return res, err
 	}
 
-	// to override __id implement: id() (string, error)
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("openpgp.entities", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.record", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -2630,51 +6614,47 @@ func createOpenpgpEntities(runtime *plugin.Runtime, args map[string]*llx.RawData
 	return res, nil
 }
 
-func (c *mqlOpenpgpEntities) MqlName() string {
-	return "openpgp.entities"
+func (c *mqlDnsRecord) MqlName() string {
+	return "dns.record"
 }
 
-func (c *mqlOpenpgpEntities) MqlID() string {
+func (c *mqlDnsRecord) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOpenpgpEntities) GetContent() *plugin.TValue[string] {
-	return &c.Content
+func (c *mqlDnsRecord) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlOpenpgpEntities) GetList() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("openpgp.entities", c.__id, "list")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlDnsRecord) GetTtl() *plugin.TValue[int64] {
+	return &c.Ttl
+}
 
-		vargContent := c.GetContent()
-		if vargContent.Error != nil {
-			return nil, vargContent.Error
-		}
+func (c *mqlDnsRecord) GetClass() *plugin.TValue[string] {
+	return &c.Class
+}
 
-		return c.list(vargContent.Data)
-	})
+func (c *mqlDnsRecord) GetType() *plugin.TValue[string] {
+	return &c.Type
 }
 
-// mqlOpenpgpEntity for the openpgp.entity resource
-type mqlOpenpgpEntity struct {
+func (c *mqlDnsRecord) GetRdata() *plugin.TValue[[]interface{}] {
+	return &c.Rdata
+}
+
+// mqlDnsMxRecord for the dns.mxRecord resource
+type mqlDnsMxRecord struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlOpenpgpEntityInternal
-	PrimaryPublicKey plugin.TValue[*mqlOpenpgpPublicKey]
-	Identities plugin.TValue[[]interface{}]
+	// optional: if you define mqlDnsMxRecordInternal it will be used here
+	Name plugin.TValue[string]
+	Preference plugin.TValue[int64]
+	DomainName plugin.TValue[string]
 }
 
-// createOpenpgpEntity creates a new instance of this resource
-func createOpenpgpEntity(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOpenpgpEntity{
+// createDnsMxRecord creates a new instance of this resource
+func createDnsMxRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsMxRecord{
 		MqlRuntime: runtime,
 	}
 
@@ -2691,7 +6671,7 @@ func createOpenpgpEntity(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("openpgp.entity", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.mxRecord", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -2701,50 +6681,46 @@ func createOpenpgpEntity(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlOpenpgpEntity) MqlName() string {
-	return "openpgp.entity"
+func (c *mqlDnsMxRecord) MqlName() string {
+	return "dns.mxRecord"
 }
 
-func (c *mqlOpenpgpEntity) MqlID() string {
+func (c *mqlDnsMxRecord) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOpenpgpEntity) GetPrimaryPublicKey() *plugin.TValue[*mqlOpenpgpPublicKey] {
-	return &c.PrimaryPublicKey
+func (c *mqlDnsMxRecord) GetName() *plugin.TValue[string] {
+	return &c.Name
 }
 
-func (c *mqlOpenpgpEntity) GetIdentities() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Identities, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("openpgp.entity", c.__id, "identities")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
+func (c *mqlDnsMxRecord) GetPreference() *plugin.TValue[int64] {
+	return &c.Preference
+}
 
-		return c.identities()
-	})
+func (c *mqlDnsMxRecord) GetDomainName() *plugin.TValue[string] {
+	return &c.DomainName
 }
 
-// mqlOpenpgpPublicKey for the openpgp.publicKey resource
-type mqlOpenpgpPublicKey struct {
+// mqlDnsDkimRecord for the dns.dkimRecord resource
+type mqlDnsDkimRecord struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlOpenpgpPublicKeyInternal it will be used here
-	Id plugin.TValue[string]
-	Version plugin.TValue[int64]
-	Fingerprint plugin.TValue[string]
-	KeyAlgorithm plugin.TValue[string]
-	BitLength plugin.TValue[int64]
-	CreationTime plugin.TValue[*time.Time]
+	mqlDnsDkimRecordInternal
+	DnsTxt plugin.TValue[string]
+	Domain plugin.TValue[string]
+	Version plugin.TValue[string]
+	HashAlgorithms plugin.TValue[[]interface{}]
+	KeyType plugin.TValue[string]
+	Notes plugin.TValue[string]
+	PublicKeyData plugin.TValue[string]
+	ServiceTypes plugin.TValue[[]interface{}]
+	Flags plugin.TValue[[]interface{}]
+	Valid plugin.TValue[bool]
 }
 
-// createOpenpgpPublicKey creates a new instance of this resource
-func createOpenpgpPublicKey(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOpenpgpPublicKey{
+// createDnsDkimRecord creates a new instance of this resource
+func createDnsDkimRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsDkimRecord{
 		MqlRuntime: runtime,
 	}
 
@@ -2761,7 +6737,7 @@ func createOpenpgpPublicKey(runtime *plugin.Runtime, args map[string]*llx.RawDat
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("openpgp.publicKey", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.dkimRecord", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -2771,54 +6747,75 @@ func createOpenpgpPublicKey(runtime *plugin.Runtime, args map[string]*llx.RawDat
 	return res, nil
 }
 
-func (c *mqlOpenpgpPublicKey) MqlName() string {
-	return "openpgp.publicKey"
+func (c *mqlDnsDkimRecord) MqlName() string {
+	return "dns.dkimRecord"
 }
 
-func (c *mqlOpenpgpPublicKey) MqlID() string {
+func (c *mqlDnsDkimRecord) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOpenpgpPublicKey) GetId() *plugin.TValue[string] {
-	return &c.Id
+func (c *mqlDnsDkimRecord) GetDnsTxt() *plugin.TValue[string] {
+	return &c.DnsTxt
 }
 
-func (c *mqlOpenpgpPublicKey) GetVersion() *plugin.TValue[int64] {
+func (c *mqlDnsDkimRecord) GetDomain() *plugin.TValue[string] {
+	return &c.Domain
+}
+
+func (c *mqlDnsDkimRecord) GetVersion() *plugin.TValue[string] {
 	return &c.Version
 }
 
-func (c *mqlOpenpgpPublicKey) GetFingerprint() *plugin.TValue[string] {
-	return &c.Fingerprint
+func (c *mqlDnsDkimRecord) GetHashAlgorithms() *plugin.TValue[[]interface{}] {
+	return &c.HashAlgorithms
 }
 
-func (c *mqlOpenpgpPublicKey) GetKeyAlgorithm() *plugin.TValue[string] {
-	return &c.KeyAlgorithm
+func (c *mqlDnsDkimRecord) GetKeyType() *plugin.TValue[string] {
+	return &c.KeyType
 }
 
-func (c *mqlOpenpgpPublicKey) GetBitLength() *plugin.TValue[int64] {
-	return &c.BitLength
+func (c *mqlDnsDkimRecord) GetNotes() *plugin.TValue[string] {
+	return &c.Notes
 }
 
-func (c *mqlOpenpgpPublicKey) GetCreationTime() *plugin.TValue[*time.Time] {
-	return &c.CreationTime
+func (c *mqlDnsDkimRecord) GetPublicKeyData() *plugin.TValue[string] {
+	return &c.PublicKeyData
 }
 
-// mqlOpenpgpIdentity for the openpgp.identity resource
-type mqlOpenpgpIdentity struct {
+func (c *mqlDnsDkimRecord) GetServiceTypes() *plugin.TValue[[]interface{}] {
+	return &c.ServiceTypes
+}
+
+func (c *mqlDnsDkimRecord) GetFlags() *plugin.TValue[[]interface{}] {
+	return &c.Flags
+}
+
+func (c *mqlDnsDkimRecord) GetValid() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Valid, func() (bool, error) {
+		return c.valid()
+	})
+}
+
+// mqlDnsSpf for the dns.spf resource
+type mqlDnsSpf struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlOpenpgpIdentityInternal
-	Fingerprint plugin.TValue[string]
-	Id plugin.TValue[string]
-	Name plugin.TValue[string]
-	Email plugin.TValue[string]
-	Comment plugin.TValue[string]
-	Signatures plugin.TValue[[]interface{}]
+	mqlDnsSpfInternal
+	Domain plugin.TValue[string]
+	Record plugin.TValue[string]
+	Mechanisms plugin.TValue[[]interface{}]
+	Modifiers plugin.TValue[map[string]interface{}]
+	Includes plugin.TValue[[]interface{}]
+	Lookups plugin.TValue[int64]
+	DefaultResult plugin.TValue[string]
+	Valid plugin.TValue[bool]
+	Errors plugin.TValue[[]interface{}]
 }
 
-// createOpenpgpIdentity creates a new instance of this resource
-func createOpenpgpIdentity(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOpenpgpIdentity{
+// createDnsSpf creates a new instance of this resource
+func createDnsSpf(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsSpf{
 		MqlRuntime: runtime,
 	}
 
@@ -2835,7 +6832,7 @@ func createOpenpgpIdentity(runtime *plugin.Runtime, args map[string]*llx.RawData
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("openpgp.identity", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.spf", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -2845,38 +6842,40 @@ func createOpenpgpIdentity(runtime *plugin.Runtime, args map[string]*llx.RawData
 	return res, nil
 }
 
-func (c *mqlOpenpgpIdentity) MqlName() string {
-	return "openpgp.identity"
+func (c *mqlDnsSpf) MqlName() string {
+	return "dns.spf"
 }
 
-func (c *mqlOpenpgpIdentity) MqlID() string {
+func (c *mqlDnsSpf) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOpenpgpIdentity) GetFingerprint() *plugin.TValue[string] {
-	return &c.Fingerprint
-}
-
-func (c *mqlOpenpgpIdentity) GetId() *plugin.TValue[string] {
-	return &c.Id
+func (c *mqlDnsSpf) GetDomain() *plugin.TValue[string] {
+	return &c.Domain
 }
 
-func (c *mqlOpenpgpIdentity) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlDnsSpf) GetRecord() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Record, func() (string, error) {
+		return c.record()
+	})
 }
 
-func (c *mqlOpenpgpIdentity) GetEmail() *plugin.TValue[string] {
-	return &c.Email
+func (c *mqlDnsSpf) GetMechanisms() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Mechanisms, func() ([]interface{}, error) {
+		return c.mechanisms()
+	})
 }
 
-func (c *mqlOpenpgpIdentity) GetComment() *plugin.TValue[string] {
-	return &c.Comment
+func (c *mqlDnsSpf) GetModifiers() *plugin.TValue[map[string]interface{}] {
+	return plugin.GetOrCompute[map[string]interface{}](&c.Modifiers, func() (map[string]interface{}, error) {
+		return c.modifiers()
+	})
 }
 
-func (c *mqlOpenpgpIdentity) GetSignatures() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Signatures, func() ([]interface{}, error) {
+func (c *mqlDnsSpf) GetIncludes() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Includes, func() ([]interface{}, error) {
 		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("openpgp.identity", c.__id, "signatures")
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns.spf", c.__id, "includes")
 			if err != nil {
 				return nil, err
 			}
@@ -2885,31 +6884,59 @@ func (c *mqlOpenpgpIdentity) GetSignatures() *plugin.TValue[[]interface{}] {
 			}
 		}
 
-		return c.signatures()
+		return c.includes()
 	})
 }
 
-// mqlOpenpgpSignature for the openpgp.signature resource
-type mqlOpenpgpSignature struct {
+func (c *mqlDnsSpf) GetLookups() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Lookups, func() (int64, error) {
+		return c.lookups()
+	})
+}
+
+func (c *mqlDnsSpf) GetDefaultResult() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.DefaultResult, func() (string, error) {
+		return c.defaultResult()
+	})
+}
+
+func (c *mqlDnsSpf) GetValid() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Valid, func() (bool, error) {
+		return c.valid()
+	})
+}
+
+func (c *mqlDnsSpf) GetErrors() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Errors, func() ([]interface{}, error) {
+		return c.errors()
+	})
+}
+
+// mqlDnsDmarc for the dns.dmarc resource
+type mqlDnsDmarc struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlOpenpgpSignatureInternal it will be used here
-	Fingerprint plugin.TValue[string]
-	IdentityName plugin.TValue[string]
-	Hash plugin.TValue[string]
-	Version plugin.TValue[int64]
-	SignatureType plugin.TValue[string]
-	KeyAlgorithm plugin.TValue[string]
-	CreationTime plugin.TValue[*time.Time]
-	LifetimeSecs plugin.TValue[int64]
-	ExpiresIn plugin.TValue[*time.Time]
-	KeyLifetimeSecs plugin.TValue[int64]
-	KeyExpiresIn plugin.TValue[*time.Time]
+	mqlDnsDmarcInternal
+	Domain plugin.TValue[string]
+	RecordDomain plugin.TValue[string]
+	Record plugin.TValue[string]
+	Version plugin.TValue[string]
+	Policy plugin.TValue[string]
+	SubdomainPolicy plugin.TValue[string]
+	Percentage plugin.TValue[int64]
+	AggregateReports plugin.TValue[[]interface{}]
+	FailureReports plugin.TValue[[]interface{}]
+	DkimAlignment plugin.TValue[string]
+	SpfAlignment plugin.TValue[string]
+	FailureOptions plugin.TValue[[]interface{}]
+	ReportInterval plugin.TValue[*time.Time]
+	Valid plugin.TValue[bool]
+	Errors plugin.TValue[[]interface{}]
 }
 
-// createOpenpgpSignature creates a new instance of this resource
-func createOpenpgpSignature(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlOpenpgpSignature{
+// createDnsDmarc creates a new instance of this resource
+func createDnsDmarc(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsDmarc{
 		MqlRuntime: runtime,
 	}
 
@@ -2926,7 +6953,7 @@ func createOpenpgpSignature(runtime *plugin.Runtime, args map[string]*llx.RawDat
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("openpgp.signature", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.dmarc", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -2936,73 +6963,121 @@ func createOpenpgpSignature(runtime *plugin.Runtime, args map[string]*llx.RawDat
 	return res, nil
 }
 
-func (c *mqlOpenpgpSignature) MqlName() string {
-	return "openpgp.signature"
+func (c *mqlDnsDmarc) MqlName() string {
+	return "dns.dmarc"
 }
 
-func (c *mqlOpenpgpSignature) MqlID() string {
+func (c *mqlDnsDmarc) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlOpenpgpSignature) GetFingerprint() *plugin.TValue[string] {
-	return &c.Fingerprint
+func (c *mqlDnsDmarc) GetDomain() *plugin.TValue[string] {
+	return &c.Domain
 }
 
-func (c *mqlOpenpgpSignature) GetIdentityName() *plugin.TValue[string] {
-	return &c.IdentityName
+func (c *mqlDnsDmarc) GetRecordDomain() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.RecordDomain, func() (string, error) {
+		return c.recordDomain()
+	})
 }
 
-func (c *mqlOpenpgpSignature) GetHash() *plugin.TValue[string] {
-	return &c.Hash
+func (c *mqlDnsDmarc) GetRecord() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Record, func() (string, error) {
+		return c.record()
+	})
 }
 
-func (c *mqlOpenpgpSignature) GetVersion() *plugin.TValue[int64] {
-	return &c.Version
+func (c *mqlDnsDmarc) GetVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
+		return c.version()
+	})
 }
 
-func (c *mqlOpenpgpSignature) GetSignatureType() *plugin.TValue[string] {
-	return &c.SignatureType
+func (c *mqlDnsDmarc) GetPolicy() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Policy, func() (string, error) {
+		return c.policy()
+	})
 }
 
-func (c *mqlOpenpgpSignature) GetKeyAlgorithm() *plugin.TValue[string] {
-	return &c.KeyAlgorithm
+func (c *mqlDnsDmarc) GetSubdomainPolicy() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.SubdomainPolicy, func() (string, error) {
+		return c.subdomainPolicy()
+	})
 }
 
-func (c *mqlOpenpgpSignature) GetCreationTime() *plugin.TValue[*time.Time] {
-	return &c.CreationTime
+func (c *mqlDnsDmarc) GetPercentage() *plugin.TValue[int64] {
+	return plugin.GetOrCompute[int64](&c.Percentage, func() (int64, error) {
+		return c.percentage()
+	})
 }
 
-func (c *mqlOpenpgpSignature) GetLifetimeSecs() *plugin.TValue[int64] {
-	return &c.LifetimeSecs
+func (c *mqlDnsDmarc) GetAggregateReports() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.AggregateReports, func() ([]interface{}, error) {
+		return c.aggregateReports()
+	})
 }
 
-func (c *mqlOpenpgpSignature) GetExpiresIn() *plugin.TValue[*time.Time] {
-	return &c.ExpiresIn
+func (c *mqlDnsDmarc) GetFailureReports() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.FailureReports, func() ([]interface{}, error) {
+		return c.failureReports()
+	})
 }
 
-func (c *mqlOpenpgpSignature) GetKeyLifetimeSecs() *plugin.TValue[int64] {
-	return &c.KeyLifetimeSecs
+func (c *mqlDnsDmarc) GetDkimAlignment() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.DkimAlignment, func() (string, error) {
+		return c.dkimAlignment()
+	})
 }
 
-func (c *mqlOpenpgpSignature) GetKeyExpiresIn() *plugin.TValue[*time.Time] {
-	return &c.KeyExpiresIn
+func (c *mqlDnsDmarc) GetSpfAlignment() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.SpfAlignment, func() (string, error) {
+		return c.spfAlignment()
+	})
+}
+
+func (c *mqlDnsDmarc) GetFailureOptions() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.FailureOptions, func() ([]interface{}, error) {
+		return c.failureOptions()
+	})
+}
+
+func (c *mqlDnsDmarc) GetReportInterval() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.ReportInterval, func() (*time.Time, error) {
+		return c.reportInterval()
+	})
+}
+
+func (c *mqlDnsDmarc) GetValid() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Valid, func() (bool, error) {
+		return c.valid()
+	})
+}
+
+func (c *mqlDnsDmarc) GetErrors() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Errors, func() ([]interface{}, error) {
+		return c.errors()
+	})
 }
 
-// mqlDomainName for the domainName resource
-type mqlDomainName struct {
+// mqlDnsMtaSts for the dns.mtaSts resource
+type mqlDnsMtaSts struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDomainNameInternal it will be used here
-	Fqdn plugin.TValue[string]
-	EffectiveTLDPlusOne plugin.TValue[string]
-	Tld plugin.TValue[string]
-	TldIcannManaged plugin.TValue[bool]
-	Labels plugin.TValue[[]interface{}]
+	mqlDnsMtaStsInternal
+	Domain plugin.TValue[string]
+	Record plugin.TValue[string]
+	PolicyId plugin.TValue[string]
+	Policy plugin.TValue[string]
+	Mode plugin.TValue[string]
+	Mx plugin.TValue[[]interface{}]
+	MaxAge plugin.TValue[*time.Time]
+	Valid plugin.TValue[bool]
+	Errors plugin.TValue[[]interface{}]
 }
 
-// createDomainName creates a new instance of this resource
-func createDomainName(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDomainName{
+// createDnsMtaSts creates a new instance of this resource
+func createDnsMtaSts(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsMtaSts{
 		MqlRuntime: runtime,
 	}
 
@@ -3019,7 +7094,7 @@ func createDomainName(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("domainName", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.mtaSts", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3029,49 +7104,81 @@ func createDomainName(runtime *plugin.Runtime, args map[string]*llx.RawData) (pl
 	return res, nil
 }
 
-func (c *mqlDomainName) MqlName() string {
-	return "domainName"
+func (c *mqlDnsMtaSts) MqlName() string {
+	return "dns.mtaSts"
 }
 
-func (c *mqlDomainName) MqlID() string {
+func (c *mqlDnsMtaSts) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDomainName) GetFqdn() *plugin.TValue[string] {
-	return &c.Fqdn
+func (c *mqlDnsMtaSts) GetDomain() *plugin.TValue[string] {
+	return &c.Domain
 }
 
-func (c *mqlDomainName) GetEffectiveTLDPlusOne() *plugin.TValue[string] {
-	return &c.EffectiveTLDPlusOne
+func (c *mqlDnsMtaSts) GetRecord() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Record, func() (string, error) {
+		return c.record()
+	})
 }
 
-func (c *mqlDomainName) GetTld() *plugin.TValue[string] {
-	return &c.Tld
+func (c *mqlDnsMtaSts) GetPolicyId() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.PolicyId, func() (string, error) {
+		return c.policyId()
+	})
 }
 
-func (c *mqlDomainName) GetTldIcannManaged() *plugin.TValue[bool] {
-	return &c.TldIcannManaged
+func (c *mqlDnsMtaSts) GetPolicy() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Policy, func() (string, error) {
+		return c.policy()
+	})
 }
 
-func (c *mqlDomainName) GetLabels() *plugin.TValue[[]interface{}] {
-	return &c.Labels
+func (c *mqlDnsMtaSts) GetMode() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Mode, func() (string, error) {
+		return c.mode()
+	})
 }
 
-// mqlDns for the dns resource
-type mqlDns struct {
+func (c *mqlDnsMtaSts) GetMx() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Mx, func() ([]interface{}, error) {
+		return c.mx()
+	})
+}
+
+func (c *mqlDnsMtaSts) GetMaxAge() *plugin.TValue[*time.Time] {
+	return plugin.GetOrCompute[*time.Time](&c.MaxAge, func() (*time.Time, error) {
+		return c.maxAge()
+	})
+}
+
+func (c *mqlDnsMtaSts) GetValid() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Valid, func() (bool, error) {
+		return c.valid()
+	})
+}
+
+func (c *mqlDnsMtaSts) GetErrors() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Errors, func() ([]interface{}, error) {
+		return c.errors()
+	})
+}
+
+// mqlDnsBimi for the dns.bimi resource
+type mqlDnsBimi struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDnsInternal it will be used here
-	Fqdn plugin.TValue[string]
-	Params plugin.TValue[interface{}]
-	Records plugin.TValue[[]interface{}]
-	Mx plugin.TValue[[]interface{}]
-	Dkim plugin.TValue[[]interface{}]
+	mqlDnsBimiInternal
+	Domain plugin.TValue[string]
+	Record plugin.TValue[string]
+	Version plugin.TValue[string]
+	Logo plugin.TValue[string]
+	Authority plugin.TValue[string]
 }
 
-// createDns creates a new instance of this resource
-func createDns(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDns{
+// createDnsBimi creates a new instance of this resource
+func createDnsBimi(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsBimi{
 		MqlRuntime: runtime,
 	}
 
@@ -3088,7 +7195,7 @@ func createDns(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Re
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("dns", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.bimi", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3098,107 +7205,58 @@ func createDns(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Re
 	return res, nil
 }
 
-func (c *mqlDns) MqlName() string {
-	return "dns"
+func (c *mqlDnsBimi) MqlName() string {
+	return "dns.bimi"
 }
 
-func (c *mqlDns) MqlID() string {
+func (c *mqlDnsBimi) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDns) GetFqdn() *plugin.TValue[string] {
-	return &c.Fqdn
+func (c *mqlDnsBimi) GetDomain() *plugin.TValue[string] {
+	return &c.Domain
 }
 
-func (c *mqlDns) GetParams() *plugin.TValue[interface{}] {
-	return plugin.GetOrCompute[interface{}](&c.Params, func() (interface{}, error) {
-		vargFqdn := c.GetFqdn()
-		if vargFqdn.Error != nil {
-			return nil, vargFqdn.Error
-		}
-
-		return c.params(vargFqdn.Data)
+func (c *mqlDnsBimi) GetRecord() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Record, func() (string, error) {
+		return c.record()
 	})
 }
 
-func (c *mqlDns) GetRecords() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Records, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "records")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
-
-		return c.records(vargParams.Data)
+func (c *mqlDnsBimi) GetVersion() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Version, func() (string, error) {
+		return c.version()
 	})
 }
 
-func (c *mqlDns) GetMx() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Mx, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "mx")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
-
-		return c.mx(vargParams.Data)
+func (c *mqlDnsBimi) GetLogo() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Logo, func() (string, error) {
+		return c.logo()
 	})
 }
 
-func (c *mqlDns) GetDkim() *plugin.TValue[[]interface{}] {
-	return plugin.GetOrCompute[[]interface{}](&c.Dkim, func() ([]interface{}, error) {
-		if c.MqlRuntime.HasRecording {
-			d, err := c.MqlRuntime.FieldResourceFromRecording("dns", c.__id, "dkim")
-			if err != nil {
-				return nil, err
-			}
-			if d != nil {
-				return d.Value.([]interface{}), nil
-			}
-		}
-
-		vargParams := c.GetParams()
-		if vargParams.Error != nil {
-			return nil, vargParams.Error
-		}
-
-		return c.dkim(vargParams.Data)
+func (c *mqlDnsBimi) GetAuthority() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Authority, func() (string, error) {
+		return c.authority()
 	})
 }
 
-// mqlDnsRecord for the dns.record resource
-type mqlDnsRecord struct {
+// mqlDnsCaa for the dns.caa resource
+type mqlDnsCaa struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDnsRecordInternal it will be used here
-	Name plugin.TValue[string]
-	Ttl plugin.TValue[int64]
-	Class plugin.TValue[string]
-	Type plugin.TValue[string]
-	Rdata plugin.TValue[[]interface{}]
+	mqlDnsCaaInternal
+	Domain plugin.TValue[string]
+	RecordDomain plugin.TValue[string]
+	Issuers plugin.TValue[[]interface{}]
+	WildcardIssuers plugin.TValue[[]interface{}]
+	Iodef plugin.TValue[[]interface{}]
+	List plugin.TValue[[]interface{}]
 }
 
-// createDnsRecord creates a new instance of this resource
-func createDnsRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDnsRecord{
+// createDnsCaa creates a new instance of this resource
+func createDnsCaa(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsCaa{
 		MqlRuntime: runtime,
 	}
 
@@ -3215,7 +7273,7 @@ func createDnsRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("dns.record", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.caa", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3225,47 +7283,73 @@ func createDnsRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plu
 	return res, nil
 }
 
-func (c *mqlDnsRecord) MqlName() string {
-	return "dns.record"
+func (c *mqlDnsCaa) MqlName() string {
+	return "dns.caa"
 }
 
-func (c *mqlDnsRecord) MqlID() string {
+func (c *mqlDnsCaa) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDnsRecord) GetName() *plugin.TValue[string] {
-	return &c.Name
+func (c *mqlDnsCaa) GetDomain() *plugin.TValue[string] {
+	return &c.Domain
 }
 
-func (c *mqlDnsRecord) GetTtl() *plugin.TValue[int64] {
-	return &c.Ttl
+func (c *mqlDnsCaa) GetRecordDomain() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.RecordDomain, func() (string, error) {
+		return c.recordDomain()
+	})
 }
 
-func (c *mqlDnsRecord) GetClass() *plugin.TValue[string] {
-	return &c.Class
+func (c *mqlDnsCaa) GetIssuers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Issuers, func() ([]interface{}, error) {
+		return c.issuers()
+	})
 }
 
-func (c *mqlDnsRecord) GetType() *plugin.TValue[string] {
-	return &c.Type
+func (c *mqlDnsCaa) GetWildcardIssuers() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.WildcardIssuers, func() ([]interface{}, error) {
+		return c.wildcardIssuers()
+	})
 }
 
-func (c *mqlDnsRecord) GetRdata() *plugin.TValue[[]interface{}] {
-	return &c.Rdata
+func (c *mqlDnsCaa) GetIodef() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Iodef, func() ([]interface{}, error) {
+		return c.iodef()
+	})
 }
 
-// mqlDnsMxRecord for the dns.mxRecord resource
-type mqlDnsMxRecord struct {
+func (c *mqlDnsCaa) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("dns.caa", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlDnsCaaRecord for the dns.caaRecord resource
+type mqlDnsCaaRecord struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	// optional: if you define mqlDnsMxRecordInternal it will be used here
+	// optional: if you define mqlDnsCaaRecordInternal it will be used here
 	Name plugin.TValue[string]
-	Preference plugin.TValue[int64]
-	DomainName plugin.TValue[string]
+	Flag plugin.TValue[int64]
+	Tag plugin.TValue[string]
+	Value plugin.TValue[string]
+	Critical plugin.TValue[bool]
 }
 
-// createDnsMxRecord creates a new instance of this resource
-func createDnsMxRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDnsMxRecord{
+// createDnsCaaRecord creates a new instance of this resource
+func createDnsCaaRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsCaaRecord{
 		MqlRuntime: runtime,
 	}
 
@@ -3282,7 +7366,7 @@ func createDnsMxRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("dns.mxRecord", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.caaRecord", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3292,46 +7376,50 @@ func createDnsMxRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (p
 	return res, nil
 }
 
-func (c *mqlDnsMxRecord) MqlName() string {
-	return "dns.mxRecord"
+func (c *mqlDnsCaaRecord) MqlName() string {
+	return "dns.caaRecord"
 }
 
-func (c *mqlDnsMxRecord) MqlID() string {
+func (c *mqlDnsCaaRecord) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDnsMxRecord) GetName() *plugin.TValue[string] {
+func (c *mqlDnsCaaRecord) GetName() *plugin.TValue[string] {
 	return &c.Name
 }
 
-func (c *mqlDnsMxRecord) GetPreference() *plugin.TValue[int64] {
-	return &c.Preference
+func (c *mqlDnsCaaRecord) GetFlag() *plugin.TValue[int64] {
+	return &c.Flag
 }
 
-func (c *mqlDnsMxRecord) GetDomainName() *plugin.TValue[string] {
-	return &c.DomainName
+func (c *mqlDnsCaaRecord) GetTag() *plugin.TValue[string] {
+	return &c.Tag
 }
 
-// mqlDnsDkimRecord for the dns.dkimRecord resource
-type mqlDnsDkimRecord struct {
+func (c *mqlDnsCaaRecord) GetValue() *plugin.TValue[string] {
+	return &c.Value
+}
+
+func (c *mqlDnsCaaRecord) GetCritical() *plugin.TValue[bool] {
+	return &c.Critical
+}
+
+// mqlDnsDnssec for the dns.dnssec resource
+type mqlDnsDnssec struct {
 	MqlRuntime *plugin.Runtime
 	__id string
-	mqlDnsDkimRecordInternal
-	DnsTxt plugin.TValue[string]
+	mqlDnsDnssecInternal
 	Domain plugin.TValue[string]
-	Version plugin.TValue[string]
-	HashAlgorithms plugin.TValue[[]interface{}]
-	KeyType plugin.TValue[string]
-	Notes plugin.TValue[string]
-	PublicKeyData plugin.TValue[string]
-	ServiceTypes plugin.TValue[[]interface{}]
-	Flags plugin.TValue[[]interface{}]
-	Valid plugin.TValue[bool]
+	Status plugin.TValue[string]
+	Signed plugin.TValue[bool]
+	Zones plugin.TValue[[]interface{}]
+	Algorithms plugin.TValue[[]interface{}]
+	Errors plugin.TValue[[]interface{}]
 }
 
-// createDnsDkimRecord creates a new instance of this resource
-func createDnsDkimRecord(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
-	res := &mqlDnsDkimRecord{
+// createDnsDnssec creates a new instance of this resource
+func createDnsDnssec(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlDnsDnssec{
 		MqlRuntime: runtime,
 	}
 
@@ -3348,7 +7436,7 @@ func createDnsDkimRecord(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	}
 
 	if runtime.HasRecording {
-		args, err = runtime.ResourceFromRecording("dns.dkimRecord", res.__id)
+		args, err = runtime.ResourceFromRecording("dns.dnssec", res.__id)
 		if err != nil || args == nil {
 			return res, err
 		}
@@ -3358,52 +7446,44 @@ func createDnsDkimRecord(runtime *plugin.Runtime, args map[string]*llx.RawData)
 	return res, nil
 }
 
-func (c *mqlDnsDkimRecord) MqlName() string {
-	return "dns.dkimRecord"
+func (c *mqlDnsDnssec) MqlName() string {
+	return "dns.dnssec"
 }
 
-func (c *mqlDnsDkimRecord) MqlID() string {
+func (c *mqlDnsDnssec) MqlID() string {
 	return c.__id
 }
 
-func (c *mqlDnsDkimRecord) GetDnsTxt() *plugin.TValue[string] {
-	return &c.DnsTxt
-}
-
-func (c *mqlDnsDkimRecord) GetDomain() *plugin.TValue[string] {
+func (c *mqlDnsDnssec) GetDomain() *plugin.TValue[string] {
 	return &c.Domain
 }
 
-func (c *mqlDnsDkimRecord) GetVersion() *plugin.TValue[string] {
-	return &c.Version
-}
-
-func (c *mqlDnsDkimRecord) GetHashAlgorithms() *plugin.TValue[[]interface{}] {
-	return &c.HashAlgorithms
-}
-
-func (c *mqlDnsDkimRecord) GetKeyType() *plugin.TValue[string] {
-	return &c.KeyType
-}
-
-func (c *mqlDnsDkimRecord) GetNotes() *plugin.TValue[string] {
-	return &c.Notes
+func (c *mqlDnsDnssec) GetStatus() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Status, func() (string, error) {
+		return c.status()
+	})
 }
 
-func (c *mqlDnsDkimRecord) GetPublicKeyData() *plugin.TValue[string] {
-	return &c.PublicKeyData
+func (c *mqlDnsDnssec) GetSigned() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Signed, func() (bool, error) {
+		return c.signed()
+	})
 }
 
-func (c *mqlDnsDkimRecord) GetServiceTypes() *plugin.TValue[[]interface{}] {
-	return &c.ServiceTypes
+func (c *mqlDnsDnssec) GetZones() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Zones, func() ([]interface{}, error) {
+		return c.zones()
+	})
 }
 
-func (c *mqlDnsDkimRecord) GetFlags() *plugin.TValue[[]interface{}] {
-	return &c.Flags
+func (c *mqlDnsDnssec) GetAlgorithms() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Algorithms, func() ([]interface{}, error) {
+		return c.algorithms()
+	})
 }
 
-func (c *mqlDnsDkimRecord) GetValid() *plugin.TValue[bool] {
-	return plugin.GetOrCompute[bool](&c.Valid, func() (bool, error) {
-		return c.valid()
+func (c *mqlDnsDnssec) GetErrors() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Errors, func() ([]interface{}, error) {
+		return c.errors()
 	})
 }
@@ -0,0 +1,208 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package portscan finds responsive services in network ranges and detects
+// which protocol they speak.
+package portscan
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/netip"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Services that are detected on open ports
+const (
+	ServiceHTTPS = "https"
+	ServiceHTTP  = "http"
+	ServiceSSH   = "ssh"
+	ServiceTLS   = "tls"
+	// ServiceTCP is any other service that accepts connections
+	ServiceTCP = "tcp"
+)
+
+// DefaultPorts are common service ports that are probed if no ports are
+// configured
+var DefaultPorts = []int{21, 22, 23, 25, 53, 80, 110, 143, 443, 465, 587, 636, 993, 995, 2222, 3306, 5432, 6443, 8080, 8443}
+
+const (
+	DefaultConcurrency = 64
+	DefaultTimeout     = 2 * time.Second
+	// MaxHosts limits the size of network ranges, which equals a /16 for IPv4
+	MaxHosts = 1 << 16
+)
+
+type Service struct {
+	Host    netip.Addr
+	Port    int
+	Service string
+}
+
+// Address returns the host and port of the service
+func (s Service) Address() string {
+	return net.JoinHostPort(s.Host.String(), strconv.Itoa(s.Port))
+}
+
+type Scanner struct {
+	Ports []int
+	// Concurrency is the maximum number of parallel connections
+	Concurrency int
+	// Timeout limits every connection attempt and read
+	Timeout time.Duration
+}
+
+func New(ports []int, concurrency int) *Scanner {
+	if len(ports) == 0 {
+		ports = DefaultPorts
+	}
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	return &Scanner{
+		Ports:       ports,
+		Concurrency: concurrency,
+		Timeout:     DefaultTimeout,
+	}
+}
+
+// Hosts returns all host addresses of a network range. For IPv4 ranges the
+// network and broadcast addresses are excluded.
+func Hosts(prefix netip.Prefix) ([]netip.Addr, error) {
+	prefix = prefix.Masked()
+	hostBits := prefix.Addr().BitLen() - prefix.Bits()
+	if hostBits > 16 {
+		return nil, errors.New("network range " + prefix.String() + " is too large, it may contain at most " + strconv.Itoa(MaxHosts) + " addresses")
+	}
+
+	res := make([]netip.Addr, 0, 1<<hostBits)
+	for addr := prefix.Addr(); prefix.Contains(addr); addr = addr.Next() {
+		res = append(res, addr)
+	}
+
+	if prefix.Addr().Is4() && hostBits > 1 {
+		res = res[1 : len(res)-1]
+	}
+	return res, nil
+}
+
+// Scan probes all ports of all hosts in the network range and returns the
+// responsive services, sorted by host and port
+func (s *Scanner) Scan(prefix netip.Prefix) ([]Service, error) {
+	hosts, err := Hosts(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	res := []Service{}
+	sem := make(chan struct{}, s.Concurrency)
+
+	for _, host := range hosts {
+		for _, port := range s.Ports {
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(host netip.Addr, port int) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+
+				service, ok := s.Probe(host, port)
+				if !ok {
+					return
+				}
+				lock.Lock()
+				res = append(res, service)
+				lock.Unlock()
+			}(host, port)
+		}
+	}
+	wg.Wait()
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Host != res[j].Host {
+			return res[i].Host.Less(res[j].Host)
+		}
+		return res[i].Port < res[j].Port
+	})
+	return res, nil
+}
+
+// Probe checks if the port is open and detects the service behind it
+func (s *Scanner) Probe(host netip.Addr, port int) (Service, bool) {
+	service := Service{Host: host, Port: port}
+	addr := service.Address()
+
+	conn, err := net.DialTimeout("tcp", addr, s.Timeout)
+	if err != nil {
+		return service, false
+	}
+
+	// some protocols like SSH send a banner before the client says anything
+	conn.SetReadDeadline(time.Now().Add(s.Timeout))
+	banner, _ := bufio.NewReader(conn).Peek(4)
+	conn.Close()
+	if len(banner) != 0 {
+		if bytes.Equal(banner, []byte("SSH-")) {
+			service.Service = ServiceSSH
+		} else {
+			service.Service = ServiceTCP
+		}
+		return service, true
+	}
+
+	if s.isHTTP(addr, true) {
+		service.Service = ServiceHTTPS
+	} else if s.isTLS(addr) {
+		service.Service = ServiceTLS
+	} else if s.isHTTP(addr, false) {
+		service.Service = ServiceHTTP
+	} else {
+		service.Service = ServiceTCP
+	}
+	return service, true
+}
+
+func (s *Scanner) dial(addr string, useTLS bool) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: s.Timeout}
+	if !useTLS {
+		return dialer.Dial("tcp", addr)
+	}
+	return tls.DialWithDialer(dialer, "tcp", addr, &tls.Config{
+		// we only detect the protocol, certificates are checked by the
+		// assets that are created for the service
+		InsecureSkipVerify: true,
+	})
+}
+
+func (s *Scanner) isTLS(addr string) bool {
+	conn, err := s.dial(addr, true)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+func (s *Scanner) isHTTP(addr string, useTLS bool) bool {
+	conn, err := s.dial(addr, useTLS)
+	if err != nil {
+		return false
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(s.Timeout))
+	if _, err := conn.Write([]byte("HEAD / HTTP/1.0\r\n\r\n")); err != nil {
+		return false
+	}
+	res, _ := bufio.NewReader(conn).Peek(5)
+	return bytes.Equal(res, []byte("HTTP/"))
+}
@@ -0,0 +1,105 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package portscan
+
+import (
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHosts(t *testing.T) {
+	hosts, err := Hosts(netip.MustParsePrefix("192.0.2.0/30"))
+	require.NoError(t, err)
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("192.0.2.1"), netip.MustParseAddr("192.0.2.2")}, hosts)
+
+	hosts, err = Hosts(netip.MustParsePrefix("192.0.2.7/32"))
+	require.NoError(t, err)
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("192.0.2.7")}, hosts)
+
+	hosts, err = Hosts(netip.MustParsePrefix("192.0.2.9/24"))
+	require.NoError(t, err)
+	assert.Len(t, hosts, 254)
+	assert.Equal(t, "192.0.2.1", hosts[0].String())
+
+	hosts, err = Hosts(netip.MustParsePrefix("2001:db8::/126"))
+	require.NoError(t, err)
+	assert.Len(t, hosts, 4)
+
+	_, err = Hosts(netip.MustParsePrefix("10.0.0.0/8"))
+	assert.Error(t, err)
+}
+
+// listen starts a TCP server that handles every connection
+func listen(t *testing.T, handle func(net.Conn)) int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				handle(conn)
+			}()
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+func serverPort(t *testing.T, srv *httptest.Server) int {
+	t.Cleanup(srv.Close)
+	return srv.Listener.Addr().(*net.TCPAddr).Port
+}
+
+func TestScan(t *testing.T) {
+	sshPort := listen(t, func(conn net.Conn) {
+		conn.Write([]byte("SSH-2.0-OpenSSH_9.6\r\n"))
+	})
+	silentPort := listen(t, func(conn net.Conn) {
+		io.Copy(io.Discard, conn)
+	})
+
+	httpSrv := httptest.NewServer(http.NotFoundHandler())
+	httpPort := serverPort(t, httpSrv)
+	httpsSrv := httptest.NewUnstartedServer(http.NotFoundHandler())
+	httpsSrv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	httpsSrv.StartTLS()
+	httpsPort := serverPort(t, httpsSrv)
+
+	// find a port that is closed
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedPort := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	scanner := New([]int{sshPort, silentPort, httpPort, httpsPort, closedPort}, 2)
+	scanner.Timeout = 500 * time.Millisecond
+	services, err := scanner.Scan(netip.MustParsePrefix("127.0.0.1/32"))
+	require.NoError(t, err)
+
+	found := map[int]string{}
+	for _, service := range services {
+		assert.Equal(t, "127.0.0.1", service.Host.String())
+		found[service.Port] = service.Service
+	}
+	assert.Equal(t, map[int]string{
+		sshPort:    ServiceSSH,
+		silentPort: ServiceTCP,
+		httpPort:   ServiceHTTP,
+		httpsPort:  ServiceHTTPS,
+	}, found)
+}
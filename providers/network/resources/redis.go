@@ -0,0 +1,102 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"net"
+	"strconv"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/network/resources/redisshake"
+)
+
+const defaultRedisPort = 6379
+
+func initRedis(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if err := initServiceTarget(runtime, args, defaultRedisPort, "redis", "tcp"); err != nil {
+		return nil, nil, err
+	}
+	return args, nil, nil
+}
+
+type mqlRedisInternal struct {
+	lock    sync.Mutex
+	fetched bool
+}
+
+func (s *mqlRedis) id() (string, error) {
+	return "redis/" + net.JoinHostPort(s.Host.Data, strconv.Itoa(int(s.Port.Data))), nil
+}
+
+// fetch probes the server and sets all fields of the resource
+func (s *mqlRedis) fetch() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.fetched {
+		return nil
+	}
+
+	tester := redisshake.New(s.Host.Data, int(s.Port.Data))
+	if err := tester.Test(); err != nil {
+		return err
+	}
+	findings := tester.Findings
+
+	if findings.ProtectedMode != nil {
+		s.ProtectedMode = plugin.TValue[bool]{Data: *findings.ProtectedMode, State: plugin.StateIsSet}
+	} else {
+		s.ProtectedMode = plugin.TValue[bool]{State: plugin.StateIsSet | plugin.StateIsNull}
+	}
+	// commands cannot be checked if the server requires authentication
+	if findings.DangerousCommands != nil {
+		s.DangerousCommands = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.DangerousCommands), State: plugin.StateIsSet}
+		s.RenamedCommands = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.RenamedCommands), State: plugin.StateIsSet}
+	} else {
+		s.DangerousCommands = plugin.TValue[[]interface{}]{State: plugin.StateIsSet | plugin.StateIsNull}
+		s.RenamedCommands = plugin.TValue[[]interface{}]{State: plugin.StateIsSet | plugin.StateIsNull}
+	}
+
+	s.AuthRequired = plugin.TValue[bool]{Data: findings.AuthRequired, State: plugin.StateIsSet}
+	s.Version = plugin.TValue[string]{Data: findings.Version, State: plugin.StateIsSet}
+	s.Mode = plugin.TValue[string]{Data: findings.Mode, State: plugin.StateIsSet}
+	s.Os = plugin.TValue[string]{Data: findings.OS, State: plugin.StateIsSet}
+	s.Errors = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.Errors), State: plugin.StateIsSet}
+	s.fetched = true
+	return nil
+}
+
+func (s *mqlRedis) authRequired() (bool, error) {
+	return false, s.fetch()
+}
+
+func (s *mqlRedis) protectedMode() (bool, error) {
+	return false, s.fetch()
+}
+
+func (s *mqlRedis) version() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlRedis) mode() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlRedis) os() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlRedis) dangerousCommands() ([]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlRedis) renamedCommands() ([]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlRedis) errors() ([]interface{}, error) {
+	return nil, s.fetch()
+}
@@ -0,0 +1,294 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package redisshake probes Redis servers without credentials. It only
+// sends commands that don't change the state of the server.
+package redisshake
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxBulkLen limits the size of bulk strings, e.g. the INFO output
+	maxBulkLen = 1 << 20
+	// maxArrayLen limits the number of array elements
+	maxArrayLen = 1024
+)
+
+// DefaultTimeout limits each connection to the server
+var DefaultTimeout = 10 * time.Second
+
+// DangerousCommands are commands that should be renamed or disabled on
+// exposed servers, since they allow to take over the server or its data
+var DangerousCommands = []string{
+	"ACL", "BGREWRITEAOF", "BGSAVE", "CONFIG", "DEBUG", "EVAL", "EVALSHA",
+	"FLUSHALL", "FLUSHDB", "FUNCTION", "KEYS", "MIGRATE", "MODULE", "MONITOR",
+	"REPLICAOF", "SAVE", "SCRIPT", "SHUTDOWN", "SLAVEOF", "SYNC",
+}
+
+type Findings struct {
+	// AuthRequired is true if clients have to authenticate
+	AuthRequired bool
+	// ProtectedMode is nil if it cannot be determined
+	ProtectedMode *bool
+	Version       string
+	Mode          string
+	OS            string
+	// DangerousCommands are the dangerous commands that are available
+	DangerousCommands []string
+	// RenamedCommands are the dangerous commands that are renamed or disabled
+	RenamedCommands []string
+
+	Errors []string
+}
+
+type Tester struct {
+	Findings Findings
+	target   string
+}
+
+// New creates a new tester for the given host and port
+func New(host string, port int) *Tester {
+	return &Tester{
+		target: net.JoinHostPort(host, strconv.Itoa(port)),
+	}
+}
+
+func (s *Tester) addError(msg string) {
+	s.Findings.Errors = append(s.Findings.Errors, msg)
+}
+
+// errorReply is an error that the server sent
+type errorReply string
+
+func (e errorReply) Error() string {
+	return string(e)
+}
+
+// prefix returns the error code, e.g. NOAUTH
+func (e errorReply) prefix() string {
+	prefix, _, _ := strings.Cut(string(e), " ")
+	return prefix
+}
+
+type connection struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+// command sends the command and reads the reply
+// see https://redis.io/docs/reference/protocol-spec/
+func (c *connection) command(args ...string) (interface{}, error) {
+	var req strings.Builder
+	req.WriteString("*" + strconv.Itoa(len(args)) + "\r\n")
+	for _, arg := range args {
+		req.WriteString("$" + strconv.Itoa(len(arg)) + "\r\n" + arg + "\r\n")
+	}
+	if _, err := c.Write([]byte(req.String())); err != nil {
+		return nil, err
+	}
+	return c.reply()
+}
+
+func (c *connection) line() (string, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasSuffix(line, "\r\n") {
+		return "", errors.New("invalid Redis reply")
+	}
+	return line[:len(line)-2], nil
+}
+
+// reply reads a reply. Errors that the server sends are returned as
+// errorReply, nil values are returned as nil.
+func (c *connection) reply() (interface{}, error) {
+	line, err := c.line()
+	if err != nil {
+		return nil, err
+	}
+	if line == "" {
+		return nil, errors.New("invalid Redis reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, errorReply(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, errors.New("invalid Redis reply")
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		if n > maxBulkLen {
+			return nil, errors.New("Redis reply is too large")
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(c.reader, buf); err != nil {
+			return nil, err
+		}
+		return string(buf[:n]), nil
+	case '*':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, errors.New("invalid Redis reply")
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		if n > maxArrayLen {
+			return nil, errors.New("Redis reply is too large")
+		}
+		res := make([]interface{}, n)
+		for i := range res {
+			// errors in arrays don't fail the whole reply
+			v, err := c.reply()
+			if _, ok := err.(errorReply); err != nil && !ok {
+				return nil, err
+			}
+			res[i] = v
+		}
+		return res, nil
+	default:
+		return nil, errors.New("invalid Redis reply")
+	}
+}
+
+func (s *Tester) dial() (*connection, error) {
+	conn, err := net.DialTimeout("tcp", s.target, DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Now().Add(DefaultTimeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &connection{Conn: conn, reader: bufio.NewReader(conn)}, nil
+}
+
+// Test runs all probes against the server. It returns an error if the
+// server doesn't speak Redis. Errors of individual probes are collected
+// in the findings.
+func (s *Tester) Test() error {
+	conn, err := s.dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	res, err := conn.command("PING")
+	if reply, ok := err.(errorReply); ok {
+		switch reply.prefix() {
+		case "NOAUTH":
+			s.Findings.AuthRequired = true
+			return nil
+		case "DENIED":
+			// servers without password only accept local clients in protected mode
+			protected := true
+			s.Findings.ProtectedMode = &protected
+			return nil
+		}
+		// e.g. servers before Redis 2.8 with a password
+		if strings.Contains(string(reply), "operation not permitted") {
+			s.Findings.AuthRequired = true
+			return nil
+		}
+		return errors.New("unexpected Redis reply: " + string(reply))
+	}
+	if err != nil {
+		return err
+	}
+	if res != "PONG" {
+		return errors.New("unexpected Redis reply to PING")
+	}
+
+	s.testInfo(conn)
+	s.testProtectedMode(conn)
+	s.testCommands(conn)
+	return nil
+}
+
+// testInfo reads the server section of INFO
+func (s *Tester) testInfo(conn *connection) {
+	res, err := conn.command("INFO", "server")
+	if err != nil {
+		s.addError("info: " + err.Error())
+		return
+	}
+	info, ok := res.(string)
+	if !ok {
+		s.addError("info: unexpected reply")
+		return
+	}
+
+	for _, line := range strings.Split(info, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "redis_version":
+			s.Findings.Version = value
+		case "redis_mode":
+			s.Findings.Mode = value
+		case "os":
+			s.Findings.OS = value
+		}
+	}
+}
+
+func (s *Tester) testProtectedMode(conn *connection) {
+	res, err := conn.command("CONFIG", "GET", "protected-mode")
+	if err != nil {
+		// e.g. CONFIG is renamed or the server is older than Redis 3.2
+		s.addError("protected mode: " + err.Error())
+		return
+	}
+	values, ok := res.([]interface{})
+	if !ok || len(values) != 2 {
+		s.addError("protected mode: not supported by server")
+		return
+	}
+	protected := values[1] == "yes"
+	s.Findings.ProtectedMode = &protected
+}
+
+// testCommands checks which dangerous commands are available. Renamed
+// and disabled commands are not part of the command table.
+func (s *Tester) testCommands(conn *connection) {
+	args := append([]string{"COMMAND", "INFO"}, DangerousCommands...)
+	res, err := conn.command(args...)
+	if err != nil {
+		s.addError("commands: " + err.Error())
+		return
+	}
+	infos, ok := res.([]interface{})
+	if !ok || len(infos) != len(DangerousCommands) {
+		s.addError("commands: unexpected reply")
+		return
+	}
+
+	s.Findings.DangerousCommands = []string{}
+	s.Findings.RenamedCommands = []string{}
+	for i := range infos {
+		if infos[i] == nil {
+			s.Findings.RenamedCommands = append(s.Findings.RenamedCommands, DangerousCommands[i])
+		} else {
+			s.Findings.DangerousCommands = append(s.Findings.DangerousCommands, DangerousCommands[i])
+		}
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package redisshake
+
+import (
+	"bufio"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testServer is a minimal Redis server
+type testServer struct {
+	password      string
+	protectedMode string
+	denied        bool
+	renamed       map[string]bool
+}
+
+func bulk(s string) string {
+	return "$" + strconv.Itoa(len(s)) + "\r\n" + s + "\r\n"
+}
+
+func (s *testServer) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		n, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+		args := make([]string, n)
+		for i := range args {
+			reader.ReadString('\n')
+			arg, _ := reader.ReadString('\n')
+			args[i] = strings.TrimSpace(arg)
+		}
+
+		cmd := strings.ToUpper(args[0])
+		switch {
+		case s.denied:
+			conn.Write([]byte("-DENIED Redis is running in protected mode because protected mode is enabled\r\n"))
+		case s.password != "":
+			conn.Write([]byte("-NOAUTH Authentication required.\r\n"))
+		case s.renamed[cmd]:
+			conn.Write([]byte("-ERR unknown command '" + args[0] + "'\r\n"))
+		case cmd == "PING":
+			conn.Write([]byte("+PONG\r\n"))
+		case cmd == "INFO":
+			conn.Write([]byte(bulk("# Server\r\nredis_version:7.2.4\r\nredis_mode:standalone\r\nos:Linux 6.5.0-1016-azure x86_64\r\n")))
+		case cmd == "CONFIG":
+			conn.Write([]byte("*2\r\n" + bulk("protected-mode") + bulk(s.protectedMode)))
+		case cmd == "COMMAND":
+			res := "*" + strconv.Itoa(len(args)-2) + "\r\n"
+			for _, name := range args[2:] {
+				if s.renamed[name] {
+					res += "*-1\r\n"
+				} else {
+					res += "*3\r\n" + bulk(strings.ToLower(name)) + ":-1\r\n*1\r\n+admin\r\n"
+				}
+			}
+			conn.Write([]byte(res))
+		default:
+			conn.Write([]byte("-ERR unknown command\r\n"))
+		}
+	}
+}
+
+func startTestServer(t *testing.T, server *testServer) (string, int) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.handle(conn)
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return host, portNum
+}
+
+func TestOpenServer(t *testing.T) {
+	renamed := map[string]bool{}
+	for _, cmd := range DangerousCommands {
+		if cmd != "KEYS" && cmd != "EVAL" {
+			renamed[cmd] = true
+		}
+	}
+	renamed["CONFIG"] = false
+
+	host, port := startTestServer(t, &testServer{protectedMode: "no", renamed: renamed})
+	tester := New(host, port)
+	require.NoError(t, tester.Test())
+	findings := tester.Findings
+
+	assert.False(t, findings.AuthRequired)
+	require.NotNil(t, findings.ProtectedMode)
+	assert.False(t, *findings.ProtectedMode)
+	assert.Equal(t, "7.2.4", findings.Version)
+	assert.Equal(t, "standalone", findings.Mode)
+	assert.Equal(t, "Linux 6.5.0-1016-azure x86_64", findings.OS)
+	assert.Equal(t, []string{"CONFIG", "EVAL", "KEYS"}, findings.DangerousCommands)
+	assert.Len(t, findings.RenamedCommands, len(DangerousCommands)-3)
+	assert.NotContains(t, findings.RenamedCommands, "CONFIG")
+	assert.Empty(t, findings.Errors)
+}
+
+func TestRenamedConfig(t *testing.T) {
+	host, port := startTestServer(t, &testServer{renamed: map[string]bool{"CONFIG": true}})
+	tester := New(host, port)
+	require.NoError(t, tester.Test())
+	findings := tester.Findings
+
+	assert.Nil(t, findings.ProtectedMode)
+	assert.Equal(t, []string{"CONFIG"}, findings.RenamedCommands)
+	assert.Len(t, findings.Errors, 1)
+}
+
+func TestAuthRequired(t *testing.T) {
+	host, port := startTestServer(t, &testServer{password: "secret"})
+	tester := New(host, port)
+	require.NoError(t, tester.Test())
+	assert.True(t, tester.Findings.AuthRequired)
+	assert.Equal(t, "", tester.Findings.Version)
+	assert.Nil(t, tester.Findings.DangerousCommands)
+}
+
+func TestProtectedMode(t *testing.T) {
+	host, port := startTestServer(t, &testServer{denied: true})
+	tester := New(host, port)
+	require.NoError(t, tester.Test())
+	assert.False(t, tester.Findings.AuthRequired)
+	require.NotNil(t, tester.Findings.ProtectedMode)
+	assert.True(t, *tester.Findings.ProtectedMode)
+}
+
+func TestNoRedis(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		conn.Write([]byte("HTTP/1.1 400 Bad Request\r\n\r\n"))
+		conn.Close()
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	tester := New(addr.IP.String(), addr.Port)
+	assert.Error(t, tester.Test())
+}
@@ -0,0 +1,169 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/network/resources/snmpshake"
+	"go.mondoo.com/cnquery/v9/types"
+)
+
+const defaultSnmpPort = 161
+
+type mqlSnmpInternal struct {
+	lock    sync.Mutex
+	fetched bool
+	creds   snmpshake.Credentials
+}
+
+// popStringArg removes the argument and returns its value
+func popStringArg(args map[string]*llx.RawData, name string) string {
+	arg, ok := args[name]
+	if !ok {
+		return ""
+	}
+	delete(args, name)
+	if arg.Value == nil {
+		return ""
+	}
+	return arg.Value.(string)
+}
+
+func initSnmp(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if err := initServiceTarget(runtime, args, defaultSnmpPort, "snmp", "udp"); err != nil {
+		return nil, nil, err
+	}
+	if oids, ok := args["oids"]; !ok || oids.Value == nil || len(oids.Value.([]interface{})) == 0 {
+		args["oids"] = llx.ArrayData([]interface{}{snmpshake.OidSystem}, types.String)
+	}
+
+	// credentials are not stored in fields, which would expose them in results
+	creds := snmpshake.Credentials{
+		Community:    popStringArg(args, "community"),
+		Username:     popStringArg(args, "username"),
+		AuthProtocol: popStringArg(args, "authProtocol"),
+		AuthPassword: popStringArg(args, "authPassword"),
+		PrivProtocol: popStringArg(args, "privProtocol"),
+		PrivPassword: popStringArg(args, "privPassword"),
+	}
+
+	oids := []string{}
+	for _, oid := range args["oids"].Value.([]interface{}) {
+		oids = append(oids, oid.(string))
+	}
+	// different credentials may lead to different results
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		creds.Community, creds.Username, creds.AuthProtocol, creds.AuthPassword, creds.PrivProtocol, creds.PrivPassword,
+	}, "\x00")))
+	args["__id"] = llx.StringData("snmp/" + net.JoinHostPort(args["host"].Value.(string), strconv.Itoa(int(args["port"].Value.(int64)))) +
+		"/" + strings.Join(oids, ",") + "/" + hex.EncodeToString(sum[:8]))
+
+	res, err := CreateResource(runtime, "snmp", args)
+	if err != nil {
+		return nil, nil, err
+	}
+	res.(*mqlSnmp).creds = creds
+	return nil, res, nil
+}
+
+func (s *mqlSnmp) id() (string, error) {
+	return "snmp/" + net.JoinHostPort(s.Host.Data, strconv.Itoa(int(s.Port.Data))), nil
+}
+
+// fetch probes the agent and sets all fields of the resource
+func (s *mqlSnmp) fetch() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.fetched {
+		return nil
+	}
+
+	oids := make([]string, len(s.Oids.Data))
+	for i := range s.Oids.Data {
+		oids[i] = s.Oids.Data[i].(string)
+	}
+
+	tester := snmpshake.New(s.Host.Data, int(s.Port.Data), s.creds, oids)
+	if err := tester.Test(); err != nil {
+		return err
+	}
+	findings := tester.Findings
+
+	walk := make(map[string]interface{}, len(findings.Walk))
+	for _, v := range findings.Walk {
+		walk[v.Oid] = v.Value
+	}
+
+	s.Version = plugin.TValue[string]{Data: findings.Version, State: plugin.StateIsSet}
+	s.V1Accepted = plugin.TValue[bool]{Data: findings.V1Accepted, State: plugin.StateIsSet}
+	s.V2cAccepted = plugin.TValue[bool]{Data: findings.V2cAccepted, State: plugin.StateIsSet}
+	s.V3Supported = plugin.TValue[bool]{Data: findings.V3Supported, State: plugin.StateIsSet}
+	s.EngineId = plugin.TValue[string]{Data: findings.EngineID, State: plugin.StateIsSet}
+	s.SysDescr = plugin.TValue[string]{Data: findings.SysDescr, State: plugin.StateIsSet}
+	s.SysObjectID = plugin.TValue[string]{Data: findings.SysObjectID, State: plugin.StateIsSet}
+	s.SysName = plugin.TValue[string]{Data: findings.SysName, State: plugin.StateIsSet}
+	s.SysContact = plugin.TValue[string]{Data: findings.SysContact, State: plugin.StateIsSet}
+	s.SysLocation = plugin.TValue[string]{Data: findings.SysLocation, State: plugin.StateIsSet}
+	s.Walk = plugin.TValue[map[string]interface{}]{Data: walk, State: plugin.StateIsSet}
+	s.Errors = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.Errors), State: plugin.StateIsSet}
+	s.fetched = true
+	return nil
+}
+
+func (s *mqlSnmp) version() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlSnmp) v1Accepted() (bool, error) {
+	return false, s.fetch()
+}
+
+func (s *mqlSnmp) v2cAccepted() (bool, error) {
+	return false, s.fetch()
+}
+
+func (s *mqlSnmp) v3Supported() (bool, error) {
+	return false, s.fetch()
+}
+
+func (s *mqlSnmp) engineId() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlSnmp) sysDescr() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlSnmp) sysObjectID() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlSnmp) sysName() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlSnmp) sysContact() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlSnmp) sysLocation() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlSnmp) walk() (map[string]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlSnmp) errors() ([]interface{}, error) {
+	return nil, s.fetch()
+}
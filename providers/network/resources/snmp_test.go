@@ -0,0 +1,54 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/network/connection"
+	"go.mondoo.com/cnquery/v9/providers/network/resources/snmpshake"
+)
+
+func TestInitSnmp(t *testing.T) {
+	runtime := &plugin.Runtime{
+		Connection: connection.NewHostConnection(1, nil, &inventory.Config{}),
+	}
+	newSnmp := func(args map[string]*llx.RawData) *mqlSnmp {
+		res, err := NewResource(runtime, "snmp", args)
+		require.NoError(t, err)
+		return res.(*mqlSnmp)
+	}
+
+	public := newSnmp(map[string]*llx.RawData{
+		"target": llx.StringData("udp://192.0.2.1"),
+	})
+	assert.Equal(t, "192.0.2.1", public.Host.Data)
+	assert.Equal(t, int64(161), public.Port.Data)
+	assert.Equal(t, []interface{}{snmpshake.OidSystem}, public.Oids.Data)
+	assert.Equal(t, snmpshake.Credentials{}, public.creds)
+
+	v3 := newSnmp(map[string]*llx.RawData{
+		"target":       llx.StringData("192.0.2.1:1161"),
+		"username":     llx.StringData("monitor"),
+		"authPassword": llx.StringData("authpassword"),
+	})
+	assert.Equal(t, int64(1161), v3.Port.Data)
+	assert.Equal(t, "monitor", v3.creds.Username)
+	assert.Equal(t, "authpassword", v3.creds.AuthPassword)
+	assert.NotContains(t, v3.MqlID(), "authpassword")
+
+	// the same agent with different credentials is a different resource
+	other := newSnmp(map[string]*llx.RawData{
+		"target":       llx.StringData("192.0.2.1:1161"),
+		"username":     llx.StringData("monitor"),
+		"authPassword": llx.StringData("otherpassword"),
+	})
+	assert.NotEqual(t, v3.MqlID(), other.MqlID())
+	assert.Equal(t, "otherpassword", other.creds.AuthPassword)
+}
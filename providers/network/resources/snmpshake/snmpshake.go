@@ -0,0 +1,527 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package snmpshake probes SNMP agents with read-only requests. It detects
+// which protocol versions an agent accepts and reads the system group and
+// other selected OIDs.
+package snmpshake
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"go.mondoo.com/cnquery/v9/providers/network/resources/ber"
+)
+
+// Protocol versions
+const (
+	Version1  = "v1"
+	Version2c = "v2c"
+	Version3  = "v3"
+)
+
+// Versions as they are encoded in messages
+const (
+	wireVersion1  = 0
+	wireVersion2c = 1
+	wireVersion3  = 3
+)
+
+// PDU types
+const (
+	pduGetRequest     byte = 0xa0
+	pduGetNextRequest byte = 0xa1
+	pduResponse       byte = 0xa2
+	pduReport         byte = 0xa8
+)
+
+// Tags of SNMP values
+// see https://datatracker.ietf.org/doc/html/rfc3416#section-3
+const (
+	tagIpAddress      byte = 0x40
+	tagCounter32      byte = 0x41
+	tagGauge32        byte = 0x42
+	tagTimeTicks      byte = 0x43
+	tagOpaque         byte = 0x44
+	tagCounter64      byte = 0x46
+	tagNoSuchObject   byte = 0x80
+	tagNoSuchInstance byte = 0x81
+	tagEndOfMibView   byte = 0x82
+)
+
+// errorNoSuchName is the error status of SNMPv1 agents for unknown OIDs
+const errorNoSuchName = 2
+
+// OIDs of the system group
+const (
+	OidSystem      = "1.3.6.1.2.1.1"
+	OidSysDescr    = OidSystem + ".1.0"
+	OidSysObjectID = OidSystem + ".2.0"
+	OidSysContact  = OidSystem + ".4.0"
+	OidSysName     = OidSystem + ".5.0"
+	OidSysLocation = OidSystem + ".6.0"
+)
+
+// DefaultCommunity is used if no community is provided
+const DefaultCommunity = "public"
+
+const (
+	// maxMessageSize is the largest message we accept, which is the
+	// maximum payload of UDP datagrams
+	maxMessageSize = 65507
+	// maxWalk limits the variables that are read for each walked OID
+	maxWalk = 1000
+)
+
+// DefaultTimeout limits each request to the agent
+var DefaultTimeout = 2 * time.Second
+
+// Retries is the number of times requests are repeated, since UDP
+// datagrams may get lost
+var Retries = 1
+
+type Credentials struct {
+	// Community for SNMPv1 and SNMPv2c, defaults to public
+	Community string
+	// Username for SNMPv3, which is used for queries if it is set
+	Username string
+	// AuthProtocol is one of MD5, SHA, SHA224, SHA256, SHA384, or SHA512
+	AuthProtocol string
+	AuthPassword string
+	// PrivProtocol is one of DES or AES
+	PrivProtocol string
+	PrivPassword string
+}
+
+type Variable struct {
+	Oid   string
+	Type  string
+	Value string
+}
+
+type Findings struct {
+	// Version is the version that was used for queries
+	Version     string
+	V1Accepted  bool
+	V2cAccepted bool
+	// V3Supported is true if the agent answered SNMPv3 engine discovery
+	V3Supported bool
+	// EngineID is the hex-encoded authoritative engine ID of SNMPv3 agents
+	EngineID    string
+	EngineBoots int64
+	EngineTime  int64
+
+	SysDescr    string
+	SysObjectID string
+	SysName     string
+	SysContact  string
+	SysLocation string
+	// Walk contains the variables of all walked OIDs
+	Walk []Variable
+
+	Errors []string
+}
+
+type Tester struct {
+	Findings Findings
+	target   string
+	creds    Credentials
+	oids     []string
+	usm      *usm
+	// requestID is used for request IDs and SNMPv3 message IDs
+	requestID int32
+}
+
+// New creates a new tester for the given host and port. The OIDs are walked
+// and default to the system group.
+func New(host string, port int, creds Credentials, oids []string) *Tester {
+	if creds.Community == "" {
+		creds.Community = DefaultCommunity
+	}
+	if len(oids) == 0 {
+		oids = []string{OidSystem}
+	}
+
+	var id [4]byte
+	rand.Read(id[:])
+	return &Tester{
+		target:    net.JoinHostPort(host, strconv.Itoa(port)),
+		creds:     creds,
+		oids:      oids,
+		requestID: int32(binary.BigEndian.Uint32(id[:]) & 0x7fffffff),
+	}
+}
+
+func (s *Tester) addError(msg string) {
+	s.Findings.Errors = append(s.Findings.Errors, msg)
+}
+
+func (s *Tester) nextRequestID() int64 {
+	s.requestID = (s.requestID + 1) & 0x7fffffff
+	return int64(s.requestID)
+}
+
+// client sends requests with one specific version
+type client interface {
+	request(pduType byte, oid string) (ber.Element, error)
+}
+
+// Test probes all versions and reads the system group as well as the
+// selected OIDs with the best available version. It returns an error if
+// the agent didn't respond to any version.
+func (s *Tester) Test() error {
+	s.testV3()
+	s.Findings.V2cAccepted = s.testCommunity(wireVersion2c)
+	s.Findings.V1Accepted = s.testCommunity(wireVersion1)
+
+	var c client
+	switch {
+	case s.creds.Username != "":
+		if !s.Findings.V3Supported {
+			if !s.Findings.V1Accepted && !s.Findings.V2cAccepted {
+				return errors.New("no SNMP response from " + s.target)
+			}
+			s.addError("agent doesn't support SNMPv3")
+			return nil
+		}
+		usm, err := newUSM(s.usm, s.creds)
+		if err != nil {
+			return err
+		}
+		s.usm = usm
+		s.Findings.Version = Version3
+		c = &v3Client{tester: s}
+	case s.Findings.V2cAccepted:
+		s.Findings.Version = Version2c
+		c = &communityClient{tester: s, version: wireVersion2c}
+	case s.Findings.V1Accepted:
+		s.Findings.Version = Version1
+		c = &communityClient{tester: s, version: wireVersion1}
+	case s.Findings.V3Supported:
+		// the agent only accepts SNMPv3, but we have no credentials
+		return nil
+	default:
+		return errors.New("no SNMP response from " + s.target)
+	}
+
+	s.testSystem(c)
+	for _, oid := range s.oids {
+		s.walk(c, oid)
+	}
+	return nil
+}
+
+func (s *Tester) exchange(msg []byte, matches func([]byte) bool) ([]byte, error) {
+	conn, err := net.Dial("udp", s.target)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	buf := make([]byte, maxMessageSize)
+	var lastErr error
+	for attempt := 0; attempt <= Retries; attempt++ {
+		if _, err := conn.Write(msg); err != nil {
+			return nil, err
+		}
+		if err := conn.SetReadDeadline(time.Now().Add(DefaultTimeout)); err != nil {
+			return nil, err
+		}
+
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				lastErr = err
+				break
+			}
+			// ignore late responses to previous requests
+			if matches(buf[:n]) {
+				return buf[:n], nil
+			}
+		}
+
+		var netErr net.Error
+		if !errors.As(lastErr, &netErr) || !netErr.Timeout() {
+			// e.g. the port is closed
+			return nil, lastErr
+		}
+	}
+	return nil, lastErr
+}
+
+// testCommunity checks if the agent accepts requests with the community
+func (s *Tester) testCommunity(version int64) bool {
+	c := &communityClient{tester: s, version: version}
+	_, err := c.request(pduGetRequest, OidSysDescr)
+	return err == nil
+}
+
+func (s *Tester) testSystem(c client) {
+	values := []struct {
+		oid string
+		dst *string
+	}{
+		{OidSysDescr, &s.Findings.SysDescr},
+		{OidSysObjectID, &s.Findings.SysObjectID},
+		{OidSysName, &s.Findings.SysName},
+		{OidSysContact, &s.Findings.SysContact},
+		{OidSysLocation, &s.Findings.SysLocation},
+	}
+	for _, v := range values {
+		pdu, err := c.request(pduGetRequest, v.oid)
+		if err != nil {
+			s.addError("failed to get " + v.oid + ": " + err.Error())
+			continue
+		}
+		vars, err := parseVariables(pdu)
+		if err != nil {
+			s.addError("failed to get " + v.oid + ": " + err.Error())
+			continue
+		}
+		if len(vars) == 1 && !isException(vars[0].Type) {
+			*v.dst = vars[0].Value
+		}
+	}
+}
+
+// walk reads all variables below the OID with GetNext requests
+func (s *Tester) walk(c client, root string) {
+	cur := root
+	for i := 0; i < maxWalk; i++ {
+		pdu, err := c.request(pduGetNextRequest, cur)
+		if err != nil {
+			// SNMPv1 agents signal the end of the MIB with an error
+			if err != errNoSuchName {
+				s.addError("failed to walk " + root + ": " + err.Error())
+			}
+			return
+		}
+		vars, err := parseVariables(pdu)
+		if err != nil {
+			s.addError("failed to walk " + root + ": " + err.Error())
+			return
+		}
+		if len(vars) != 1 {
+			return
+		}
+
+		v := vars[0]
+		if isException(v.Type) || v.Oid == cur || !strings.HasPrefix(v.Oid, root+".") {
+			return
+		}
+		s.Findings.Walk = append(s.Findings.Walk, v)
+		cur = v.Oid
+	}
+}
+
+var errNoSuchName = errors.New("no such name")
+
+func encodePDU(pduType byte, requestID int64, oid string) ([]byte, error) {
+	varbinds := [][]byte{}
+	if oid != "" {
+		encoded, err := ber.OID(oid)
+		if err != nil {
+			return nil, err
+		}
+		varbinds = append(varbinds, ber.Sequence(encoded, ber.Null()))
+	}
+	return ber.Encode(pduType,
+		ber.Integer(requestID),
+		ber.Integer(0),
+		ber.Integer(0),
+		ber.Sequence(varbinds...),
+	), nil
+}
+
+// pduRequestID returns the request ID of a PDU
+func pduRequestID(pdu ber.Element) (int64, error) {
+	id, _, err := ber.Parse(pdu.Value)
+	if err != nil {
+		return 0, err
+	}
+	return id.Int()
+}
+
+// checkPDU returns an error if the PDU isn't a successful response
+func checkPDU(pdu ber.Element) error {
+	if pdu.Tag != pduResponse {
+		return errors.New("unexpected SNMP PDU")
+	}
+	fields, err := pdu.Children()
+	if err != nil {
+		return err
+	}
+	if len(fields) != 4 {
+		return errors.New("invalid SNMP PDU")
+	}
+	status, err := fields[1].Int()
+	if err != nil {
+		return err
+	}
+	switch status {
+	case 0:
+		return nil
+	case errorNoSuchName:
+		return errNoSuchName
+	default:
+		return errors.New("agent returned SNMP error status " + strconv.Itoa(int(status)))
+	}
+}
+
+// communityClient sends SNMPv1 and SNMPv2c requests
+// see https://datatracker.ietf.org/doc/html/rfc1157#section-4
+type communityClient struct {
+	tester  *Tester
+	version int64
+}
+
+func (c *communityClient) request(pduType byte, oid string) (ber.Element, error) {
+	requestID := c.tester.nextRequestID()
+	pdu, err := encodePDU(pduType, requestID, oid)
+	if err != nil {
+		return ber.Element{}, err
+	}
+	msg := ber.Sequence(ber.Integer(c.version), ber.String(c.tester.creds.Community), pdu)
+
+	var res ber.Element
+	_, err = c.tester.exchange(msg, func(data []byte) bool {
+		pdu, err := c.parse(data)
+		if err != nil {
+			return false
+		}
+		id, err := pduRequestID(pdu)
+		if err != nil || id != requestID {
+			return false
+		}
+		res = pdu
+		return true
+	})
+	if err != nil {
+		return ber.Element{}, err
+	}
+	return res, checkPDU(res)
+}
+
+func (c *communityClient) parse(data []byte) (ber.Element, error) {
+	msg, _, err := ber.Parse(data)
+	if err != nil {
+		return ber.Element{}, err
+	}
+	fields, err := msg.Children()
+	if err != nil {
+		return ber.Element{}, err
+	}
+	if len(fields) != 3 {
+		return ber.Element{}, errors.New("invalid SNMP message")
+	}
+	version, err := fields[0].Int()
+	if err != nil || version != c.version {
+		return ber.Element{}, errors.New("unexpected SNMP version")
+	}
+	return fields[2], nil
+}
+
+// parseVariables returns the variable bindings of a PDU
+func parseVariables(pdu ber.Element) ([]Variable, error) {
+	fields, err := pdu.Children()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) != 4 {
+		return nil, errors.New("invalid SNMP PDU")
+	}
+	varbinds, err := fields[3].Children()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Variable, len(varbinds))
+	for i := range varbinds {
+		parts, err := varbinds[i].Children()
+		if err != nil {
+			return nil, err
+		}
+		if len(parts) != 2 {
+			return nil, errors.New("invalid SNMP variable binding")
+		}
+		oid, err := parts[0].OID()
+		if err != nil {
+			return nil, err
+		}
+		typ, value, err := formatValue(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		res[i] = Variable{Oid: oid, Type: typ, Value: value}
+	}
+	return res, nil
+}
+
+// Types of variables without a value
+const (
+	TypeNoSuchObject   = "NoSuchObject"
+	TypeNoSuchInstance = "NoSuchInstance"
+	TypeEndOfMibView   = "EndOfMibView"
+)
+
+func isException(typ string) bool {
+	return typ == TypeNoSuchObject || typ == TypeNoSuchInstance || typ == TypeEndOfMibView
+}
+
+func formatValue(v ber.Element) (string, string, error) {
+	switch v.Tag {
+	case ber.TagInteger:
+		i, err := v.Int()
+		return "Integer", strconv.FormatInt(i, 10), err
+	case ber.TagOctetString:
+		return "OctetString", formatOctetString(v.Value), nil
+	case ber.TagNull:
+		return "Null", "", nil
+	case ber.TagOID:
+		oid, err := v.OID()
+		return "ObjectIdentifier", oid, err
+	case tagIpAddress:
+		if len(v.Value) != 4 {
+			return "", "", errors.New("invalid IP address")
+		}
+		return "IpAddress", net.IP(v.Value).String(), nil
+	case tagCounter32, tagGauge32, tagTimeTicks, tagCounter64:
+		i, err := v.Uint()
+		typ := map[byte]string{
+			tagCounter32: "Counter32", tagGauge32: "Gauge32", tagTimeTicks: "TimeTicks", tagCounter64: "Counter64",
+		}[v.Tag]
+		return typ, strconv.FormatUint(i, 10), err
+	case tagOpaque:
+		return "Opaque", hex.EncodeToString(v.Value), nil
+	case tagNoSuchObject:
+		return TypeNoSuchObject, "", nil
+	case tagNoSuchInstance:
+		return TypeNoSuchInstance, "", nil
+	case tagEndOfMibView:
+		return TypeEndOfMibView, "", nil
+	default:
+		return "Unknown", hex.EncodeToString(v.Value), nil
+	}
+}
+
+// formatOctetString returns printable strings as they are and everything
+// else hex-encoded, e.g. MAC addresses
+func formatOctetString(v []byte) string {
+	if !utf8.Valid(v) {
+		return hex.EncodeToString(v)
+	}
+	for _, r := range string(v) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return hex.EncodeToString(v)
+		}
+	}
+	return string(v)
+}
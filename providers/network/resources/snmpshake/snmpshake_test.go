@@ -0,0 +1,309 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package snmpshake
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/hex"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/providers/network/resources/ber"
+)
+
+// testAgent is a minimal SNMP agent
+type testAgent struct {
+	community string
+	versions  map[int64]bool
+	// usm is the configuration of the SNMPv3 user, nil disables SNMPv3
+	usm  *usm
+	vars map[string][]byte
+}
+
+func compareOids(a, b string) int {
+	pa, pb := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		x, _ := strconv.Atoi(pa[i])
+		y, _ := strconv.Atoi(pb[i])
+		if x != y {
+			return x - y
+		}
+	}
+	return len(pa) - len(pb)
+}
+
+func (a *testAgent) lookup(pduType byte, oid string, version int64) (string, []byte, bool) {
+	if pduType == pduGetRequest {
+		if v, ok := a.vars[oid]; ok {
+			return oid, v, true
+		}
+		return oid, []byte{tagNoSuchObject, 0}, version != wireVersion1
+	}
+
+	oids := make([]string, 0, len(a.vars))
+	for k := range a.vars {
+		oids = append(oids, k)
+	}
+	sort.Slice(oids, func(i, j int) bool { return compareOids(oids[i], oids[j]) < 0 })
+	for _, k := range oids {
+		if compareOids(k, oid) > 0 {
+			return k, a.vars[k], true
+		}
+	}
+	return oid, []byte{tagEndOfMibView, 0}, version != wireVersion1
+}
+
+func (a *testAgent) respond(pdu ber.Element, version int64) []byte {
+	fields, _ := pdu.Children()
+	requestID, _ := fields[0].Int()
+	varbinds, _ := fields[3].Children()
+
+	status := int64(0)
+	res := [][]byte{}
+	for i := range varbinds {
+		parts, _ := varbinds[i].Children()
+		oid, _ := parts[0].OID()
+		next, value, ok := a.lookup(pdu.Tag, oid, version)
+		if !ok {
+			status = errorNoSuchName
+		}
+		encoded, _ := ber.OID(next)
+		res = append(res, ber.Sequence(encoded, value))
+	}
+	return ber.Encode(pduResponse, ber.Integer(requestID), ber.Integer(status), ber.Integer(0), ber.Sequence(res...))
+}
+
+func (a *testAgent) report(msgID int64, oid string, flags byte) []byte {
+	encoded, _ := ber.OID(oid)
+	pdu := ber.Encode(pduReport, ber.Integer(0), ber.Integer(0), ber.Integer(0),
+		ber.Sequence(ber.Sequence(encoded, ber.Encode(tagCounter32, []byte{1}))))
+	u := *a.usm
+	if flags&flagAuth == 0 {
+		u.username = ""
+	}
+	msg, _ := u.encode(msgID, pdu, flags)
+	return msg
+}
+
+func (a *testAgent) handle(data []byte) []byte {
+	elem, _, err := ber.Parse(data)
+	if err != nil {
+		return nil
+	}
+	fields, _ := elem.Children()
+	version, _ := fields[0].Int()
+
+	if version != wireVersion3 {
+		if !a.versions[version] || string(fields[1].Value) != a.community {
+			return nil
+		}
+		return ber.Sequence(ber.Integer(version), ber.String(a.community), a.respond(fields[2], version))
+	}
+
+	if a.usm == nil {
+		return nil
+	}
+	msg, err := decodeV3(data, a.usm)
+	if msg == nil {
+		return nil
+	}
+	if msg.flags&flagAuth == 0 {
+		return a.report(msg.msgID, "1.3.6.1.6.3.15.1.1.4.0", 0)
+	}
+	if msg.username != a.usm.username {
+		return a.report(msg.msgID, "1.3.6.1.6.3.15.1.1.3.0", 0)
+	}
+
+	i := bytes.Index(data, msg.authParams)
+	zeroed := append([]byte{}, data...)
+	copy(zeroed[i:], make([]byte, len(msg.authParams)))
+	mac := hmac.New(a.usm.auth.hash, a.usm.authKey)
+	mac.Write(zeroed)
+	if !hmac.Equal(mac.Sum(nil)[:a.usm.auth.truncate], msg.authParams) {
+		return a.report(msg.msgID, "1.3.6.1.6.3.15.1.1.5.0", 0)
+	}
+	if err != nil {
+		return a.report(msg.msgID, "1.3.6.1.6.3.15.1.1.6.0", 0)
+	}
+
+	res, _ := a.usm.encode(msg.msgID, a.respond(msg.pdu, version), a.usm.flags())
+	return res
+}
+
+func startTestAgent(t *testing.T, agent *testAgent) (string, int) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, maxMessageSize)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if res := agent.handle(buf[:n]); res != nil {
+				conn.WriteTo(res, addr)
+			}
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(conn.LocalAddr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return host, portNum
+}
+
+func testVars() map[string][]byte {
+	sysObjectID, _ := ber.OID("1.3.6.1.4.1.8072.3.2.10")
+	return map[string][]byte{
+		OidSysDescr:                      ber.String("Linux router 5.15.0"),
+		OidSysObjectID:                   sysObjectID,
+		OidSystem + ".3.0":               ber.Encode(tagTimeTicks, []byte{0x01, 0x00}),
+		OidSysContact:                    ber.String("admin@example.com"),
+		OidSysName:                       ber.String("router"),
+		OidSysLocation:                   ber.String("rack 1"),
+		"1.3.6.1.2.1.2.2.1.6.2":          ber.OctetString([]byte{0x00, 0x1a, 0x2b, 0x3c, 0x4d, 0x5e}),
+		"1.3.6.1.2.1.4.20.1.1.127.0.0.1": ber.Encode(tagIpAddress, []byte{127, 0, 0, 1}),
+	}
+}
+
+func shortTimeout(t *testing.T) {
+	timeout := DefaultTimeout
+	DefaultTimeout = 100 * time.Millisecond
+	t.Cleanup(func() { DefaultTimeout = timeout })
+}
+
+func TestLocalizeKey(t *testing.T) {
+	// see https://datatracker.ietf.org/doc/html/rfc3414#appendix-A.3
+	engineID, err := hex.DecodeString("000000000000000000000002")
+	require.NoError(t, err)
+	assert.Equal(t, "526f5eed9fcce26f8964c2930787d82b", hex.EncodeToString(localizeKey(md5.New, "maplesyrup", engineID)))
+	assert.Equal(t, "6695febc9288e36282235fc7151f128497b38f3f", hex.EncodeToString(localizeKey(sha1.New, "maplesyrup", engineID)))
+}
+
+func TestCommunity(t *testing.T) {
+	shortTimeout(t)
+	host, port := startTestAgent(t, &testAgent{
+		community: "public",
+		versions:  map[int64]bool{wireVersion1: true, wireVersion2c: true},
+		vars:      testVars(),
+	})
+
+	tester := New(host, port, Credentials{}, []string{OidSystem, "1.3.6.1.2.1.2", "1.3.6.1.2.1.4"})
+	require.NoError(t, tester.Test())
+	findings := tester.Findings
+
+	assert.True(t, findings.V1Accepted)
+	assert.True(t, findings.V2cAccepted)
+	assert.False(t, findings.V3Supported)
+	assert.Equal(t, Version2c, findings.Version)
+	assert.Equal(t, "Linux router 5.15.0", findings.SysDescr)
+	assert.Equal(t, "1.3.6.1.4.1.8072.3.2.10", findings.SysObjectID)
+	assert.Equal(t, "router", findings.SysName)
+	assert.Equal(t, "admin@example.com", findings.SysContact)
+	assert.Equal(t, "rack 1", findings.SysLocation)
+	assert.Empty(t, findings.Errors)
+
+	require.Len(t, findings.Walk, 8)
+	assert.Equal(t, Variable{Oid: OidSysDescr, Type: "OctetString", Value: "Linux router 5.15.0"}, findings.Walk[0])
+	assert.Equal(t, Variable{Oid: OidSystem + ".3.0", Type: "TimeTicks", Value: "256"}, findings.Walk[2])
+	assert.Equal(t, Variable{Oid: "1.3.6.1.2.1.2.2.1.6.2", Type: "OctetString", Value: "001a2b3c4d5e"}, findings.Walk[6])
+	assert.Equal(t, Variable{Oid: "1.3.6.1.2.1.4.20.1.1.127.0.0.1", Type: "IpAddress", Value: "127.0.0.1"}, findings.Walk[7])
+}
+
+func TestV1Only(t *testing.T) {
+	shortTimeout(t)
+	host, port := startTestAgent(t, &testAgent{
+		community: "private",
+		versions:  map[int64]bool{wireVersion1: true},
+		vars:      testVars(),
+	})
+
+	tester := New(host, port, Credentials{Community: "private"}, nil)
+	require.NoError(t, tester.Test())
+	findings := tester.Findings
+
+	assert.True(t, findings.V1Accepted)
+	assert.False(t, findings.V2cAccepted)
+	assert.Equal(t, Version1, findings.Version)
+	assert.Equal(t, "router", findings.SysName)
+	// SNMPv1 agents end walks with an error
+	assert.Len(t, findings.Walk, 6)
+	assert.Empty(t, findings.Errors)
+
+	// the wrong community is ignored by the agent
+	tester = New(host, port, Credentials{}, nil)
+	assert.Error(t, tester.Test())
+}
+
+func TestV3(t *testing.T) {
+	shortTimeout(t)
+	engineID, err := hex.DecodeString("80001f8880e9630000d61ff449")
+	require.NoError(t, err)
+
+	for _, priv := range []string{PrivAES, PrivDES} {
+		t.Run(priv, func(t *testing.T) {
+			creds := Credentials{
+				Username:     "monitor",
+				AuthProtocol: "sha-256",
+				AuthPassword: "authpassword",
+				PrivProtocol: priv,
+				PrivPassword: "privpassword",
+			}
+			agentUsm, err := newUSM(&usm{engineID: engineID, engineBoots: 3, engineTime: 1200}, creds)
+			require.NoError(t, err)
+			host, port := startTestAgent(t, &testAgent{usm: agentUsm, vars: testVars()})
+
+			tester := New(host, port, creds, nil)
+			require.NoError(t, tester.Test())
+			findings := tester.Findings
+
+			assert.False(t, findings.V1Accepted)
+			assert.False(t, findings.V2cAccepted)
+			assert.True(t, findings.V3Supported)
+			assert.Equal(t, "80001f8880e9630000d61ff449", findings.EngineID)
+			assert.Equal(t, int64(3), findings.EngineBoots)
+			assert.Equal(t, Version3, findings.Version)
+			assert.Equal(t, "Linux router 5.15.0", findings.SysDescr)
+			assert.Len(t, findings.Walk, 6)
+			assert.Empty(t, findings.Errors)
+
+			// wrong passwords are reported by the agent
+			creds.AuthPassword = "wrongpassword"
+			tester = New(host, port, creds, nil)
+			require.NoError(t, tester.Test())
+			assert.Equal(t, "", tester.Findings.SysDescr)
+			require.NotEmpty(t, tester.Findings.Errors)
+			assert.Contains(t, tester.Findings.Errors[0], "wrong digest")
+
+			// without credentials only the engine is discovered
+			tester = New(host, port, Credentials{}, nil)
+			require.NoError(t, tester.Test())
+			assert.True(t, tester.Findings.V3Supported)
+			assert.Equal(t, "", tester.Findings.Version)
+		})
+	}
+}
+
+func TestNoAgent(t *testing.T) {
+	shortTimeout(t)
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := conn.LocalAddr().(*net.UDPAddr)
+	conn.Close()
+
+	tester := New(addr.IP.String(), addr.Port, Credentials{}, nil)
+	assert.Error(t, tester.Test())
+}
@@ -0,0 +1,500 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package snmpshake
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"strings"
+
+	"go.mondoo.com/cnquery/v9/providers/network/resources/ber"
+)
+
+// securityModelUSM is the user-based security model of SNMPv3
+// see https://datatracker.ietf.org/doc/html/rfc3414
+const securityModelUSM = 3
+
+// Flags of SNMPv3 messages
+const (
+	flagAuth       byte = 0x01
+	flagPriv       byte = 0x02
+	flagReportable byte = 0x04
+)
+
+// Privacy protocols
+const (
+	PrivDES = "DES"
+	PrivAES = "AES"
+)
+
+type authProtocol struct {
+	hash func() hash.Hash
+	// truncate is the length of the message authentication code
+	truncate int
+}
+
+// authProtocols are the supported authentication protocols
+// see https://datatracker.ietf.org/doc/html/rfc3414 and https://datatracker.ietf.org/doc/html/rfc7860
+var authProtocols = map[string]authProtocol{
+	"MD5":    {md5.New, 12},
+	"SHA":    {sha1.New, 12},
+	"SHA224": {sha256.New224, 16},
+	"SHA256": {sha256.New, 24},
+	"SHA384": {sha512.New384, 32},
+	"SHA512": {sha512.New, 48},
+}
+
+// Reports that agents send for failed requests
+var usmReports = map[string]string{
+	"1.3.6.1.6.3.15.1.1.1.0": "unsupported security level",
+	"1.3.6.1.6.3.15.1.1.2.0": "not in time window",
+	"1.3.6.1.6.3.15.1.1.3.0": "unknown user name",
+	"1.3.6.1.6.3.15.1.1.4.0": "unknown engine ID",
+	"1.3.6.1.6.3.15.1.1.5.0": "wrong digest",
+	"1.3.6.1.6.3.15.1.1.6.0": "decryption error",
+}
+
+const oidNotInTimeWindow = "1.3.6.1.6.3.15.1.1.2.0"
+
+var errNotInTimeWindow = errors.New("SNMPv3 report: not in time window")
+
+type usm struct {
+	engineID    []byte
+	engineBoots int64
+	engineTime  int64
+	username    string
+	auth        *authProtocol
+	authKey     []byte
+	priv        string
+	privKey     []byte
+	salt        uint64
+}
+
+// normalizeProtocol accepts names like sha-256 or AES128
+func normalizeProtocol(name string) string {
+	name = strings.ToUpper(strings.ReplaceAll(name, "-", ""))
+	switch name {
+	case "SHA1":
+		return "SHA"
+	case "AES128":
+		return PrivAES
+	}
+	return name
+}
+
+// newUSM sets up the credentials for the engine that was discovered
+func newUSM(engine *usm, creds Credentials) (*usm, error) {
+	res := &usm{
+		engineID:    engine.engineID,
+		engineBoots: engine.engineBoots,
+		engineTime:  engine.engineTime,
+		username:    creds.Username,
+	}
+	if creds.AuthPassword == "" {
+		if creds.PrivPassword != "" {
+			return nil, errors.New("SNMPv3 privacy requires authentication")
+		}
+		return res, nil
+	}
+
+	name := normalizeProtocol(creds.AuthProtocol)
+	if name == "" {
+		name = "SHA"
+	}
+	auth, ok := authProtocols[name]
+	if !ok {
+		return nil, errors.New("unsupported SNMPv3 authentication protocol: " + creds.AuthProtocol)
+	}
+	res.auth = &auth
+	res.authKey = localizeKey(auth.hash, creds.AuthPassword, res.engineID)
+
+	if creds.PrivPassword == "" {
+		return res, nil
+	}
+	res.priv = normalizeProtocol(creds.PrivProtocol)
+	if res.priv == "" {
+		res.priv = PrivAES
+	}
+	if res.priv != PrivAES && res.priv != PrivDES {
+		return nil, errors.New("unsupported SNMPv3 privacy protocol: " + creds.PrivProtocol)
+	}
+	res.privKey = localizeKey(auth.hash, creds.PrivPassword, res.engineID)
+	if len(res.privKey) < 16 {
+		return nil, errors.New("SNMPv3 privacy requires a longer key")
+	}
+
+	var salt [8]byte
+	rand.Read(salt[:])
+	res.salt = binary.BigEndian.Uint64(salt[:])
+	return res, nil
+}
+
+// localizeKey derives the key for the engine from the password
+// see https://datatracker.ietf.org/doc/html/rfc3414#appendix-A.2
+func localizeKey(newHash func() hash.Hash, password string, engineID []byte) []byte {
+	h := newHash()
+	buf := make([]byte, 64)
+	idx := 0
+	for count := 0; count < 1048576; count += len(buf) {
+		for i := range buf {
+			buf[i] = password[idx%len(password)]
+			idx++
+		}
+		h.Write(buf)
+	}
+	ku := h.Sum(nil)
+
+	h.Reset()
+	h.Write(ku)
+	h.Write(engineID)
+	h.Write(ku)
+	return h.Sum(nil)
+}
+
+func (u *usm) flags() byte {
+	var res byte
+	if u.auth != nil {
+		res |= flagAuth
+	}
+	if u.privKey != nil {
+		res |= flagPriv
+	}
+	return res
+}
+
+// encrypt encrypts the scoped PDU and returns the privacy parameters
+func (u *usm) encrypt(data []byte) ([]byte, []byte, error) {
+	u.salt++
+	params := make([]byte, 8)
+
+	switch u.priv {
+	case PrivDES:
+		// see https://datatracker.ietf.org/doc/html/rfc3414#section-8.1.1.1
+		binary.BigEndian.PutUint32(params, uint32(u.engineBoots))
+		binary.BigEndian.PutUint32(params[4:], uint32(u.salt))
+		block, err := des.NewCipher(u.privKey[:8])
+		if err != nil {
+			return nil, nil, err
+		}
+		iv := make([]byte, 8)
+		for i := range iv {
+			iv[i] = u.privKey[8+i] ^ params[i]
+		}
+		if rem := len(data) % 8; rem != 0 {
+			data = append(data, make([]byte, 8-rem)...)
+		}
+		res := make([]byte, len(data))
+		cipher.NewCBCEncrypter(block, iv).CryptBlocks(res, data)
+		return res, params, nil
+
+	default:
+		// see https://datatracker.ietf.org/doc/html/rfc3826#section-3.1.3
+		binary.BigEndian.PutUint64(params, u.salt)
+		block, err := aes.NewCipher(u.privKey[:16])
+		if err != nil {
+			return nil, nil, err
+		}
+		res := make([]byte, len(data))
+		cipher.NewCFBEncrypter(block, aesIV(u.engineBoots, u.engineTime, params)).XORKeyStream(res, data)
+		return res, params, nil
+	}
+}
+
+func (u *usm) decrypt(data []byte, boots int64, time int64, params []byte) ([]byte, error) {
+	if len(params) != 8 {
+		return nil, errors.New("invalid SNMPv3 privacy parameters")
+	}
+
+	switch u.priv {
+	case PrivDES:
+		if len(data)%8 != 0 {
+			return nil, errors.New("invalid SNMPv3 encrypted PDU")
+		}
+		block, err := des.NewCipher(u.privKey[:8])
+		if err != nil {
+			return nil, err
+		}
+		iv := make([]byte, 8)
+		for i := range iv {
+			iv[i] = u.privKey[8+i] ^ params[i]
+		}
+		res := make([]byte, len(data))
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(res, data)
+		return res, nil
+
+	default:
+		block, err := aes.NewCipher(u.privKey[:16])
+		if err != nil {
+			return nil, err
+		}
+		res := make([]byte, len(data))
+		cipher.NewCFBDecrypter(block, aesIV(boots, time, params)).XORKeyStream(res, data)
+		return res, nil
+	}
+}
+
+func aesIV(boots int64, time int64, salt []byte) []byte {
+	iv := make([]byte, 16)
+	binary.BigEndian.PutUint32(iv, uint32(boots))
+	binary.BigEndian.PutUint32(iv[4:], uint32(time))
+	copy(iv[8:], salt)
+	return iv
+}
+
+// encode creates an SNMPv3 message for the PDU
+// see https://datatracker.ietf.org/doc/html/rfc3412#section-6
+func (u *usm) encode(msgID int64, pdu []byte, flags byte) ([]byte, error) {
+	msgData := ber.Sequence(ber.OctetString(u.engineID), ber.String(""), pdu)
+	var privParams []byte
+	if flags&flagPriv != 0 {
+		encrypted, params, err := u.encrypt(msgData)
+		if err != nil {
+			return nil, err
+		}
+		msgData = ber.OctetString(encrypted)
+		privParams = params
+	}
+
+	build := func(authParams []byte) []byte {
+		params := ber.Sequence(
+			ber.OctetString(u.engineID),
+			ber.Integer(u.engineBoots),
+			ber.Integer(u.engineTime),
+			ber.String(u.username),
+			ber.OctetString(authParams),
+			ber.OctetString(privParams),
+		)
+		return ber.Sequence(
+			ber.Integer(wireVersion3),
+			ber.Sequence(
+				ber.Integer(msgID),
+				ber.Integer(maxMessageSize),
+				ber.OctetString([]byte{flags}),
+				ber.Integer(securityModelUSM),
+			),
+			ber.OctetString(params),
+			msgData,
+		)
+	}
+
+	if flags&flagAuth == 0 {
+		return build(nil), nil
+	}
+
+	// the MAC is calculated over the message with zeroed parameters
+	// see https://datatracker.ietf.org/doc/html/rfc3414#section-6.3.1
+	msg := build(make([]byte, u.auth.truncate))
+	mac := hmac.New(u.auth.hash, u.authKey)
+	mac.Write(msg)
+	return build(mac.Sum(nil)[:u.auth.truncate]), nil
+}
+
+type v3Message struct {
+	msgID       int64
+	flags       byte
+	engineID    []byte
+	engineBoots int64
+	engineTime  int64
+	username    string
+	authParams  []byte
+	privParams  []byte
+	pdu         ber.Element
+}
+
+// decodeV3 decodes an SNMPv3 message. The message ID is set even if the
+// PDU cannot be decrypted.
+func decodeV3(data []byte, u *usm) (*v3Message, error) {
+	elem, _, err := ber.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := elem.Children()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) != 4 {
+		return nil, errors.New("invalid SNMPv3 message")
+	}
+	if version, err := fields[0].Int(); err != nil || version != wireVersion3 {
+		return nil, errors.New("unexpected SNMP version")
+	}
+
+	global, err := fields[1].Children()
+	if err != nil {
+		return nil, err
+	}
+	if len(global) != 4 || len(global[2].Value) != 1 {
+		return nil, errors.New("invalid SNMPv3 message")
+	}
+	res := &v3Message{flags: global[2].Value[0]}
+	if res.msgID, err = global[0].Int(); err != nil {
+		return nil, err
+	}
+
+	paramsElem, _, err := ber.Parse(fields[2].Value)
+	if err != nil {
+		return res, err
+	}
+	params, err := paramsElem.Children()
+	if err != nil {
+		return res, err
+	}
+	if len(params) != 6 {
+		return res, errors.New("invalid SNMPv3 security parameters")
+	}
+	res.engineID = params[0].Value
+	if res.engineBoots, err = params[1].Int(); err != nil {
+		return res, err
+	}
+	if res.engineTime, err = params[2].Int(); err != nil {
+		return res, err
+	}
+	res.username = string(params[3].Value)
+	res.authParams = params[4].Value
+	res.privParams = params[5].Value
+
+	scoped := fields[3]
+	if res.flags&flagPriv != 0 {
+		if u == nil || u.privKey == nil {
+			return res, errors.New("cannot decrypt SNMPv3 message")
+		}
+		decrypted, err := u.decrypt(scoped.Value, res.engineBoots, res.engineTime, res.privParams)
+		if err != nil {
+			return res, err
+		}
+		// the decrypted data may contain padding
+		if scoped, _, err = ber.Parse(decrypted); err != nil {
+			return res, errors.New("failed to decrypt SNMPv3 message")
+		}
+	}
+
+	parts, err := scoped.Children()
+	if err != nil {
+		return res, err
+	}
+	if len(parts) != 3 {
+		return res, errors.New("invalid SNMPv3 scoped PDU")
+	}
+	res.pdu = parts[2]
+	return res, nil
+}
+
+// reportError returns the error that a report PDU indicates
+func reportError(pdu ber.Element) error {
+	vars, err := parseVariables(pdu)
+	if err != nil {
+		return err
+	}
+	if len(vars) == 0 {
+		return errors.New("SNMPv3 report without variables")
+	}
+	if vars[0].Oid == oidNotInTimeWindow {
+		return errNotInTimeWindow
+	}
+	if msg, ok := usmReports[vars[0].Oid]; ok {
+		return errors.New("SNMPv3 report: " + msg)
+	}
+	return errors.New("SNMPv3 report: " + vars[0].Oid)
+}
+
+// testV3 discovers the authoritative engine of the agent, which is the
+// first step of all SNMPv3 communication
+// see https://datatracker.ietf.org/doc/html/rfc3414#section-4
+func (s *Tester) testV3() {
+	msgID := s.nextRequestID()
+	pdu, err := encodePDU(pduGetRequest, s.nextRequestID(), "")
+	if err != nil {
+		s.addError(err.Error())
+		return
+	}
+	msg, err := (&usm{}).encode(msgID, pdu, flagReportable)
+	if err != nil {
+		s.addError(err.Error())
+		return
+	}
+
+	var res *v3Message
+	_, err = s.exchange(msg, func(data []byte) bool {
+		m, err := decodeV3(data, nil)
+		if err != nil || m.msgID != msgID {
+			return false
+		}
+		res = m
+		return true
+	})
+	if err != nil || len(res.engineID) == 0 {
+		return
+	}
+
+	s.usm = &usm{
+		engineID:    res.engineID,
+		engineBoots: res.engineBoots,
+		engineTime:  res.engineTime,
+	}
+	s.Findings.V3Supported = true
+	s.Findings.EngineID = hex.EncodeToString(res.engineID)
+	s.Findings.EngineBoots = res.engineBoots
+	s.Findings.EngineTime = res.engineTime
+}
+
+// v3Client sends SNMPv3 requests
+type v3Client struct {
+	tester *Tester
+}
+
+func (c *v3Client) request(pduType byte, oid string) (ber.Element, error) {
+	u := c.tester.usm
+	// the request is repeated once if the agent reports that our engine
+	// time is outdated, since the report contains its current time
+	for attempt := 0; ; attempt++ {
+		msgID := c.tester.nextRequestID()
+		pdu, err := encodePDU(pduType, c.tester.nextRequestID(), oid)
+		if err != nil {
+			return ber.Element{}, err
+		}
+		msg, err := u.encode(msgID, pdu, u.flags()|flagReportable)
+		if err != nil {
+			return ber.Element{}, err
+		}
+
+		var res *v3Message
+		var decodeErr error
+		_, err = c.tester.exchange(msg, func(data []byte) bool {
+			m, err := decodeV3(data, u)
+			if m == nil || m.msgID != msgID {
+				return false
+			}
+			res, decodeErr = m, err
+			return true
+		})
+		if err != nil {
+			return ber.Element{}, err
+		}
+		if decodeErr != nil {
+			return ber.Element{}, decodeErr
+		}
+
+		if res.pdu.Tag == pduReport {
+			err := reportError(res.pdu)
+			if err == errNotInTimeWindow && attempt == 0 {
+				u.engineBoots = res.engineBoots
+				u.engineTime = res.engineTime
+				continue
+			}
+			return ber.Element{}, err
+		}
+		return res.pdu, checkPDU(res.pdu)
+	}
+}
@@ -3,8 +3,72 @@
 
 package resources
 
-import "strconv"
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/network/connection"
+)
 
 func (s *mqlSocket) id() (string, error) {
 	return s.Protocol.Data + "://" + s.Address.Data + ":" + strconv.Itoa(int(s.Port.Data)), nil
 }
+
+// parseServiceTarget splits targets like host, host:port, or scheme://host:port
+func parseServiceTarget(target string, defaultPort int64, schemes ...string) (string, int64, error) {
+	for _, scheme := range schemes {
+		target = strings.TrimPrefix(target, scheme+"://")
+	}
+	if target == "" {
+		return "", 0, errors.New("target must be provided in the form of: host:port or host (defaults to port " + strconv.Itoa(int(defaultPort)) + ")")
+	}
+
+	host, rawPort, err := net.SplitHostPort(target)
+	if err != nil {
+		// no port was provided
+		return strings.Trim(target, "[]"), defaultPort, nil
+	}
+
+	port, err := strconv.ParseUint(rawPort, 10, 16)
+	if err != nil {
+		return "", 0, errors.New("failed to parse port: " + rawPort)
+	}
+	return host, int64(port), nil
+}
+
+// initServiceTarget sets the host and port of resources that probe services.
+// They are either provided directly, via the target, or by the connection.
+// The first scheme is the runtime of assets that were discovered for the
+// service, which provide its port.
+func initServiceTarget(runtime *plugin.Runtime, args map[string]*llx.RawData, defaultPort int64, schemes ...string) error {
+	if _, ok := args["host"]; ok {
+		if _, ok := args["port"]; !ok {
+			args["port"] = llx.IntData(defaultPort)
+		}
+		return nil
+	}
+
+	if target, ok := args["target"]; ok {
+		host, port, err := parseServiceTarget(target.Value.(string), defaultPort, schemes...)
+		if err != nil {
+			return err
+		}
+		args["host"] = llx.StringData(host)
+		args["port"] = llx.IntData(port)
+		delete(args, "target")
+		return nil
+	}
+
+	conn := runtime.Connection.(*connection.HostConnection)
+	port := defaultPort
+	if len(schemes) != 0 && conn.Conf.Runtime == schemes[0] && conn.Conf.Port != 0 {
+		port = int64(conn.Conf.Port)
+	}
+	args["host"] = llx.StringData(conn.Conf.Host)
+	args["port"] = llx.IntData(port)
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/network/resources/sshshake"
+	"golang.org/x/crypto/ssh"
+)
+
+const defaultSshPort = 22
+
+// parseSshTarget splits targets like host, host:port, or ssh://host:port
+func parseSshTarget(target string) (string, int64, error) {
+	return parseServiceTarget(target, defaultSshPort, "ssh", "tcp")
+}
+
+func initSshServer(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	// e.g. SSH services that were discovered in network ranges use the ssh runtime
+	if err := initServiceTarget(runtime, args, defaultSshPort, "ssh", "tcp"); err != nil {
+		return nil, nil, err
+	}
+	return args, nil, nil
+}
+
+type mqlSshServerInternal struct {
+	lock    sync.Mutex
+	fetched bool
+}
+
+func (s *mqlSshServer) id() (string, error) {
+	return "ssh.server/" + net.JoinHostPort(s.Host.Data, strconv.Itoa(int(s.Port.Data))), nil
+}
+
+// fetch probes the server and sets all fields of the resource
+func (s *mqlSshServer) fetch() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.fetched {
+		return nil
+	}
+
+	tester := sshshake.New(s.Host.Data, int(s.Port.Data))
+	if err := tester.Test(); err != nil {
+		return err
+	}
+	findings := tester.Findings
+
+	hostKeys := make([]interface{}, len(findings.HostKeys))
+	for i, key := range findings.HostKeys {
+		fingerprint := ssh.FingerprintSHA256(key.Key)
+		o, err := CreateResource(s.MqlRuntime, "ssh.server.hostKey", map[string]*llx.RawData{
+			"__id":           llx.StringData(s.__id + "/" + fingerprint),
+			"type":           llx.StringData(key.Type),
+			"bits":           llx.IntData(int64(key.Bits)),
+			"fingerprint":    llx.StringData(fingerprint),
+			"md5Fingerprint": llx.StringData(ssh.FingerprintLegacyMD5(key.Key)),
+			"publicKey":      llx.StringData(strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key.Key)))),
+		})
+		if err != nil {
+			return err
+		}
+		hostKeys[i] = o
+	}
+
+	s.Banner = plugin.TValue[string]{Data: findings.Banner, State: plugin.StateIsSet}
+	s.ProtocolVersion = plugin.TValue[string]{Data: findings.ProtocolVersion, State: plugin.StateIsSet}
+	s.SoftwareVersion = plugin.TValue[string]{Data: findings.SoftwareVersion, State: plugin.StateIsSet}
+	s.Comments = plugin.TValue[string]{Data: findings.Comments, State: plugin.StateIsSet}
+	s.KexAlgorithms = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.KexAlgorithms), State: plugin.StateIsSet}
+	s.HostKeyAlgorithms = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.HostKeyAlgorithms), State: plugin.StateIsSet}
+	s.Ciphers = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.Ciphers), State: plugin.StateIsSet}
+	s.Macs = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.MACs), State: plugin.StateIsSet}
+	s.CompressionAlgorithms = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.CompressionAlgorithms), State: plugin.StateIsSet}
+	s.HostKeys = plugin.TValue[[]interface{}]{Data: hostKeys, State: plugin.StateIsSet}
+	s.AuthMethods = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.AuthMethods), State: plugin.StateIsSet}
+	s.Errors = plugin.TValue[[]interface{}]{Data: llx.TArr2Raw(findings.Errors), State: plugin.StateIsSet}
+	s.fetched = true
+	return nil
+}
+
+func (s *mqlSshServer) banner() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlSshServer) protocolVersion() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlSshServer) softwareVersion() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlSshServer) comments() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlSshServer) kexAlgorithms() ([]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlSshServer) hostKeyAlgorithms() ([]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlSshServer) ciphers() ([]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlSshServer) macs() ([]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlSshServer) compressionAlgorithms() ([]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlSshServer) hostKeys() ([]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlSshServer) authMethods() ([]interface{}, error) {
+	return nil, s.fetch()
+}
+
+func (s *mqlSshServer) errors() ([]interface{}, error) {
+	return nil, s.fetch()
+}
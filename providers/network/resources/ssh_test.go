@@ -0,0 +1,38 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSshTarget(t *testing.T) {
+	tests := []struct {
+		target string
+		host   string
+		port   int64
+	}{
+		{"example.com", "example.com", 22},
+		{"example.com:2222", "example.com", 2222},
+		{"ssh://192.0.2.1:22", "192.0.2.1", 22},
+		{"[2001:db8::1]:2222", "2001:db8::1", 2222},
+		{"[2001:db8::1]", "2001:db8::1", 22},
+	}
+	for _, test := range tests {
+		t.Run(test.target, func(t *testing.T) {
+			host, port, err := parseSshTarget(test.target)
+			require.NoError(t, err)
+			assert.Equal(t, test.host, host)
+			assert.Equal(t, test.port, port)
+		})
+	}
+
+	_, _, err := parseSshTarget("example.com:ssh")
+	assert.Error(t, err)
+	_, _, err = parseSshTarget("")
+	assert.Error(t, err)
+}
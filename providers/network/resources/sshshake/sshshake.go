@@ -0,0 +1,387 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package sshshake probes SSH servers without credentials. It collects
+// everything that is exposed during an unauthenticated handshake.
+package sshshake
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// ClientVersion is the identification string we send to servers
+const ClientVersion = "SSH-2.0-cnquery"
+
+const (
+	msgKexInit = 20
+	// maxPacketLen limits the packets we read before the key exchange, which
+	// are small in practice
+	maxPacketLen = 256 * 1024
+	// maxBannerLines limits the lines a server may send before its
+	// identification, see https://datatracker.ietf.org/doc/html/rfc4253#section-4.2
+	maxBannerLines = 32
+)
+
+// DefaultTimeout limits each connection to the server
+var DefaultTimeout = 10 * time.Second
+
+// Algorithms that the client supports for probing. They include insecure
+// algorithms, which allows us to connect to old servers.
+var (
+	clientKexAlgorithms = []string{
+		"curve25519-sha256", "curve25519-sha256@libssh.org",
+		"ecdh-sha2-nistp256", "ecdh-sha2-nistp384", "ecdh-sha2-nistp521",
+		"diffie-hellman-group-exchange-sha256", "diffie-hellman-group16-sha512",
+		"diffie-hellman-group14-sha256", "diffie-hellman-group14-sha1",
+		"diffie-hellman-group-exchange-sha1", "diffie-hellman-group1-sha1",
+	}
+	clientCiphers = []string{
+		"chacha20-poly1305@openssh.com", "aes128-gcm@openssh.com", "aes256-gcm@openssh.com",
+		"aes128-ctr", "aes192-ctr", "aes256-ctr",
+		"aes128-cbc", "3des-cbc", "arcfour256", "arcfour128", "arcfour",
+	}
+	clientMACs = []string{
+		"hmac-sha2-256-etm@openssh.com", "hmac-sha2-512-etm@openssh.com",
+		"hmac-sha2-256", "hmac-sha2-512", "hmac-sha1", "hmac-sha1-96",
+	}
+)
+
+// Auth methods that can be detected without sending credentials
+const (
+	AuthPublicKey           = "publickey"
+	AuthPassword            = "password"
+	AuthKeyboardInteractive = "keyboard-interactive"
+)
+
+// errProbeDone aborts connections once we have what we need
+var errProbeDone = errors.New("probe done")
+
+type HostKey struct {
+	// Type is the key type, e.g. ssh-ed25519 or ssh-rsa
+	Type string
+	Key  ssh.PublicKey
+	Bits int
+}
+
+type Findings struct {
+	// Banner is the identification string of the server, e.g.
+	// SSH-2.0-OpenSSH_9.6p1 Ubuntu-3ubuntu13
+	Banner          string
+	ProtocolVersion string
+	SoftwareVersion string
+	Comments        string
+	// PreBanner are lines the server sends before its identification
+	PreBanner []string
+
+	KexAlgorithms         []string
+	HostKeyAlgorithms     []string
+	Ciphers               []string
+	MACs                  []string
+	CompressionAlgorithms []string
+
+	HostKeys    []HostKey
+	AuthMethods []string
+	Errors      []string
+}
+
+type Tester struct {
+	Findings Findings
+	target   string
+}
+
+// New creates a new tester for the given host and port
+func New(host string, port int) *Tester {
+	return &Tester{
+		target: net.JoinHostPort(host, strconv.Itoa(port)),
+	}
+}
+
+func (s *Tester) addError(msg string) {
+	s.Findings.Errors = append(s.Findings.Errors, msg)
+}
+
+// Test runs all probes against the server. It returns an error if the
+// server doesn't speak SSH. Errors of individual probes are collected
+// in the findings.
+func (s *Tester) Test() error {
+	if err := s.testKexInit(); err != nil {
+		return err
+	}
+
+	s.testHostKeys()
+	s.testAuthMethods()
+	return nil
+}
+
+func (s *Tester) dial() (net.Conn, error) {
+	conn, err := net.DialTimeout("tcp", s.target, DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Now().Add(DefaultTimeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// testKexInit reads the identification and the key exchange init message
+// of the server, which lists all algorithms it supports
+// see https://datatracker.ietf.org/doc/html/rfc4253#section-7.1
+func (s *Tester) testKexInit() error {
+	conn, err := s.dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte(ClientVersion + "\r\n")); err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(conn)
+	if err := s.parseIdentification(reader); err != nil {
+		return err
+	}
+
+	for {
+		payload, err := readPacket(reader)
+		if err != nil {
+			return errors.New("failed to read key exchange init: " + err.Error())
+		}
+		// servers may send other messages like SSH_MSG_IGNORE first
+		if len(payload) != 0 && payload[0] == msgKexInit {
+			return s.parseKexInit(payload)
+		}
+	}
+}
+
+func (s *Tester) parseIdentification(reader *bufio.Reader) error {
+	for i := 0; i < maxBannerLines; i++ {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return errors.New("failed to read SSH identification: " + err.Error())
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		if !strings.HasPrefix(line, "SSH-") {
+			s.Findings.PreBanner = append(s.Findings.PreBanner, line)
+			continue
+		}
+
+		s.Findings.Banner = line
+		// SSH-protoversion-softwareversion SP comments
+		ident, comments, _ := strings.Cut(line, " ")
+		parts := strings.SplitN(ident, "-", 3)
+		if len(parts) != 3 {
+			return errors.New("invalid SSH identification: " + line)
+		}
+		s.Findings.ProtocolVersion = parts[1]
+		s.Findings.SoftwareVersion = parts[2]
+		s.Findings.Comments = comments
+		return nil
+	}
+	return errors.New("server didn't send an SSH identification")
+}
+
+// readPacket reads an unencrypted binary packet and returns its payload
+// see https://datatracker.ietf.org/doc/html/rfc4253#section-6
+func readPacket(reader io.Reader) ([]byte, error) {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, err
+	}
+
+	packetLen := binary.BigEndian.Uint32(header[0:4])
+	paddingLen := uint32(header[4])
+	if packetLen > maxPacketLen || paddingLen+1 > packetLen {
+		return nil, errors.New("invalid packet length " + strconv.FormatUint(uint64(packetLen), 10))
+	}
+
+	packet := make([]byte, packetLen-1)
+	if _, err := io.ReadFull(reader, packet); err != nil {
+		return nil, err
+	}
+	return packet[:packetLen-1-paddingLen], nil
+}
+
+func (s *Tester) parseKexInit(payload []byte) error {
+	// message type and 16 byte cookie
+	if len(payload) < 17 {
+		return errors.New("key exchange init is too short")
+	}
+	data := payload[17:]
+
+	lists := make([][]string, 10)
+	for i := range lists {
+		if len(data) < 4 {
+			return errors.New("key exchange init is too short")
+		}
+		l := binary.BigEndian.Uint32(data[0:4])
+		data = data[4:]
+		if uint32(len(data)) < l {
+			return errors.New("key exchange init is too short")
+		}
+		if l != 0 {
+			lists[i] = strings.Split(string(data[:l]), ",")
+		}
+		data = data[l:]
+	}
+
+	s.Findings.KexAlgorithms = lists[0]
+	s.Findings.HostKeyAlgorithms = lists[1]
+	// algorithms are negotiated per direction, but are the same in practice
+	s.Findings.Ciphers = union(lists[2], lists[3])
+	s.Findings.MACs = union(lists[4], lists[5])
+	s.Findings.CompressionAlgorithms = union(lists[6], lists[7])
+	return nil
+}
+
+func union(a []string, b []string) []string {
+	res := []string{}
+	exists := map[string]struct{}{}
+	for _, list := range [][]string{a, b} {
+		for _, v := range list {
+			if _, ok := exists[v]; ok {
+				continue
+			}
+			exists[v] = struct{}{}
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+func (s *Tester) clientConfig() *ssh.ClientConfig {
+	return &ssh.ClientConfig{
+		User:          "cnquery",
+		ClientVersion: ClientVersion,
+		Timeout:       DefaultTimeout,
+		Config: ssh.Config{
+			KeyExchanges: clientKexAlgorithms,
+			Ciphers:      clientCiphers,
+			MACs:         clientMACs,
+		},
+	}
+}
+
+func (s *Tester) handshake(conf *ssh.ClientConfig) error {
+	conn, err := s.dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	c, chans, reqs, err := ssh.NewClientConn(conn, s.target, conf)
+	if err != nil {
+		return err
+	}
+	// this only happens if the server allows access without authentication
+	go ssh.DiscardRequests(reqs)
+	go func() {
+		for ch := range chans {
+			ch.Reject(ssh.Prohibited, "")
+		}
+	}()
+	return c.Close()
+}
+
+// testHostKeys collects the host key for every host key algorithm that the
+// server supports
+func (s *Tester) testHostKeys() {
+	seen := map[string]struct{}{}
+	for _, algo := range s.Findings.HostKeyAlgorithms {
+		var key ssh.PublicKey
+		conf := s.clientConfig()
+		conf.HostKeyAlgorithms = []string{algo}
+		conf.HostKeyCallback = func(hostname string, remote net.Addr, k ssh.PublicKey) error {
+			key = k
+			return errProbeDone
+		}
+
+		err := s.handshake(conf)
+		if key == nil {
+			if err != nil {
+				s.addError("failed to get host key for " + algo + ": " + err.Error())
+			}
+			continue
+		}
+
+		fingerprint := ssh.FingerprintSHA256(key)
+		if _, ok := seen[fingerprint]; ok {
+			// e.g. RSA keys are used for ssh-rsa, rsa-sha2-256 and rsa-sha2-512
+			continue
+		}
+		seen[fingerprint] = struct{}{}
+		s.Findings.HostKeys = append(s.Findings.HostKeys, HostKey{
+			Type: key.Type(),
+			Key:  key,
+			Bits: keyBits(key),
+		})
+	}
+}
+
+func keyBits(key ssh.PublicKey) int {
+	cryptoKey, ok := key.(ssh.CryptoPublicKey)
+	if !ok {
+		return 0
+	}
+
+	switch k := cryptoKey.CryptoPublicKey().(type) {
+	case *rsa.PublicKey:
+		return k.N.BitLen()
+	case *ecdsa.PublicKey:
+		return k.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		return 256
+	default:
+		return 0
+	}
+}
+
+// testAuthMethods detects which authentication methods the server offers.
+// The client first tries the "none" method, which lists all methods that
+// can continue. Our callbacks are only called for offered methods and never
+// send any credentials. Keyboard-interactive is only detected if the server
+// sends a challenge.
+func (s *Tester) testAuthMethods() {
+	methods := []string{}
+	record := func(method string) {
+		methods = append(methods, method)
+	}
+
+	conf := s.clientConfig()
+	conf.HostKeyCallback = ssh.InsecureIgnoreHostKey()
+	conf.Auth = []ssh.AuthMethod{
+		ssh.PublicKeysCallback(func() ([]ssh.Signer, error) {
+			record(AuthPublicKey)
+			return nil, errProbeDone
+		}),
+		ssh.PasswordCallback(func() (string, error) {
+			record(AuthPassword)
+			return "", errProbeDone
+		}),
+		ssh.KeyboardInteractive(func(name, instruction string, questions []string, echos []bool) ([]string, error) {
+			record(AuthKeyboardInteractive)
+			return nil, errProbeDone
+		}),
+	}
+
+	err := s.handshake(conf)
+	if err != nil && len(methods) == 0 && !strings.Contains(err.Error(), "unable to authenticate") {
+		s.addError("failed to test authentication methods: " + err.Error())
+	}
+	s.Findings.AuthMethods = methods
+}
@@ -0,0 +1,119 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshshake
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func startTestServer(t *testing.T, conf *ssh.ServerConfig) (string, int) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				// no client can authenticate, so this always fails
+				ssh.NewServerConn(conn, conf)
+			}()
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return host, portNum
+}
+
+func TestTester(t *testing.T) {
+	_, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edSigner, err := ssh.NewSignerFromKey(edPriv)
+	require.NoError(t, err)
+	rsaPriv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaSigner, err := ssh.NewSignerFromKey(rsaPriv)
+	require.NoError(t, err)
+
+	var usedPassword bool
+	conf := &ssh.ServerConfig{
+		ServerVersion: "SSH-2.0-OpenSSH_9.6p1 Ubuntu-3ubuntu13",
+		Config: ssh.Config{
+			Ciphers: []string{"aes128-gcm@openssh.com", "aes128-cbc"},
+		},
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			usedPassword = true
+			return nil, errors.New("denied")
+		},
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			return nil, errors.New("denied")
+		},
+	}
+	conf.AddHostKey(edSigner)
+	conf.AddHostKey(rsaSigner)
+
+	tester := New(startTestServer(t, conf))
+	require.NoError(t, tester.Test())
+	findings := tester.Findings
+
+	assert.Equal(t, "SSH-2.0-OpenSSH_9.6p1 Ubuntu-3ubuntu13", findings.Banner)
+	assert.Equal(t, "2.0", findings.ProtocolVersion)
+	assert.Equal(t, "OpenSSH_9.6p1", findings.SoftwareVersion)
+	assert.Equal(t, "Ubuntu-3ubuntu13", findings.Comments)
+
+	assert.Contains(t, findings.KexAlgorithms, "curve25519-sha256")
+	assert.Contains(t, findings.HostKeyAlgorithms, "ssh-ed25519")
+	assert.Contains(t, findings.HostKeyAlgorithms, "rsa-sha2-512")
+	assert.Equal(t, []string{"aes128-gcm@openssh.com", "aes128-cbc"}, findings.Ciphers)
+	assert.NotEmpty(t, findings.MACs)
+	assert.Equal(t, []string{"none"}, findings.CompressionAlgorithms)
+
+	require.Len(t, findings.HostKeys, 2)
+	keys := map[string]HostKey{}
+	for _, key := range findings.HostKeys {
+		keys[key.Type] = key
+	}
+	assert.Equal(t, 256, keys["ssh-ed25519"].Bits)
+	assert.Equal(t, ssh.FingerprintSHA256(edSigner.PublicKey()), ssh.FingerprintSHA256(keys["ssh-ed25519"].Key))
+	assert.Equal(t, 2048, keys["ssh-rsa"].Bits)
+
+	assert.Equal(t, []string{AuthPublicKey, AuthPassword}, findings.AuthMethods)
+	assert.False(t, usedPassword, "the tester must never send credentials")
+	assert.Empty(t, findings.Errors)
+}
+
+func TestTesterNoSsh(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		conn.Write([]byte("220 smtp.example.com ESMTP\r\n"))
+		conn.Close()
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	err = New(addr.IP.String(), addr.Port).Test()
+	assert.Error(t, err)
+}
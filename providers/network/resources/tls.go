@@ -6,11 +6,14 @@ package resources
 import (
 	"crypto/x509"
 	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
 	"go.mondoo.com/cnquery/v9/llx"
 	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/v9/providers/core/resources/regex"
@@ -18,6 +21,7 @@ import (
 	"go.mondoo.com/cnquery/v9/providers/network/resources/certificates"
 	"go.mondoo.com/cnquery/v9/providers/network/resources/tlsshake"
 	"go.mondoo.com/cnquery/v9/types"
+	"golang.org/x/exp/slices"
 )
 
 var reTarget = regexp.MustCompile("([^/:]+?)(:\\d+)?$")
@@ -25,11 +29,31 @@ var reTarget = regexp.MustCompile("([^/:]+?)(:\\d+)?$")
 var rexUrlDomain = regexp.MustCompile(regex.UrlDomain)
 
 func initTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
-	// if the socket is set already, we have nothing else to do
-	if _, ok := args["socket"]; ok {
-		return args, nil, nil
+	if _, ok := args["caBundle"]; !ok {
+		args["caBundle"] = llx.StringData("")
 	}
 
+	// if the socket is set already, we only need to detect STARTTLS
+	if _, ok := args["socket"]; !ok {
+		if err := initTlsSocket(runtime, args); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if raw, ok := args["starttls"]; ok {
+		protocol := raw.Value.(string)
+		if protocol != "" && !slices.Contains(tlsshake.STARTTLS_PROTOCOLS, protocol) {
+			return nil, nil, errors.New("unsupported STARTTLS protocol '" + protocol + "', supported: " + strings.Join(tlsshake.STARTTLS_PROTOCOLS, ", "))
+		}
+	} else {
+		socket := args["socket"].Value.(*mqlSocket)
+		args["starttls"] = llx.StringData(tlsshake.STARTTLS_PORTS[int(socket.Port.Data)])
+	}
+
+	return args, nil, nil
+}
+
+func initTlsSocket(runtime *plugin.Runtime, args map[string]*llx.RawData) error {
 	conn := runtime.Connection.(*connection.HostConnection)
 	if conn.Conf.Port == 0 {
 		conn.Conf.Port = 443
@@ -38,7 +62,7 @@ func initTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]
 	if target, ok := args["target"]; ok {
 		m := reTarget.FindStringSubmatch(target.Value.(string))
 		if len(m) == 0 {
-			return nil, nil, errors.New("target must be provided in the form of: tcp://target:port, udp://target:port, or target:port (defaults to tcp)")
+			return errors.New("target must be provided in the form of: tcp://target:port, udp://target:port, or target:port (defaults to tcp)")
 		}
 
 		proto := "tcp"
@@ -47,7 +71,7 @@ func initTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]
 		if len(m[2]) != 0 {
 			rawPort, err := strconv.ParseUint(m[2][1:], 10, 64)
 			if err != nil {
-				return nil, nil, errors.New("failed to parse port: " + m[2])
+				return errors.New("failed to parse port: " + m[2])
 			}
 			port = int64(rawPort)
 		}
@@ -64,7 +88,7 @@ func initTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]
 			"address":  llx.StringData(address),
 		})
 		if err != nil {
-			return nil, nil, err
+			return err
 		}
 
 		args["socket"] = llx.ResourceData(socket, "socket")
@@ -78,45 +102,51 @@ func initTls(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]
 			"address":  llx.StringData(conn.Conf.Host),
 		})
 		if err != nil {
-			return nil, nil, err
+			return err
 		}
 
 		args["socket"] = llx.ResourceData(socket, "socket")
 		args["domainName"] = llx.StringData(conn.Conf.Host)
 	}
 
-	return args, nil, nil
+	return nil
 }
 
 type mqlTlsInternal struct {
 	lock sync.Mutex
+	// ocsp are the findings of the OCSP stapling probe, which only runs if
+	// one of its fields is requested
+	ocsp *tlsshake.Findings
 }
 
 func (s *mqlTls) id() (string, error) {
 	return "tls+" + s.Socket.Data.__id, nil
 }
 
-func parseCertificates(runtime *plugin.Runtime, domainName string, findings *tlsshake.Findings, certificateList []*x509.Certificate) ([]interface{}, error) {
+// parseCaBundle returns the pool of PEM-encoded CA certificates, or nil for
+// the system roots if none are provided
+func parseCaBundle(bundle string) (*x509.CertPool, error) {
+	if bundle == "" {
+		return nil, nil
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(bundle)) {
+		return nil, errors.New("failed to parse CA bundle, no PEM-encoded certificates found")
+	}
+	return pool, nil
+}
+
+func parseCertificates(runtime *plugin.Runtime, domainName string, roots *x509.CertPool, findings *tlsshake.Findings, certificateList []*x509.Certificate) ([]interface{}, error) {
 	res := make([]interface{}, len(certificateList))
 
 	verified := false
 	if len(certificateList) != 0 {
-		intermediates := x509.NewCertPool()
-		for i := 1; i < len(certificateList); i++ {
-			intermediates.AddCert(certificateList[i])
-		}
-
-		verifyCerts, err := certificateList[0].Verify(x509.VerifyOptions{
-			DNSName:       domainName,
-			Intermediates: intermediates,
-		})
+		err := tlsshake.VerifyChain(certificateList, domainName, roots)
 		if err != nil {
 			findings.Errors = append(findings.Errors, "Failed to verify certificate chain for "+certificateList[0].Subject.String())
 		}
-
-		if len(verifyCerts) != 0 {
-			verified = verifyCerts[0][0].Equal(certificateList[0])
-		}
+		verified = err == nil
 	}
 
 	for i := range certificateList {
@@ -163,15 +193,16 @@ func parseCertificates(runtime *plugin.Runtime, domainName string, findings *tls
 	return res, nil
 }
 
-func (s *mqlTls) params(socket *mqlSocket, domainName string) (map[string]interface{}, error) {
+func (s *mqlTls) params(socket *mqlSocket, domainName string, caBundle string, starttls string) (map[string]interface{}, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	host := socket.Address.Data
-	port := socket.Port.Data
-	proto := socket.Protocol.Data
+	roots, err := parseCaBundle(caBundle)
+	if err != nil {
+		return nil, err
+	}
 
-	tester := tlsshake.New(proto, domainName, host, int(port))
+	tester := newTlsTester(socket, domainName, starttls)
 	if err := tester.Test(tlsshake.DefaultScanConfig()); err != nil {
 		return nil, err
 	}
@@ -179,8 +210,37 @@ func (s *mqlTls) params(socket *mqlSocket, domainName string) (map[string]interf
 	res := map[string]interface{}{}
 	findings := tester.Findings
 
+	chainErr := tlsshake.VerifyChain(findings.Certificates, domainName, roots)
+	res["chainValid"] = chainErr == nil
+	res["chainError"] = ""
+	if chainErr != nil {
+		res["chainError"] = chainErr.Error()
+	}
+	grade, gradeReasons := tlsshake.Grade(&findings, chainErr)
+	res["grade"] = grade
+	res["secureRenegotiation"] = findings.SecureRenegotiation()
+	if findings.FallbackSCSV != nil {
+		res["fallbackScsv"] = *findings.FallbackSCSV
+	}
+
+	// Create certificates
+	certs, err := parseCertificates(s.MqlRuntime, domainName, roots, &findings, findings.Certificates)
+	if err != nil {
+		s.Certificates = plugin.TValue[[]interface{}]{Error: err, State: plugin.StateIsSet}
+	} else {
+		s.Certificates = plugin.TValue[[]interface{}]{Data: certs, State: plugin.StateIsSet}
+	}
+
+	certs, err = parseCertificates(s.MqlRuntime, domainName, roots, &findings, findings.NonSNIcertificates)
+	if err != nil {
+		s.NonSniCertificates = plugin.TValue[[]interface{}]{Error: err, State: plugin.StateIsSet}
+	} else {
+		s.NonSniCertificates = plugin.TValue[[]interface{}]{Data: certs, State: plugin.StateIsSet}
+	}
+
 	lists := map[string][]string{
-		"errors": findings.Errors,
+		"errors":       findings.Errors,
+		"gradeReasons": gradeReasons,
 	}
 	for field, data := range lists {
 		v := make([]interface{}, len(data))
@@ -203,21 +263,6 @@ func (s *mqlTls) params(socket *mqlSocket, domainName string) (map[string]interf
 		res[field] = v
 	}
 
-	// Create certificates
-	certs, err := parseCertificates(s.MqlRuntime, domainName, &findings, findings.Certificates)
-	if err != nil {
-		s.Certificates = plugin.TValue[[]interface{}]{Error: err, State: plugin.StateIsSet}
-	} else {
-		s.Certificates = plugin.TValue[[]interface{}]{Data: certs, State: plugin.StateIsSet}
-	}
-
-	certs, err = parseCertificates(s.MqlRuntime, domainName, &findings, findings.NonSNIcertificates)
-	if err != nil {
-		s.NonSniCertificates = plugin.TValue[[]interface{}]{Error: err, State: plugin.StateIsSet}
-	} else {
-		s.NonSniCertificates = plugin.TValue[[]interface{}]{Data: certs, State: plugin.StateIsSet}
-	}
-
 	return res, nil
 }
 
@@ -300,3 +345,153 @@ func (s *mqlTls) nonSniCertificates(params interface{}) ([]interface{}, error) {
 	// (TODO: use the recording data to do this async)
 	return nil, nil
 }
+
+func (s *mqlTls) cipherSuites(params interface{}) ([]interface{}, error) {
+	names, err := s.ciphers(params)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i].(string) < names[j].(string) })
+
+	res := make([]interface{}, len(names))
+	for i := range names {
+		cipher := tlsshake.Cipher(names[i].(string))
+		o, err := CreateResource(s.MqlRuntime, "tls.cipher", map[string]*llx.RawData{
+			"name":           llx.StringData(cipher.Name),
+			"keyExchange":    llx.StringData(cipher.KeyExchange),
+			"authentication": llx.StringData(cipher.Authentication),
+			"encryption":     llx.StringData(cipher.Encryption),
+			"mac":            llx.StringData(cipher.Mac),
+			"bits":           llx.IntData(int64(cipher.Bits)),
+			"forwardSecrecy": llx.BoolData(cipher.ForwardSecrecy),
+			"aead":           llx.BoolData(cipher.AEAD),
+			"grade":          llx.StringData(cipher.Grade),
+		})
+		if err != nil {
+			return nil, err
+		}
+		res[i] = o
+	}
+	return res, nil
+}
+
+func (s *mqlTls) fallbackScsv(params interface{}) (bool, error) {
+	paramsM, _ := params.(map[string]interface{})
+	if honored, ok := paramsM["fallbackScsv"].(bool); ok {
+		return honored, nil
+	}
+	s.FallbackScsv.State = plugin.StateIsSet | plugin.StateIsNull
+	return false, nil
+}
+
+func (s *mqlTls) secureRenegotiation(params interface{}) (bool, error) {
+	paramsM, _ := params.(map[string]interface{})
+	res, _ := paramsM["secureRenegotiation"].(bool)
+	return res, nil
+}
+
+// newTlsTester creates a tester for the socket of the TLS connection
+func newTlsTester(socket *mqlSocket, domainName string, starttls string) *tlsshake.Tester {
+	tester := tlsshake.New(socket.Protocol.Data, domainName, socket.Address.Data, int(socket.Port.Data))
+	tester.StartTLS = starttls
+	return tester
+}
+
+// ocspFindings runs the OCSP stapling probe once for all fields that need it.
+// The probe requires a complete handshake, which is only tested via TCP.
+func (s *mqlTls) ocspFindings(socket *mqlSocket, domainName string, starttls string) (*tlsshake.Findings, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.ocsp != nil {
+		return s.ocsp, nil
+	}
+
+	tester := newTlsTester(socket, domainName, starttls)
+	if socket.Protocol.Data == "tcp" {
+		if err := tester.TestOCSPStapling(); err != nil {
+			return nil, errors.Wrap(err, "failed to test OCSP stapling")
+		}
+	}
+	s.ocsp = &tester.Findings
+	return s.ocsp, nil
+}
+
+func (s *mqlTls) ocspStapling(socket *mqlSocket, domainName string, starttls string) (bool, error) {
+	findings, err := s.ocspFindings(socket, domainName, starttls)
+	if err != nil {
+		return false, err
+	}
+	return len(findings.OCSPStaple) != 0, nil
+}
+
+func (s *mqlTls) ocspStatus(socket *mqlSocket, domainName string, starttls string) (string, error) {
+	findings, err := s.ocspFindings(socket, domainName, starttls)
+	if err != nil {
+		return "", err
+	}
+	if len(findings.OCSPStaple) == 0 || len(findings.Certificates) == 0 {
+		return "", nil
+	}
+
+	issuer := tlsshake.Issuer(findings.Certificates)
+	if issuer == nil {
+		log.Debug().Msg("network> failed to find the issuer of the certificate for the stapled OCSP response")
+		return tlsshake.OCSP_UNKNOWN, nil
+	}
+
+	status, err := tlsshake.OCSPStatus(findings.OCSPStaple, findings.Certificates[0], issuer)
+	if err != nil {
+		log.Debug().Err(err).Msg("network> failed to parse stapled OCSP response")
+		return tlsshake.OCSP_UNKNOWN, nil
+	}
+	return status, nil
+}
+
+// alpnProtocols needs one handshake per protocol, which is why it isn't part
+// of the default scan
+func (s *mqlTls) alpnProtocols(socket *mqlSocket, domainName string, starttls string) ([]interface{}, error) {
+	if socket.Protocol.Data != "tcp" {
+		return []interface{}{}, nil
+	}
+
+	tester := newTlsTester(socket, domainName, starttls)
+	tester.TestALPN(tlsshake.DefaultALPN)
+
+	res := make([]interface{}, len(tester.Findings.ALPN))
+	for i := range tester.Findings.ALPN {
+		res[i] = tester.Findings.ALPN[i]
+	}
+	return res, nil
+}
+
+func (s *mqlTls) chainValid(params interface{}) (bool, error) {
+	paramsM, _ := params.(map[string]interface{})
+	res, _ := paramsM["chainValid"].(bool)
+	return res, nil
+}
+
+func (s *mqlTls) chainError(params interface{}) (string, error) {
+	paramsM, _ := params.(map[string]interface{})
+	res, _ := paramsM["chainError"].(string)
+	return res, nil
+}
+
+func (s *mqlTls) grade(params interface{}) (string, error) {
+	paramsM, _ := params.(map[string]interface{})
+	res, _ := paramsM["grade"].(string)
+	return res, nil
+}
+
+func (s *mqlTls) gradeReasons(params interface{}) ([]interface{}, error) {
+	paramsM, _ := params.(map[string]interface{})
+	res, ok := paramsM["gradeReasons"].([]interface{})
+	if !ok {
+		return []interface{}{}, nil
+	}
+	return res, nil
+}
+
+func (s *mqlTlsCipher) id() (string, error) {
+	return "tls.cipher/" + s.Name.Data, nil
+}
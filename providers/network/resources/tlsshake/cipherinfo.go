@@ -0,0 +1,155 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tlsshake
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Cipher strength grades, modeled after https://ciphersuite.info
+const (
+	CIPHER_INSECURE    = "insecure"
+	CIPHER_WEAK        = "weak"
+	CIPHER_SECURE      = "secure"
+	CIPHER_RECOMMENDED = "recommended"
+)
+
+// CipherInfo describes the algorithms of a cipher suite
+type CipherInfo struct {
+	Name string
+	// KeyExchange is the key exchange algorithm, e.g. ECDHE or RSA. TLS 1.3
+	// cipher suites don't define it and use "any".
+	KeyExchange string
+	// Authentication is the authentication algorithm, e.g. RSA or ECDSA
+	Authentication string
+	// Encryption is the bulk encryption algorithm, e.g. AES_128_GCM
+	Encryption string
+	// Mac is the message authentication algorithm, or AEAD
+	Mac string
+	// Bits is the effective key size of the encryption
+	Bits           int
+	ForwardSecrecy bool
+	AEAD           bool
+	Export         bool
+	Grade          string
+}
+
+var cipherMacs = map[string]struct{}{
+	"MD5":    {},
+	"SHA":    {},
+	"SHA256": {},
+	"SHA384": {},
+	"SHA512": {},
+	"SM3":    {},
+	"NULL":   {},
+}
+
+// Cipher returns the properties of a cipher suite, which are derived from
+// its IANA name
+func Cipher(name string) CipherInfo {
+	res := CipherInfo{Name: name}
+
+	suite := name
+	for _, prefix := range []string{"SSL_CK_", "SSL_", "TLS_"} {
+		if strings.HasPrefix(suite, prefix) {
+			suite = strings.TrimPrefix(suite, prefix)
+			break
+		}
+	}
+	res.Export = strings.Contains(suite, "EXPORT")
+
+	var rest string
+	if strings.HasPrefix(name, "SSL_CK_") {
+		// SSLv2 cipher kinds always use RSA
+		res.KeyExchange = "RSA"
+		res.Authentication = "RSA"
+		rest = strings.Replace(suite, "_WITH_", "_", 1)
+	} else if kexAuth, enc, ok := strings.Cut(suite, "_WITH_"); ok {
+		parts := []string{}
+		for _, part := range strings.Split(kexAuth, "_") {
+			switch part {
+			case "EXPORT", "EXPORT1024", "FIPS", "SHA":
+			default:
+				parts = append(parts, part)
+			}
+		}
+		res.KeyExchange = parts[0]
+		res.Authentication = parts[len(parts)-1]
+		rest = enc
+	} else {
+		// TLS 1.3 cipher suites only define the encryption and hash,
+		// key exchange always uses (EC)DHE
+		res.KeyExchange = "any"
+		res.Authentication = "any"
+		rest = suite
+	}
+
+	tokens := strings.Split(rest, "_")
+	if len(tokens) > 1 {
+		if _, ok := cipherMacs[tokens[len(tokens)-1]]; ok {
+			res.Mac = tokens[len(tokens)-1]
+			tokens = tokens[:len(tokens)-1]
+		}
+	}
+	res.Encryption = strings.Join(tokens, "_")
+
+	res.AEAD = strings.Contains(res.Encryption, "GCM") ||
+		strings.Contains(res.Encryption, "CCM") ||
+		strings.Contains(res.Encryption, "POLY1305")
+	if res.AEAD {
+		res.Mac = "AEAD"
+	}
+
+	switch res.KeyExchange {
+	case "DHE", "ECDHE", "any":
+		res.ForwardSecrecy = true
+	}
+
+	res.Bits = cipherBits(res.Encryption)
+	res.Grade = cipherGrade(&res)
+	return res
+}
+
+func cipherBits(enc string) int {
+	switch {
+	case enc == "NULL":
+		return 0
+	case strings.Contains(enc, "3DES"), strings.Contains(enc, "EDE3"):
+		return 112
+	case strings.HasPrefix(enc, "DES40"):
+		return 40
+	case strings.HasPrefix(enc, "DES"):
+		return 56
+	case strings.HasPrefix(enc, "CHACHA20"):
+		return 256
+	case strings.HasPrefix(enc, "IDEA"), strings.HasPrefix(enc, "SEED"), strings.HasPrefix(enc, "SM4"):
+		return 128
+	}
+
+	for _, token := range strings.Split(enc, "_") {
+		if bits, err := strconv.Atoi(token); err == nil {
+			return bits
+		}
+	}
+	return 0
+}
+
+func cipherGrade(c *CipherInfo) string {
+	switch {
+	case c.Encryption == "NULL" || c.KeyExchange == "NULL" || c.Authentication == "anon":
+		return CIPHER_INSECURE
+	case c.Export || c.Mac == "MD5" || c.Bits < 112:
+		return CIPHER_INSECURE
+	case strings.HasPrefix(c.Encryption, "RC4"), strings.HasPrefix(c.Encryption, "RC2"):
+		return CIPHER_INSECURE
+	case !c.ForwardSecrecy || !c.AEAD || c.Bits < 128:
+		// includes 64-bit block ciphers like 3DES and IDEA
+		return CIPHER_WEAK
+	case (c.KeyExchange == "ECDHE" || c.KeyExchange == "any") && !strings.HasSuffix(c.Encryption, "CCM_8"):
+		return CIPHER_RECOMMENDED
+	default:
+		return CIPHER_SECURE
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tlsshake
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"errors"
+	"sort"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+)
+
+// OCSP statuses of stapled responses
+const (
+	OCSP_GOOD    = "good"
+	OCSP_REVOKED = "revoked"
+	OCSP_UNKNOWN = "unknown"
+)
+
+// OCSPStatus parses a stapled OCSP response and returns its status
+func OCSPStatus(staple []byte, cert *x509.Certificate, issuer *x509.Certificate) (string, error) {
+	if len(staple) == 0 {
+		return "", errors.New("no OCSP response was stapled")
+	}
+
+	res, err := ocsp.ParseResponseForCert(staple, cert, issuer)
+	if err != nil {
+		return "", err
+	}
+
+	switch res.Status {
+	case ocsp.Good:
+		return OCSP_GOOD, nil
+	case ocsp.Revoked:
+		return OCSP_REVOKED, nil
+	default:
+		return OCSP_UNKNOWN, nil
+	}
+}
+
+// Issuer returns the certificate of the chain that issued the leaf, which
+// is the first one. Servers may send the chain in any order, so the issuer
+// is the certificate whose subject matches the issuer of the leaf and that
+// signed it. It returns nil if the chain has no such certificate.
+func Issuer(certs []*x509.Certificate) *x509.Certificate {
+	if len(certs) < 2 {
+		return nil
+	}
+	leaf := certs[0]
+	for _, cert := range certs[1:] {
+		if !bytes.Equal(cert.RawSubject, leaf.RawIssuer) {
+			continue
+		}
+		if leaf.CheckSignatureFrom(cert) == nil {
+			return cert
+		}
+	}
+	return nil
+}
+
+// VerifyChain verifies the certificates of a server, leaf first, for the
+// given domain. If no roots are provided, the system roots are used.
+func VerifyChain(certs []*x509.Certificate, domainName string, roots *x509.CertPool) error {
+	if len(certs) == 0 {
+		return errors.New("no certificates found")
+	}
+
+	intermediates := x509.NewCertPool()
+	for i := 1; i < len(certs); i++ {
+		intermediates.AddCert(certs[i])
+	}
+
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		DNSName:       domainName,
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   time.Now(),
+	})
+	return err
+}
+
+// Grade rates the TLS configuration of a server, in the spirit of the
+// SSL Labs server rating guide:
+// https://github.com/ssllabs/research/wiki/SSL-Server-Rating-Guide
+//
+// The grade is A to F, or T if the certificate chain isn't trusted and M if
+// it doesn't match the domain. Reasons explain why a grade was capped.
+func Grade(findings *Findings, chainErr error) (string, []string) {
+	versions := findings.SupportedVersions()
+	if len(versions) == 0 {
+		return "", []string{"no TLS/SSL versions are supported"}
+	}
+
+	g := grader{grade: "A", reasons: []string{}}
+
+	ciphers := make([]CipherInfo, 0, len(findings.Ciphers))
+	for name, ok := range findings.Ciphers {
+		if ok {
+			ciphers = append(ciphers, Cipher(name))
+		}
+	}
+	sort.Slice(ciphers, func(i, j int) bool { return ciphers[i].Bits < ciphers[j].Bits })
+
+	// score protocols, key exchange and ciphers, weighted like SSL Labs
+	protocolScores := map[string]float64{"ssl3": 80, "tls1.0": 90, "tls1.1": 95, "tls1.2": 100, "tls1.3": 100}
+	protocolScore := (protocolScores[versions[0]] + protocolScores[versions[len(versions)-1]]) / 2
+	var cipherScore float64
+	if len(ciphers) != 0 {
+		cipherScore = (cipherBitsScore(ciphers[0].Bits) + cipherBitsScore(ciphers[len(ciphers)-1].Bits)) / 2
+	}
+	var score float64
+	if len(findings.Certificates) != 0 {
+		score = 0.3*protocolScore + 0.3*certKeyScore(findings.Certificates[0]) + 0.4*cipherScore
+	} else {
+		// the key can't be rated without certificates
+		score = (0.3*protocolScore + 0.4*cipherScore) / 0.7
+	}
+	switch {
+	case score >= 80:
+	case score >= 65:
+		g.cap("B", "overall score is below 80")
+	case score >= 50:
+		g.cap("C", "overall score is below 65")
+	case score >= 35:
+		g.cap("D", "overall score is below 50")
+	case score >= 20:
+		g.cap("E", "overall score is below 35")
+	default:
+		g.cap("F", "overall score is below 20")
+	}
+
+	hasVersion := map[string]bool{}
+	for _, version := range versions {
+		hasVersion[version] = true
+	}
+	if hasVersion["ssl3"] {
+		g.cap("C", "supports SSL 3")
+	}
+	if hasVersion["tls1.0"] || hasVersion["tls1.1"] {
+		g.cap("B", "supports TLS 1.0 or TLS 1.1")
+	}
+	if !hasVersion["tls1.2"] && !hasVersion["tls1.3"] {
+		g.cap("C", "doesn't support TLS 1.2 or TLS 1.3")
+	}
+
+	var forwardSecrecy, aead bool
+	for _, c := range ciphers {
+		switch {
+		case c.Authentication == "anon", c.Encryption == "NULL", c.Export:
+			g.cap("F", "supports anonymous, NULL or export ciphers: "+c.Name)
+		case c.Bits < 128, c.Grade == CIPHER_INSECURE:
+			// covers RC4, DES and 64-bit block ciphers like 3DES
+			g.cap("C", "supports insecure ciphers: "+c.Name)
+		}
+		forwardSecrecy = forwardSecrecy || c.ForwardSecrecy
+		aead = aead || c.AEAD
+	}
+	if len(ciphers) != 0 {
+		if !forwardSecrecy {
+			g.cap("B", "doesn't support forward secrecy")
+		}
+		if !aead {
+			g.cap("A-", "doesn't support AEAD ciphers")
+		}
+	}
+
+	if findings.FallbackSCSV != nil && !*findings.FallbackSCSV {
+		g.cap("A-", "doesn't protect against protocol downgrades (TLS_FALLBACK_SCSV)")
+	}
+	if !findings.SecureRenegotiation() {
+		g.cap("C", "doesn't support secure renegotiation")
+	}
+
+	if chainErr != nil {
+		var hostErr x509.HostnameError
+		if errors.As(chainErr, &hostErr) {
+			g.override("M", "certificate doesn't match the domain: "+chainErr.Error())
+		} else {
+			g.override("T", "certificate isn't trusted: "+chainErr.Error())
+		}
+	}
+
+	return g.grade, g.reasons
+}
+
+// gradeOrder ranks grades from best to worst
+var gradeOrder = map[string]int{"A": 0, "A-": 1, "B": 2, "C": 3, "D": 4, "E": 5, "F": 6}
+
+type grader struct {
+	grade   string
+	reasons []string
+}
+
+// cap limits the grade to the given one
+func (g *grader) cap(grade string, reason string) {
+	g.reasons = append(g.reasons, reason)
+	if gradeOrder[grade] > gradeOrder[g.grade] {
+		g.grade = grade
+	}
+}
+
+// override replaces the grade independent of any other score, it must be
+// called after all caps
+func (g *grader) override(grade string, reason string) {
+	g.reasons = append(g.reasons, reason)
+	g.grade = grade
+}
+
+func certKeyScore(cert *x509.Certificate) float64 {
+	var bits int
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		bits = key.N.BitLen()
+	case *ecdsa.PublicKey:
+		// EC keys are rated by their RSA equivalent strength
+		bits = key.Curve.Params().BitSize * 12
+	case ed25519.PublicKey:
+		bits = 3072
+	}
+
+	switch {
+	case bits < 512:
+		return 20
+	case bits < 1024:
+		return 40
+	case bits < 2048:
+		return 80
+	case bits < 4096:
+		return 90
+	default:
+		return 100
+	}
+}
+
+func cipherBitsScore(bits int) float64 {
+	switch {
+	case bits == 0:
+		return 0
+	case bits < 128:
+		return 20
+	case bits < 256:
+		return 80
+	default:
+		return 100
+	}
+}
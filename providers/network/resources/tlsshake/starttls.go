@@ -0,0 +1,369 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tlsshake
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Protocols that support upgrading plaintext connections via STARTTLS
+const (
+	STARTTLS_SMTP     = "smtp"
+	STARTTLS_IMAP     = "imap"
+	STARTTLS_POP3     = "pop3"
+	STARTTLS_FTP      = "ftp"
+	STARTTLS_XMPP     = "xmpp"
+	STARTTLS_LDAP     = "ldap"
+	STARTTLS_POSTGRES = "postgres"
+)
+
+var STARTTLS_PROTOCOLS = []string{
+	STARTTLS_SMTP, STARTTLS_IMAP, STARTTLS_POP3, STARTTLS_FTP,
+	STARTTLS_XMPP, STARTTLS_LDAP, STARTTLS_POSTGRES,
+}
+
+// STARTTLS_PORTS are well-known ports of plaintext protocols that are
+// upgraded via STARTTLS
+var STARTTLS_PORTS = map[int]string{
+	21:   STARTTLS_FTP,
+	25:   STARTTLS_SMTP,
+	110:  STARTTLS_POP3,
+	143:  STARTTLS_IMAP,
+	389:  STARTTLS_LDAP,
+	587:  STARTTLS_SMTP,
+	5222: STARTTLS_XMPP,
+	5432: STARTTLS_POSTGRES,
+}
+
+// startTLSTimeout limits the plaintext exchange before the TLS handshake
+const startTLSTimeout = 10 * time.Second
+
+// StartTLS upgrades a plaintext connection, after which the server expects
+// a TLS handshake
+func StartTLS(conn net.Conn, protocol string, domainName string) error {
+	if err := conn.SetDeadline(time.Now().Add(startTLSTimeout)); err != nil {
+		return err
+	}
+
+	// servers don't send anything after accepting the upgrade until they
+	// receive the client hello, which means we don't lose any buffered data
+	reader := bufio.NewReader(conn)
+
+	var err error
+	switch protocol {
+	case STARTTLS_SMTP:
+		err = startTLSSmtp(conn, reader)
+	case STARTTLS_IMAP:
+		err = startTLSImap(conn, reader)
+	case STARTTLS_POP3:
+		err = startTLSPop3(conn, reader)
+	case STARTTLS_FTP:
+		err = startTLSFtp(conn, reader)
+	case STARTTLS_XMPP:
+		err = startTLSXmpp(conn, reader, domainName)
+	case STARTTLS_LDAP:
+		err = startTLSLdap(conn, reader)
+	case STARTTLS_POSTGRES:
+		err = startTLSPostgres(conn, reader)
+	default:
+		err = errors.New("unsupported STARTTLS protocol: " + protocol)
+	}
+	if err != nil {
+		return errors.New(protocol + " STARTTLS failed: " + err.Error())
+	}
+
+	return conn.SetDeadline(time.Time{})
+}
+
+// readReply reads a reply with status code, which may span multiple lines
+// like "250-first\r\n250 last\r\n", as used by SMTP and FTP
+func readReply(reader *bufio.Reader) (string, []string, error) {
+	lines := []string{}
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return "", nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if len(line) < 3 {
+			return "", nil, errors.New("invalid reply: " + line)
+		}
+		lines = append(lines, line)
+		if len(line) == 3 || line[3] == ' ' {
+			return line[0:3], lines, nil
+		}
+	}
+}
+
+func expectReply(reader *bufio.Reader, code string) ([]string, error) {
+	got, lines, err := readReply(reader)
+	if err != nil {
+		return nil, err
+	}
+	if got != code {
+		return nil, errors.New("unexpected reply: " + strings.Join(lines, " "))
+	}
+	return lines, nil
+}
+
+// see https://datatracker.ietf.org/doc/html/rfc3207
+func startTLSSmtp(conn net.Conn, reader *bufio.Reader) error {
+	if _, err := expectReply(reader, "220"); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(conn, "EHLO cnquery\r\n"); err != nil {
+		return err
+	}
+	lines, err := expectReply(reader, "250")
+	if err != nil {
+		return err
+	}
+	supported := false
+	for _, line := range lines[1:] {
+		if len(line) > 4 && strings.EqualFold(strings.TrimSpace(line[4:]), "STARTTLS") {
+			supported = true
+		}
+	}
+	if !supported {
+		return errors.New("server doesn't support STARTTLS")
+	}
+
+	if _, err := io.WriteString(conn, "STARTTLS\r\n"); err != nil {
+		return err
+	}
+	_, err = expectReply(reader, "220")
+	return err
+}
+
+// see https://datatracker.ietf.org/doc/html/rfc4217
+func startTLSFtp(conn net.Conn, reader *bufio.Reader) error {
+	if _, err := expectReply(reader, "220"); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(conn, "AUTH TLS\r\n"); err != nil {
+		return err
+	}
+	_, err := expectReply(reader, "234")
+	return err
+}
+
+func readLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	return strings.TrimRight(line, "\r\n"), err
+}
+
+// see https://datatracker.ietf.org/doc/html/rfc3501#section-6.2.1
+func startTLSImap(conn net.Conn, reader *bufio.Reader) error {
+	greeting, err := readLine(reader)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(greeting, "* OK") {
+		return errors.New("unexpected greeting: " + greeting)
+	}
+
+	if _, err := io.WriteString(conn, "a1 STARTTLS\r\n"); err != nil {
+		return err
+	}
+	for {
+		line, err := readLine(reader)
+		if err != nil {
+			return err
+		}
+		// skip untagged responses
+		if !strings.HasPrefix(line, "a1 ") {
+			continue
+		}
+		if !strings.HasPrefix(line, "a1 OK") {
+			return errors.New("unexpected reply: " + line)
+		}
+		return nil
+	}
+}
+
+// see https://datatracker.ietf.org/doc/html/rfc2595#section-4
+func startTLSPop3(conn net.Conn, reader *bufio.Reader) error {
+	greeting, err := readLine(reader)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(greeting, "+OK") {
+		return errors.New("unexpected greeting: " + greeting)
+	}
+
+	if _, err := io.WriteString(conn, "STLS\r\n"); err != nil {
+		return err
+	}
+	line, err := readLine(reader)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(line, "+OK") {
+		return errors.New("unexpected reply: " + line)
+	}
+	return nil
+}
+
+// readUntil reads until one of the markers is found and returns everything
+// that was read
+func readUntil(reader *bufio.Reader, markers ...string) (string, error) {
+	var buf strings.Builder
+	for {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return buf.String(), err
+		}
+		buf.WriteByte(b)
+		if buf.Len() > maxStartTLSResponse {
+			return "", errors.New("response is too large")
+		}
+		for _, marker := range markers {
+			if strings.HasSuffix(buf.String(), marker) {
+				return buf.String(), nil
+			}
+		}
+	}
+}
+
+const maxStartTLSResponse = 64 * 1024
+
+// see https://datatracker.ietf.org/doc/html/rfc6120#section-5.4
+func startTLSXmpp(conn net.Conn, reader *bufio.Reader, domainName string) error {
+	_, err := io.WriteString(conn, "<?xml version='1.0'?><stream:stream to='"+domainName+
+		"' xmlns='jabber:client' xmlns:stream='http://etherx.jabber.org/streams' version='1.0'>")
+	if err != nil {
+		return err
+	}
+
+	features, err := readUntil(reader, "</stream:features>", "</stream:stream>")
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(features, "urn:ietf:params:xml:ns:xmpp-tls") {
+		return errors.New("server doesn't support STARTTLS")
+	}
+
+	if _, err := io.WriteString(conn, "<starttls xmlns='urn:ietf:params:xml:ns:xmpp-tls'/>"); err != nil {
+		return err
+	}
+	res, err := readUntil(reader, "/>", "</failure>")
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(res, "<proceed") {
+		return errors.New("unexpected reply: " + res)
+	}
+	return nil
+}
+
+// ldapStartTLSRequest is the StartTLS extended request with message ID 1
+// see https://datatracker.ietf.org/doc/html/rfc4511#section-4.14
+var ldapStartTLSRequest = append([]byte{
+	0x30, 0x1d, // LDAPMessage
+	0x02, 0x01, 0x01, // messageID 1
+	0x77, 0x18, // ExtendedRequest
+	0x80, 0x16, // requestName
+}, "1.3.6.1.4.1.1466.20037"...)
+
+// readBER reads a BER element and returns its tag and content
+func readBER(reader *bufio.Reader) (byte, []byte, error) {
+	tag, err := reader.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	l, err := reader.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	length := int(l)
+	if l&0x80 != 0 {
+		n := int(l & 0x7f)
+		if n == 0 || n > 4 {
+			return 0, nil, errors.New("unsupported BER length")
+		}
+		lenBytes := make([]byte, 4)
+		if _, err := io.ReadFull(reader, lenBytes[4-n:]); err != nil {
+			return 0, nil, err
+		}
+		length = int(binary.BigEndian.Uint32(lenBytes))
+	}
+	if length > maxStartTLSResponse {
+		return 0, nil, errors.New("response is too large")
+	}
+
+	content := make([]byte, length)
+	_, err = io.ReadFull(reader, content)
+	return tag, content, err
+}
+
+func startTLSLdap(conn net.Conn, reader *bufio.Reader) error {
+	if _, err := conn.Write(ldapStartTLSRequest); err != nil {
+		return err
+	}
+
+	tag, msg, err := readBER(reader)
+	if err != nil {
+		return err
+	}
+	if tag != 0x30 {
+		return errors.New("unexpected LDAP response")
+	}
+
+	// skip the message ID and read the ExtendedResponse
+	msgReader := bufio.NewReader(bytes.NewReader(msg))
+	if _, _, err := readBER(msgReader); err != nil {
+		return err
+	}
+	tag, res, err := readBER(msgReader)
+	if err != nil {
+		return err
+	}
+	if tag != 0x78 {
+		return errors.New("unexpected LDAP response")
+	}
+
+	// the result code is the first element of the response
+	tag, code, err := readBER(bufio.NewReader(bytes.NewReader(res)))
+	if err != nil {
+		return err
+	}
+	if tag != 0x0a || len(code) != 1 {
+		return errors.New("unexpected LDAP response")
+	}
+	if code[0] != 0 {
+		return errors.New("server rejected the request with LDAP result code " + strconv.Itoa(int(code[0])))
+	}
+	return nil
+}
+
+// see https://www.postgresql.org/docs/current/protocol-flow.html#PROTOCOL-FLOW-SSL
+func startTLSPostgres(conn net.Conn, reader *bufio.Reader) error {
+	// SSLRequest: length 8 and the SSL request code 80877103
+	if _, err := conn.Write([]byte{0, 0, 0, 8, 0x04, 0xd2, 0x16, 0x2f}); err != nil {
+		return err
+	}
+
+	res, err := reader.ReadByte()
+	if err != nil {
+		return err
+	}
+	switch res {
+	case 'S':
+		return nil
+	case 'N':
+		return errors.New("server doesn't support TLS")
+	default:
+		return errors.New("unexpected response")
+	}
+}
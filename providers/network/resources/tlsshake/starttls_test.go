@@ -0,0 +1,143 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tlsshake
+
+import (
+	"bufio"
+	"crypto/tls"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startTLSServer accepts connections, runs the plaintext script of the
+// protocol and then upgrades the connection to TLS
+func startTLSServer(t *testing.T, conf *tls.Config, script func(conn net.Conn, reader *bufio.Reader)) (string, int) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				script(conn, bufio.NewReader(conn))
+				tlsConn := tls.Server(conn, conf)
+				if tlsConn.Handshake() == nil {
+					tlsConn.Close()
+				}
+			}()
+		}
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port
+}
+
+func readUntilLine(reader *bufio.Reader, prefix string) {
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil || strings.HasPrefix(line, prefix) {
+			return
+		}
+	}
+}
+
+var startTLSScripts = map[string]func(conn net.Conn, reader *bufio.Reader){
+	STARTTLS_SMTP: func(conn net.Conn, reader *bufio.Reader) {
+		io.WriteString(conn, "220-mail.example.com ESMTP\r\n220 ready\r\n")
+		readUntilLine(reader, "EHLO")
+		io.WriteString(conn, "250-mail.example.com\r\n250-PIPELINING\r\n250 STARTTLS\r\n")
+		readUntilLine(reader, "STARTTLS")
+		io.WriteString(conn, "220 Go ahead\r\n")
+	},
+	STARTTLS_IMAP: func(conn net.Conn, reader *bufio.Reader) {
+		io.WriteString(conn, "* OK [CAPABILITY IMAP4rev1 STARTTLS] ready\r\n")
+		readUntilLine(reader, "a1 STARTTLS")
+		io.WriteString(conn, "a1 OK Begin TLS negotiation now\r\n")
+	},
+	STARTTLS_POP3: func(conn net.Conn, reader *bufio.Reader) {
+		io.WriteString(conn, "+OK POP3 ready\r\n")
+		readUntilLine(reader, "STLS")
+		io.WriteString(conn, "+OK Begin TLS negotiation\r\n")
+	},
+	STARTTLS_FTP: func(conn net.Conn, reader *bufio.Reader) {
+		io.WriteString(conn, "220 FTP ready\r\n")
+		readUntilLine(reader, "AUTH TLS")
+		io.WriteString(conn, "234 AUTH TLS successful\r\n")
+	},
+	STARTTLS_XMPP: func(conn net.Conn, reader *bufio.Reader) {
+		readUntil(reader, "version='1.0'>")
+		io.WriteString(conn, "<?xml version='1.0'?><stream:stream from='example.com' id='1' version='1.0' "+
+			"xmlns='jabber:client' xmlns:stream='http://etherx.jabber.org/streams'><stream:features>"+
+			"<starttls xmlns='urn:ietf:params:xml:ns:xmpp-tls'><required/></starttls></stream:features>")
+		readUntil(reader, "/>")
+		io.WriteString(conn, "<proceed xmlns='urn:ietf:params:xml:ns:xmpp-tls'/>")
+	},
+	STARTTLS_LDAP: func(conn net.Conn, reader *bufio.Reader) {
+		io.ReadFull(reader, make([]byte, len(ldapStartTLSRequest)))
+		// ExtendedResponse with resultCode success, empty matchedDN and diagnosticMessage
+		conn.Write([]byte{0x30, 0x0c, 0x02, 0x01, 0x01, 0x78, 0x07, 0x0a, 0x01, 0x00, 0x04, 0x00, 0x04, 0x00})
+	},
+	STARTTLS_POSTGRES: func(conn net.Conn, reader *bufio.Reader) {
+		io.ReadFull(reader, make([]byte, 8))
+		conn.Write([]byte("S"))
+	},
+}
+
+func TestStartTLS(t *testing.T) {
+	ca := newTestCert(t, "Test CA", nil)
+	leaf := newTestCert(t, "example.com", ca)
+	conf := &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{leaf.cert.Raw, ca.cert.Raw},
+			PrivateKey:  leaf.priv,
+		}},
+	}
+
+	for _, protocol := range STARTTLS_PROTOCOLS {
+		t.Run(protocol, func(t *testing.T) {
+			host, port := startTLSServer(t, conf, startTLSScripts[protocol])
+
+			tester := New("tcp", "example.com", host, port)
+			tester.StartTLS = protocol
+			state, err := tester.dialTLS(nil)
+			require.NoError(t, err)
+			require.NotEmpty(t, state.PeerCertificates)
+			assert.Equal(t, "example.com", state.PeerCertificates[0].Subject.CommonName)
+		})
+	}
+
+	t.Run("scan", func(t *testing.T) {
+		host, port := startTLSServer(t, conf, startTLSScripts[STARTTLS_SMTP])
+
+		tester := New("tcp", "example.com", host, port)
+		tester.StartTLS = STARTTLS_SMTP
+		require.NoError(t, tester.Test(DefaultScanConfig()))
+		assert.Contains(t, tester.Findings.SupportedVersions(), "tls1.2")
+		require.NotEmpty(t, tester.Findings.Certificates)
+		assert.Equal(t, "example.com", tester.Findings.Certificates[0].Subject.CommonName)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		host, port := startTLSServer(t, conf, func(conn net.Conn, reader *bufio.Reader) {
+			io.WriteString(conn, "220 ready\r\n")
+			readUntilLine(reader, "EHLO")
+			io.WriteString(conn, "250-mail.example.com\r\n250 PIPELINING\r\n")
+		})
+
+		tester := New("tcp", "example.com", host, port)
+		tester.StartTLS = STARTTLS_SMTP
+		_, err := tester.dialTLS(nil)
+		assert.EqualError(t, err, "smtp STARTTLS failed: server doesn't support STARTTLS")
+	})
+}
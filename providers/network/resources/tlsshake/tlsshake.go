@@ -8,6 +8,7 @@ package tlsshake
 import (
 	"bufio"
 	"bytes"
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/binary"
 	"encoding/hex"
@@ -32,17 +33,33 @@ type ScanConfig struct {
 	SNIsupported              bool
 	FakeSNI                   bool
 	SecureClientRenegotiation bool
+	// FallbackSCSV tests if the server rejects protocol downgrades
+	FallbackSCSV bool
+	// OCSPStapling tests if the server staples OCSP responses
+	OCSPStapling bool
+	// ALPN are the application protocols that are tested
+	ALPN []string
 
 	// internal scan fields that users don't configure
 	version       string
 	ciphersFilter func(string) bool
+	fallbackSCSV  bool
 }
 
+// DefaultALPN are commonly used application protocols
+// see https://www.iana.org/assignments/tls-extensiontype-values/tls-extensiontype-values.xhtml#alpn-protocol-ids
+var DefaultALPN = []string{"h2", "http/1.1", "http/1.0", "spdy/3.1", "acme-tls/1", "dot", "imap", "pop3", "ftp", "xmpp-client"}
+
+// dialTimeout limits connection attempts of the probes that use the
+// standard TLS client
+const dialTimeout = 10 * time.Second
+
 func DefaultScanConfig() ScanConfig {
 	return ScanConfig{
 		SNIsupported:              true,
 		FakeSNI:                   true,
 		SecureClientRenegotiation: true,
+		FallbackSCSV:              true,
 	}
 }
 
@@ -50,7 +67,11 @@ func DefaultScanConfig() ScanConfig {
 // session of tests. We re-use it to avoid duplicate requests and optimize
 // the overall test run.
 type Tester struct {
-	Findings   Findings
+	Findings Findings
+	// StartTLS is the protocol that is used to upgrade plaintext connections
+	// before the handshake, see STARTTLS_PROTOCOLS. Leave empty to connect
+	// via TLS directly.
+	StartTLS   string
 	sync       sync.Mutex
 	proto      string
 	target     string
@@ -66,6 +87,36 @@ type Findings struct {
 	Certificates       []*x509.Certificate
 	NonSNIcertificates []*x509.Certificate
 	Revocations        map[string]*revocation
+	// FallbackSCSV is set if the server rejects protocol downgrades as
+	// defined in RFC 7507. It is nil if this couldn't be tested, e.g. because
+	// the server supports less than two versions.
+	FallbackSCSV *bool
+	// OCSPStaple is the OCSP response the server staples to its certificate
+	OCSPStaple []byte
+	// ALPN are the application protocols the server negotiates
+	ALPN []string
+}
+
+// SupportedVersions returns all supported versions, from oldest to newest
+func (f *Findings) SupportedVersions() []string {
+	res := []string{}
+	for _, version := range TLS_VERSIONS {
+		if f.Versions[version] {
+			res = append(res, version)
+		}
+	}
+	return res
+}
+
+// SecureRenegotiation returns true if the server supports secure
+// renegotiation (RFC 5746) or doesn't support renegotiation at all,
+// which is the case for TLS 1.3
+func (f *Findings) SecureRenegotiation() bool {
+	if f.Extensions["renegotiation_info"] {
+		return true
+	}
+	versions := f.SupportedVersions()
+	return len(versions) == 1 && versions[0] == "tls1.3"
 }
 
 type revocation struct {
@@ -174,15 +225,141 @@ func (s *Tester) Test(conf ScanConfig) error {
 
 	workers.Wait()
 
+	if conf.FallbackSCSV {
+		if err := s.testFallbackSCSV(); err != nil {
+			errs.Add(err)
+		}
+	}
+	if s.proto == "tcp" {
+		s.testClientFeatures(conf)
+	}
+
 	return errs.Deduplicate()
 }
 
+// testFallbackSCSV connects with the second highest version of the server
+// and signals that this is a downgrade via TLS_FALLBACK_SCSV. Servers that
+// protect against downgrades reject the connection.
+// see https://datatracker.ietf.org/doc/html/rfc7507
+func (s *Tester) testFallbackSCSV() error {
+	versions := s.Findings.SupportedVersions()
+	if len(versions) < 2 {
+		return nil
+	}
+
+	_, err := s.testTLS(s.proto, s.target, &ScanConfig{
+		version:       versions[len(versions)-2],
+		ciphersFilter: func(string) bool { return true },
+		fallbackSCSV:  true,
+	})
+	return err
+}
+
+func (s *Tester) setFallbackSCSV(honored bool) {
+	s.sync.Lock()
+	s.Findings.FallbackSCSV = &honored
+	s.sync.Unlock()
+}
+
+// dial connects to the target, the connection is ready for the TLS handshake
+func (s *Tester) dial(proto string, target string) (net.Conn, error) {
+	conn, err := net.DialTimeout(proto, target, dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.StartTLS != "" {
+		if err := StartTLS(conn, s.StartTLS, s.domainName); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// dialTLS connects via the standard TLS client, which is used for features
+// that require a complete handshake
+func (s *Tester) dialTLS(nextProtos []string) (*tls.ConnectionState, error) {
+	rawConn, err := s.dial(s.proto, s.target)
+	if err != nil {
+		return nil, err
+	}
+	defer rawConn.Close()
+
+	conn := tls.Client(rawConn, &tls.Config{
+		ServerName: s.domainName,
+		// certificates are verified separately, we want to learn about the
+		// server's features even if they are invalid
+		InsecureSkipVerify: true,
+		MinVersion:         tls.VersionTLS10,
+		NextProtos:         nextProtos,
+	})
+	if err := conn.SetDeadline(time.Now().Add(dialTimeout)); err != nil {
+		return nil, err
+	}
+	if err := conn.Handshake(); err != nil {
+		return nil, err
+	}
+
+	state := conn.ConnectionState()
+	return &state, nil
+}
+
+// testClientFeatures tests OCSP stapling and the ALPN protocols of the config
+func (s *Tester) testClientFeatures(conf ScanConfig) {
+	// certificates are encrypted in TLS 1.3 handshakes, which means we can't
+	// collect them for servers that only support it
+	s.sync.Lock()
+	missingCertificates := len(s.Findings.Certificates) == 0
+	s.sync.Unlock()
+
+	if conf.OCSPStapling || missingCertificates {
+		if err := s.TestOCSPStapling(); err != nil {
+			s.addError("failed to test OCSP stapling: " + err.Error())
+		}
+	}
+
+	s.TestALPN(conf.ALPN)
+}
+
+// TestOCSPStapling completes a handshake to find the OCSP response that the
+// server staples to its certificate. It also collects the certificates.
+func (s *Tester) TestOCSPStapling() error {
+	state, err := s.dialTLS(nil)
+	if err != nil {
+		return err
+	}
+
+	s.sync.Lock()
+	s.Findings.OCSPStaple = state.OCSPResponse
+	if len(s.Findings.Certificates) == 0 {
+		s.Findings.Certificates = state.PeerCertificates
+	}
+	s.sync.Unlock()
+	return nil
+}
+
+// TestALPN tests which of the application protocols the server negotiates,
+// with one handshake per protocol
+func (s *Tester) TestALPN(protos []string) {
+	for _, proto := range protos {
+		// servers may abort the handshake if they don't support the protocol
+		state, err := s.dialTLS([]string{proto})
+		if err != nil || state.NegotiatedProtocol != proto {
+			continue
+		}
+		s.sync.Lock()
+		s.Findings.ALPN = append(s.Findings.ALPN, proto)
+		s.sync.Unlock()
+	}
+}
+
 // Attempts to connect to an endpoint with a given version and records
 // results in the Tester.
 // Returns the number of remaining ciphers to test (if so desired)
 // and any potential error
 func (s *Tester) testTLS(proto string, target string, conf *ScanConfig) (int, error) {
-	conn, err := net.Dial(proto, target)
+	conn, err := s.dial(proto, target)
 	if err != nil {
 		return 0, multierr.Wrap(err, "failed to connect to target")
 	}
@@ -228,6 +405,13 @@ func (s *Tester) parseAlert(data []byte, conf *ScanConfig) error {
 		description = "cannot find description"
 	}
 
+	if conf.fallbackSCSV {
+		if description == "INAPPROPRIATE_FALLBACK" {
+			s.setFallbackSCSV(true)
+		}
+		return nil
+	}
+
 	switch description {
 	case "PROTOCOL_VERSION":
 		// here we know the TLS version is not supported
@@ -401,6 +585,12 @@ func (s *Tester) parseHandshake(data []byte, version string, conf *ScanConfig) (
 	handshakeType := data[0]
 	handshakeLen := bytes3int(data[1:4])
 
+	if conf.fallbackSCSV && handshakeType == HANDSHAKE_TYPE_ServerHello {
+		// the server accepted the downgrade, we don't need anything else
+		s.setFallbackSCSV(false)
+		return true, nil
+	}
+
 	switch handshakeType {
 	case HANDSHAKE_TYPE_ServerHello:
 		err := s.parseServerHello(data[4:4+handshakeLen], version, conf)
@@ -633,6 +823,10 @@ func (s *Tester) helloTLSMsg(conf *ScanConfig) ([]byte, int, error) {
 		return nil, 0, errors.New("unsupported TLS/SSL version: " + conf.version)
 	}
 
+	if conf.fallbackSCSV {
+		ciphers = append(ciphers, CIPHER_FallbackSCSV...)
+	}
+
 	return constructTLSHello(conf.version, ciphers, extensions.Bytes()), cipherCount, nil
 }
 
@@ -838,6 +1032,10 @@ const (
 	EXTENSION_ServerName        string = "\x00\x00"
 	EXTENSION_SupportedVersions string = "\x00\x2b"
 	EXTENSION_RenegotiationInfo string = "\xff\x01"
+
+	// TLS_FALLBACK_SCSV signaling cipher suite value
+	// https://datatracker.ietf.org/doc/html/rfc7507#section-2
+	CIPHER_FallbackSCSV string = "\x56\x00"
 )
 
 // https://tools.ietf.org/html/rfc5246#appendix-A.3
@@ -0,0 +1,208 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tlsshake
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	priv crypto.Signer
+}
+
+func newTestCert(t *testing.T, name string, parent *testCert) *testCert {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	issuer, signer := tmpl, crypto.Signer(priv)
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		tmpl.DNSNames = []string{name}
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		issuer, signer = parent.cert, parent.priv
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, issuer, priv.Public(), signer)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCert{cert: cert, priv: priv}
+}
+
+func TestScanner(t *testing.T) {
+	ca := newTestCert(t, "Test CA", nil)
+	leaf := newTestCert(t, "example.com", ca)
+	staple, err := ocsp.CreateResponse(ca.cert, ca.cert, ocsp.Response{
+		Status:       ocsp.Good,
+		SerialNumber: leaf.cert.SerialNumber,
+		ThisUpdate:   time.Now().Add(-time.Hour),
+		NextUpdate:   time.Now().Add(time.Hour),
+	}, ca.priv)
+	require.NoError(t, err)
+
+	srv := httptest.NewUnstartedServer(http.NotFoundHandler())
+	srv.TLS = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"h2", "http/1.1"},
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{leaf.cert.Raw, ca.cert.Raw},
+			PrivateKey:  leaf.priv,
+			OCSPStaple:  staple,
+		}},
+	}
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.StartTLS()
+	defer srv.Close()
+
+	host, portStr, err := net.SplitHostPort(srv.Listener.Addr().String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+
+	tester := New("tcp", "example.com", host, port)
+	require.NoError(t, tester.Test(DefaultScanConfig()))
+	findings := tester.Findings
+
+	assert.Equal(t, []string{"tls1.2", "tls1.3"}, findings.SupportedVersions())
+	require.NotNil(t, findings.FallbackSCSV)
+	assert.True(t, *findings.FallbackSCSV)
+	assert.True(t, findings.SecureRenegotiation())
+	// ALPN and OCSP stapling are only tested on request
+	assert.Empty(t, findings.ALPN)
+	assert.Empty(t, findings.OCSPStaple)
+	require.Len(t, findings.Certificates, 2)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	require.NoError(t, VerifyChain(findings.Certificates, "example.com", roots))
+
+	grade, reasons := Grade(&findings, nil)
+	assert.Equal(t, "A", grade)
+	assert.Empty(t, reasons)
+
+	t.Run("untrusted chain", func(t *testing.T) {
+		err := VerifyChain(findings.Certificates, "example.com", x509.NewCertPool())
+		require.Error(t, err)
+		grade, _ := Grade(&findings, err)
+		assert.Equal(t, "T", grade)
+
+		err = VerifyChain(findings.Certificates, "other.com", roots)
+		require.Error(t, err)
+		grade, _ = Grade(&findings, err)
+		assert.Equal(t, "M", grade)
+	})
+
+	t.Run("client features", func(t *testing.T) {
+		tester := New("tcp", "example.com", host, port)
+		require.NoError(t, tester.TestOCSPStapling())
+		tester.TestALPN(DefaultALPN)
+		findings := tester.Findings
+
+		assert.Equal(t, []string{"h2", "http/1.1"}, findings.ALPN)
+		require.Len(t, findings.Certificates, 2)
+		status, err := OCSPStatus(findings.OCSPStaple, findings.Certificates[0], Issuer(findings.Certificates))
+		require.NoError(t, err)
+		assert.Equal(t, OCSP_GOOD, status)
+	})
+}
+
+func TestIssuer(t *testing.T) {
+	root := newTestCert(t, "root", nil)
+	other := newTestCert(t, "other", nil)
+	leaf := newTestCert(t, "example.com", root)
+
+	assert.Nil(t, Issuer([]*x509.Certificate{leaf.cert}))
+	assert.Equal(t, root.cert, Issuer([]*x509.Certificate{leaf.cert, root.cert}))
+	assert.Equal(t, root.cert, Issuer([]*x509.Certificate{leaf.cert, other.cert, root.cert}))
+	assert.Nil(t, Issuer([]*x509.Certificate{leaf.cert, other.cert}))
+}
+
+func TestGrade(t *testing.T) {
+	findings := &Findings{
+		Versions: map[string]bool{"tls1.0": true, "tls1.2": true},
+		Ciphers: map[string]bool{
+			"TLS_RSA_WITH_3DES_EDE_CBC_SHA":         true,
+			"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256": true,
+		},
+		Extensions: map[string]bool{"renegotiation_info": true},
+	}
+	grade, reasons := Grade(findings, nil)
+	assert.Equal(t, "C", grade)
+	assert.Contains(t, reasons, "supports TLS 1.0 or TLS 1.1")
+	assert.Contains(t, reasons, "supports insecure ciphers: TLS_RSA_WITH_3DES_EDE_CBC_SHA")
+
+	findings.Ciphers["TLS_RSA_EXPORT_WITH_RC4_40_MD5"] = true
+	grade, _ = Grade(findings, nil)
+	assert.Equal(t, "F", grade)
+}
+
+func TestCipher(t *testing.T) {
+	assert.Equal(t, CipherInfo{
+		Name:           "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+		KeyExchange:    "ECDHE",
+		Authentication: "RSA",
+		Encryption:     "AES_128_GCM",
+		Mac:            "AEAD",
+		Bits:           128,
+		ForwardSecrecy: true,
+		AEAD:           true,
+		Grade:          CIPHER_RECOMMENDED,
+	}, Cipher("TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"))
+
+	assert.Equal(t, CipherInfo{
+		Name:           "TLS_CHACHA20_POLY1305_SHA256",
+		KeyExchange:    "any",
+		Authentication: "any",
+		Encryption:     "CHACHA20_POLY1305",
+		Mac:            "AEAD",
+		Bits:           256,
+		ForwardSecrecy: true,
+		AEAD:           true,
+		Grade:          CIPHER_RECOMMENDED,
+	}, Cipher("TLS_CHACHA20_POLY1305_SHA256"))
+
+	c := Cipher("TLS_RSA_WITH_AES_256_CBC_SHA")
+	assert.Equal(t, "SHA", c.Mac)
+	assert.Equal(t, CIPHER_WEAK, c.Grade)
+
+	c = Cipher("TLS_DH_anon_EXPORT_WITH_DES40_CBC_SHA")
+	assert.Equal(t, "DH", c.KeyExchange)
+	assert.Equal(t, "anon", c.Authentication)
+	assert.True(t, c.Export)
+	assert.Equal(t, CIPHER_INSECURE, c.Grade)
+
+	c = Cipher("SSL_CK_RC4_128_WITH_MD5")
+	assert.Equal(t, "RSA", c.KeyExchange)
+	assert.Equal(t, "RC4_128", c.Encryption)
+	assert.Equal(t, CIPHER_INSECURE, c.Grade)
+}
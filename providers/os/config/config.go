@@ -6,6 +6,7 @@ package config
 import (
 	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
 	"go.mondoo.com/cnquery/v9/providers/os/provider"
+	"go.mondoo.com/cnquery/v9/providers/os/resources"
 	"go.mondoo.com/cnquery/v9/providers/os/resources/discovery/docker_engine"
 )
 
@@ -25,6 +26,7 @@ var Config = plugin.Provider{
 		provider.ContainerRegistryConnectionType,
 		provider.RegistryImageConnectionType,
 		provider.FilesystemConnectionType,
+		provider.K8sNodeConnectionType,
 	},
 	Connectors: []plugin.Connector{
 		{
@@ -36,6 +38,7 @@ var Config = plugin.Provider{
 			Discovery: []string{
 				docker_engine.DiscoveryContainerRunning,
 				docker_engine.DiscoveryContainerImages,
+				resources.DiscoveryCertificates,
 			},
 			Flags: []plugin.Flag{
 				{
@@ -60,6 +63,9 @@ var Config = plugin.Provider{
 			Short:   "a remote system via SSH",
 			MinArgs: 1,
 			MaxArgs: 1,
+			Discovery: []string{
+				resources.DiscoveryCertificates,
+			},
 			Flags: []plugin.Flag{
 				{
 					Long:    "sudo",
@@ -0,0 +1,36 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connection
+
+import (
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers/os/connection/shared"
+)
+
+var _ shared.Connection = &CertificateConnection{}
+
+// CertificateConnection is the connection of a certificate that was
+// discovered on a system. It reads the certificate via the connection of
+// the system instead of connecting to the system again.
+type CertificateConnection struct {
+	shared.Connection
+	id    uint32
+	asset *inventory.Asset
+}
+
+func NewCertificateConnection(id uint32, asset *inventory.Asset, parent shared.Connection) *CertificateConnection {
+	return &CertificateConnection{
+		Connection: parent,
+		id:         id,
+		asset:      asset,
+	}
+}
+
+func (c *CertificateConnection) ID() uint32 {
+	return c.id
+}
+
+func (c *CertificateConnection) Asset() *inventory.Asset {
+	return c.asset
+}
@@ -5,7 +5,6 @@ package image
 
 import (
 	"crypto/tls"
-	"fmt"
 	"io"
 	"net"
 	"net/http"
@@ -17,6 +16,7 @@ import (
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/rs/zerolog/log"
 )
 
 // Option is a functional option
@@ -26,6 +26,7 @@ type Option func(*options) error
 type options struct {
 	insecure bool
 	auth     authn.Authenticator
+	platform *v1.Platform
 }
 
 func WithInsecure(insecure bool) Option {
@@ -42,67 +43,40 @@ func WithAuthenticator(auth authn.Authenticator) Option {
 	}
 }
 
-func GetImageDescriptor(ref name.Reference, opts ...Option) (*remote.Descriptor, error) {
-	o := &options{
-		insecure: false,
-	}
-
-	for _, option := range opts {
-		if err := option(o); err != nil {
-			return nil, err
-		}
-	}
-
-	if o.auth == nil {
-		kc := authn.NewMultiKeychain(
-			authn.DefaultKeychain,
-		)
-		if strings.Contains(ref.Name(), ".ecr.") {
-			kc = authn.NewMultiKeychain(
-				authn.DefaultKeychain,
-				authn.NewKeychainFromHelper(ecr.NewECRHelper()),
-			)
-		}
-		auth, err := kc.Resolve(ref.Context())
-		if err != nil {
-			fmt.Printf("getting creds for %q: %v", ref, err)
-			return nil, err
-		}
-		o.auth = auth
+// WithPlatform sets the platform that is resolved for multi-platform images
+func WithPlatform(platform v1.Platform) Option {
+	return func(o *options) error {
+		o.platform = &platform
+		return nil
 	}
-
-	return remote.Get(ref, remote.WithAuth(o.auth))
 }
 
-func LoadImageFromRegistry(ref name.Reference, opts ...Option) (v1.Image, io.ReadCloser, error) {
-	o := &options{
-		insecure: false,
-	}
-
-	for _, option := range opts {
-		if err := option(o); err != nil {
-			return nil, nil, err
-		}
+// resolveAuth looks up the credentials for the registry of the image, unless an
+// authenticator is set
+func (o *options) resolveAuth(ref name.Reference) error {
+	if o.auth != nil {
+		return nil
 	}
 
-	if o.auth == nil {
-		kc := authn.NewMultiKeychain(
+	kc := authn.NewMultiKeychain(
+		authn.DefaultKeychain,
+	)
+	if strings.Contains(ref.Name(), ".ecr.") {
+		kc = authn.NewMultiKeychain(
 			authn.DefaultKeychain,
+			authn.NewKeychainFromHelper(ecr.NewECRHelper()),
 		)
-		if strings.Contains(ref.Name(), ".ecr.") {
-			kc = authn.NewMultiKeychain(
-				authn.DefaultKeychain,
-				authn.NewKeychainFromHelper(ecr.NewECRHelper()),
-			)
-		}
-		auth, err := kc.Resolve(ref.Context())
-		if err != nil {
-			fmt.Printf("getting creds for %q: %v", ref, err)
-			return nil, nil, err
-		}
-		o.auth = auth
 	}
+	auth, err := kc.Resolve(ref.Context())
+	if err != nil {
+		log.Debug().Err(err).Str("image", ref.String()).Msg("could not get credentials for registry")
+		return err
+	}
+	o.auth = auth
+	return nil
+}
 
+func (o *options) transport() http.RoundTripper {
 	// mimic http.DefaultTransport
 	tr := &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
@@ -123,8 +97,43 @@ func LoadImageFromRegistry(ref name.Reference, opts ...Option) (v1.Image, io.Rea
 			InsecureSkipVerify: true,
 		}
 	}
+	return tr
+}
+
+func GetImageDescriptor(ref name.Reference, opts ...Option) (*remote.Descriptor, error) {
+	o := &options{
+		insecure: false,
+	}
+
+	for _, option := range opts {
+		if err := option(o); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := o.resolveAuth(ref); err != nil {
+		return nil, err
+	}
+
+	return remote.Get(ref, remote.WithAuth(o.auth))
+}
+
+func LoadImageFromRegistry(ref name.Reference, opts ...Option) (v1.Image, io.ReadCloser, error) {
+	o := &options{
+		insecure: false,
+	}
+
+	for _, option := range opts {
+		if err := option(o); err != nil {
+			return nil, nil, err
+		}
+	}
 
-	img, err := remote.Image(ref, remote.WithAuth(o.auth), remote.WithTransport(tr))
+	if err := o.resolveAuth(ref); err != nil {
+		return nil, nil, err
+	}
+
+	img, err := remote.Image(ref, remote.WithAuth(o.auth), remote.WithTransport(o.transport()))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -138,3 +147,81 @@ func LoadImageFromRegistry(ref name.Reference, opts ...Option) (v1.Image, io.Rea
 
 	return img, f, nil
 }
+
+// ImageMetadata is the manifest and config of an image and the artifacts that refer to it
+type ImageMetadata struct {
+	// Digest of the image manifest. For multi-platform images, it is the manifest of
+	// the platform set via WithPlatform, or of linux/amd64 if none is set.
+	Digest v1.Hash
+	// IndexDigest is the digest of the image index of multi-platform images
+	IndexDigest *v1.Hash
+	Manifest    *v1.Manifest
+	Config      *v1.ConfigFile
+	// Referrers are artifacts like SBOMs, signatures, and attestations that refer to the
+	// image via the OCI referrers API
+	Referrers []v1.Descriptor
+}
+
+// LoadImageMetadata fetches the manifest, config, and referrers of an image from its
+// registry. Unlike LoadImageFromRegistry, it does not download any layers.
+func LoadImageMetadata(ref name.Reference, opts ...Option) (*ImageMetadata, error) {
+	o := &options{
+		insecure: false,
+	}
+
+	for _, option := range opts {
+		if err := option(o); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := o.resolveAuth(ref); err != nil {
+		return nil, err
+	}
+	remoteOpts := []remote.Option{remote.WithAuth(o.auth), remote.WithTransport(o.transport())}
+	if o.platform != nil {
+		remoteOpts = append(remoteOpts, remote.WithPlatform(*o.platform))
+	}
+
+	desc, err := remote.Get(ref, remoteOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ImageMetadata{}
+	if desc.MediaType.IsIndex() {
+		res.IndexDigest = &desc.Digest
+	}
+
+	// resolves the platform of multi-platform images
+	img, err := desc.Image()
+	if err != nil {
+		return nil, err
+	}
+	if res.Digest, err = img.Digest(); err != nil {
+		return nil, err
+	}
+	if res.Manifest, err = img.Manifest(); err != nil {
+		return nil, err
+	}
+	if res.Config, err = img.ConfigFile(); err != nil {
+		return nil, err
+	}
+
+	// registries without the referrers API fall back to the referrers tag schema,
+	// which some registries reject, so images without referrers are still usable
+	res.Referrers = []v1.Descriptor{}
+	index, err := remote.Referrers(ref.Context().Digest(res.Digest.String()), remoteOpts...)
+	if err != nil {
+		log.Debug().Err(err).Str("image", ref.String()).Msg("could not fetch referrers of image")
+		return res, nil
+	}
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		log.Debug().Err(err).Str("image", ref.String()).Msg("could not fetch referrers of image")
+		return res, nil
+	}
+	res.Referrers = indexManifest.Manifests
+
+	return res, nil
+}
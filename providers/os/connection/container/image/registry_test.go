@@ -0,0 +1,172 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package image
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/partial"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadImageMetadata(t *testing.T) {
+	var lock sync.Mutex
+	var requests []string
+	handler := registry.New(registry.WithReferrersSupport(true))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests = append(requests, r.URL.Path)
+		lock.Unlock()
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	img, err := random.Image(1024, 2)
+	require.NoError(t, err)
+	cfg, err := img.ConfigFile()
+	require.NoError(t, err)
+	cfg = cfg.DeepCopy()
+	cfg.Config.Labels = map[string]string{"org.opencontainers.image.source": "https://github.com/mondoohq/cnquery"}
+	cfg.Config.User = "1000"
+	cfg.Config.ExposedPorts = map[string]struct{}{"8080/tcp": {}}
+	cfg.Config.Entrypoint = []string{"/app"}
+	img, err = mutate.ConfigFile(img, cfg)
+	require.NoError(t, err)
+	img = mutate.Annotations(img, map[string]string{"org.opencontainers.image.base.name": "docker.io/library/alpine:3.18"}).(v1.Image)
+
+	ref, err := name.ParseReference(strings.TrimPrefix(server.URL, "http://") + "/app:1.0")
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	mediaType, err := img.MediaType()
+	require.NoError(t, err)
+	size, err := img.Size()
+	require.NoError(t, err)
+
+	// an SBOM that refers to the image
+	sbom, err := random.Image(64, 1)
+	require.NoError(t, err)
+	sbom = mutate.ConfigMediaType(sbom, "application/spdx+json")
+	sbom = mutate.Subject(sbom, v1.Descriptor{MediaType: mediaType, Digest: digest, Size: size}).(v1.Image)
+	sbomDigest, err := sbom.Digest()
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref.Context().Digest(sbomDigest.String()), sbom))
+
+	lock.Lock()
+	requests = nil
+	lock.Unlock()
+
+	metadata, err := LoadImageMetadata(ref, WithAuthenticator(authn.Anonymous))
+	require.NoError(t, err)
+	assert.Equal(t, digest, metadata.Digest)
+	assert.Nil(t, metadata.IndexDigest)
+	assert.Equal(t, "docker.io/library/alpine:3.18", metadata.Manifest.Annotations["org.opencontainers.image.base.name"])
+	assert.Equal(t, "1000", metadata.Config.Config.User)
+	assert.Equal(t, []string{"/app"}, metadata.Config.Config.Entrypoint)
+	assert.Equal(t, "https://github.com/mondoohq/cnquery", metadata.Config.Config.Labels["org.opencontainers.image.source"])
+	require.Len(t, metadata.Referrers, 1)
+	assert.Equal(t, sbomDigest, metadata.Referrers[0].Digest)
+	assert.Equal(t, "application/spdx+json", metadata.Referrers[0].ArtifactType)
+
+	// only the config is downloaded, not the layers
+	layers, err := img.Layers()
+	require.NoError(t, err)
+	for _, layer := range layers {
+		layerDigest, err := layer.Digest()
+		require.NoError(t, err)
+		for _, req := range requests {
+			assert.NotContains(t, req, layerDigest.String())
+		}
+	}
+}
+
+func TestLoadImageMetadataForPlatform(t *testing.T) {
+	server := httptest.NewServer(registry.New(registry.WithReferrersSupport(true)))
+	defer server.Close()
+
+	amd64, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	arm64, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	index := mutate.AppendManifests(empty.Index,
+		mutate.IndexAddendum{Add: amd64, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "amd64"}}},
+		mutate.IndexAddendum{Add: arm64, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "arm64"}}},
+	)
+
+	ref, err := name.ParseReference(strings.TrimPrefix(server.URL, "http://") + "/app:1.0")
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(ref, index))
+	indexDigest, err := index.Digest()
+	require.NoError(t, err)
+	amd64Digest, err := amd64.Digest()
+	require.NoError(t, err)
+	arm64Digest, err := arm64.Digest()
+	require.NoError(t, err)
+
+	// a signature that refers to the arm64 image
+	arm64Desc, err := partial.Descriptor(arm64)
+	require.NoError(t, err)
+	sig, err := random.Image(64, 1)
+	require.NoError(t, err)
+	sig = mutate.ConfigMediaType(sig, "application/vnd.dev.cosign.artifact.sig.v1+json")
+	sig = mutate.Subject(sig, *arm64Desc).(v1.Image)
+	sigDigest, err := sig.Digest()
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref.Context().Digest(sigDigest.String()), sig))
+
+	metadata, err := LoadImageMetadata(ref, WithAuthenticator(authn.Anonymous))
+	require.NoError(t, err)
+	assert.Equal(t, amd64Digest, metadata.Digest)
+	assert.Equal(t, indexDigest, *metadata.IndexDigest)
+	assert.Empty(t, metadata.Referrers)
+
+	metadata, err = LoadImageMetadata(ref, WithAuthenticator(authn.Anonymous), WithPlatform(v1.Platform{OS: "linux", Architecture: "arm64"}))
+	require.NoError(t, err)
+	assert.Equal(t, arm64Digest, metadata.Digest)
+	assert.Equal(t, indexDigest, *metadata.IndexDigest)
+	require.Len(t, metadata.Referrers, 1)
+	assert.Equal(t, sigDigest, metadata.Referrers[0].Digest)
+	assert.Equal(t, "application/vnd.dev.cosign.artifact.sig.v1+json", metadata.Referrers[0].ArtifactType)
+}
+
+func TestLoadImageMetadataWithoutReferrers(t *testing.T) {
+	// a registry that fails for the referrers API and the referrers tag schema
+	handler := registry.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/referrers/") || strings.Contains(r.URL.Path, "/manifests/sha256-") {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	ref, err := name.ParseReference(strings.TrimPrefix(server.URL, "http://") + "/app:1.0")
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+
+	metadata, err := LoadImageMetadata(ref, WithAuthenticator(authn.Anonymous))
+	require.NoError(t, err)
+	assert.Equal(t, digest, metadata.Digest)
+	assert.Empty(t, metadata.Referrers)
+}
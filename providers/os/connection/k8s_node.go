@@ -0,0 +1,153 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connection
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers/os/connection/k8s_node"
+	"go.mondoo.com/cnquery/v9/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v9/providers/os/connection/ssh/cat"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	K8sNode shared.ConnectionType = "k8s-node"
+
+	// options of k8s-node connections, the node name is the host. The
+	// namespace of the privileged debug pods is required.
+	OPTION_K8S_KUBECONFIG = "kubeconfig"
+	OPTION_K8S_CONTEXT    = "context"
+	OPTION_K8S_NAMESPACE  = "namespace"
+	OPTION_K8S_IMAGE      = "image"
+)
+
+var _ shared.Connection = &K8sNodeConnection{}
+
+// K8sNodeConnection connects to the operating system of a Kubernetes node
+// through a privileged debug pod, so nodes can be scanned without SSH access
+type K8sNodeConnection struct {
+	id    uint32
+	asset *inventory.Asset
+	pod   *k8s_node.DebugPod
+	fs    afero.Fs
+}
+
+func NewK8sNodeConnection(id uint32, conf *inventory.Config, asset *inventory.Asset) (*K8sNodeConnection, error) {
+	if conf.Host == "" {
+		return nil, errors.New("no node name provided for k8s-node connection")
+	}
+	namespace := conf.Options[OPTION_K8S_NAMESPACE]
+	if namespace == "" {
+		return nil, errors.New("no namespace provided for the debug pod of k8s-node connection")
+	}
+
+	config, err := k8sNodeClientConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	pod, err := k8s_node.StartDebugPod(context.Background(), client, k8s_node.NewSpdyExecutor(config, client),
+		namespace, conf.Host, conf.Options[OPTION_K8S_IMAGE])
+	if err != nil {
+		return nil, err
+	}
+
+	return newK8sNodeConnection(id, asset, pod), nil
+}
+
+// k8sNodeClientConfig loads the same config as the k8s provider, which
+// passes its kubeconfig on. Without a kubeconfig it uses the in-cluster
+// config, if it runs inside of a cluster.
+func k8sNodeClientConfig(conf *inventory.Config) (*rest.Config, error) {
+	kubeconfig := conf.Options[OPTION_K8S_KUBECONFIG]
+	if kubeconfig == "" {
+		if config, err := rest.InClusterConfig(); err == nil {
+			return config, nil
+		}
+	}
+
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if kubeconfig != "" {
+		rules.ExplicitPath = kubeconfig
+	}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules,
+		&clientcmd.ConfigOverrides{CurrentContext: conf.Options[OPTION_K8S_CONTEXT]}).ClientConfig()
+}
+
+func newK8sNodeConnection(id uint32, asset *inventory.Asset, pod *k8s_node.DebugPod) *K8sNodeConnection {
+	conn := &K8sNodeConnection{
+		id:    id,
+		asset: asset,
+		pod:   pod,
+	}
+	conn.fs = cat.New(conn)
+	return conn
+}
+
+func (c *K8sNodeConnection) ID() uint32 {
+	return c.id
+}
+
+func (c *K8sNodeConnection) Name() string {
+	return string(K8sNode)
+}
+
+func (c *K8sNodeConnection) Type() shared.ConnectionType {
+	return K8sNode
+}
+
+func (c *K8sNodeConnection) Asset() *inventory.Asset {
+	return c.asset
+}
+
+func (c *K8sNodeConnection) Capabilities() shared.Capabilities {
+	return shared.Capability_File | shared.Capability_RunCommand
+}
+
+func (c *K8sNodeConnection) RunCommand(command string) (*shared.Command, error) {
+	log.Debug().Str("command", command).Str("node", c.pod.Node).Msg("k8s-node> run command")
+	return c.pod.Exec(command)
+}
+
+func (c *K8sNodeConnection) FileSystem() afero.Fs {
+	return c.fs
+}
+
+func (c *K8sNodeConnection) FileInfo(path string) (shared.FileInfoDetails, error) {
+	afs := &afero.Afero{Fs: c.FileSystem()}
+	stat, err := afs.Stat(path)
+	if err != nil {
+		return shared.FileInfoDetails{}, err
+	}
+
+	uid := int64(-1)
+	gid := int64(-1)
+	if stat, ok := stat.Sys().(*shared.FileInfo); ok {
+		uid = stat.Uid
+		gid = stat.Gid
+	}
+
+	return shared.FileInfoDetails{
+		Mode: shared.FileModeDetails{FileMode: stat.Mode()},
+		Size: stat.Size(),
+		Uid:  uid,
+		Gid:  gid,
+	}, nil
+}
+
+// Close deletes the debug pod
+func (c *K8sNodeConnection) Close() {
+	c.pod.Delete()
+}
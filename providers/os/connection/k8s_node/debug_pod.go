@@ -0,0 +1,222 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package k8s_node
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v9/providers/os/connection/shared"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+const (
+	DefaultImage = "busybox:1.36"
+
+	// HostRoot is where the root filesystem of the node is mounted in the debug pod
+	HostRoot = "/host"
+
+	containerName = "debugger"
+	startTimeout  = 2 * time.Minute
+	execTimeout   = 10 * time.Minute
+	deleteTimeout = 30 * time.Second
+
+	// maxLifetime stops debug pods that are left behind, e.g. when the scanner
+	// is killed. Pods are deleted once their node is scanned.
+	maxLifetime = 2 * time.Hour
+)
+
+// Executor runs a command in a container of a pod
+type Executor func(ctx context.Context, namespace, pod, container string, command []string, stdout, stderr io.Writer) error
+
+// DebugPod is a privileged pod that runs on a node and shares its namespaces.
+// Commands run chrooted into the root filesystem of the node, similar to
+// `kubectl debug node/<name>`.
+type DebugPod struct {
+	client    kubernetes.Interface
+	exec      Executor
+	Namespace string
+	Name      string
+	Node      string
+}
+
+// NewDebugPodSpec returns the pod that is scheduled on the node
+func NewDebugPodSpec(namespace, node, image string) *corev1.Pod {
+	if image == "" {
+		image = DefaultImage
+	}
+
+	// keep the pod name well below the limit, node names can be long
+	prefix := node
+	if len(prefix) > 40 {
+		prefix = prefix[:40]
+	}
+	name := "cnquery-node-debugger-" + strings.Trim(prefix, ".-") + "-" + rand.String(5)
+
+	privileged := true
+	deadline := int64(maxLifetime.Seconds())
+	hostPathType := corev1.HostPathDirectory
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       "cnquery-node-debugger",
+				"app.kubernetes.io/managed-by": "cnquery",
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:      node,
+			HostPID:       true,
+			HostIPC:       true,
+			HostNetwork:   true,
+			RestartPolicy: corev1.RestartPolicyNever,
+			// the kubelet stops the pod if it was not deleted
+			ActiveDeadlineSeconds: &deadline,
+			// the pod has to run on every node, including tainted control plane nodes
+			Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+			Containers: []corev1.Container{{
+				Name:    containerName,
+				Image:   image,
+				Command: []string{"sleep", "infinity"},
+				SecurityContext: &corev1.SecurityContext{
+					Privileged: &privileged,
+				},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "host-root",
+					MountPath: HostRoot,
+				}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "host-root",
+				VolumeSource: corev1.VolumeSource{
+					HostPath: &corev1.HostPathVolumeSource{Path: "/", Type: &hostPathType},
+				},
+			}},
+		},
+	}
+}
+
+// StartDebugPod creates a debug pod on the node and waits until it runs
+func StartDebugPod(ctx context.Context, client kubernetes.Interface, exec Executor, namespace, node, image string) (*DebugPod, error) {
+	pod, err := client.CoreV1().Pods(namespace).Create(ctx, NewDebugPodSpec(namespace, node, image), metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not create debug pod on node %s: %w", node, err)
+	}
+	log.Debug().Str("node", node).Str("pod", pod.Namespace+"/"+pod.Name).Msg("k8s-node> created debug pod")
+
+	res := &DebugPod{
+		client:    client,
+		exec:      exec,
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+		Node:      node,
+	}
+
+	err = wait.PollUntilContextTimeout(ctx, time.Second, startTimeout, true, func(ctx context.Context) (bool, error) {
+		p, err := client.CoreV1().Pods(res.Namespace).Get(ctx, res.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		switch p.Status.Phase {
+		case corev1.PodRunning:
+			return true, nil
+		case corev1.PodFailed, corev1.PodSucceeded:
+			return false, errors.New("debug pod " + p.Name + " terminated: " + p.Status.Message)
+		}
+		return false, nil
+	})
+	if err != nil {
+		res.Delete()
+		return nil, fmt.Errorf("debug pod on node %s did not start: %w", node, err)
+	}
+	return res, nil
+}
+
+// Exec runs a shell command in the root filesystem of the node
+func (p *DebugPod) Exec(command string) (*shared.Command, error) {
+	res := shared.Command{
+		Command: command,
+		Stats: shared.PerfStats{
+			Start: time.Now(),
+		},
+		Stdout: &bytes.Buffer{},
+		Stderr: &bytes.Buffer{},
+	}
+	defer func() {
+		res.Stats.Duration = time.Since(res.Stats.Start)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), execTimeout)
+	defer cancel()
+
+	cmd := []string{"chroot", HostRoot, "/bin/sh", "-c", command}
+	err := p.exec(ctx, p.Namespace, p.Name, containerName, cmd, res.Stdout, res.Stderr)
+	if err == nil {
+		return &res, nil
+	}
+
+	// if the program failed, we do not return err but its exit code
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) && exitErr.Exited() {
+		res.ExitStatus = exitErr.ExitStatus()
+		return &res, nil
+	}
+	return &res, err
+}
+
+// Delete removes the debug pod from the cluster
+func (p *DebugPod) Delete() error {
+	ctx, cancel := context.WithTimeout(context.Background(), deleteTimeout)
+	defer cancel()
+
+	gracePeriod := int64(0)
+	err := p.client.CoreV1().Pods(p.Namespace).Delete(ctx, p.Name, metav1.DeleteOptions{
+		GracePeriodSeconds: &gracePeriod,
+	})
+	if err != nil {
+		log.Warn().Err(err).Str("pod", p.Namespace+"/"+p.Name).Msg("k8s-node> could not delete debug pod")
+	}
+	return err
+}
+
+// NewSpdyExecutor runs commands via the exec subresource of pods
+func NewSpdyExecutor(config *rest.Config, client kubernetes.Interface) Executor {
+	return func(ctx context.Context, namespace, pod, container string, command []string, stdout, stderr io.Writer) error {
+		req := client.CoreV1().RESTClient().Post().
+			Resource("pods").
+			Namespace(namespace).
+			Name(pod).
+			SubResource("exec").
+			VersionedParams(&corev1.PodExecOptions{
+				Container: container,
+				Command:   command,
+				Stdout:    true,
+				Stderr:    true,
+			}, scheme.ParameterCodec)
+
+		executor, err := remotecommand.NewSPDYExecutor(config, "POST", req.URL())
+		if err != nil {
+			return err
+		}
+		return executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+			Stdout: stdout,
+			Stderr: stderr,
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package k8s_node
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+func TestDebugPodSpec(t *testing.T) {
+	pod := NewDebugPodSpec("mondoo", "worker-1", "")
+	assert.Equal(t, "mondoo", pod.Namespace)
+	assert.True(t, strings.HasPrefix(pod.Name, "cnquery-node-debugger-worker-1-"))
+	assert.Equal(t, "worker-1", pod.Spec.NodeName)
+	assert.True(t, pod.Spec.HostPID)
+	assert.True(t, pod.Spec.HostNetwork)
+	require.NotNil(t, pod.Spec.ActiveDeadlineSeconds)
+	assert.Equal(t, int64(maxLifetime.Seconds()), *pod.Spec.ActiveDeadlineSeconds)
+	require.Len(t, pod.Spec.Containers, 1)
+	assert.Equal(t, DefaultImage, pod.Spec.Containers[0].Image)
+	assert.True(t, *pod.Spec.Containers[0].SecurityContext.Privileged)
+	assert.Equal(t, HostRoot, pod.Spec.Containers[0].VolumeMounts[0].MountPath)
+	assert.Equal(t, "/", pod.Spec.Volumes[0].HostPath.Path)
+
+	pod = NewDebugPodSpec("mondoo", strings.Repeat("a", 60)+".example.com", "alpine:3.18")
+	assert.Equal(t, "mondoo", pod.Namespace)
+	assert.Equal(t, "alpine:3.18", pod.Spec.Containers[0].Image)
+	assert.LessOrEqual(t, len(pod.Name), 70)
+}
+
+func TestDebugPod(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	// there is no kubelet, so pods run as soon as they are created
+	client.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+		pod.Status.Phase = corev1.PodRunning
+		return false, nil, nil
+	})
+
+	var executed []string
+	exec := func(ctx context.Context, namespace, pod, container string, command []string, stdout, stderr io.Writer) error {
+		executed = command
+		if _, ok := ctx.Deadline(); !ok {
+			return errors.New("command has no timeout")
+		}
+		switch command[len(command)-1] {
+		case "hostname":
+			stdout.Write([]byte("worker-1\n"))
+			return nil
+		case "false":
+			return utilexec.CodeExitError{Err: errors.New("command terminated with exit code 1"), Code: 1}
+		default:
+			return errors.New("connection refused")
+		}
+	}
+
+	pod, err := StartDebugPod(context.Background(), client, exec, "default", "worker-1", "")
+	require.NoError(t, err)
+	assert.Equal(t, "default", pod.Namespace)
+	assert.Equal(t, "worker-1", pod.Node)
+
+	cmd, err := pod.Exec("hostname")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"chroot", HostRoot, "/bin/sh", "-c", "hostname"}, executed)
+	out, err := io.ReadAll(cmd.Stdout)
+	require.NoError(t, err)
+	assert.Equal(t, "worker-1\n", string(out))
+	assert.Equal(t, 0, cmd.ExitStatus)
+
+	cmd, err = pod.Exec("false")
+	require.NoError(t, err)
+	assert.Equal(t, 1, cmd.ExitStatus)
+
+	_, err = pod.Exec("ls")
+	assert.Error(t, err)
+
+	require.NoError(t, pod.Delete())
+	pods, err := client.CoreV1().Pods("default").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items)
+}
+
+func TestDebugPodFailed(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+		pod.Status.Phase = corev1.PodFailed
+		pod.Status.Message = "image pull failed"
+		return false, nil, nil
+	})
+
+	_, err := StartDebugPod(context.Background(), client, nil, "default", "worker-1", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "image pull failed")
+
+	// failed pods are cleaned up
+	pods, err := client.CoreV1().Pods("default").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connection
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: dev
+  cluster:
+    server: https://dev.example.com:6443
+- name: prod
+  cluster:
+    server: https://prod.example.com:6443
+contexts:
+- name: dev
+  context:
+    cluster: dev
+    user: admin
+- name: prod
+  context:
+    cluster: prod
+    user: admin
+current-context: dev
+users:
+- name: admin
+  user:
+    token: secret
+`
+
+func TestK8sNodeClientConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(path, []byte(testKubeconfig), 0o600))
+
+	config, err := k8sNodeClientConfig(&inventory.Config{Options: map[string]string{
+		OPTION_K8S_KUBECONFIG: path,
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, "https://dev.example.com:6443", config.Host)
+
+	config, err = k8sNodeClientConfig(&inventory.Config{Options: map[string]string{
+		OPTION_K8S_KUBECONFIG: path,
+		OPTION_K8S_CONTEXT:    "prod",
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, "https://prod.example.com:6443", config.Host)
+}
+
+func TestK8sNodeConnectionRequiresNamespace(t *testing.T) {
+	_, err := NewK8sNodeConnection(1, &inventory.Config{Host: "worker-1"}, &inventory.Asset{})
+	assert.EqualError(t, err, "no namespace provided for the debug pod of k8s-node connection")
+}
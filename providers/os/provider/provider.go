@@ -38,6 +38,8 @@ const (
 	ContainerRegistryConnectionType = "container-registry"
 	RegistryImageConnectionType     = "registry-image"
 	FilesystemConnectionType        = "filesystem"
+	K8sNodeConnectionType           = "k8s-node"
+	CertificateConnectionType       = "certificate"
 )
 
 type Service struct {
@@ -250,6 +252,17 @@ func (s *Service) Connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 		}
 	}
 
+	certAssets, err := s.discoverCertificates(conn)
+	if err != nil {
+		return nil, err
+	}
+	if len(certAssets) != 0 {
+		if inv == nil {
+			inv = &inventory.Inventory{}
+		}
+		inv.AddAssets(certAssets...)
+	}
+
 	return &plugin.ConnectRes{
 		Id:        uint32(conn.ID()),
 		Name:      conn.Name(),
@@ -296,6 +309,12 @@ func (s *Service) Shutdown(req *plugin.ShutdownReq) (*plugin.ShutdownRes, error)
 		if x, ok := runtime.Connection.(*connection.TarConnection); ok {
 			x.CloseFN()
 		}
+		// k8s-node assets are scanned with ephemeral providers, which are shut
+		// down once their asset is scanned, so each debug pod is deleted after
+		// its node was scanned
+		if x, ok := runtime.Connection.(*connection.K8sNodeConnection); ok {
+			x.Close()
+		}
 	}
 	return &plugin.ShutdownRes{}, nil
 }
@@ -310,7 +329,27 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 	var conn shared.Connection
 	var err error
 
-	switch conf.Type {
+	// discovered certificates keep their identity instead of the one of their system
+	if _, ok := conf.Options[resources.OptionCertificatePath]; ok {
+		name, platformIds := asset.Name, asset.PlatformIds
+		defer func() {
+			asset.Name, asset.PlatformIds = name, platformIds
+		}()
+	}
+
+	connType := conf.Type
+	// discovered certificates are read via the connection of their system,
+	// if it is still connected
+	parent := s.certificateParent(asset)
+	if parent != nil {
+		connType = CertificateConnectionType
+	}
+
+	switch connType {
+	case CertificateConnectionType:
+		s.lastConnectionID++
+		conn = connection.NewCertificateConnection(s.lastConnectionID, asset, parent)
+
 	case LocalConnectionType:
 		s.lastConnectionID++
 		conn = connection.NewLocalConnection(s.lastConnectionID, conf, asset)
@@ -424,6 +463,24 @@ func (s *Service) connect(req *plugin.ConnectReq, callback plugin.ProviderCallba
 			asset.PlatformIds = fingerprint.PlatformIDs
 		}
 
+	case K8sNodeConnectionType:
+		s.lastConnectionID++
+		conn, err = connection.NewK8sNodeConnection(s.lastConnectionID, conf, asset)
+		if err != nil {
+			return nil, err
+		}
+		idDetectors := asset.IdDetector
+		if len(idDetectors) == 0 {
+			// fallback to default id detectors
+			idDetectors = []string{ids.IdDetector_Hostname, ids.IdDetector_CloudDetect}
+		}
+
+		// the node keeps the name it has in the cluster
+		fingerprint, err := IdentifyPlatform(conn, asset.Platform, idDetectors)
+		if err == nil {
+			asset.PlatformIds = fingerprint.PlatformIDs
+		}
+
 	// Do not expose mock connection as a supported type
 	case "mock":
 		s.lastConnectionID++
@@ -562,6 +619,35 @@ func (s *Service) discoverRegistry(conn *connection.TarConnection) (*inventory.I
 	return inventory, nil
 }
 
+// discoverCertificates only runs for the explicit target, since it searches
+// large parts of the file system
+func (s *Service) discoverCertificates(conn shared.Connection) ([]*inventory.Asset, error) {
+	conf := conn.Asset().Connections[0]
+	if conf.Discover == nil || !stringx.Contains(conf.Discover.Targets, resources.DiscoveryCertificates) {
+		return nil, nil
+	}
+
+	return resources.DiscoverCertificates(s.runtimes[conn.ID()])
+}
+
+// certificateParent returns the connection of the system that the
+// certificate asset was discovered on
+func (s *Service) certificateParent(asset *inventory.Asset) shared.Connection {
+	conf := asset.Connections[0]
+	if _, ok := conf.Options[resources.OptionCertificatePath]; !ok {
+		return nil
+	}
+	runtime, ok := s.runtimes[conf.Id]
+	if !ok {
+		return nil
+	}
+	parent := runtime.Connection.(shared.Connection)
+	if parent.Asset().Name != asset.Labels[resources.LabelCertificateHost] {
+		return nil
+	}
+	return parent
+}
+
 func (s *Service) discoverLocalContainers(conf *inventory.Config) (*inventory.Inventory, error) {
 	if conf == nil || conf.Discover == nil {
 		return nil, nil
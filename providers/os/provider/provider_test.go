@@ -0,0 +1,50 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/os/connection"
+	"go.mondoo.com/cnquery/v9/providers/os/connection/mock"
+	"go.mondoo.com/cnquery/v9/providers/os/resources"
+)
+
+func TestCertificateConnection(t *testing.T) {
+	s := Init()
+	parent, err := s.connect(&plugin.ConnectReq{Asset: &inventory.Asset{
+		Name:        "host",
+		Connections: []*inventory.Config{{Type: "mock"}},
+	}}, nil)
+	require.NoError(t, err)
+
+	certificate := func(host string) *inventory.Asset {
+		return &inventory.Asset{
+			Name:   "example.com",
+			Labels: map[string]string{resources.LabelCertificateHost: host},
+			Connections: []*inventory.Config{{
+				Type:    "mock",
+				Id:      parent.ID(),
+				Options: map[string]string{resources.OptionCertificatePath: "/etc/ssl/example.pem"},
+			}},
+		}
+	}
+
+	asset := certificate("host")
+	conn, err := s.connect(&plugin.ConnectReq{Asset: asset}, nil)
+	require.NoError(t, err)
+	require.IsType(t, &connection.CertificateConnection{}, conn)
+	assert.NotEqual(t, parent.ID(), conn.ID())
+	assert.Equal(t, asset, conn.Asset())
+	assert.Same(t, parent, conn.(*connection.CertificateConnection).Connection)
+
+	// certificates of other systems get their own connection
+	conn, err = s.connect(&plugin.ConnectReq{Asset: certificate("other")}, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &mock.Connection{}, conn)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package certstore reads certificates from files in PEM, DER, PKCS#12,
+// and Java KeyStore formats.
+package certstore
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"path"
+	"strings"
+
+	"golang.org/x/crypto/pkcs12"
+)
+
+// Formats of certificate stores
+const (
+	FormatPEM    = "pem"
+	FormatDER    = "der"
+	FormatPKCS12 = "pkcs12"
+	FormatJKS    = "jks"
+)
+
+// ErrEncrypted is returned for stores that are protected by an unknown password
+var ErrEncrypted = errors.New("certificate store is protected by an unknown password")
+
+// Extensions are the file extensions of certificate stores
+var Extensions = map[string]string{
+	".pem":        FormatPEM,
+	".crt":        FormatPEM,
+	".cer":        FormatPEM,
+	".cert":       FormatPEM,
+	".der":        FormatDER,
+	".p12":        FormatPKCS12,
+	".pfx":        FormatPKCS12,
+	".jks":        FormatJKS,
+	".keystore":   FormatJKS,
+	".truststore": FormatJKS,
+}
+
+// Names are file names of certificate stores without a known extension
+var Names = map[string]string{
+	"cacerts": FormatJKS,
+}
+
+// DefaultPasswords are tried for password-protected stores, since many of
+// them use well-known defaults
+var DefaultPasswords = []string{"", "changeit", "changeme", "password"}
+
+// IsCandidate checks if the file name indicates a certificate store
+func IsCandidate(filepath string) bool {
+	name := strings.ToLower(path.Base(filepath))
+	if _, ok := Names[name]; ok {
+		return true
+	}
+	_, ok := Extensions[path.Ext(name)]
+	return ok
+}
+
+// Parse detects the format of the store and returns its certificates. The
+// format is empty if the data isn't a certificate store.
+func Parse(filepath string, data []byte) (string, []*x509.Certificate, error) {
+	if bytes.Contains(data, []byte("-----BEGIN CERTIFICATE-----")) {
+		certs, err := parsePEM(data)
+		return FormatPEM, certs, err
+	}
+
+	if isJKS(data) {
+		certs, err := parseJKS(data)
+		return FormatJKS, certs, err
+	}
+
+	// DER-encoded certificates and PKCS#12 stores are both ASN.1 sequences
+	if len(data) == 0 || data[0] != 0x30 {
+		return "", nil, nil
+	}
+
+	if cert, err := x509.ParseCertificate(data); err == nil {
+		return FormatDER, []*x509.Certificate{cert}, nil
+	}
+
+	ext := strings.ToLower(path.Ext(filepath))
+	if Extensions[ext] == FormatPKCS12 {
+		certs, err := parsePKCS12(data)
+		return FormatPKCS12, certs, err
+	}
+	return "", nil, nil
+}
+
+func parsePEM(data []byte) ([]*x509.Certificate, error) {
+	res := []*x509.Certificate{}
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return res, nil
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, cert)
+	}
+}
+
+func parsePKCS12(data []byte) ([]*x509.Certificate, error) {
+	for _, password := range DefaultPasswords {
+		blocks, err := pkcs12.ToPEM(data, password)
+		if err == pkcs12.ErrIncorrectPassword {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		res := []*x509.Certificate{}
+		for i := range blocks {
+			if blocks[i].Type != "CERTIFICATE" {
+				continue
+			}
+			cert, err := x509.ParseCertificate(blocks[i].Bytes)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, cert)
+		}
+		return res, nil
+	}
+	return nil, ErrEncrypted
+}
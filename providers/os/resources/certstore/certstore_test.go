@@ -0,0 +1,148 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package certstore
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCert(t *testing.T, name string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+	return cert
+}
+
+// newJKS writes a version 2 keystore with a private key entry, whose key
+// is not a real encrypted key, and a trusted certificate entry
+func newJKS(chain []*x509.Certificate, trusted *x509.Certificate) []byte {
+	var buf bytes.Buffer
+	write := func(v interface{}) { binary.Write(&buf, binary.BigEndian, v) }
+	writeString := func(s string) {
+		write(uint16(len(s)))
+		buf.WriteString(s)
+	}
+	writeCert := func(cert *x509.Certificate) {
+		writeString("X.509")
+		write(uint32(len(cert.Raw)))
+		buf.Write(cert.Raw)
+	}
+
+	write(uint32(jksMagic))
+	write(uint32(2))
+	write(uint32(2))
+
+	write(uint32(jksPrivateKeyEntry))
+	writeString("server")
+	write(time.Now().UnixMilli())
+	write(uint32(4))
+	buf.WriteString("key!")
+	write(uint32(len(chain)))
+	for i := range chain {
+		writeCert(chain[i])
+	}
+
+	write(uint32(jksTrustedCertEntry))
+	writeString("ca")
+	write(time.Now().UnixMilli())
+	writeCert(trusted)
+
+	// integrity digest
+	buf.Write(make([]byte, 20))
+	return buf.Bytes()
+}
+
+func names(certs []*x509.Certificate) []string {
+	res := make([]string, len(certs))
+	for i := range certs {
+		res[i] = certs[i].Subject.CommonName
+	}
+	return res
+}
+
+func TestParse(t *testing.T) {
+	leaf := newTestCert(t, "leaf.example.com")
+	ca := newTestCert(t, "CA")
+
+	t.Run("pem", func(t *testing.T) {
+		var data []byte
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("key")})...)
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw})...)
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})...)
+
+		format, certs, err := Parse("/etc/ssl/server.pem", data)
+		require.NoError(t, err)
+		assert.Equal(t, FormatPEM, format)
+		assert.Equal(t, []string{"leaf.example.com", "CA"}, names(certs))
+	})
+
+	t.Run("der", func(t *testing.T) {
+		format, certs, err := Parse("/etc/ssl/server.der", leaf.Raw)
+		require.NoError(t, err)
+		assert.Equal(t, FormatDER, format)
+		assert.Equal(t, []string{"leaf.example.com"}, names(certs))
+	})
+
+	t.Run("jks", func(t *testing.T) {
+		format, certs, err := Parse("/opt/app/keystore.jks", newJKS([]*x509.Certificate{leaf, ca}, ca))
+		require.NoError(t, err)
+		assert.Equal(t, FormatJKS, format)
+		assert.Equal(t, []string{"leaf.example.com", "CA", "CA"}, names(certs))
+	})
+
+	t.Run("pkcs12 with default password", func(t *testing.T) {
+		data, err := os.ReadFile("testdata/changeit.p12")
+		require.NoError(t, err)
+		format, certs, err := Parse("/opt/app/store.p12", data)
+		require.NoError(t, err)
+		assert.Equal(t, FormatPKCS12, format)
+		assert.Equal(t, []string{"store.example.com"}, names(certs))
+	})
+
+	t.Run("pkcs12 with unknown password", func(t *testing.T) {
+		data, err := os.ReadFile("testdata/secret.p12")
+		require.NoError(t, err)
+		format, _, err := Parse("/opt/app/store.pfx", data)
+		assert.Equal(t, FormatPKCS12, format)
+		assert.Equal(t, ErrEncrypted, err)
+	})
+
+	t.Run("no store", func(t *testing.T) {
+		format, certs, err := Parse("/etc/ssl/private.pem", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")}))
+		require.NoError(t, err)
+		assert.Equal(t, "", format)
+		assert.Empty(t, certs)
+	})
+}
+
+func TestIsCandidate(t *testing.T) {
+	assert.True(t, IsCandidate("/etc/ssl/certs/server.PEM"))
+	assert.True(t, IsCandidate("/etc/ssl/certs/java/cacerts"))
+	assert.True(t, IsCandidate("/opt/app/conf/app.keystore"))
+	assert.False(t, IsCandidate("/etc/ssl/private/server.key"))
+	assert.False(t, IsCandidate("/etc/passwd"))
+}
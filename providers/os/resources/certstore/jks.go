@@ -0,0 +1,168 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package certstore
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// Magic numbers of Java KeyStores (JKS) and their successor JCEKS
+const (
+	jksMagic   = 0xfeedfeed
+	jceksMagic = 0xcececece
+)
+
+// Entry types of Java KeyStores
+const (
+	jksPrivateKeyEntry  = 1
+	jksTrustedCertEntry = 2
+	jksSecretKeyEntry   = 3
+)
+
+// maxJKSEntrySize limits allocations for corrupt stores
+const maxJKSEntrySize = 1 << 20
+
+func isJKS(data []byte) bool {
+	if len(data) < 4 {
+		return false
+	}
+	magic := binary.BigEndian.Uint32(data)
+	return magic == jksMagic || magic == jceksMagic
+}
+
+type jksReader struct {
+	r *bytes.Reader
+}
+
+func (j jksReader) uint16() (uint16, error) {
+	var v uint16
+	err := binary.Read(j.r, binary.BigEndian, &v)
+	return v, err
+}
+
+func (j jksReader) uint32() (uint32, error) {
+	var v uint32
+	err := binary.Read(j.r, binary.BigEndian, &v)
+	return v, err
+}
+
+func (j jksReader) bytes(n int) ([]byte, error) {
+	if n > maxJKSEntrySize {
+		return nil, errors.New("invalid keystore entry size")
+	}
+	res := make([]byte, n)
+	_, err := io.ReadFull(j.r, res)
+	return res, err
+}
+
+// string reads strings in Java's modified UTF-8 format
+func (j jksReader) string() (string, error) {
+	n, err := j.uint16()
+	if err != nil {
+		return "", err
+	}
+	raw, err := j.bytes(int(n))
+	return string(raw), err
+}
+
+func (j jksReader) certificate(version uint32) (*x509.Certificate, error) {
+	if version == 2 {
+		typ, err := j.string()
+		if err != nil {
+			return nil, err
+		}
+		if typ != "X.509" {
+			return nil, errors.New("unsupported certificate type in keystore: " + typ)
+		}
+	}
+
+	n, err := j.uint32()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := j.bytes(int(n))
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(raw)
+}
+
+// parseJKS reads the certificates of a Java KeyStore. They are stored in
+// plain text, which means no password is needed. Only private keys are
+// encrypted and the password is just used to check the integrity of the
+// store.
+// see https://github.com/openjdk/jdk/blob/master/src/java.base/share/classes/sun/security/provider/JavaKeyStore.java
+func parseJKS(data []byte) ([]*x509.Certificate, error) {
+	j := jksReader{r: bytes.NewReader(data)}
+	if _, err := j.uint32(); err != nil {
+		return nil, err
+	}
+	version, err := j.uint32()
+	if err != nil {
+		return nil, err
+	}
+	if version != 1 && version != 2 {
+		return nil, errors.New("unsupported keystore version")
+	}
+	count, err := j.uint32()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*x509.Certificate{}
+	for i := uint32(0); i < count; i++ {
+		tag, err := j.uint32()
+		if err != nil {
+			return nil, err
+		}
+		// alias
+		if _, err := j.string(); err != nil {
+			return nil, err
+		}
+		// timestamp
+		if _, err := j.bytes(8); err != nil {
+			return nil, err
+		}
+
+		switch tag {
+		case jksPrivateKeyEntry:
+			n, err := j.uint32()
+			if err != nil {
+				return nil, err
+			}
+			// skip the encrypted private key
+			if _, err := j.bytes(int(n)); err != nil {
+				return nil, err
+			}
+			chainLength, err := j.uint32()
+			if err != nil {
+				return nil, err
+			}
+			for k := uint32(0); k < chainLength; k++ {
+				cert, err := j.certificate(version)
+				if err != nil {
+					return nil, err
+				}
+				res = append(res, cert)
+			}
+		case jksTrustedCertEntry:
+			cert, err := j.certificate(version)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, cert)
+		case jksSecretKeyEntry:
+			// secret keys of JCEKS are serialized Java objects, which
+			// we cannot skip, so we return what we have read so far
+			return res, nil
+		default:
+			return nil, errors.New("unsupported keystore entry")
+		}
+	}
+	return res, nil
+}
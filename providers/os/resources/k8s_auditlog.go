@@ -0,0 +1,246 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/util/convert"
+	"go.mondoo.com/cnquery/v9/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v9/types"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// audit log lines include request and response bodies at the RequestResponse level
+const maxAuditLogLineSize = 16 * 1024 * 1024
+
+// auditEvent is an event of the audit.k8s.io/v1 API, which is written by the API server
+// as one JSON object per line
+type auditEvent struct {
+	Kind                     string                     `json:"kind"`
+	Level                    string                     `json:"level"`
+	AuditID                  string                     `json:"auditID"`
+	Stage                    string                     `json:"stage"`
+	RequestURI               string                     `json:"requestURI"`
+	Verb                     string                     `json:"verb"`
+	User                     authenticationv1.UserInfo  `json:"user"`
+	ImpersonatedUser         *authenticationv1.UserInfo `json:"impersonatedUser,omitempty"`
+	SourceIPs                []string                   `json:"sourceIPs,omitempty"`
+	UserAgent                string                     `json:"userAgent,omitempty"`
+	ObjectRef                *auditObjectReference      `json:"objectRef,omitempty"`
+	ResponseStatus           *metav1.Status             `json:"responseStatus,omitempty"`
+	RequestReceivedTimestamp metav1.MicroTime           `json:"requestReceivedTimestamp"`
+	StageTimestamp           metav1.MicroTime           `json:"stageTimestamp"`
+	Annotations              map[string]string          `json:"annotations,omitempty"`
+}
+
+type auditObjectReference struct {
+	Resource        string `json:"resource,omitempty"`
+	Namespace       string `json:"namespace,omitempty"`
+	Name            string `json:"name,omitempty"`
+	UID             string `json:"uid,omitempty"`
+	APIGroup        string `json:"apiGroup,omitempty"`
+	APIVersion      string `json:"apiVersion,omitempty"`
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+	Subresource     string `json:"subresource,omitempty"`
+}
+
+func initK8sAuditlog(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if args["path"] == nil {
+		return nil, nil, errors.New("k8s.auditlog requires a path")
+	}
+	for _, filter := range []string{"user", "verb", "resource", "namespace"} {
+		if args[filter] == nil {
+			args[filter] = llx.StringData("")
+		}
+	}
+	for _, filter := range []string{"since", "until"} {
+		if args[filter] == nil {
+			args[filter] = llx.TimeDataPtr(nil)
+		}
+	}
+	return args, nil, nil
+}
+
+func (k *mqlK8sAuditlog) id() (string, error) {
+	var id strings.Builder
+	id.WriteString(k.Path.Data)
+	if k.User.Data != "" {
+		id.WriteString(" user=" + k.User.Data)
+	}
+	if k.Verb.Data != "" {
+		id.WriteString(" verb=" + k.Verb.Data)
+	}
+	if k.Resource.Data != "" {
+		id.WriteString(" resource=" + k.Resource.Data)
+	}
+	if k.Namespace.Data != "" {
+		id.WriteString(" namespace=" + k.Namespace.Data)
+	}
+	if k.Since.Data != nil {
+		id.WriteString(" since=" + k.Since.Data.Format(time.RFC3339Nano))
+	}
+	if k.Until.Data != nil {
+		id.WriteString(" until=" + k.Until.Data.Format(time.RFC3339Nano))
+	}
+	return id.String(), nil
+}
+
+// matches checks if an event passes the filters of the audit log
+func (k *mqlK8sAuditlog) matches(event *auditEvent) bool {
+	if k.User.Data != "" && event.User.Username != k.User.Data {
+		return false
+	}
+	if k.Verb.Data != "" && event.Verb != k.Verb.Data {
+		return false
+	}
+	var ref auditObjectReference
+	if event.ObjectRef != nil {
+		ref = *event.ObjectRef
+	}
+	if k.Resource.Data != "" && ref.Resource != k.Resource.Data {
+		return false
+	}
+	if k.Namespace.Data != "" && ref.Namespace != k.Namespace.Data {
+		return false
+	}
+	received := event.RequestReceivedTimestamp.Time
+	if k.Since.Data != nil && received.Before(*k.Since.Data) {
+		return false
+	}
+	if k.Until.Data != nil && !received.Before(*k.Until.Data) {
+		return false
+	}
+	return true
+}
+
+// entries streams the audit log from the filesystem of the connection, so logs
+// can be read on API server nodes, e.g. via ssh or k8s-node. Only events that
+// match the filters are kept.
+func (k *mqlK8sAuditlog) entries() ([]interface{}, error) {
+	path := k.Path.Data
+	conn := k.MqlRuntime.Connection.(shared.Connection)
+	f, err := conn.FileSystem().Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var reader io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		reader = gz
+	}
+
+	res := []interface{}{}
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), maxAuditLogLineSize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := scanner.Bytes()
+		if len(strings.TrimSpace(string(data))) == 0 {
+			continue
+		}
+
+		var event auditEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			// rotated logs may end with a truncated line
+			log.Warn().Err(err).Str("path", path).Int("line", line).Msg("k8s.auditlog> skip invalid audit event")
+			continue
+		}
+		if event.Kind != "" && event.Kind != "Event" {
+			continue
+		}
+		if !k.matches(&event) {
+			continue
+		}
+
+		entry, err := newMqlAuditlogEntry(k.MqlRuntime, path+":"+strconv.Itoa(line), &event)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func newMqlAuditlogEntry(runtime *plugin.Runtime, id string, event *auditEvent) (plugin.Resource, error) {
+	objectRef := map[string]interface{}{}
+	ref := auditObjectReference{}
+	if event.ObjectRef != nil {
+		ref = *event.ObjectRef
+		var err error
+		objectRef, err = convert.JsonToDict(ref)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	responseStatus := map[string]interface{}{}
+	var responseCode int64
+	if event.ResponseStatus != nil {
+		var err error
+		responseStatus, err = convert.JsonToDict(event.ResponseStatus)
+		if err != nil {
+			return nil, err
+		}
+		responseCode = int64(event.ResponseStatus.Code)
+	}
+
+	var impersonatedUser string
+	if event.ImpersonatedUser != nil {
+		impersonatedUser = event.ImpersonatedUser.Username
+	}
+
+	return CreateResource(runtime, "k8s.auditlog.entry", map[string]*llx.RawData{
+		"__id":             llx.StringData(id),
+		"auditID":          llx.StringData(event.AuditID),
+		"level":            llx.StringData(event.Level),
+		"stage":            llx.StringData(event.Stage),
+		"timestamp":        llx.TimeDataPtr(auditTime(event.RequestReceivedTimestamp)),
+		"stageTimestamp":   llx.TimeDataPtr(auditTime(event.StageTimestamp)),
+		"requestURI":       llx.StringData(event.RequestURI),
+		"verb":             llx.StringData(event.Verb),
+		"user":             llx.StringData(event.User.Username),
+		"groups":           llx.ArrayData(convert.SliceAnyToInterface(event.User.Groups), types.String),
+		"impersonatedUser": llx.StringData(impersonatedUser),
+		"sourceIPs":        llx.ArrayData(convert.SliceAnyToInterface(event.SourceIPs), types.String),
+		"userAgent":        llx.StringData(event.UserAgent),
+		"objectRef":        llx.DictData(objectRef),
+		"resource":         llx.StringData(ref.Resource),
+		"subresource":      llx.StringData(ref.Subresource),
+		"namespace":        llx.StringData(ref.Namespace),
+		"name":             llx.StringData(ref.Name),
+		"responseStatus":   llx.DictData(responseStatus),
+		"responseCode":     llx.IntData(responseCode),
+		"annotations":      llx.MapData(convert.MapToInterfaceMap(event.Annotations), types.String),
+	})
+}
+
+// auditTime returns nil for unset timestamps, so they are null
+func auditTime(t metav1.MicroTime) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t.Time
+}
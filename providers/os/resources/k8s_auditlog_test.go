@@ -0,0 +1,122 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/os/connection"
+)
+
+func auditlog(t *testing.T, args map[string]*llx.RawData) *mqlK8sAuditlog {
+	runtime := &plugin.Runtime{
+		Connection: connection.NewLocalConnection(1, nil, nil),
+	}
+	r, err := CreateResource(runtime, "k8s.auditlog", args)
+	require.NoError(t, err)
+	return r.(*mqlK8sAuditlog)
+}
+
+func TestK8sAuditlog(t *testing.T) {
+	entries, err := auditlog(t, map[string]*llx.RawData{
+		"path": llx.StringData("./testdata/k8s-audit.log"),
+	}).entries()
+	require.NoError(t, err)
+	// blank and truncated lines are skipped
+	require.Len(t, entries, 2)
+
+	exec := entries[0].(*mqlK8sAuditlogEntry)
+	assert.Equal(t, "alice@example.com", exec.User.Data)
+	assert.Equal(t, []interface{}{"developers", "system:authenticated"}, exec.Groups.Data)
+	assert.Equal(t, "create", exec.Verb.Data)
+	assert.Equal(t, "pods", exec.Resource.Data)
+	assert.Equal(t, "exec", exec.Subresource.Data)
+	assert.Equal(t, "app", exec.Namespace.Data)
+	assert.Equal(t, "web-6d4cf56db6-x2x8k", exec.Name.Data)
+	assert.Equal(t, "exec", exec.ObjectRef.Data.(map[string]interface{})["subresource"])
+	assert.Equal(t, []interface{}{"10.0.0.12"}, exec.SourceIPs.Data)
+	assert.Equal(t, int64(101), exec.ResponseCode.Data)
+	assert.Equal(t, "allow", exec.Annotations.Data["authorization.k8s.io/decision"])
+	assert.Equal(t, time.Date(2023, 10, 10, 9, 12, 0, 123456000, time.UTC), exec.Timestamp.Data.UTC())
+	assert.Equal(t, "", exec.ImpersonatedUser.Data)
+
+	list := entries[1].(*mqlK8sAuditlogEntry)
+	assert.Equal(t, "system:serviceaccount:app:web", list.User.Data)
+	assert.Equal(t, "admin", list.ImpersonatedUser.Data)
+	assert.Equal(t, "", list.Name.Data)
+	assert.Equal(t, int64(403), list.ResponseCode.Data)
+	assert.Equal(t, "Forbidden", list.ResponseStatus.Data.(map[string]interface{})["reason"])
+	assert.Equal(t, []interface{}{"10.0.1.5", "192.168.1.1"}, list.SourceIPs.Data)
+}
+
+func TestK8sAuditlogFilters(t *testing.T) {
+	log := auditlog(t, map[string]*llx.RawData{
+		"path":     llx.StringData("./testdata/k8s-audit.log"),
+		"resource": llx.StringData("secrets"),
+	})
+	assert.Equal(t, "./testdata/k8s-audit.log resource=secrets", log.MqlID())
+	entries, err := log.entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "list", entries[0].(*mqlK8sAuditlogEntry).Verb.Data)
+
+	entries, err = auditlog(t, map[string]*llx.RawData{
+		"path": llx.StringData("./testdata/k8s-audit.log"),
+		"user": llx.StringData("alice@example.com"),
+		"verb": llx.StringData("create"),
+	}).entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "app", entries[0].(*mqlK8sAuditlogEntry).Namespace.Data)
+
+	since := time.Date(2023, 10, 10, 9, 12, 0, 123456000, time.UTC)
+	until := since.Add(time.Second)
+	entries, err = auditlog(t, map[string]*llx.RawData{
+		"path":  llx.StringData("./testdata/k8s-audit.log"),
+		"since": llx.TimeData(since),
+		"until": llx.TimeData(until),
+	}).entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "alice@example.com", entries[0].(*mqlK8sAuditlogEntry).User.Data)
+
+	entries, err = auditlog(t, map[string]*llx.RawData{
+		"path":      llx.StringData("./testdata/k8s-audit.log"),
+		"namespace": llx.StringData("default"),
+	}).entries()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestK8sAuditlogGzip(t *testing.T) {
+	data, err := os.ReadFile("./testdata/k8s-audit.log")
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "audit.log.gz")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	gz := gzip.NewWriter(f)
+	_, err = gz.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	require.NoError(t, f.Close())
+
+	entries, err := auditlog(t, map[string]*llx.RawData{
+		"path": llx.StringData(path),
+	}).entries()
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	_, err = auditlog(t, map[string]*llx.RawData{
+		"path": llx.StringData("./testdata/missing.log"),
+	}).entries()
+	assert.Error(t, err)
+}
@@ -473,6 +473,36 @@ func TestDict_Methods_Array(t *testing.T) {
 	})
 }
 
+func TestDict_Methods_Schema(t *testing.T) {
+	p := "parse.json('/dummy.json')."
+	schema := `{"type":"object","required":["hello","missing"],"properties":{"hello":{"type":"string"},"int-array":{"type":"array","items":{"type":"integer","maximum":2}}}}`
+
+	x := testutils.InitTester(testutils.LinuxMock())
+	x.TestSimple(t, []testutils.SimpleTest{
+		{
+			Code:        p + `params.as('{"properties":{"hello":{"type":"string"}},"additionalProperties":true}').hello`,
+			Expectation: "hello",
+		},
+		{
+			Code: p + "params.validate('" + schema + "')",
+			Expectation: []interface{}{
+				"$: missing required property 'missing'",
+				"$.int-array[2]: value must be <= 2",
+			},
+		},
+		{
+			Code:        p + `params.validate('{"type":"object"}')`,
+			Expectation: []interface{}{},
+		},
+	})
+
+	t.Run("as fails if the value doesn't match", func(t *testing.T) {
+		res := x.TestQuery(t, p+"params.as('"+schema+"')")
+		require.NotEmpty(t, res)
+		assert.EqualError(t, res[0].Data.Error, "value doesn't match the schema: $: missing required property 'missing'; $.int-array[2]: value must be <= 2")
+	})
+}
+
 func TestDict_Methods_OtherJson(t *testing.T) {
 	x := testutils.InitTester(testutils.LinuxMock())
 	x.TestSimple(t, []testutils.SimpleTest{
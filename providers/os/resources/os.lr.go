@@ -102,6 +102,14 @@ func init() {
 			Init: initOsRootCertificates,
 			Create: createOsRootCertificates,
 		},
+		"os.certificateStores": {
+			Init: initOsCertificateStores,
+			Create: createOsCertificateStores,
+		},
+		"os.certificateStore": {
+			Init: initOsCertificateStore,
+			Create: createOsCertificateStore,
+		},
 		"command": {
 			// to override args, implement: initCommand(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
 			Create: createCommand,
@@ -334,6 +342,14 @@ func init() {
 			Init: initKubelet,
 			Create: createKubelet,
 		},
+		"k8s.auditlog": {
+			Init: initK8sAuditlog,
+			Create: createK8sAuditlog,
+		},
+		"k8s.auditlog.entry": {
+			// to override args, implement: initK8sAuditlogEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error)
+			Create: createK8sAuditlogEntry,
+		},
 		"python": {
 			Init: initPython,
 			Create: createPython,
@@ -719,6 +735,27 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"os.rootCertificates.list": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlOsRootCertificates).GetList()).ToDataRes(types.Array(types.Resource("certificate")))
 	},
+	"os.certificateStores.paths": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlOsCertificateStores).GetPaths()).ToDataRes(types.Array(types.String))
+	},
+	"os.certificateStores.list": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlOsCertificateStores).GetList()).ToDataRes(types.Array(types.Resource("os.certificateStore")))
+	},
+	"os.certificateStore.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlOsCertificateStore).GetPath()).ToDataRes(types.String)
+	},
+	"os.certificateStore.format": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlOsCertificateStore).GetFormat()).ToDataRes(types.String)
+	},
+	"os.certificateStore.file": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlOsCertificateStore).GetFile()).ToDataRes(types.Resource("file"))
+	},
+	"os.certificateStore.certificates": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlOsCertificateStore).GetCertificates()).ToDataRes(types.Array(types.Resource("certificate")))
+	},
+	"os.certificateStore.encrypted": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlOsCertificateStore).GetEncrypted()).ToDataRes(types.Bool)
+	},
 	"command.command": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlCommand).GetCommand()).ToDataRes(types.String)
 	},
@@ -1532,6 +1569,90 @@ var getDataFields = map[string]func(r plugin.Resource) *plugin.DataRes{
 	"kubelet.configuration": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlKubelet).GetConfiguration()).ToDataRes(types.Dict)
 	},
+	"k8s.auditlog.path": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlog).GetPath()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.user": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlog).GetUser()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.verb": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlog).GetVerb()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.resource": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlog).GetResource()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlog).GetNamespace()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.since": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlog).GetSince()).ToDataRes(types.Time)
+	},
+	"k8s.auditlog.until": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlog).GetUntil()).ToDataRes(types.Time)
+	},
+	"k8s.auditlog.entries": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlog).GetEntries()).ToDataRes(types.Array(types.Resource("k8s.auditlog.entry")))
+	},
+	"k8s.auditlog.entry.auditID": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetAuditID()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.entry.level": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetLevel()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.entry.stage": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetStage()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.entry.timestamp": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetTimestamp()).ToDataRes(types.Time)
+	},
+	"k8s.auditlog.entry.stageTimestamp": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetStageTimestamp()).ToDataRes(types.Time)
+	},
+	"k8s.auditlog.entry.requestURI": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetRequestURI()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.entry.verb": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetVerb()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.entry.user": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetUser()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.entry.groups": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetGroups()).ToDataRes(types.Array(types.String))
+	},
+	"k8s.auditlog.entry.impersonatedUser": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetImpersonatedUser()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.entry.sourceIPs": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetSourceIPs()).ToDataRes(types.Array(types.String))
+	},
+	"k8s.auditlog.entry.userAgent": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetUserAgent()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.entry.objectRef": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetObjectRef()).ToDataRes(types.Dict)
+	},
+	"k8s.auditlog.entry.resource": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetResource()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.entry.subresource": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetSubresource()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.entry.namespace": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetNamespace()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.entry.name": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetName()).ToDataRes(types.String)
+	},
+	"k8s.auditlog.entry.responseStatus": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetResponseStatus()).ToDataRes(types.Dict)
+	},
+	"k8s.auditlog.entry.responseCode": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetResponseCode()).ToDataRes(types.Int)
+	},
+	"k8s.auditlog.entry.annotations": func(r plugin.Resource) *plugin.DataRes {
+		return (r.(*mqlK8sAuditlogEntry).GetAnnotations()).ToDataRes(types.Map(types.String, types.String))
+	},
 	"python.path": func(r plugin.Resource) *plugin.DataRes {
 		return (r.(*mqlPython).GetPath()).ToDataRes(types.String)
 	},
@@ -2323,6 +2444,42 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlOsRootCertificates).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
 		return
 	},
+	"os.certificateStores.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlOsCertificateStores).__id, ok = v.Value.(string)
+			return
+		},
+	"os.certificateStores.paths": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOsCertificateStores).Paths, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"os.certificateStores.list": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOsCertificateStores).List, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"os.certificateStore.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlOsCertificateStore).__id, ok = v.Value.(string)
+			return
+		},
+	"os.certificateStore.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOsCertificateStore).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"os.certificateStore.format": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOsCertificateStore).Format, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"os.certificateStore.file": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOsCertificateStore).File, ok = plugin.RawToTValue[*mqlFile](v.Value, v.Error)
+		return
+	},
+	"os.certificateStore.certificates": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOsCertificateStore).Certificates, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"os.certificateStore.encrypted": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlOsCertificateStore).Encrypted, ok = plugin.RawToTValue[bool](v.Value, v.Error)
+		return
+	},
 	"command.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 			r.(*mqlCommand).__id, ok = v.Value.(string)
 			return
@@ -3639,6 +3796,126 @@ var setDataFields = map[string]func(r plugin.Resource, v *llx.RawData) bool {
 		r.(*mqlKubelet).Configuration, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
 		return
 	},
+	"k8s.auditlog.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sAuditlog).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.auditlog.path": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlog).Path, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlog).User, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.verb": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlog).Verb, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.resource": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlog).Resource, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlog).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.since": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlog).Since, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.until": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlog).Until, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entries": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlog).Entries, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+			r.(*mqlK8sAuditlogEntry).__id, ok = v.Value.(string)
+			return
+		},
+	"k8s.auditlog.entry.auditID": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).AuditID, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.level": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).Level, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.stage": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).Stage, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.timestamp": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).Timestamp, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.stageTimestamp": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).StageTimestamp, ok = plugin.RawToTValue[*time.Time](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.requestURI": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).RequestURI, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.verb": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).Verb, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.user": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).User, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.groups": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).Groups, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.impersonatedUser": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).ImpersonatedUser, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.sourceIPs": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).SourceIPs, ok = plugin.RawToTValue[[]interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.userAgent": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).UserAgent, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.objectRef": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).ObjectRef, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.resource": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).Resource, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.subresource": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).Subresource, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.namespace": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).Namespace, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.name": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).Name, ok = plugin.RawToTValue[string](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.responseStatus": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).ResponseStatus, ok = plugin.RawToTValue[interface{}](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.responseCode": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).ResponseCode, ok = plugin.RawToTValue[int64](v.Value, v.Error)
+		return
+	},
+	"k8s.auditlog.entry.annotations": func(r plugin.Resource, v *llx.RawData) (ok bool) {
+		r.(*mqlK8sAuditlogEntry).Annotations, ok = plugin.RawToTValue[map[string]interface{}](v.Value, v.Error)
+		return
+	},
 	"python.__id": func(r plugin.Resource, v *llx.RawData) (ok bool) {
 			r.(*mqlPython).__id, ok = v.Value.(string)
 			return
@@ -5715,6 +5992,169 @@ func (c *mqlOsRootCertificates) GetList() *plugin.TValue[[]interface{}] {
 	})
 }
 
+// mqlOsCertificateStores for the os.certificateStores resource
+type mqlOsCertificateStores struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlOsCertificateStoresInternal it will be used here
+	Paths plugin.TValue[[]interface{}]
+	List plugin.TValue[[]interface{}]
+}
+
+// createOsCertificateStores creates a new instance of this resource
+func createOsCertificateStores(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOsCertificateStores{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("os.certificateStores", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlOsCertificateStores) MqlName() string {
+	return "os.certificateStores"
+}
+
+func (c *mqlOsCertificateStores) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlOsCertificateStores) GetPaths() *plugin.TValue[[]interface{}] {
+	return &c.Paths
+}
+
+func (c *mqlOsCertificateStores) GetList() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.List, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.certificateStores", c.__id, "list")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.list()
+	})
+}
+
+// mqlOsCertificateStore for the os.certificateStore resource
+type mqlOsCertificateStore struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	mqlOsCertificateStoreInternal
+	Path plugin.TValue[string]
+	Format plugin.TValue[string]
+	File plugin.TValue[*mqlFile]
+	Certificates plugin.TValue[[]interface{}]
+	Encrypted plugin.TValue[bool]
+}
+
+// createOsCertificateStore creates a new instance of this resource
+func createOsCertificateStore(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlOsCertificateStore{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("os.certificateStore", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlOsCertificateStore) MqlName() string {
+	return "os.certificateStore"
+}
+
+func (c *mqlOsCertificateStore) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlOsCertificateStore) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlOsCertificateStore) GetFormat() *plugin.TValue[string] {
+	return plugin.GetOrCompute[string](&c.Format, func() (string, error) {
+		return c.format()
+	})
+}
+
+func (c *mqlOsCertificateStore) GetFile() *plugin.TValue[*mqlFile] {
+	return plugin.GetOrCompute[*mqlFile](&c.File, func() (*mqlFile, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.certificateStore", c.__id, "file")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.(*mqlFile), nil
+			}
+		}
+
+		return c.file()
+	})
+}
+
+func (c *mqlOsCertificateStore) GetCertificates() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Certificates, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("os.certificateStore", c.__id, "certificates")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.certificates()
+	})
+}
+
+func (c *mqlOsCertificateStore) GetEncrypted() *plugin.TValue[bool] {
+	return plugin.GetOrCompute[bool](&c.Encrypted, func() (bool, error) {
+		return c.encrypted()
+	})
+}
+
 // mqlCommand for the command resource
 type mqlCommand struct {
 	MqlRuntime *plugin.Runtime
@@ -10465,6 +10905,241 @@ func (c *mqlKubelet) GetConfiguration() *plugin.TValue[interface{}] {
 	return &c.Configuration
 }
 
+// mqlK8sAuditlog for the k8s.auditlog resource
+type mqlK8sAuditlog struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlK8sAuditlogInternal it will be used here
+	Path plugin.TValue[string]
+	User plugin.TValue[string]
+	Verb plugin.TValue[string]
+	Resource plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Since plugin.TValue[*time.Time]
+	Until plugin.TValue[*time.Time]
+	Entries plugin.TValue[[]interface{}]
+}
+
+// createK8sAuditlog creates a new instance of this resource
+func createK8sAuditlog(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sAuditlog{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	if res.__id == "" {
+	res.__id, err = res.id()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.auditlog", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sAuditlog) MqlName() string {
+	return "k8s.auditlog"
+}
+
+func (c *mqlK8sAuditlog) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sAuditlog) GetPath() *plugin.TValue[string] {
+	return &c.Path
+}
+
+func (c *mqlK8sAuditlog) GetUser() *plugin.TValue[string] {
+	return &c.User
+}
+
+func (c *mqlK8sAuditlog) GetVerb() *plugin.TValue[string] {
+	return &c.Verb
+}
+
+func (c *mqlK8sAuditlog) GetResource() *plugin.TValue[string] {
+	return &c.Resource
+}
+
+func (c *mqlK8sAuditlog) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
+
+func (c *mqlK8sAuditlog) GetSince() *plugin.TValue[*time.Time] {
+	return &c.Since
+}
+
+func (c *mqlK8sAuditlog) GetUntil() *plugin.TValue[*time.Time] {
+	return &c.Until
+}
+
+func (c *mqlK8sAuditlog) GetEntries() *plugin.TValue[[]interface{}] {
+	return plugin.GetOrCompute[[]interface{}](&c.Entries, func() ([]interface{}, error) {
+		if c.MqlRuntime.HasRecording {
+			d, err := c.MqlRuntime.FieldResourceFromRecording("k8s.auditlog", c.__id, "entries")
+			if err != nil {
+				return nil, err
+			}
+			if d != nil {
+				return d.Value.([]interface{}), nil
+			}
+		}
+
+		return c.entries()
+	})
+}
+
+// mqlK8sAuditlogEntry for the k8s.auditlog.entry resource
+type mqlK8sAuditlogEntry struct {
+	MqlRuntime *plugin.Runtime
+	__id string
+	// optional: if you define mqlK8sAuditlogEntryInternal it will be used here
+	AuditID plugin.TValue[string]
+	Level plugin.TValue[string]
+	Stage plugin.TValue[string]
+	Timestamp plugin.TValue[*time.Time]
+	StageTimestamp plugin.TValue[*time.Time]
+	RequestURI plugin.TValue[string]
+	Verb plugin.TValue[string]
+	User plugin.TValue[string]
+	Groups plugin.TValue[[]interface{}]
+	ImpersonatedUser plugin.TValue[string]
+	SourceIPs plugin.TValue[[]interface{}]
+	UserAgent plugin.TValue[string]
+	ObjectRef plugin.TValue[interface{}]
+	Resource plugin.TValue[string]
+	Subresource plugin.TValue[string]
+	Namespace plugin.TValue[string]
+	Name plugin.TValue[string]
+	ResponseStatus plugin.TValue[interface{}]
+	ResponseCode plugin.TValue[int64]
+	Annotations plugin.TValue[map[string]interface{}]
+}
+
+// createK8sAuditlogEntry creates a new instance of this resource
+func createK8sAuditlogEntry(runtime *plugin.Runtime, args map[string]*llx.RawData) (plugin.Resource, error) {
+	res := &mqlK8sAuditlogEntry{
+		MqlRuntime: runtime,
+	}
+
+	err := SetAllData(res, args)
+	if err != nil {
+		return res, err
+	}
+
+	// to override __id implement: id() (string, error)
+
+	if runtime.HasRecording {
+		args, err = runtime.ResourceFromRecording("k8s.auditlog.entry", res.__id)
+		if err != nil || args == nil {
+			return res, err
+		}
+		return res, SetAllData(res, args)
+	}
+
+	return res, nil
+}
+
+func (c *mqlK8sAuditlogEntry) MqlName() string {
+	return "k8s.auditlog.entry"
+}
+
+func (c *mqlK8sAuditlogEntry) MqlID() string {
+	return c.__id
+}
+
+func (c *mqlK8sAuditlogEntry) GetAuditID() *plugin.TValue[string] {
+	return &c.AuditID
+}
+
+func (c *mqlK8sAuditlogEntry) GetLevel() *plugin.TValue[string] {
+	return &c.Level
+}
+
+func (c *mqlK8sAuditlogEntry) GetStage() *plugin.TValue[string] {
+	return &c.Stage
+}
+
+func (c *mqlK8sAuditlogEntry) GetTimestamp() *plugin.TValue[*time.Time] {
+	return &c.Timestamp
+}
+
+func (c *mqlK8sAuditlogEntry) GetStageTimestamp() *plugin.TValue[*time.Time] {
+	return &c.StageTimestamp
+}
+
+func (c *mqlK8sAuditlogEntry) GetRequestURI() *plugin.TValue[string] {
+	return &c.RequestURI
+}
+
+func (c *mqlK8sAuditlogEntry) GetVerb() *plugin.TValue[string] {
+	return &c.Verb
+}
+
+func (c *mqlK8sAuditlogEntry) GetUser() *plugin.TValue[string] {
+	return &c.User
+}
+
+func (c *mqlK8sAuditlogEntry) GetGroups() *plugin.TValue[[]interface{}] {
+	return &c.Groups
+}
+
+func (c *mqlK8sAuditlogEntry) GetImpersonatedUser() *plugin.TValue[string] {
+	return &c.ImpersonatedUser
+}
+
+func (c *mqlK8sAuditlogEntry) GetSourceIPs() *plugin.TValue[[]interface{}] {
+	return &c.SourceIPs
+}
+
+func (c *mqlK8sAuditlogEntry) GetUserAgent() *plugin.TValue[string] {
+	return &c.UserAgent
+}
+
+func (c *mqlK8sAuditlogEntry) GetObjectRef() *plugin.TValue[interface{}] {
+	return &c.ObjectRef
+}
+
+func (c *mqlK8sAuditlogEntry) GetResource() *plugin.TValue[string] {
+	return &c.Resource
+}
+
+func (c *mqlK8sAuditlogEntry) GetSubresource() *plugin.TValue[string] {
+	return &c.Subresource
+}
+
+func (c *mqlK8sAuditlogEntry) GetNamespace() *plugin.TValue[string] {
+	return &c.Namespace
+}
+
+func (c *mqlK8sAuditlogEntry) GetName() *plugin.TValue[string] {
+	return &c.Name
+}
+
+func (c *mqlK8sAuditlogEntry) GetResponseStatus() *plugin.TValue[interface{}] {
+	return &c.ResponseStatus
+}
+
+func (c *mqlK8sAuditlogEntry) GetResponseCode() *plugin.TValue[int64] {
+	return &c.ResponseCode
+}
+
+func (c *mqlK8sAuditlogEntry) GetAnnotations() *plugin.TValue[map[string]interface{}] {
+	return &c.Annotations
+}
+
 // mqlPython for the python resource
 type mqlPython struct {
 	MqlRuntime *plugin.Runtime
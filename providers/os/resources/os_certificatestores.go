@@ -0,0 +1,338 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package resources
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/inventory"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"go.mondoo.com/cnquery/v9/providers/os/connection/shared"
+	"go.mondoo.com/cnquery/v9/providers/os/resources/certstore"
+	"go.mondoo.com/cnquery/v9/types"
+)
+
+// CertificateStorePaths are the directories that are searched for
+// certificate stores by default
+var CertificateStorePaths = []string{
+	"/etc",
+	"/usr/local/etc",
+	"/opt",
+	"/srv",
+}
+
+// DiscoveryCertificates discovers the certificates in certificate stores as assets
+const DiscoveryCertificates = "certificates"
+
+// Connection options of certificates that were discovered as assets
+const (
+	OptionCertificatePath        = "certificate-path"
+	OptionCertificateFingerprint = "certificate-fingerprint"
+)
+
+// LabelCertificateHost is the asset label with the name of the system that
+// a certificate was discovered on
+const LabelCertificateHost = "certificate/host"
+
+func initOsCertificateStores(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if paths, ok := args["paths"]; !ok || paths.Value == nil || len(paths.Value.([]interface{})) == 0 {
+		args["paths"] = llx.ArrayData(llx.TArr2Raw(CertificateStorePaths), types.String)
+	}
+	return args, nil, nil
+}
+
+func (s *mqlOsCertificateStores) id() (string, error) {
+	paths := make([]string, len(s.Paths.Data))
+	for i := range s.Paths.Data {
+		paths[i] = s.Paths.Data[i].(string)
+	}
+	return "os.certificateStores/" + strings.Join(paths, ","), nil
+}
+
+// findCertificateStores searches the directories for files that may be
+// certificate stores, based on their names
+func findCertificateStores(runtime *plugin.Runtime, paths []interface{}) ([]string, error) {
+	conn := runtime.Connection.(shared.Connection)
+	if platform := conn.Asset().Platform; platform != nil && platform.IsFamily("windows") {
+		return nil, errors.New("certificate stores are not supported on this platform: " + platform.Name)
+	}
+
+	res := []string{}
+	for i := range paths {
+		dir, err := CreateResource(runtime, "file", map[string]*llx.RawData{
+			"path": llx.StringData(paths[i].(string)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !dir.(*mqlFile).GetExists().Data {
+			continue
+		}
+
+		find, err := CreateResource(runtime, "files.find", map[string]*llx.RawData{
+			"from": llx.StringData(paths[i].(string)),
+			"type": llx.StringData("file"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		files := find.(*mqlFilesFind).GetList()
+		if files.Error != nil {
+			return nil, files.Error
+		}
+
+		for j := range files.Data {
+			path := files.Data[j].(*mqlFile).Path.Data
+			if certstore.IsCandidate(path) {
+				res = append(res, path)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *mqlOsCertificateStores) list() ([]interface{}, error) {
+	found, err := findCertificateStores(s.MqlRuntime, s.Paths.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []interface{}{}
+	for i := range found {
+		o, err := CreateResource(s.MqlRuntime, "os.certificateStore", map[string]*llx.RawData{
+			"path": llx.StringData(found[i]),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		store := o.(*mqlOsCertificateStore)
+		if err := store.fetch(); err != nil {
+			log.Debug().Err(err).Str("path", found[i]).Msg("os.certificateStores> failed to read store")
+			continue
+		}
+		// files with matching names that don't contain certificates, e.g. private keys
+		if store.Format.Data == "" {
+			continue
+		}
+		res = append(res, store)
+	}
+	return res, nil
+}
+
+func initOsCertificateStore(runtime *plugin.Runtime, args map[string]*llx.RawData) (map[string]*llx.RawData, plugin.Resource, error) {
+	if path, ok := args["path"]; ok && path.Value != nil {
+		return args, nil, nil
+	}
+
+	// certificates that were discovered as assets refer to their store
+	conn := runtime.Connection.(shared.Connection)
+	if conf := conn.Asset().Connections; len(conf) != 0 && conf[0].Options[OptionCertificatePath] != "" {
+		args["path"] = llx.StringData(conf[0].Options[OptionCertificatePath])
+		return args, nil, nil
+	}
+	return nil, nil, errors.New("missing path for os.certificateStore")
+}
+
+// certificateFingerprint returns the SHA-256 fingerprint, which is used by
+// the certificate resource as well
+func certificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+type mqlOsCertificateStoreInternal struct {
+	lock    sync.Mutex
+	fetched bool
+	certs   []*x509.Certificate
+}
+
+func (s *mqlOsCertificateStore) id() (string, error) {
+	return "os.certificateStore/" + s.Path.Data, nil
+}
+
+// assetFingerprint returns the fingerprint of the certificate, if this
+// store belongs to an asset of a discovered certificate
+func (s *mqlOsCertificateStore) assetFingerprint() string {
+	conn := s.MqlRuntime.Connection.(shared.Connection)
+	conf := conn.Asset().Connections
+	if len(conf) == 0 || conf[0].Options[OptionCertificatePath] != s.Path.Data {
+		return ""
+	}
+	return conf[0].Options[OptionCertificateFingerprint]
+}
+
+// fetch reads the store and sets its format
+func (s *mqlOsCertificateStore) fetch() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.fetched {
+		return nil
+	}
+
+	file, err := s.file()
+	if err != nil {
+		return err
+	}
+	content := file.GetContent()
+	if content.Error != nil {
+		return content.Error
+	}
+
+	format, certs, err := certstore.Parse(s.Path.Data, []byte(content.Data))
+	encrypted := err == certstore.ErrEncrypted
+	if err != nil && !encrypted {
+		return err
+	}
+
+	if fingerprint := s.assetFingerprint(); fingerprint != "" {
+		filtered := []*x509.Certificate{}
+		for i := range certs {
+			if certificateFingerprint(certs[i]) == fingerprint {
+				filtered = append(filtered, certs[i])
+			}
+		}
+		certs = filtered
+	}
+
+	s.Format = plugin.TValue[string]{Data: format, State: plugin.StateIsSet}
+	s.Encrypted = plugin.TValue[bool]{Data: encrypted, State: plugin.StateIsSet}
+	s.certs = certs
+	s.fetched = true
+	return nil
+}
+
+func (s *mqlOsCertificateStore) format() (string, error) {
+	return "", s.fetch()
+}
+
+func (s *mqlOsCertificateStore) encrypted() (bool, error) {
+	return false, s.fetch()
+}
+
+func (s *mqlOsCertificateStore) file() (*mqlFile, error) {
+	f, err := CreateResource(s.MqlRuntime, "file", map[string]*llx.RawData{
+		"path": llx.StringData(s.Path.Data),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f.(*mqlFile), nil
+}
+
+func (s *mqlOsCertificateStore) certificates() ([]interface{}, error) {
+	if err := s.fetch(); err != nil {
+		return nil, err
+	}
+
+	if len(s.certs) == 0 {
+		return []interface{}{}, nil
+	}
+
+	var content strings.Builder
+	for i := range s.certs {
+		content.Write(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.certs[i].Raw}))
+	}
+
+	certs, err := s.MqlRuntime.CreateSharedResource("certificates", map[string]*llx.RawData{
+		"pem": llx.StringData(content.String()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.MqlRuntime.GetSharedData("certificates", certs.MqlID(), "list")
+	if err != nil {
+		return nil, err
+	}
+	return list.Value.([]interface{}), nil
+}
+
+func isRootCertificate(cert *x509.Certificate) bool {
+	return cert.IsCA && bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignatureFrom(cert) == nil
+}
+
+// DiscoverCertificates returns an asset for every certificate in the
+// certificate stores of the system. Root certificates are skipped, since
+// most of them are part of the trust stores of the system.
+func DiscoverCertificates(runtime *plugin.Runtime) ([]*inventory.Asset, error) {
+	o, err := NewResource(runtime, "os.certificateStores", map[string]*llx.RawData{})
+	if err != nil {
+		return nil, err
+	}
+	stores := o.(*mqlOsCertificateStores).GetList()
+	if stores.Error != nil {
+		return nil, stores.Error
+	}
+
+	conn := runtime.Connection.(shared.Connection)
+	res := []*inventory.Asset{}
+	seen := map[string]struct{}{}
+	for i := range stores.Data {
+		store := stores.Data[i].(*mqlOsCertificateStore)
+		for _, cert := range store.certs {
+			if isRootCertificate(cert) {
+				continue
+			}
+			// the same certificate is often linked to multiple locations
+			fingerprint := certificateFingerprint(cert)
+			if _, ok := seen[fingerprint]; ok {
+				continue
+			}
+			seen[fingerprint] = struct{}{}
+
+			res = append(res, certificateAsset(conn.Asset(), store, cert, fingerprint))
+		}
+	}
+	return res, nil
+}
+
+// certificateAsset returns the asset of a certificate. Its connection keeps
+// the ID of the parent's connection, which the provider reuses if it is
+// still connected.
+func certificateAsset(parent *inventory.Asset, store *mqlOsCertificateStore, cert *x509.Certificate, fingerprint string) *inventory.Asset {
+	conf := parent.Connections[0].Clone(inventory.WithoutDiscovery())
+	if conf.Options == nil {
+		conf.Options = map[string]string{}
+	}
+	conf.Options[OptionCertificatePath] = store.Path.Data
+	conf.Options[OptionCertificateFingerprint] = fingerprint
+
+	name := cert.Subject.CommonName
+	if name == "" {
+		name = cert.Subject.String()
+	}
+
+	return &inventory.Asset{
+		Name:        name + " (" + store.Path.Data + ")",
+		PlatformIds: []string{"//platformid.api.mondoo.app/runtime/certificate/sha256/" + fingerprint},
+		Platform: &inventory.Platform{
+			Name:    "x509-certificate",
+			Title:   "X.509 Certificate",
+			Family:  []string{"certificate"},
+			Kind:    "certificate",
+			Runtime: conf.Type,
+		},
+		Labels: map[string]string{
+			LabelCertificateHost: parent.Name,
+			"certificate/path":   store.Path.Data,
+			"certificate/format": store.Format.Data,
+		},
+		Connections: []*inventory.Config{conf},
+		RelatedAssets: []*inventory.Asset{{
+			Name:        parent.Name,
+			PlatformIds: parent.PlatformIds,
+		}},
+	}
+}
@@ -38,10 +38,10 @@ func ResolveManager(conn shared.Connection) (OSProcessManager, error) {
 		return nil, errors.New("cannot find OS information for package detection")
 	}
 
-	// procfs over ssh is super slow, lets deactivate until we have a faster approach
+	// procfs over ssh or k8s debug pods is super slow, lets deactivate until we have a faster approach
 	disableProcFs := false
 	switch conn.(type) {
-	case *connection.SshConnection:
+	case *connection.SshConnection, *connection.K8sNodeConnection:
 		disableProcFs = true
 	case *mock.Connection:
 		disableProcFs = true
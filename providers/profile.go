@@ -0,0 +1,133 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"time"
+
+	"go.mondoo.com/cnquery/v9/cli/prof"
+	pp "go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+	"google.golang.org/protobuf/proto"
+)
+
+// EnableProfiling adds all calls to providers and all queries to the report.
+// Providers that are already running, like the builtin providers, are
+// profiled from now on as well.
+func (c *coordinator) EnableProfiling(report *prof.Report) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.profile = report
+	for id, x := range builtinProviders {
+		if id != mockProvider.ID {
+			x.Runtime.Plugin = newProfiledPlugin(x.Runtime.Plugin, x.Runtime.Name, report)
+		}
+	}
+	for _, running := range c.RunningByID {
+		running.Plugin = newProfiledPlugin(running.Plugin, running.Name, report)
+	}
+	for running := range c.RunningEphemeral {
+		running.Plugin = newProfiledPlugin(running.Plugin, running.Name, report)
+	}
+}
+
+// ProfileReport returns the report of all provider calls and queries, or
+// nil if profiling is not enabled
+func (c *coordinator) ProfileReport() *prof.Report {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.profile
+}
+
+func (r *Runtime) profileReport() *prof.Report {
+	if r.coordinator == nil {
+		return nil
+	}
+	return r.coordinator.ProfileReport()
+}
+
+func (r *Runtime) ProfilesQueries() bool {
+	return r.profileReport() != nil
+}
+
+func (r *Runtime) ProfileQuery(name string, start time.Time, failed bool) {
+	report := r.profileReport()
+	if report == nil {
+		return
+	}
+	report.Add(prof.Event{
+		Category: prof.CategoryQuery,
+		Name:     name,
+		Start:    start,
+		Duration: time.Since(start),
+		Failed:   failed,
+	})
+}
+
+// profiledPlugin measures all calls that cross the plugin boundary of a
+// provider and adds them to the profile report
+type profiledPlugin struct {
+	pp.ProviderPlugin
+	name   string
+	report *prof.Report
+}
+
+func newProfiledPlugin(plugin pp.ProviderPlugin, name string, report *prof.Report) pp.ProviderPlugin {
+	if _, ok := plugin.(*profiledPlugin); ok {
+		return plugin
+	}
+	return &profiledPlugin{
+		ProviderPlugin: plugin,
+		name:           name,
+		report:         report,
+	}
+}
+
+func (p *profiledPlugin) add(call string, resource string, field string, start time.Time, req proto.Message, res proto.Message, failed bool) {
+	p.report.Add(prof.Event{
+		Category:     prof.CategoryProvider,
+		Name:         call,
+		Provider:     p.name,
+		Resource:     resource,
+		Field:        field,
+		Start:        start,
+		Duration:     time.Since(start),
+		RequestSize:  proto.Size(req),
+		ResponseSize: proto.Size(res),
+		Failed:       failed,
+	})
+}
+
+func (p *profiledPlugin) Connect(req *pp.ConnectReq, callback pp.ProviderCallback) (*pp.ConnectRes, error) {
+	start := time.Now()
+	res, err := p.ProviderPlugin.Connect(req, callback)
+	p.add("Connect", "", "", start, req, res, err != nil)
+	return res, err
+}
+
+func (p *profiledPlugin) MockConnect(req *pp.ConnectReq, callback pp.ProviderCallback) (*pp.ConnectRes, error) {
+	start := time.Now()
+	res, err := p.ProviderPlugin.MockConnect(req, callback)
+	p.add("MockConnect", "", "", start, req, res, err != nil)
+	return res, err
+}
+
+func (p *profiledPlugin) GetData(req *pp.DataReq) (*pp.DataRes, error) {
+	start := time.Now()
+	res, err := p.ProviderPlugin.GetData(req)
+	p.add("GetData", req.Resource, req.Field, start, req, res, err != nil || res.GetError() != "")
+	return res, err
+}
+
+func (p *profiledPlugin) StoreData(req *pp.StoreReq) (*pp.StoreRes, error) {
+	var resource string
+	if len(req.Resources) == 1 {
+		resource = req.Resources[0].Name
+	}
+
+	start := time.Now()
+	res, err := p.ProviderPlugin.StoreData(req)
+	p.add("StoreData", resource, "", start, req, res, err != nil)
+	return res, err
+}
@@ -0,0 +1,60 @@
+// Copyright (c) Mondoo, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package providers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mondoo.com/cnquery/v9/cli/prof"
+	"go.mondoo.com/cnquery/v9/llx"
+	"go.mondoo.com/cnquery/v9/providers-sdk/v1/plugin"
+)
+
+func TestEnableProfiling(t *testing.T) {
+	// builtin providers are started before profiling can be enabled
+	builtinPlugins := map[string]plugin.ProviderPlugin{}
+	for id, x := range builtinProviders {
+		builtinPlugins[id] = x.Runtime.Plugin
+	}
+	t.Cleanup(func() {
+		for id, x := range builtinProviders {
+			x.Runtime.Plugin = builtinPlugins[id]
+		}
+	})
+
+	running := &RunningProvider{Name: "test", ID: "test", Plugin: &cachingProvider{created: map[string]bool{}, requests: map[string]int{}}}
+	c := &coordinator{
+		RunningByID:      map[string]*RunningProvider{"test": running},
+		RunningEphemeral: map[*RunningProvider]struct{}{},
+		runtimes:         map[string]*Runtime{},
+	}
+	runtime := &Runtime{coordinator: c}
+	assert.False(t, runtime.ProfilesQueries())
+
+	report := prof.NewReport()
+	c.EnableProfiling(report)
+	assert.Same(t, report, c.ProfileReport())
+	require.IsType(t, &profiledPlugin{}, running.Plugin)
+	require.IsType(t, &profiledPlugin{}, builtinProviders[BuiltinCoreID].Runtime.Plugin)
+	_, isProfiled := builtinProviders[mockProvider.ID].Runtime.Plugin.(*profiledPlugin)
+	assert.False(t, isProfiled)
+
+	_, err := running.Plugin.GetData(&plugin.DataReq{Resource: "packages", Field: "list"})
+	require.NoError(t, err)
+
+	var profiler llx.QueryProfiler = runtime
+	require.True(t, profiler.ProfilesQueries())
+	profiler.ProfileQuery("packages.list", time.Now(), false)
+
+	events := report.Events()
+	require.Len(t, events, 2)
+	assert.Equal(t, prof.CategoryProvider, events[0].Category)
+	assert.Equal(t, "test", events[0].Provider)
+	assert.Equal(t, "packages", events[0].Resource)
+	assert.Equal(t, prof.CategoryQuery, events[1].Category)
+	assert.Equal(t, "packages.list", events[1].Name)
+}
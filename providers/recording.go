@@ -72,6 +72,12 @@ type resourceRecording struct {
 	Resource string
 	ID       string
 	Fields   map[string]*llx.RawData
+	// Expires is only set for cached resources and holds the unix time
+	// after which the resource data is stale
+	Expires int64 `json:",omitempty"`
+	// Complete is only set for cached resources whose static fields are
+	// cached, so that providers can create them again
+	Complete bool `json:",omitempty"`
 }
 
 type NullRecording struct{}
@@ -188,6 +194,19 @@ func LoadRecordingFile(path string) (*recording, error) {
 }
 
 func (r *recording) Save() error {
+	if err := r.store(); err != nil {
+		return err
+	}
+
+	log.Info().Msg("stored recording in " + r.Path)
+	return nil
+}
+
+func (r *recording) store() error {
+	return r.storeFile(0o644)
+}
+
+func (r *recording) storeFile(perm os.FileMode) error {
 	r.finalize()
 
 	var raw []byte
@@ -201,11 +220,9 @@ func (r *recording) Save() error {
 		return multierr.Wrap(err, "failed to marshal json for recording")
 	}
 
-	if err := os.WriteFile(r.Path, raw, 0o644); err != nil {
+	if err := os.WriteFile(r.Path, raw, perm); err != nil {
 		return multierr.Wrap(err, "failed to store recording")
 	}
-
-	log.Info().Msg("stored recording in " + r.Path)
 	return nil
 }
 
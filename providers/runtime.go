@@ -207,6 +207,11 @@ func (r *Runtime) Connect(req *plugin.ConnectReq) error {
 	callbacks := providerCallbacks{
 		runtime: r,
 	}
+	// Cached results are served like recordings: the provider needs to be able
+	// to initialize resources from the cache, which it never created itself.
+	if _, ok := r.Recording.(*resultCache); ok {
+		req.HasRecording = true
+	}
 
 	var err error
 	r.Provider.Connection, err = r.Provider.Instance.Plugin.Connect(req, &callbacks)
@@ -363,10 +368,50 @@ func (r *Runtime) watchAndUpdate(resource string, resourceID string, field strin
 		raw = data.Data.RawData()
 	}
 
-	r.Recording.AddData(provider.Connection.Id, resource, resourceID, field, raw)
+	if cache, ok := r.Recording.(*resultCache); ok && raw.Type.ContainsResource() {
+		cache.AddResourceData(provider.Connection.Id, resource, resourceID, field, raw, r.staticFields)
+	} else {
+		r.Recording.AddData(provider.Connection.Id, resource, resourceID, field, raw)
+	}
 	return raw, nil
 }
 
+// staticFields retrieves the fields of a resource that its provider sets when
+// it creates the resource. Unset fields are skipped.
+func (r *Runtime) staticFields(resource string, id string) (map[string]*llx.RawData, error) {
+	provider, info, err := r.lookupResourceProvider(resource)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil || provider.Connection == nil || info == nil {
+		return nil, errors.New("cannot find provider for resource '" + resource + "'")
+	}
+
+	res := map[string]*llx.RawData{}
+	for name, field := range info.Fields {
+		if !field.IsMandatory || (field.Provider != "" && field.Provider != info.Provider) {
+			continue
+		}
+		data, err := provider.Instance.Plugin.GetData(&plugin.DataReq{
+			Connection: provider.Connection.Id,
+			Resource:   resource,
+			ResourceId: id,
+			Field:      name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if data.Error != "" {
+			return nil, errors.New(data.Error)
+		}
+		if data.Data == nil {
+			continue
+		}
+		res[name] = data.Data.RawData()
+	}
+	return res, nil
+}
+
 func (r *Runtime) handlePluginError(err error, provider *ConnectedProvider) (bool, error) {
 	st, ok := status.FromError(err)
 	if !ok {
@@ -390,7 +435,7 @@ type providerCallbacks struct {
 }
 
 func (p *providerCallbacks) GetRecording(req *plugin.DataReq) (*plugin.ResourceData, error) {
-	resource, ok := p.recording.resources[req.Resource+"\x00"+req.ResourceId]
+	fields, ok := p.recordedFields(req.Resource, req.ResourceId)
 	if !ok {
 		return nil, nil
 	}
@@ -398,15 +443,33 @@ func (p *providerCallbacks) GetRecording(req *plugin.DataReq) (*plugin.ResourceD
 	res := plugin.ResourceData{
 		Name:   req.Resource,
 		Id:     req.ResourceId,
-		Fields: make(map[string]*llx.Result, len(resource.Fields)),
+		Fields: make(map[string]*llx.Result, len(fields)),
 	}
-	for k, v := range resource.Fields {
+	for k, v := range fields {
 		res.Fields[k] = v.Result()
 	}
 
 	return &res, nil
 }
 
+func (p *providerCallbacks) recordedFields(resource string, id string) (map[string]*llx.RawData, bool) {
+	if p.recording != nil {
+		obj, ok := p.recording.resources[resource+"\x00"+id]
+		if !ok {
+			return nil, false
+		}
+		return obj.Fields, true
+	}
+
+	// without a mocked recording we look at the runtime's recording,
+	// which is how cached results are served
+	provider := p.runtime.Provider
+	if p.runtime.Recording == nil || provider == nil || provider.Connection == nil {
+		return nil, false
+	}
+	return p.runtime.Recording.GetResource(provider.Connection.Id, resource, id)
+}
+
 func (p *providerCallbacks) GetData(req *plugin.DataReq) (*plugin.DataRes, error) {
 	if req.Field == "" {
 		res, err := p.runtime.CreateResource(req.Resource, req.Args)